@@ -5,21 +5,40 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error)
+	GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error)
+	GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetKeyValuePairs(address string, prefix string, cursor string, pageSize int, options state.AccountQueryOptions) (*state.ApiKeyValuePairs, error)
+	GetTransactionsHistory(address string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error)
+	GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error)
+	GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error)
+	GetProof(address string) (*state.ApiProof, error)
+	GetProofDataTrie(address string, key string) (*state.ApiProof, error)
 	IsInterfaceNil() bool
 }
 
+const (
+	cursorEpochQueryParam = "epoch"
+	cursorNonceQueryParam = "nonce"
+	keyPrefixQueryParam   = "prefix"
+	keysCursorQueryParam  = "cursor"
+	pageSizeQueryParam    = "size"
+	defaultPageSize       = 100
+)
+
 type accountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
@@ -34,10 +53,17 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/:address", GetAccount)
 	router.RegisterHandler(http.MethodGet, "/:address/balance", GetBalance)
 	router.RegisterHandler(http.MethodGet, "/:address/key/:key", GetValueForKey)
+	router.RegisterHandler(http.MethodGet, "/:address/keys", GetKeyValuePairs)
+	router.RegisterHandler(http.MethodGet, "/:address/proof", GetProof)
+	router.RegisterHandler(http.MethodGet, "/:address/key/:key/proof", GetProofDataTrie)
+	router.RegisterHandler(http.MethodGet, "/:address/transactions", GetTransactionsHistory)
+	router.RegisterHandler(http.MethodGet, "/:address/esdt", GetAllESDTTokens)
+	router.RegisterHandler(http.MethodGet, "/:address/esdt/:tokenIdentifier", GetESDTBalance)
 }
 
 // GetAccount returns an accountResponse containing information
-//  about the account correlated with provided address
+//  about the account correlated with provided address, optionally as it was at the block selected by the
+//  blockNonce or blockHash query parameters
 func GetAccount(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -45,8 +71,14 @@ func GetAccount(c *gin.Context) {
 		return
 	}
 
+	options, err := shared.GetAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+
 	addr := c.Param("address")
-	acc, err := ef.GetAccount(addr)
+	acc, err := ef.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
 		return
@@ -54,7 +86,8 @@ func GetAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
 }
 
-// GetBalance returns the balance for the address parameter
+// GetBalance returns the balance for the address parameter, optionally as it was at the block selected by the
+// blockNonce or blockHash query parameters
 func GetBalance(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -68,7 +101,13 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ef.GetBalance(addr)
+	options, err := shared.GetAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
+		return
+	}
+
+	balance, err := ef.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
 		return
@@ -77,7 +116,8 @@ func GetBalance(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"balance": balance.String()})
 }
 
-// GetValueForKey returns the value for the given address and key
+// GetValueForKey returns the value for the given address and key, optionally as it was at the block selected by the
+// blockNonce or blockHash query parameters
 func GetValueForKey(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -97,7 +137,13 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := ef.GetValueForKey(addr, key)
+	options, err := shared.GetAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error())})
+		return
+	}
+
+	value, err := ef.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error())})
 		return
@@ -106,6 +152,224 @@ func GetValueForKey(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"value": value})
 }
 
+// GetKeyValuePairs returns a page of the key-value pairs from the data trie of the given address, in the data trie
+// order, optionally filtered by the hex encoded prefix query parameter and as it was at the block selected by the
+// blockNonce or blockHash query parameters. The next page can be requested by providing the returned opaque cursor as
+// the cursor query parameter
+func GetKeyValuePairs(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	options, err := shared.GetAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error())})
+		return
+	}
+
+	pageSize, err := getQueryParamPageSize(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error())})
+		return
+	}
+
+	prefix := c.Query(keyPrefixQueryParam)
+	cursor := c.Query(keysCursorQueryParam)
+	pairs, err := ef.GetKeyValuePairs(addr, prefix, cursor, pageSize, options)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"keys": pairs})
+}
+
+// GetTransactionsHistory returns a page of the transactions which touched the given address, from the newest to the
+// oldest. The next page can be requested by providing the returned cursor as the epoch and nonce query parameters
+func GetTransactionsHistory(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsHistory.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	cursor, err := getQueryParamsHistoryCursor(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsHistory.Error(), err.Error())})
+		return
+	}
+
+	pageSize, err := getQueryParamPageSize(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsHistory.Error(), err.Error())})
+		return
+	}
+
+	history, err := ef.GetTransactionsHistory(addr, cursor, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsHistory.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"history": history})
+}
+
+// GetAllESDTTokens returns all the elrond standard digital tokens held by the given address
+func GetAllESDTTokens(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	tokens, err := ef.GetAllESDTTokens(addr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"esdts": tokens})
+}
+
+// GetESDTBalance returns the balance of the given elrond standard digital token held by the given address
+func GetESDTBalance(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), errors.ErrEmptyTokenIdentifier.Error())})
+		return
+	}
+
+	balance, err := ef.GetESDTBalance(addr, tokenIdentifier)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"esdt": balance})
+}
+
+// GetProof returns the Merkle proof of the given address, computed against the state of the last committed block
+func GetProof(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	proof, err := ef.GetProof(addr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": proof})
+}
+
+// GetProofDataTrie returns the Merkle proof of the given address, computed against the state of the last committed
+// block, together with the data trie root hash of the account and the Merkle proof of the given key from its data trie
+func GetProofDataTrie(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error())})
+		return
+	}
+
+	proof, err := ef.GetProofDataTrie(addr, key)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": proof})
+}
+
+func getQueryParamsHistoryCursor(c *gin.Context) (*transaction.ApiHistoryCursor, error) {
+	epochStr := c.Query(cursorEpochQueryParam)
+	nonceStr := c.Query(cursorNonceQueryParam)
+	if epochStr == "" && nonceStr == "" {
+		return nil, nil
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, cursorEpochQueryParam)
+	}
+
+	nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, cursorNonceQueryParam)
+	}
+
+	return &transaction.ApiHistoryCursor{
+		Epoch: uint32(epoch),
+		Nonce: nonce,
+	}, nil
+}
+
+func getQueryParamPageSize(c *gin.Context) (int, error) {
+	pageSizeStr := c.Query(pageSizeQueryParam)
+	if pageSizeStr == "" {
+		return defaultPageSize, nil
+	}
+
+	pageSize, err := strconv.Atoi(pageSizeStr)
+	if err != nil || pageSize < 1 {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, pageSizeQueryParam)
+	}
+
+	return pageSize, nil
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -17,10 +17,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // General response structure
@@ -78,7 +81,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -103,7 +106,7 @@ func TestGetBalance_WithWrongAddressShouldReturnZero(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -129,7 +132,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -149,7 +152,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnZeroAndError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -194,7 +197,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ state.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -212,6 +215,478 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	assert.Equal(t, testValue, valueForKeyResponseObj.Value)
 }
 
+func TestGetValueForKey_WithBlockHashShouldPassQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	testValue := "value"
+	facade := mock.Facade{
+		GetValueForKeyCalled: func(_ string, _ string, options state.AccountQueryOptions) (string, error) {
+			assert.Equal(t, state.AccountQueryOptions{BlockHash: []byte{0xab, 0xcd}}, options)
+			return testValue, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/key/test?blockHash=abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	valueForKeyResponseObj := valueForKeyResponse{}
+	loadResponse(resp.Body, &valueForKeyResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, testValue, valueForKeyResponseObj.Value)
+}
+
+type transactionsHistoryResponse struct {
+	GeneralResponse
+	History transaction.ApiAddressHistory `json:"history"`
+}
+
+type keyValuePairsResponse struct {
+	GeneralResponse
+	Keys state.ApiKeyValuePairs `json:"keys"`
+}
+
+type esdtTokensResponse struct {
+	GeneralResponse
+	ESDTs []*esdt.ApiESDTBalance `json:"esdts"`
+}
+
+type esdtBalanceResponse struct {
+	GeneralResponse
+	ESDT *esdt.ApiESDTBalance `json:"esdt"`
+}
+
+type proofResponse struct {
+	GeneralResponse
+	Proof *state.ApiProof `json:"proof"`
+}
+
+func TestGetTransactionsHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	var providedCursor *transaction.ApiHistoryCursor
+	providedPageSize := 0
+	facade := mock.Facade{
+		GetTransactionsHistoryCalled: func(address string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error) {
+			assert.Equal(t, testAddress, address)
+			providedCursor = cursor
+			providedPageSize = pageSize
+
+			return &transaction.ApiAddressHistory{
+				Transactions: []*transaction.ApiAddressHistoryEntry{{Hash: "aa", Type: "normal", BlockNonce: 3, Epoch: 1}},
+				NextCursor:   &transaction.ApiHistoryCursor{Epoch: 1, Nonce: 3},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?epoch=2&nonce=10&size=5", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, &transaction.ApiHistoryCursor{Epoch: 2, Nonce: 10}, providedCursor)
+	assert.Equal(t, 5, providedPageSize)
+	assert.Equal(t, 1, len(response.History.Transactions))
+	assert.Equal(t, "aa", response.History.Transactions[0].Hash)
+	assert.Equal(t, &transaction.ApiHistoryCursor{Epoch: 1, Nonce: 3}, response.History.NextCursor)
+}
+
+func TestGetTransactionsHistory_NoQueryParamsShouldUseDefaults(t *testing.T) {
+	t.Parallel()
+
+	var providedCursor *transaction.ApiHistoryCursor
+	providedPageSize := 0
+	facade := mock.Facade{
+		GetTransactionsHistoryCalled: func(_ string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error) {
+			providedCursor = cursor
+			providedPageSize = pageSize
+
+			return &transaction.ApiAddressHistory{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Nil(t, providedCursor)
+	assert.Equal(t, 100, providedPageSize)
+}
+
+func TestGetTransactionsHistory_InvalidQueryParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"epoch=1", "nonce=1", "epoch=a&nonce=1", "epoch=1&nonce=-1", "size=0", "size=a"} {
+		req, _ := http.NewRequest("GET", "/address/address/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetTransactionsHistory_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsHistoryCalled: func(_ string, _ *transaction.ApiHistoryCursor, _ int) (*transaction.ApiAddressHistory, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetTransactionsHistory.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	providedPrefix := ""
+	providedCursor := ""
+	providedPageSize := 0
+	var providedOptions state.AccountQueryOptions
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(address string, prefix string, cursor string, pageSize int, options state.AccountQueryOptions) (*state.ApiKeyValuePairs, error) {
+			assert.Equal(t, testAddress, address)
+			providedPrefix = prefix
+			providedCursor = cursor
+			providedPageSize = pageSize
+			providedOptions = options
+
+			return &state.ApiKeyValuePairs{
+				Pairs:      []*state.ApiKeyValuePair{{Key: "aa01", Value: "ff"}},
+				NextCursor: "aa02",
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?prefix=aa&cursor=aa01&size=1&blockNonce=7", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aa", providedPrefix)
+	assert.Equal(t, "aa01", providedCursor)
+	assert.Equal(t, 1, providedPageSize)
+	assert.Equal(t, state.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true}, providedOptions)
+	require.Equal(t, 1, len(response.Keys.Pairs))
+	assert.Equal(t, &state.ApiKeyValuePair{Key: "aa01", Value: "ff"}, response.Keys.Pairs[0])
+	assert.Equal(t, "aa02", response.Keys.NextCursor)
+}
+
+func TestGetKeyValuePairs_NoQueryParamsShouldUseDefaults(t *testing.T) {
+	t.Parallel()
+
+	providedPageSize := 0
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, prefix string, cursor string, pageSize int, _ state.AccountQueryOptions) (*state.ApiKeyValuePairs, error) {
+			assert.Equal(t, "", prefix)
+			assert.Equal(t, "", cursor)
+			providedPageSize = pageSize
+
+			return &state.ApiKeyValuePairs{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 100, providedPageSize)
+}
+
+func TestGetKeyValuePairs_InvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"size=0", "size=a"} {
+		req, _ := http.NewRequest("GET", "/address/address/keys?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetKeyValuePairs_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, _ string, _ string, _ int, _ state.AccountQueryOptions) (*state.ApiKeyValuePairs, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetKeyValuePairs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAllESDTTokens_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.Facade{
+		GetAllESDTTokensCalled: func(address string) ([]*esdt.ApiESDTBalance, error) {
+			assert.Equal(t, testAddress, address)
+
+			return []*esdt.ApiESDTBalance{
+				{TokenIdentifier: "TKA-0a1b2c", Balance: "10"},
+				{TokenIdentifier: "TKB-3d4e5f", Balance: "20", Frozen: true},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/esdt", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(response.ESDTs))
+	assert.Equal(t, "TKA-0a1b2c", response.ESDTs[0].TokenIdentifier)
+	assert.Equal(t, "20", response.ESDTs[1].Balance)
+	assert.True(t, response.ESDTs[1].Frozen)
+}
+
+func TestGetAllESDTTokens_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAllESDTTokensCalled: func(_ string) ([]*esdt.ApiESDTBalance, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetESDTTokens.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTBalance_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testToken := "TKA-0a1b2c"
+	facade := mock.Facade{
+		GetESDTBalanceCalled: func(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testToken, tokenIdentifier)
+
+			return &esdt.ApiESDTBalance{TokenIdentifier: tokenIdentifier, Balance: "37"}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/esdt/%s", testAddress, testToken), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtBalanceResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.ESDT)
+	assert.Equal(t, testToken, response.ESDT.TokenIdentifier)
+	assert.Equal(t, "37", response.ESDT.Balance)
+	assert.False(t, response.ESDT.Frozen)
+}
+
+func TestGetESDTBalance_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTBalanceCalled: func(_ string, _ string) (*esdt.ApiESDTBalance, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt/TKA-0a1b2c", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetESDTBalance.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedProof := &state.ApiProof{
+		RootHash:   "aabb",
+		BlockNonce: 37,
+		Proof:      []string{"aa", "bb"},
+	}
+	facade := mock.Facade{
+		GetProofCalled: func(address string) (*state.ApiProof, error) {
+			assert.Equal(t, testAddress, address)
+
+			return expectedProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, response.Proof)
+}
+
+func TestGetProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofCalled: func(_ string) (*state.ApiProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProofDataTrie_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testKey := "6b6579"
+	expectedProof := &state.ApiProof{
+		RootHash:         "aabb",
+		BlockNonce:       37,
+		Proof:            []string{"cc"},
+		DataTrieRootHash: "dd",
+		DataTrieProof:    []string{"ee", "ff"},
+	}
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(address string, key string) (*state.ApiProof, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testKey, key)
+
+			return expectedProof, nil
+		},
+		GetValueForKeyCalled: func(_ string, _ string, _ state.AccountQueryOptions) (string, error) {
+			assert.Fail(t, "should have not called GetValueForKey")
+			return "", nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/%s/proof", testAddress, testKey), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, response.Proof)
+}
+
+func TestGetProofDataTrie_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(_ string, _ string) (*state.ApiProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/key/6b6579/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
 func TestGetAccount_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	t.Parallel()
 
@@ -230,7 +705,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -251,7 +726,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -275,6 +750,65 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_WithBlockNonceShouldPassQueryOptions(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountHandler: func(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+			assert.Equal(t, state.AccountQueryOptions{BlockNonce: 10, HasBlockNonce: true}, options)
+			return state.NewUserAccount([]byte("1234"))
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, accountResponse.Error)
+}
+
+func TestGetAccount_InvalidBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountHandler: func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
+			assert.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, errors2.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetBalance_RootHashNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
+			return nil, state.ErrRootHashNotAvailable
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/balance?blockNonce=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	addressResponse := NewAddressResponse()
+	loadResponse(resp.Body, &addressResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(addressResponse.Error, state.ErrRootHashNotAvailable.Error()))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -319,6 +853,12 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/keys", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/esdt", Open: true},
+					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
 				},
 			},
 		},
@@ -6,7 +6,10 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
@@ -144,6 +147,27 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		hardfork.Routes(wrappedHardforkRouter)
 	}
 
+	blockRoutes := ws.Group("/block")
+	blockRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
+	if err == nil {
+		block.Routes(wrappedBlockRouter)
+	}
+
+	hyperblockRoutes := ws.Group("/hyperblock")
+	hyperblockRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedHyperblockRouter, err := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, routesConfig)
+	if err == nil {
+		hyperblock.Routes(wrappedHyperblockRouter)
+	}
+
+	esdtRoutes := ws.Group("/esdt")
+	esdtRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedESDTRouter, err := wrapper.NewRouterWrapper("esdt", esdtRoutes, routesConfig)
+	if err == nil {
+		esdt.Routes(wrappedESDTRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -0,0 +1,108 @@
+package block
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-gonic/gin"
+)
+
+const withTxsQueryParam = "withTxs"
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines block related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/by-nonce/:nonce", GetBlockByNonce)
+	router.RegisterHandler(http.MethodGet, "/by-hash/:hash", GetBlockByHash)
+}
+
+// GetBlockByNonce returns the block having the provided nonce. If the withTxs query parameter is set, all the
+// transactions included in the block are returned as well
+func GetBlockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	apiBlock, err := ef.GetBlockByNonce(nonce, withTxs)
+	if err != nil {
+		c.JSON(shared.GetBlockErrorStatusCode(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"block": apiBlock})
+}
+
+// GetBlockByHash returns the block having the provided hex encoded hash. If the withTxs query parameter is set,
+// all the transactions included in the block are returned as well
+func GetBlockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error())})
+		return
+	}
+	_, err := hex.DecodeString(hash)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockHash.Error())})
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	apiBlock, err := ef.GetBlockByHash(hash, withTxs)
+	if err != nil {
+		c.JSON(shared.GetBlockErrorStatusCode(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"block": apiBlock})
+}
+
+func getQueryParamWithTxs(c *gin.Context) (bool, error) {
+	withTxsStr := c.Query(withTxsQueryParam)
+	if withTxsStr == "" {
+		return false, nil
+	}
+
+	withTxs, err := strconv.ParseBool(withTxsStr)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, withTxsQueryParam)
+	}
+
+	return withTxs, nil
+}
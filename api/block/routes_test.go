@@ -0,0 +1,262 @@
+package block_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GeneralResponse struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+type blockResponse struct {
+	GeneralResponse
+	Block *dataBlock.ApiBlock `json:"block"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBlockByNonce_InvalidWithTxsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37?withTxs=maybe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetBlockByNonce_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(_ uint64, _ bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*dataBlock.ApiBlock, error) {
+			assert.True(t, withTxs)
+			return &dataBlock.ApiBlock{Nonce: nonce, Hash: "hash"}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(37), response.Block.Nonce)
+	assert.Equal(t, "hash", response.Block.Hash)
+}
+
+func TestGetBlockByHash_InvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facadeCalled := false
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(_ string, _ bool) (*dataBlock.ApiBlock, error) {
+			facadeCalled = true
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-hash/zz", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockHash.Error()))
+	assert.False(t, facadeCalled)
+}
+
+func TestGetBlockByHash_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(_ string, _ bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByHash_NotFoundShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, _ bool) (*dataBlock.ApiBlock, error) {
+			return nil, fmt.Errorf("%w: hash %s", data.ErrBlockNotFound, hash)
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, data.ErrBlockNotFound.Error()))
+}
+
+func TestGetBlockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			assert.False(t, withTxs)
+			return &dataBlock.ApiBlock{Nonce: 37, Hash: hash}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", response.Block.Hash)
+}
+
+func TestGetBlock_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler block.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoutes := ws.Group("/block")
+	if handler != nil {
+		blockRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	blockRoutesWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRoutesWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	blockRoutes := ws.Group("/block")
+	blockRoutesWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRoutesWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
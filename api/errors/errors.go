@@ -42,3 +42,39 @@ var ErrTxNotFound = errors.New("transaction was not found")
 
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
+
+// ErrGetBlock signals an error happened when trying to fetch a block
+var ErrGetBlock = errors.New("block getting failed")
+
+// ErrInvalidBlockNonce signals that an invalid block nonce was provided
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrValidationEmptyBlockHash signals an empty block hash was provided
+var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
+
+// ErrInvalidBlockHash signals that a block hash which is not hex encoded was provided
+var ErrInvalidBlockHash = errors.New("invalid block hash")
+
+// ErrInvalidQueryParameter signals that an invalid query parameter was provided
+var ErrInvalidQueryParameter = errors.New("invalid query parameter")
+
+// ErrGetKeyValuePairs signals an error in getting the key-value pairs of an account
+var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
+
+// ErrGetTransactionsHistory signals an error happened when trying to fetch the transactions history of an account
+var ErrGetTransactionsHistory = errors.New("get transactions history error")
+
+// ErrGetESDTTokens signals an error happened when trying to fetch the ESDT tokens held by an account
+var ErrGetESDTTokens = errors.New("get esdt tokens error")
+
+// ErrGetESDTBalance signals an error happened when trying to fetch the ESDT balance of an account
+var ErrGetESDTBalance = errors.New("get esdt balance error")
+
+// ErrGetESDTTokenData signals an error happened when trying to fetch the data of an ESDT token
+var ErrGetESDTTokenData = errors.New("get esdt token data error")
+
+// ErrGetProof signals an error happened when trying to compute a Merkle proof
+var ErrGetProof = errors.New("get proof error")
+
+// ErrEmptyTokenIdentifier signals that an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("token identifier is empty")
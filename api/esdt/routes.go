@@ -0,0 +1,45 @@
+package esdt
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines esdt related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/:tokenIdentifier", GetESDTTokenData)
+}
+
+// GetESDTTokenData returns the issuer, the supply and the properties of the provided elrond standard digital token
+func GetESDTTokenData(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTTokenData.Error(), errors.ErrEmptyTokenIdentifier.Error())})
+		return
+	}
+
+	tokenData, err := ef.GetESDTTokenData(tokenIdentifier)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetESDTTokenData.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": tokenData})
+}
@@ -0,0 +1,153 @@
+package esdt_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	esdtApi "github.com/ElrondNetwork/elrond-go/api/esdt"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type GeneralResponse struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+type esdtTokenResponse struct {
+	GeneralResponse
+	Token *esdt.ApiESDTToken `json:"token"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetESDTTokenData_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTTokenDataCalled: func(_ string) (*esdt.ApiESDTToken, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/esdt/TKN-0a1b2c", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTTokenData.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTTokenData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetESDTTokenDataCalled: func(tokenIdentifier string) (*esdt.ApiESDTToken, error) {
+			return &esdt.ApiESDTToken{
+				TokenIdentifier: tokenIdentifier,
+				Issuer:          "erd1issuer",
+				Supply:          "1000",
+				Burnable:        true,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/esdt/TKN-0a1b2c", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokenResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Token)
+	assert.Equal(t, "TKN-0a1b2c", response.Token.TokenIdentifier)
+	assert.Equal(t, "erd1issuer", response.Token.Issuer)
+	assert.Equal(t, "1000", response.Token.Supply)
+	assert.True(t, response.Token.Burnable)
+	assert.False(t, response.Token.Mintable)
+}
+
+func TestGetESDTTokenData_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/esdt/TKN-0a1b2c", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler esdtApi.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	esdtRoutes := ws.Group("/esdt")
+	if handler != nil {
+		esdtRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	esdtRoutesWrapper, _ := wrapper.NewRouterWrapper("esdt", esdtRoutes, getRoutesConfig())
+	esdtApi.Routes(esdtRoutesWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	esdtRoutes := ws.Group("/esdt")
+	esdtRoutesWrapper, _ := wrapper.NewRouterWrapper("esdt", esdtRoutes, getRoutesConfig())
+	esdtApi.Routes(esdtRoutesWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"esdt": {
+				Routes: []config.RouteConfig{
+					{Name: "/:tokenIdentifier", Open: true},
+				},
+			},
+		},
+	}
+}
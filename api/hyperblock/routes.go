@@ -0,0 +1,78 @@
+package hyperblock
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error)
+	GetHyperblockByHash(hash string) (*block.ApiHyperblock, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines hyperblock related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/by-nonce/:nonce", GetHyperblockByNonce)
+	router.RegisterHandler(http.MethodGet, "/by-hash/:hash", GetHyperblockByHash)
+}
+
+// GetHyperblockByNonce returns the hyperblock built around the metachain block having the provided nonce
+func GetHyperblockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	hyperblock, err := ef.GetHyperblockByNonce(nonce)
+	if err != nil {
+		c.JSON(shared.GetBlockErrorStatusCode(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"hyperblock": hyperblock})
+}
+
+// GetHyperblockByHash returns the hyperblock built around the metachain block having the provided hex encoded hash
+func GetHyperblockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error())})
+		return
+	}
+	_, err := hex.DecodeString(hash)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockHash.Error())})
+		return
+	}
+
+	hyperblock, err := ef.GetHyperblockByHash(hash)
+	if err != nil {
+		c.JSON(shared.GetBlockErrorStatusCode(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"hyperblock": hyperblock})
+}
@@ -0,0 +1,222 @@
+package hyperblock_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GeneralResponse struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+type hyperblockResponse struct {
+	GeneralResponse
+	Hyperblock *block.ApiHyperblock `json:"hyperblock"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetHyperblockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetHyperblockByNonce_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(_ uint64) (*block.ApiHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByNonce_NotFoundShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(nonce uint64) (*block.ApiHyperblock, error) {
+			return nil, fmt.Errorf("%w: nonce %d", data.ErrBlockNotFound, nonce)
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, data.ErrBlockNotFound.Error()))
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(nonce uint64) (*block.ApiHyperblock, error) {
+			return &block.ApiHyperblock{Nonce: nonce}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(37), response.Hyperblock.Nonce)
+}
+
+func TestGetHyperblockByHash_InvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facadeCalled := false
+	facade := mock.Facade{
+		GetHyperblockByHashCalled: func(_ string) (*block.ApiHyperblock, error) {
+			facadeCalled = true
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/zz", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockHash.Error()))
+	assert.False(t, facadeCalled)
+}
+
+func TestGetHyperblockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetHyperblockByHashCalled: func(hash string) (*block.ApiHyperblock, error) {
+			return &block.ApiHyperblock{Hash: hash}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", response.Hyperblock.Hash)
+}
+
+func TestGetHyperblock_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler hyperblock.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	hyperblockRoutes := ws.Group("/hyperblock")
+	if handler != nil {
+		hyperblockRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	hyperblockRoutesWrapper, _ := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoutesWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	hyperblockRoutes := ws.Group("/hyperblock")
+	hyperblockRoutesWrapper, _ := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoutesWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"hyperblock": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -58,7 +59,7 @@ func TestSourceThrottler_LimitBadRequestShouldErr(t *testing.T) {
 	t.Parallel()
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -78,7 +79,7 @@ func TestSourceThrottler_LimitUnderShouldProcessRequest(t *testing.T) {
 	t.Parallel()
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -98,7 +99,7 @@ func TestSourceThrottler_LimitOverShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -123,7 +124,7 @@ func TestSourceThrottler_LimitResetShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
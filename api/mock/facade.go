@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -20,21 +22,87 @@ type Facade struct {
 	ShouldErrorStop                   bool
 	TpsBenchmarkHandler               func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler              func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler                    func(string) (*big.Int, error)
-	GetAccountHandler                 func(address string) (state.UserAccountHandler, error)
+	BalanceHandler                    func(string, state.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler                 func(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
 	GenerateTransactionHandler        func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler             func(hash string) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler        func(tx *transaction.Transaction) error
+	CreateRelayedTransactionHandler   func(userTx *transaction.Transaction) (*transaction.Transaction, error)
 	SendBulkTransactionsHandler       func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryHandler             func(query *process.SCQuery, options state.AccountQueryOptions) (*vmcommon.VMOutput, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler        func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                  func() map[string]interface{}
 	GetQueryHandlerCalled             func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled        func(hash string) (string, error)
-	GetValueForKeyCalled              func(address string, key string) (string, error)
+	GetValueForKeyCalled              func(address string, key string, options state.AccountQueryOptions) (string, error)
+	GetBlockByNonceCalled             func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHashCalled              func(hash string, withTxs bool) (*block.ApiBlock, error)
+	GetHyperblockByNonceCalled        func(nonce uint64) (*block.ApiHyperblock, error)
+	GetHyperblockByHashCalled         func(hash string) (*block.ApiHyperblock, error)
+	GetKeyValuePairsCalled            func(address string, prefix string, cursor string, pageSize int, options state.AccountQueryOptions) (*state.ApiKeyValuePairs, error)
+	GetTransactionsHistoryCalled      func(address string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error)
+	GetAllESDTTokensCalled            func(address string) ([]*esdt.ApiESDTBalance, error)
+	GetESDTBalanceCalled              func(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error)
+	GetESDTTokenDataCalled            func(tokenIdentifier string) (*esdt.ApiESDTToken, error)
+	GetProofCalled                    func(address string) (*state.ApiProof, error)
+	GetProofDataTrieCalled            func(address string, key string) (*state.ApiProof, error)
+}
+
+// GetProof -
+func (f *Facade) GetProof(address string) (*state.ApiProof, error) {
+	return f.GetProofCalled(address)
+}
+
+// GetProofDataTrie -
+func (f *Facade) GetProofDataTrie(address string, key string) (*state.ApiProof, error) {
+	return f.GetProofDataTrieCalled(address, key)
+}
+
+// GetAllESDTTokens -
+func (f *Facade) GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error) {
+	return f.GetAllESDTTokensCalled(address)
+}
+
+// GetESDTBalance -
+func (f *Facade) GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+	return f.GetESDTBalanceCalled(address, tokenIdentifier)
+}
+
+// GetESDTTokenData -
+func (f *Facade) GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error) {
+	return f.GetESDTTokenDataCalled(tokenIdentifier)
+}
+
+// GetTransactionsHistory -
+func (f *Facade) GetTransactionsHistory(
+	address string,
+	cursor *transaction.ApiHistoryCursor,
+	pageSize int,
+) (*transaction.ApiAddressHistory, error) {
+	return f.GetTransactionsHistoryCalled(address, cursor, pageSize)
+}
+
+// GetBlockByNonce -
+func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByNonceCalled(nonce, withTxs)
+}
+
+// GetBlockByHash -
+func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByHashCalled(hash, withTxs)
+}
+
+// GetHyperblockByNonce -
+func (f *Facade) GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error) {
+	return f.GetHyperblockByNonceCalled(nonce)
+}
+
+// GetHyperblockByHash -
+func (f *Facade) GetHyperblockByHash(hash string) (*block.ApiHyperblock, error) {
+	return f.GetHyperblockByHashCalled(hash)
 }
 
 // GetTransactionStatus -
@@ -71,22 +139,33 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
+// GetKeyValuePairs is the mock implementation of a handler's GetKeyValuePairs method
+func (f *Facade) GetKeyValuePairs(
+	address string,
+	prefix string,
+	cursor string,
+	pageSize int,
+	options state.AccountQueryOptions,
+) (*state.ApiKeyValuePairs, error) {
+	return f.GetKeyValuePairsCalled(address, prefix, cursor, pageSize, options)
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -99,8 +178,10 @@ func (f *Facade) CreateTransaction(
 	gasLimit uint64,
 	data string,
 	signatureHex string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, []byte, error) {
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -118,14 +199,19 @@ func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
 }
 
+// CreateRelayedTransaction is the mock implementation of a handler's CreateRelayedTransaction method
+func (f *Facade) CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error) {
+	return f.CreateRelayedTransactionHandler(userTx)
+}
+
 // ValidatorStatisticsApi is the mock implementation of a handler's ValidatorStatisticsApi method
 func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return f.ValidatorStatisticsHandler()
 }
 
 // ExecuteSCQuery is a mock implementation.
-func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
-	return f.ExecuteSCQueryHandler(query)
+func (f *Facade) ExecuteSCQuery(query *process.SCQuery, options state.AccountQueryOptions) (*vmcommon.VMOutput, error) {
+	return f.ExecuteSCQueryHandler(query, options)
 }
 
 // StatusMetrics is the mock implementation for the StatusMetrics
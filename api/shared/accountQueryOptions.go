@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// BlockNonceQueryParam is the query parameter selecting, by nonce, the past block an account query is run on
+	BlockNonceQueryParam = "blockNonce"
+	// BlockHashQueryParam is the query parameter selecting, by hex encoded hash, the past block an account query is
+	// run on
+	BlockHashQueryParam = "blockHash"
+)
+
+// GetAccountQueryOptions parses the optional blockNonce and blockHash query parameters of the request. At most one of
+// them can be provided
+func GetAccountQueryOptions(c *gin.Context) (state.AccountQueryOptions, error) {
+	options := state.AccountQueryOptions{}
+
+	blockNonceStr := c.Query(BlockNonceQueryParam)
+	blockHashStr := c.Query(BlockHashQueryParam)
+	if blockNonceStr != "" && blockHashStr != "" {
+		return options, fmt.Errorf("%w: only one of %s and %s can be provided",
+			errors.ErrInvalidQueryParameter, BlockNonceQueryParam, BlockHashQueryParam)
+	}
+
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, BlockNonceQueryParam)
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil || len(blockHash) == 0 {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, BlockHashQueryParam)
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	return options, nil
+}
@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func createContextWithQuery(rawQuery string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest(http.MethodGet, "/address?"+rawQuery, nil)
+
+	return c
+}
+
+func TestGetAccountQueryOptions_NoParamsShouldReturnCurrentState(t *testing.T) {
+	t.Parallel()
+
+	options, err := GetAccountQueryOptions(createContextWithQuery(""))
+	assert.Nil(t, err)
+	assert.False(t, options.IsHistorical())
+}
+
+func TestGetAccountQueryOptions_BlockNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	options, err := GetAccountQueryOptions(createContextWithQuery("blockNonce=37"))
+	assert.Nil(t, err)
+	assert.Equal(t, state.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}, options)
+	assert.True(t, options.IsHistorical())
+}
+
+func TestGetAccountQueryOptions_BlockHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	options, err := GetAccountQueryOptions(createContextWithQuery("blockHash=aabb"))
+	assert.Nil(t, err)
+	assert.Equal(t, state.AccountQueryOptions{BlockHash: []byte{0xaa, 0xbb}}, options)
+	assert.True(t, options.IsHistorical())
+}
+
+func TestGetAccountQueryOptions_InvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rawQueries := []string{
+		"blockNonce=abc",
+		"blockNonce=-1",
+		"blockHash=xyz",
+		"blockNonce=1&blockHash=aabb",
+	}
+	for _, rawQuery := range rawQueries {
+		_, err := GetAccountQueryOptions(createContextWithQuery(rawQuery))
+		assert.True(t, errors.Is(err, apiErrors.ErrInvalidQueryParameter), rawQuery)
+	}
+}
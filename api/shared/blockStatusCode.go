@@ -0,0 +1,18 @@
+package shared
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// GetBlockErrorStatusCode returns the HTTP status code of an error which occurred while fetching a block: not found if
+// the block does not exist in the storage and internal server error otherwise
+func GetBlockErrorStatusCode(err error) int {
+	if errors.Is(err, data.ErrBlockNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
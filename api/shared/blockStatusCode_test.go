@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlockErrorStatusCode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, http.StatusNotFound, GetBlockErrorStatusCode(data.ErrBlockNotFound))
+	assert.Equal(t, http.StatusNotFound, GetBlockErrorStatusCode(fmt.Errorf("%w: nonce 37", data.ErrBlockNotFound)))
+	assert.Equal(t, http.StatusInternalServerError, GetBlockErrorStatusCode(errors.New("expected error")))
+}
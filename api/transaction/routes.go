@@ -15,8 +15,9 @@ import (
 // TxService interface defines methods that can be used from `elrondFacade` context variable
 type TxService interface {
 	CreateTransaction(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
+	CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error)
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
 	GetTransactionStatus(hash string) (string, error)
@@ -50,6 +51,20 @@ type SendTxRequest struct {
 	GasPrice  uint64 `form:"gasPrice" json:"gasPrice"`
 	GasLimit  uint64 `form:"gasLimit" json:"gasLimit"`
 	Signature string `form:"signature" json:"signature"`
+	ChainID   string `form:"chainID" json:"chainID"`
+	Version   uint32 `form:"version" json:"version"`
+}
+
+// RelayedTxResponse holds the relayed transaction carrying a user transaction. The relayer has to add its address,
+// nonce and signature before sending it
+type RelayedTxResponse struct {
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+	Data     string `json:"data"`
+	ChainID  string `json:"chainID"`
+	Version  uint32 `json:"version"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -67,6 +82,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodPost, "/send", SendTransaction)
 	router.RegisterHandler(http.MethodPost, "/cost", ComputeTransactionGasLimit)
 	router.RegisterHandler(http.MethodPost, "/send-multiple", SendMultipleTransactions)
+	router.RegisterHandler(http.MethodPost, "/relayed", CreateRelayedTransaction)
 	router.RegisterHandler(http.MethodGet, "/:txhash", GetTransaction)
 	router.RegisterHandler(http.MethodGet, "/:txhash/status", GetTransactionStatus)
 }
@@ -95,6 +111,8 @@ func SendTransaction(c *gin.Context) {
 		gtx.GasLimit,
 		gtx.Data,
 		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
 	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
@@ -147,6 +165,8 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.GasLimit,
 			receivedTx.Data,
 			receivedTx.Signature,
+			receivedTx.ChainID,
+			receivedTx.Version,
 		)
 		if err != nil {
 			continue
@@ -176,6 +196,64 @@ func SendMultipleTransactions(c *gin.Context) {
 	)
 }
 
+// CreateRelayedTransaction will receive a signed user transaction and will return the relayed transaction that
+// carries it, ready to be completed and signed by the relayer
+func CreateRelayedTransaction(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var gtx = SendTxRequest{}
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	userTx, _, err := ef.CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.Sender,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
+		return
+	}
+
+	relayedTx, err := ef.CreateRelayedTransaction(userTx)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
+		return
+	}
+
+	receiver, err := ef.EncodeAddressPubkey(relayedTx.RcvAddr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := RelayedTxResponse{
+		Receiver: receiver,
+		Value:    relayedTx.Value.String(),
+		GasPrice: relayedTx.GasPrice,
+		GasLimit: relayedTx.GasLimit,
+		Data:     string(relayedTx.Data),
+		ChainID:  string(relayedTx.ChainID),
+		Version:  relayedTx.Version,
+	}
+
+	c.JSON(http.StatusOK, gin.H{"relayedTransaction": response})
+}
+
 // GetTransaction returns transaction details for a given txhash
 func GetTransaction(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(TxService)
@@ -245,6 +323,8 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		gtx.GasLimit,
 		gtx.Data,
 		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
 	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
@@ -49,6 +49,11 @@ type TransactionCostResponse struct {
 	Cost uint64 `json:"txGasUnits"`
 }
 
+type RelayedTransactionResponse struct {
+	GeneralResponse
+	RelayedTx *transaction.RelayedTxResponse `json:"relayedTransaction,omitempty"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -222,7 +227,7 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	errorString := "send transaction error"
 
 	facade := mock.Facade{
-		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (t *tr.Transaction, i []byte, err error) {
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (t *tr.Transaction, i []byte, err error) {
 			return nil, nil, nil
 		},
 		SendBulkTransactionsHandler: func(txs []*tr.Transaction) (u uint64, err error) {
@@ -267,7 +272,7 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 
 	facade := mock.Facade{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-			gasLimit uint64, data string, signatureHex string) (t *tr.Transaction, i []byte, err error) {
+			gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (t *tr.Transaction, i []byte, err error) {
 			txHash, _ := hex.DecodeString(hexTxHash)
 			return nil, txHash, nil
 		},
@@ -346,7 +351,7 @@ func TestSendMultipleTransactions_OkPayloadShouldWork(t *testing.T) {
 
 	facade := mock.Facade{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-			gasLimit uint64, data string, signatureHex string) (*tr.Transaction, []byte, error) {
+			gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*tr.Transaction, []byte, error) {
 			createTxWasCalled = true
 			return &tr.Transaction{}, make([]byte, 0), nil
 		},
@@ -395,7 +400,7 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	expectedGasLimit := uint64(37)
 
 	facade := mock.Facade{
-		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*tr.Transaction, []byte, error) {
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ string, _ uint32) (*tr.Transaction, []byte, error) {
 			return &tr.Transaction{}, nil, nil
 		},
 		ComputeTransactionGasLimitHandler: func(tx *tr.Transaction) (uint64, error) {
@@ -429,6 +434,80 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	assert.Equal(t, expectedGasLimit, transactionCostResponse.Cost)
 }
 
+func TestCreateRelayedTransaction_ErrorWhenFacadeCreateRelayedTransactionError(t *testing.T) {
+	t.Parallel()
+
+	errorString := "create relayed transaction error"
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ string, _ uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, nil, nil
+		},
+		CreateRelayedTransactionHandler: func(userTx *tr.Transaction) (*tr.Transaction, error) {
+			return nil, errors.New(errorString)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender", Receiver: "receiver", Value: "10"})
+	req, _ := http.NewRequest("POST", "/transaction/relayed", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	relayedTxResponse := RelayedTransactionResponse{}
+	loadResponse(resp.Body, &relayedTxResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, relayedTxResponse.Error, errorString)
+	assert.Nil(t, relayedTxResponse.RelayedTx)
+}
+
+func TestCreateRelayedTransaction_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	userAddress := []byte("user")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ string, _ uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{SndAddr: userAddress}, nil, nil
+		},
+		CreateRelayedTransactionHandler: func(userTx *tr.Transaction) (*tr.Transaction, error) {
+			return &tr.Transaction{
+				Value:    big.NewInt(10),
+				RcvAddr:  userTx.SndAddr,
+				GasPrice: 5,
+				GasLimit: 100,
+				Data:     []byte("relayedTx@aabb"),
+				ChainID:  []byte("chainID"),
+				Version:  1,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender", Receiver: "receiver", Value: "10"})
+	req, _ := http.NewRequest("POST", "/transaction/relayed", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	relayedTxResponse := RelayedTransactionResponse{}
+	loadResponse(resp.Body, &relayedTxResponse)
+
+	expectedResponse := &transaction.RelayedTxResponse{
+		Receiver: hex.EncodeToString(userAddress),
+		Value:    "10",
+		GasPrice: 5,
+		GasLimit: 100,
+		Data:     "relayedTx@aabb",
+		ChainID:  "chainID",
+		Version:  1,
+	}
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, relayedTxResponse.Error)
+	assert.Equal(t, expectedResponse, relayedTxResponse.RelayedTx)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -474,6 +553,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 				[]config.RouteConfig{
 					{Name: "/send", Open: true},
 					{Name: "/send-multiple", Open: true},
+					{Name: "/relayed", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
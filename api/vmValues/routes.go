@@ -6,7 +6,9 @@ import (
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
@@ -14,7 +16,7 @@ import (
 
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
-	ExecuteSCQuery(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQuery(query *process.SCQuery, options state.AccountQueryOptions) (*vmcommon.VMOutput, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -94,7 +96,12 @@ func doExecuteQuery(context *gin.Context) (*vmcommon.VMOutput, error) {
 		return nil, err
 	}
 
-	vmOutput, err := facade.ExecuteSCQuery(command)
+	options, err := shared.GetAccountQueryOptions(context)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := facade.ExecuteSCQuery(command, options)
 	if err != nil {
 		return nil, err
 	}
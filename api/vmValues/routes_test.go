@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-contrib/cors"
@@ -45,7 +46,7 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{
 				ReturnData: [][]byte{valueBuff},
 			}, nil
@@ -72,7 +73,7 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{
 				ReturnData: [][]byte{[]byte(valueBuff)},
 			}, nil
@@ -99,7 +100,7 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vmcommon.VMOutput{
@@ -126,7 +127,7 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 
 			return &vmcommon.VMOutput{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -148,6 +149,48 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockNonceShouldPassQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery, options state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
+			require.Equal(t, state.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true}, options)
+
+			return &vmcommon.VMOutput{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query?blockNonce=5", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+}
+
+func TestQuery_WithInvalidBlockHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&mock.Facade{}, "/vm-values/query?blockHash=invalid", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidQueryParameter.Error())
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -165,7 +208,7 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return nil, errExpected
 		},
 	}
@@ -184,7 +227,7 @@ func TestAllRoutes_WhenBadAddressShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid address")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	}
@@ -203,7 +246,7 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	}
@@ -222,7 +265,7 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	}
@@ -240,7 +283,7 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery, _ state.AccountQueryOptions) (vmOutput *vmcommon.VMOutput, e error) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	}
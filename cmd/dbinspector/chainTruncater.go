@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+)
+
+// maxBootstrapRoundsToSearch bounds the search of the bootstrap data below the round of the block the chain is
+// truncated to, in case no bootstrap data was saved exactly in that round
+const maxBootstrapRoundsToSearch = 1000
+
+var errNoBootstrapRoundFound = errors.New("no bootstrap round found at or below the requested nonce")
+var errNothingToTruncate = errors.New("the chain is already at or below the requested nonce")
+var errTargetStateMissing = errors.New("the state of the block the chain is truncated to is not available")
+
+// truncate moves the last saved bootstrap round back to the highest round whose last header is not above the
+// provided nonce and removes the self shard headers above it, so the node resyncs the removed blocks on restart. The
+// truncation is aborted if the state root of the block the chain is truncated to is not available, unless forced
+func (di *dbInspector) truncate(nonce uint64, dryRun bool, force bool) error {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(di.marshalizer, di.store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return err
+	}
+
+	highestRound := bootStorer.GetHighestRound()
+	highestData, err := bootStorer.Get(highestRound)
+	if err != nil {
+		return err
+	}
+
+	highestNonce := highestData.LastHeader.Nonce
+	if highestNonce <= nonce {
+		return fmt.Errorf("%w: highest nonce %d, requested nonce %d", errNothingToTruncate, highestNonce, nonce)
+	}
+
+	targetRound, targetData, err := di.findBootstrapRoundForNonce(bootStorer, highestRound, nonce)
+	if err != nil {
+		return err
+	}
+
+	log.Info("truncating the chain",
+		"from nonce", highestNonce,
+		"from round", highestRound,
+		"to nonce", targetData.LastHeader.Nonce,
+		"to round", targetRound,
+		"dry run", dryRun,
+	)
+
+	err = di.checkStateAvailable(targetData.LastHeader.Hash)
+	if err != nil && !force {
+		return fmt.Errorf("%w, the truncation can be forced with the --%s flag", err, forceFlagName)
+	}
+	if err != nil {
+		log.Warn("forced truncation, the node will need a full resync", "error", err.Error())
+	}
+
+	for removedNonce := targetData.LastHeader.Nonce + 1; removedNonce <= highestNonce; removedNonce++ {
+		di.removeHeader(removedNonce, dryRun)
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	return bootStorer.SaveLastRound(targetRound)
+}
+
+// findBootstrapRoundForNonce returns the bootstrap data saved in the round of the self shard header with the provided
+// nonce or, if missing, the closest one below it, the search being bounded to maxBootstrapRoundsToSearch rounds
+func (di *dbInspector) findBootstrapRoundForNonce(
+	bootStorer process.BootStorer,
+	highestRound int64,
+	nonce uint64,
+) (int64, bootstrapStorage.BootstrapData, error) {
+	hash, err := di.getHeaderHashByNonce(nonce)
+	if err != nil {
+		return 0, bootstrapStorage.BootstrapData{}, err
+	}
+	header, _, err := di.getHeader(hash)
+	if err != nil {
+		return 0, bootstrapStorage.BootstrapData{}, err
+	}
+
+	startRound := int64(header.GetRound())
+	if startRound > highestRound {
+		startRound = highestRound
+	}
+
+	for round := startRound; round >= 0 && startRound-round < maxBootstrapRoundsToSearch; round-- {
+		bootstrapData, errGet := bootStorer.Get(round)
+		if errGet != nil {
+			continue
+		}
+		if bootstrapData.LastHeader.Nonce <= nonce {
+			return round, bootstrapData, nil
+		}
+	}
+
+	return 0, bootstrapStorage.BootstrapData{}, fmt.Errorf("%w: %d", errNoBootstrapRoundFound, nonce)
+}
+
+func (di *dbInspector) removeHeader(nonce uint64, dryRun bool) {
+	nonceKey := di.uint64Converter.ToByteSlice(nonce)
+	hash, err := di.getHeaderHashByNonce(nonce)
+	if err != nil {
+		log.Warn("no header hash found for nonce", "nonce", nonce, "error", err.Error())
+		return
+	}
+
+	log.Info("removing header", "nonce", nonce, "hash", hex.EncodeToString(hash), "dry run", dryRun)
+	if dryRun {
+		return
+	}
+
+	err = di.store.GetStorer(di.selfNonceHashUnit()).Remove(nonceKey)
+	if err != nil {
+		log.Warn("could not remove the nonce to hash mapping", "nonce", nonce, "error", err.Error())
+	}
+	err = di.store.GetStorer(di.selfHeadersUnit()).Remove(hash)
+	if err != nil {
+		log.Warn("could not remove the header", "nonce", nonce, "error", err.Error())
+	}
+}
+
+// checkStateAvailable returns an error if the state root of the header the chain is truncated to can not be found,
+// since the node would not be able to start from it
+func (di *dbInspector) checkStateAvailable(headerHash []byte) error {
+	header, _, err := di.getHeader(headerHash)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errTargetStateMissing, err.Error())
+	}
+
+	databases, err := di.openTrieDatabases(accountsTrie)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errTargetStateMissing, err.Error())
+	}
+	defer databases.close()
+
+	_, err = databases.get(header.GetRootHash())
+	if err != nil {
+		return fmt.Errorf("%w: nonce %d, root hash %s", errTargetStateMissing,
+			header.GetNonce(), hex.EncodeToString(header.GetRootHash()))
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNumBlocks = 5
+
+// saveTestChain saves the blocks with nonces from 1 to testNumBlocks, the block with the nonce n being proposed in
+// round 2n. The blocks use the provided root hash, except the ones having a root hash in rootHashesByNonce
+func saveTestChain(
+	t *testing.T,
+	di *dbInspector,
+	rootHash []byte,
+	rootHashesByNonce map[uint64][]byte,
+	nonceWithoutBootstrapData uint64,
+) map[uint64][]byte {
+	hashes := make(map[uint64][]byte)
+	for nonce := uint64(1); nonce <= testNumBlocks; nonce++ {
+		header := &block.Header{
+			Nonce:    nonce,
+			Round:    2 * nonce,
+			RootHash: rootHash,
+		}
+		if rootHashesByNonce[nonce] != nil {
+			header.RootHash = rootHashesByNonce[nonce]
+		}
+
+		hashes[nonce] = saveTestBlock(t, di, header, nonce != nonceWithoutBootstrapData)
+	}
+
+	return hashes
+}
+
+func getTestHighestBootstrapData(t *testing.T, di *dbInspector) bootstrapStorage.BootstrapData {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(di.marshalizer, di.store.GetStorer(dataRetriever.BootstrapUnit))
+	require.Nil(t, err)
+
+	bootstrapData, err := bootStorer.Get(bootStorer.GetHighestRound())
+	require.Nil(t, err)
+
+	return bootstrapData
+}
+
+func requireTestChainUntil(t *testing.T, di *dbInspector, hashes map[uint64][]byte, lastNonce uint64) {
+	for nonce := uint64(1); nonce <= testNumBlocks; nonce++ {
+		_, errNonce := di.getHeaderHashByNonce(nonce)
+		_, _, errHeader := di.getHeader(hashes[nonce])
+		if nonce <= lastNonce {
+			assert.Nil(t, errNonce, "nonce %d", nonce)
+			assert.Nil(t, errHeader, "nonce %d", nonce)
+			continue
+		}
+
+		assert.NotNil(t, errNonce, "nonce %d", nonce)
+		assert.NotNil(t, errHeader, "nonce %d", nonce)
+	}
+
+	assert.Equal(t, lastNonce, getTestHighestBootstrapData(t, di).LastHeader.Nonce)
+}
+
+func TestDbInspector_TruncateShouldRemoveTheBlocksAboveTheNonce(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, nil, 0)
+
+	err := di.truncate(3, false, false)
+	assert.Nil(t, err)
+
+	requireTestChainUntil(t, di, hashes, 3)
+	assert.Equal(t, hashes[3], getTestHighestBootstrapData(t, di).LastHeader.Hash)
+}
+
+func TestDbInspector_TruncateDryRunShouldNotChangeTheChain(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, nil, 0)
+
+	err := di.truncate(3, true, false)
+	assert.Nil(t, err)
+
+	requireTestChainUntil(t, di, hashes, testNumBlocks)
+}
+
+func TestDbInspector_TruncateAtOrAboveTheHighestNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, nil, 0)
+
+	err := di.truncate(testNumBlocks, false, false)
+	assert.True(t, errors.Is(err, errNothingToTruncate))
+
+	requireTestChainUntil(t, di, hashes, testNumBlocks)
+}
+
+func TestDbInspector_TruncateWithoutBootstrapDataInTheBlockRoundShouldUseThePreviousRound(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, nil, 3)
+
+	err := di.truncate(3, false, false)
+	assert.Nil(t, err)
+
+	requireTestChainUntil(t, di, hashes, 2)
+}
+
+func TestDbInspector_TruncateToMissingNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, nil, 0)
+	err := di.store.GetStorer(di.selfNonceHashUnit()).Remove(di.uint64Converter.ToByteSlice(3))
+	require.Nil(t, err)
+
+	err = di.truncate(3, false, false)
+	assert.True(t, errors.Is(err, errNotFoundInAnyEpoch))
+
+	assert.Equal(t, uint64(testNumBlocks), getTestHighestBootstrapData(t, di).LastHeader.Nonce)
+	_, _, err = di.getHeader(hashes[testNumBlocks])
+	assert.Nil(t, err)
+}
+
+func TestDbInspector_TruncateWithMissingStateShouldAbort(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, map[uint64][]byte{3: []byte("missing root hash")}, 0)
+
+	err := di.truncate(3, false, false)
+	assert.True(t, errors.Is(err, errTargetStateMissing))
+
+	requireTestChainUntil(t, di, hashes, testNumBlocks)
+}
+
+func TestDbInspector_TruncateWithMissingStateShouldWorkIfForced(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hashes := saveTestChain(t, di, rootHash, map[uint64][]byte{3: []byte("missing root hash")}, 0)
+
+	err := di.truncate(3, false, true)
+	assert.Nil(t, err)
+
+	requireTestChainUntil(t, di, hashes, 3)
+}
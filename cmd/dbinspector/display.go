@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// printDecoded prints the provided value as indented JSON, with all the byte slices displayed in hex
+func printDecoded(title string, value interface{}) error {
+	buff, err := json.MarshalIndent(toDisplayable(reflect.ValueOf(value)), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s:\n%s\n", title, string(buff))
+
+	return nil
+}
+
+func toDisplayable(value reflect.Value) interface{} {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return toDisplayable(value.Elem())
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			buff := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(buff), value)
+			return hex.EncodeToString(buff)
+		}
+
+		elements := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elements = append(elements, toDisplayable(value.Index(i)))
+		}
+		return elements
+	case reflect.Map:
+		fields := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			fields[fmt.Sprintf("%v", toDisplayable(iter.Key()))] = toDisplayable(iter.Value())
+		}
+		return fields
+	case reflect.Struct:
+		if value.Type() == bigIntType {
+			bigValue := value.Interface().(big.Int)
+			return bigValue.String()
+		}
+
+		fields := make(map[string]interface{})
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			fields[field.Name] = toDisplayable(value.Field(i))
+		}
+		return fields
+	default:
+		return value.Interface()
+	}
+}
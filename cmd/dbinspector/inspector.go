@@ -0,0 +1,319 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	factoryHasher "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+)
+
+var errNotFoundInAnyEpoch = errors.New("not found in any epoch")
+
+// dbInspector opens the storage of a stopped node, for the shard and epoch found in its latest bootstrap data
+type dbInspector struct {
+	generalConfig    *config.Config
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	shardCoordinator sharding.Coordinator
+	pathManager      storage.PathManagerHandler
+	store            dataRetriever.StorageService
+	epoch            uint32
+	lastRound        int64
+}
+
+func newDBInspector() (*dbInspector, error) {
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalizer, err := factoryMarshalizer.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := factoryHasher.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	latestStorageDataProvider, err := factory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		marshalizer,
+		hasher,
+		*generalConfig,
+		argsConfig.chainID,
+		argsConfig.workingDir,
+		defaultDBPath,
+		defaultEpochString,
+		defaultShardString,
+	)
+	if err != nil {
+		return nil, err
+	}
+	latestData, err := latestStorageDataProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("found the latest data in storage",
+		"epoch", latestData.Epoch,
+		"shard", latestData.ShardID,
+		"round", latestData.LastRound,
+	)
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(argsConfig.numOfShards), latestData.ShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := createPathManager()
+	if err != nil {
+		return nil, err
+	}
+
+	storageServiceFactory, err := storageFactory.NewStorageServiceFactory(
+		generalConfig,
+		shardCoordinator,
+		pathManager,
+		notifier.NewEpochStartSubscriptionHandler(),
+		latestData.Epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var store dataRetriever.StorageService
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		store, err = storageServiceFactory.CreateForMeta()
+	} else {
+		store, err = storageServiceFactory.CreateForShard()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbInspector{
+		generalConfig:    generalConfig,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		shardCoordinator: shardCoordinator,
+		pathManager:      pathManager,
+		store:            store,
+		epoch:            latestData.Epoch,
+		lastRound:        latestData.LastRound,
+	}, nil
+}
+
+func createPathManager() (storage.PathManagerHandler, error) {
+	dbPath := filepath.Join(argsConfig.workingDir, defaultDBPath, argsConfig.chainID)
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPath,
+		fmt.Sprintf("%s_%s", defaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPath,
+		defaultStaticDbString,
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
+
+func (di *dbInspector) close() {
+	err := di.store.CloseAll()
+	log.LogIfError(err)
+}
+
+func (di *dbInspector) selfHeadersUnit() dataRetriever.UnitType {
+	if di.shardCoordinator.SelfId() == core.MetachainShardId {
+		return dataRetriever.MetaBlockUnit
+	}
+
+	return dataRetriever.BlockHeaderUnit
+}
+
+func (di *dbInspector) selfNonceHashUnit() dataRetriever.UnitType {
+	if di.shardCoordinator.SelfId() == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(di.shardCoordinator.SelfId())
+}
+
+// getFromAnyEpoch searches the key in the active persisters of the unit and then in each epoch, from the latest one
+// down to the genesis epoch, returning the value and the epoch it was found in
+func (di *dbInspector) getFromAnyEpoch(unit dataRetriever.UnitType, key []byte) ([]byte, uint32, error) {
+	storer := di.store.GetStorer(unit)
+	if storer == nil {
+		return nil, 0, fmt.Errorf("%w: %s", dataRetriever.ErrNoSuchStorageUnit, unit.String())
+	}
+
+	for epoch := int64(di.epoch); epoch >= 0; epoch-- {
+		value, err := storer.GetFromEpoch(key, uint32(epoch))
+		if err == nil {
+			return value, uint32(epoch), nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("%w: unit %s, key %s", errNotFoundInAnyEpoch, unit.String(), hex.EncodeToString(key))
+}
+
+func (di *dbInspector) getHeader(hash []byte) (data.HeaderHandler, uint32, error) {
+	headerBytes, epoch, err := di.getFromAnyEpoch(di.selfHeadersUnit(), hash)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var header data.HeaderHandler = &block.Header{}
+	if di.shardCoordinator.SelfId() == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err = di.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return header, epoch, nil
+}
+
+func (di *dbInspector) getHeaderHashByNonce(nonce uint64) ([]byte, error) {
+	hash, _, err := di.getFromAnyEpoch(di.selfNonceHashUnit(), di.uint64Converter.ToByteSlice(nonce))
+
+	return hash, err
+}
+
+func (di *dbInspector) printHeader(hexHash string, nonce uint64, isNonceSet bool) error {
+	if len(hexHash) == 0 && !isNonceSet {
+		return errMissingHash
+	}
+
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return err
+	}
+	if isNonceSet {
+		hash, err = di.getHeaderHashByNonce(nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	header, epoch, err := di.getHeader(hash)
+	if err != nil {
+		return err
+	}
+
+	return printDecoded(fmt.Sprintf("header %s, found in epoch %d", hex.EncodeToString(hash), epoch), header)
+}
+
+func (di *dbInspector) printMiniBlock(hexHash string) error {
+	hash, err := decodeHash(hexHash)
+	if err != nil {
+		return err
+	}
+
+	miniBlockBytes, epoch, err := di.getFromAnyEpoch(dataRetriever.MiniBlockUnit, hash)
+	if err != nil {
+		return err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = di.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return err
+	}
+
+	return printDecoded(fmt.Sprintf("miniblock %s, found in epoch %d", hexHash, epoch), miniBlock)
+}
+
+func (di *dbInspector) printTransaction(hexHash string) error {
+	hash, err := decodeHash(hexHash)
+	if err != nil {
+		return err
+	}
+
+	candidates := []struct {
+		unit dataRetriever.UnitType
+		tx   data.TransactionHandler
+	}{
+		{unit: dataRetriever.TransactionUnit, tx: &transaction.Transaction{}},
+		{unit: dataRetriever.UnsignedTransactionUnit, tx: &smartContractResult.SmartContractResult{}},
+		{unit: dataRetriever.RewardTransactionUnit, tx: &rewardTx.RewardTx{}},
+	}
+
+	for _, candidate := range candidates {
+		txBytes, epoch, errGet := di.getFromAnyEpoch(candidate.unit, hash)
+		if errGet != nil {
+			continue
+		}
+
+		err = di.marshalizer.Unmarshal(candidate.tx, txBytes)
+		if err != nil {
+			return err
+		}
+
+		title := fmt.Sprintf("%s %s, found in epoch %d", candidate.unit.String(), hexHash, epoch)
+		return printDecoded(title, candidate.tx)
+	}
+
+	return fmt.Errorf("%w: transaction %s", errNotFoundInAnyEpoch, hexHash)
+}
+
+func (di *dbInspector) printBootstrapData(round int64, isRoundSet bool) error {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(di.marshalizer, di.store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return err
+	}
+
+	highestRound := bootStorer.GetHighestRound()
+	fmt.Printf("highest saved round: %d\n", highestRound)
+	if !isRoundSet {
+		round = highestRound
+	}
+
+	bootstrapData, err := bootStorer.Get(round)
+	if err != nil {
+		return err
+	}
+
+	return printDecoded(fmt.Sprintf("bootstrap data for round %d", round), bootstrapData)
+}
+
+func decodeHash(hexHash string) ([]byte, error) {
+	if len(hexHash) == 0 {
+		return nil, errMissingHash
+	}
+
+	return hex.DecodeString(hexHash)
+}
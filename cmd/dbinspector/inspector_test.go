@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAccountsTriePath = "AccountsTrie/MainDB"
+
+var testUnits = []dataRetriever.UnitType{
+	dataRetriever.BootstrapUnit,
+	dataRetriever.BlockHeaderUnit,
+	dataRetriever.ShardHdrNonceHashDataUnit,
+	dataRetriever.MiniBlockUnit,
+	dataRetriever.TransactionUnit,
+	dataRetriever.UnsignedTransactionUnit,
+	dataRetriever.RewardTransactionUnit,
+}
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+// createTestInspector creates an inspector over temporary leveldb units of shard 0, the accounts trie holding the
+// provided pairs being saved before. It returns the inspector, the root hash of the accounts trie and the function
+// closing and removing the databases
+func createTestInspector(t *testing.T, pairs map[string]string) (*dbInspector, []byte, func()) {
+	dir, err := ioutil.TempDir("", "dbinspector")
+	require.Nil(t, err)
+
+	pathManager, err := pathmanager.NewPathManager(
+		filepath.Join(dir, "Epoch_"+core.PathEpochPlaceholder, "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+		filepath.Join(dir, defaultStaticDbString, "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+	)
+	require.Nil(t, err)
+
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+	di := &dbInspector{
+		generalConfig: &config.Config{
+			AccountsTrieStorage: config.StorageConfig{DB: createTestDBConfig(testAccountsTriePath)},
+			TrieSnapshotDB:      createTestDBConfig("snapshots"),
+		},
+		marshalizer:      &marshal.GogoProtoMarshalizer{},
+		hasher:           &blake2b.Blake2b{},
+		uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		shardCoordinator: shardCoordinator,
+		pathManager:      pathManager,
+	}
+
+	rootHash := saveTestAccountsTrie(t, di, pairs)
+
+	store := dataRetriever.NewChainStorer()
+	for _, unit := range testUnits {
+		db, errCreate := leveldb.NewSerialDB(filepath.Join(dir, unit.String()), 1, 1, 10)
+		require.Nil(t, errCreate)
+		cache, _ := lrucache.NewCache(10)
+		storer, _ := storageUnit.NewStorageUnit(cache, db)
+		store.AddStorer(unit, storer)
+	}
+	di.store = store
+
+	cleanup := func() {
+		di.close()
+		_ = os.RemoveAll(dir)
+	}
+
+	return di, rootHash, cleanup
+}
+
+func saveTestAccountsTrie(t *testing.T, di *dbInspector, pairs map[string]string) []byte {
+	db, err := leveldb.NewSerialDB(di.pathManager.PathForStatic("0", testAccountsTriePath), 1, 1, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	tr, err := trie.NewTrie(trieStorage, di.marshalizer, di.hasher, 5)
+	require.Nil(t, err)
+
+	for key, value := range pairs {
+		err = tr.Update([]byte(key), []byte(value))
+		require.Nil(t, err)
+	}
+	err = tr.Commit()
+	require.Nil(t, err)
+
+	rootHash, err := tr.Root()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// saveTestBlock saves a self shard header, its nonce to hash mapping and, if required, the bootstrap data of its round
+func saveTestBlock(t *testing.T, di *dbInspector, header *block.Header, saveBootstrapData bool) []byte {
+	headerBytes, err := di.marshalizer.Marshal(header)
+	require.Nil(t, err)
+	hash := di.hasher.Compute(string(headerBytes))
+
+	err = di.store.Put(dataRetriever.BlockHeaderUnit, hash, headerBytes)
+	require.Nil(t, err)
+	err = di.store.Put(di.selfNonceHashUnit(), di.uint64Converter.ToByteSlice(header.Nonce), hash)
+	require.Nil(t, err)
+
+	if !saveBootstrapData {
+		return hash
+	}
+
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(di.marshalizer, di.store.GetStorer(dataRetriever.BootstrapUnit))
+	require.Nil(t, err)
+	err = bootStorer.Put(int64(header.Round), bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: header.ShardID,
+			Nonce:   header.Nonce,
+			Hash:    hash,
+		},
+	})
+	require.Nil(t, err)
+
+	return hash
+}
+
+func TestDbInspector_PrintHeader(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key": "value"})
+	defer cleanup()
+	hash := saveTestBlock(t, di, &block.Header{Nonce: 1, Round: 2, RootHash: rootHash}, true)
+
+	assert.Nil(t, di.printHeader(hex.EncodeToString(hash), 0, false))
+	assert.Nil(t, di.printHeader("", 1, true))
+	assert.Equal(t, errMissingHash, di.printHeader("", 0, false))
+	assert.True(t, errors.Is(di.printHeader("", 2, true), errNotFoundInAnyEpoch))
+	assert.True(t, errors.Is(di.printHeader(hex.EncodeToString([]byte("missing")), 0, false), errNotFoundInAnyEpoch))
+}
+
+func TestDbInspector_PrintMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	di, _, cleanup := createTestInspector(t, nil)
+	defer cleanup()
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, ReceiverShardID: 1}
+	miniBlockBytes, _ := di.marshalizer.Marshal(miniBlock)
+	miniBlockHash := di.hasher.Compute(string(miniBlockBytes))
+	_ = di.store.Put(dataRetriever.MiniBlockUnit, miniBlockHash, miniBlockBytes)
+
+	assert.Nil(t, di.printMiniBlock(hex.EncodeToString(miniBlockHash)))
+	assert.Equal(t, errMissingHash, di.printMiniBlock(""))
+	assert.True(t, errors.Is(di.printMiniBlock(hex.EncodeToString([]byte("missing"))), errNotFoundInAnyEpoch))
+}
+
+func TestDbInspector_PrintTransaction(t *testing.T) {
+	t.Parallel()
+
+	di, _, cleanup := createTestInspector(t, nil)
+	defer cleanup()
+	scr := &smartContractResult.SmartContractResult{Nonce: 7, Data: []byte("data")}
+	scrBytes, _ := di.marshalizer.Marshal(scr)
+	scrHash := di.hasher.Compute(string(scrBytes))
+	_ = di.store.Put(dataRetriever.UnsignedTransactionUnit, scrHash, scrBytes)
+
+	assert.Nil(t, di.printTransaction(hex.EncodeToString(scrHash)))
+	assert.Equal(t, errMissingHash, di.printTransaction(""))
+	assert.True(t, errors.Is(di.printTransaction(hex.EncodeToString([]byte("missing"))), errNotFoundInAnyEpoch))
+}
+
+func TestDbInspector_PrintBootstrapData(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, nil)
+	defer cleanup()
+	saveTestBlock(t, di, &block.Header{Nonce: 1, Round: 2, RootHash: rootHash}, true)
+	saveTestBlock(t, di, &block.Header{Nonce: 2, Round: 4, RootHash: rootHash}, true)
+
+	assert.Nil(t, di.printBootstrapData(0, false))
+	assert.Nil(t, di.printBootstrapData(2, true))
+	assert.NotNil(t, di.printBootstrapData(3, true))
+}
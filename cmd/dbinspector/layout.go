@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// listLayout prints the epochs and shards directories of the provided chain, or of all the chains found in the db
+// folder, together with the storage units each one holds
+func listLayout(dbRoot string, chainID string) error {
+	chainIDs := []string{chainID}
+	if len(chainID) == 0 {
+		var err error
+		chainIDs, err = listSubdirectories(dbRoot)
+		if err != nil {
+			return err
+		}
+	}
+
+	unitsByDirectory := getUnitsByDirectory()
+	for _, id := range chainIDs {
+		fmt.Printf("chain %s\n", id)
+
+		err := listChainLayout(filepath.Join(dbRoot, id), unitsByDirectory)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listChainLayout(chainPath string, unitsByDirectory map[string]dataRetriever.UnitType) error {
+	directories, err := listSubdirectories(chainPath)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(directories, func(i, j int) bool {
+		return directoryOrder(directories[i]) < directoryOrder(directories[j])
+	})
+
+	for _, directory := range directories {
+		isEpoch := strings.HasPrefix(directory, defaultEpochString+"_")
+		if !isEpoch && directory != defaultStaticDbString {
+			continue
+		}
+
+		fmt.Printf("  %s\n", directory)
+		shards, errList := listSubdirectories(filepath.Join(chainPath, directory))
+		if errList != nil {
+			return errList
+		}
+
+		for _, shard := range shards {
+			units, errUnits := listSubdirectories(filepath.Join(chainPath, directory, shard))
+			if errUnits != nil {
+				return errUnits
+			}
+
+			fmt.Printf("    %s: %s\n", shard, describeUnits(units, unitsByDirectory))
+		}
+	}
+
+	return nil
+}
+
+// directoryOrder sorts the epochs numerically, after the static directory
+func directoryOrder(directory string) int64 {
+	epoch, err := strconv.ParseInt(strings.TrimPrefix(directory, defaultEpochString+"_"), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return epoch
+}
+
+func describeUnits(directories []string, unitsByDirectory map[string]dataRetriever.UnitType) string {
+	descriptions := make([]string, 0, len(directories))
+	for _, directory := range directories {
+		unit, ok := unitsByDirectory[directory]
+		if !ok {
+			descriptions = append(descriptions, directory)
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", directory, unit.String()))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// getUnitsByDirectory maps the storage units directories, as named in the configuration file, to their unit types.
+// The mapping is empty if the configuration file can not be loaded
+func getUnitsByDirectory() map[string]dataRetriever.UnitType {
+	unitsByDirectory := make(map[string]dataRetriever.UnitType)
+
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+	if err != nil {
+		log.Debug("the storage units will not be identified", "error", err.Error())
+		return unitsByDirectory
+	}
+
+	unitsByDirectory[generalConfig.TxStorage.DB.FilePath] = dataRetriever.TransactionUnit
+	unitsByDirectory[generalConfig.MiniBlocksStorage.DB.FilePath] = dataRetriever.MiniBlockUnit
+	unitsByDirectory[generalConfig.PeerBlockBodyStorage.DB.FilePath] = dataRetriever.PeerChangesUnit
+	unitsByDirectory[generalConfig.BlockHeaderStorage.DB.FilePath] = dataRetriever.BlockHeaderUnit
+	unitsByDirectory[generalConfig.MetaBlockStorage.DB.FilePath] = dataRetriever.MetaBlockUnit
+	unitsByDirectory[generalConfig.UnsignedTransactionStorage.DB.FilePath] = dataRetriever.UnsignedTransactionUnit
+	unitsByDirectory[generalConfig.RewardTxStorage.DB.FilePath] = dataRetriever.RewardTransactionUnit
+	unitsByDirectory[generalConfig.MetaHdrNonceHashStorage.DB.FilePath] = dataRetriever.MetaHdrNonceHashDataUnit
+	unitsByDirectory[generalConfig.Heartbeat.HeartbeatStorage.DB.FilePath] = dataRetriever.HeartbeatUnit
+	unitsByDirectory[generalConfig.BootstrapStorage.DB.FilePath] = dataRetriever.BootstrapUnit
+	unitsByDirectory[generalConfig.StatusMetricsStorage.DB.FilePath] = dataRetriever.StatusMetricsUnit
+	unitsByDirectory[generalConfig.TxLogsStorage.DB.FilePath] = dataRetriever.TxLogsUnit
+	unitsByDirectory[generalConfig.TxsMetadata.Storage.DB.FilePath] = dataRetriever.TransactionsMetadataUnit
+	unitsByDirectory[generalConfig.TxsMetadata.MiniblocksStorage.DB.FilePath] = dataRetriever.MiniblocksMetadataUnit
+	unitsByDirectory[generalConfig.TxsHistory.Storage.DB.FilePath] = dataRetriever.TransactionsHistoryUnit
+	unitsByDirectory[generalConfig.BlockCommitJournalStorage.DB.FilePath] = dataRetriever.BlockCommitJournalUnit
+	for shardID := uint32(0); shardID < uint32(argsConfig.numOfShards); shardID++ {
+		directory := generalConfig.ShardHdrNonceHashStorage.DB.FilePath + fmt.Sprintf("%d", shardID)
+		unitsByDirectory[directory] = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+	}
+
+	return unitsByDirectory
+}
+
+func listSubdirectories(path string) ([]string, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	directories := make([]string, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() {
+			directories = append(directories, f.Name())
+		}
+	}
+
+	return directories, nil
+}
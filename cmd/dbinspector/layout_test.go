@@ -0,0 +1,52 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLayout(t *testing.T) {
+	t.Parallel()
+
+	dbRoot, err := ioutil.TempDir("", "dbinspector_layout")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dbRoot)
+	}()
+
+	for _, directory := range []string{
+		filepath.Join("1", "Epoch_0", "Shard_0", "BlockHeaders"),
+		filepath.Join("1", "Epoch_10", "Shard_0", "MiniBlocks"),
+		filepath.Join("1", defaultStaticDbString, "Shard_0", "AccountsTrie"),
+	} {
+		err = os.MkdirAll(filepath.Join(dbRoot, directory), 0700)
+		require.Nil(t, err)
+	}
+
+	assert.Nil(t, listLayout(dbRoot, "1"))
+	assert.Nil(t, listLayout(dbRoot, ""))
+	assert.NotNil(t, listLayout(dbRoot, "missing chain"))
+}
+
+func TestDirectoryOrder(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int64(-1), directoryOrder(defaultStaticDbString))
+	assert.Equal(t, int64(2), directoryOrder("Epoch_2"))
+	assert.True(t, directoryOrder("Epoch_2") < directoryOrder("Epoch_10"))
+}
+
+func TestDescribeUnits(t *testing.T) {
+	t.Parallel()
+
+	unitsByDirectory := map[string]dataRetriever.UnitType{"BlockHeaders": dataRetriever.BlockHeaderUnit}
+
+	description := describeUnits([]string{"BlockHeaders", "Unknown"}, unitsByDirectory)
+	assert.Equal(t, "BlockHeaders ("+dataRetriever.BlockHeaderUnit.String()+"), Unknown", description)
+}
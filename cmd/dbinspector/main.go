@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir  string
+	configFile  string
+	chainID     string
+	numOfShards uint
+}
+
+const (
+	defaultDBPath         = "db"
+	defaultEpochString    = "Epoch"
+	defaultStaticDbString = "Static"
+	defaultShardString    = "Shard"
+
+	forceFlagName = "force"
+)
+
+var (
+	dbInspectorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the path of the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, containing the db folder. Example: ./",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// configurationFile defines a flag for the path of the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's main configuration file. Example: ./config/config.toml",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+
+	// chainID defines a flag for the chain ID, used as the name of the folder holding the node's databases
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID of the network, as found in the node's db folder. Example: 1",
+		Destination: &argsConfig.chainID,
+	}
+
+	// numOfShards defines a flag for the number of shards of the network
+	numOfShards = cli.UintFlag{
+		Name:        "num-of-shards",
+		Usage:       "The number of shards of the network, excluding the metachain. Example: 2",
+		Destination: &argsConfig.numOfShards,
+	}
+
+	// hash defines a flag for the hex encoded hash of the inspected item
+	hash = cli.StringFlag{
+		Name:  "hash",
+		Usage: "The hex encoded hash of the inspected item",
+	}
+
+	// nonce defines a flag for a block nonce
+	nonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The nonce of the block",
+	}
+
+	// round defines a flag for the round of the inspected bootstrap data, the highest saved round being used if missing
+	round = cli.Int64Flag{
+		Name:  "round",
+		Usage: "The round of the bootstrap data. The highest saved round is used if not provided",
+	}
+
+	// trieType defines a flag for the inspected trie
+	trieType = cli.StringFlag{
+		Name:  "trie",
+		Usage: "The inspected trie. Possible values: accounts, peer",
+		Value: accountsTrie,
+	}
+
+	// dryRun defines a flag for reporting the changes of a truncation without applying them
+	dryRun = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Only reports the changes the truncation would do",
+	}
+
+	// force defines a flag for truncating the chain even if the state of the block it is truncated to is missing
+	force = cli.BoolFlag{
+		Name:  forceFlagName,
+		Usage: "Truncates the chain even if the state of the block it is truncated to is missing, the node needing a full resync",
+	}
+
+	argsConfig = &cfg{}
+
+	errMissingChainID     = errors.New("missing chain ID")
+	errInvalidNumOfShards = errors.New("invalid number of shards")
+	errMissingHash        = errors.New("missing hash")
+	errMissingNonce       = errors.New("missing nonce")
+
+	log = logger.GetOrCreate("dbinspector")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbInspectorHelpTemplate
+	app.Name = "DB inspector Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will inspect, and optionally repair, the databases of a stopped node"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		chainID,
+		numOfShards,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "list",
+			Usage: "lists the epochs, shards and storage units found in the db folder",
+			Action: func(_ *cli.Context) error {
+				return listLayout(filepath.Join(argsConfig.workingDir, defaultDBPath), argsConfig.chainID)
+			},
+		},
+		{
+			Name:  "header",
+			Usage: "decodes the self shard header with the provided hash or nonce",
+			Flags: []cli.Flag{hash, nonce},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.printHeader(c.String(hash.Name), c.Uint64(nonce.Name), c.IsSet(nonce.Name))
+			}),
+		},
+		{
+			Name:  "miniblock",
+			Usage: "decodes the miniblock with the provided hash",
+			Flags: []cli.Flag{hash},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.printMiniBlock(c.String(hash.Name))
+			}),
+		},
+		{
+			Name:  "transaction",
+			Usage: "decodes the transaction, smart contract result or reward transaction with the provided hash",
+			Flags: []cli.Flag{hash},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.printTransaction(c.String(hash.Name))
+			}),
+		},
+		{
+			Name:  "bootstrap",
+			Usage: "decodes the bootstrap data saved for the provided round",
+			Flags: []cli.Flag{round},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.printBootstrapData(c.Int64(round.Name), c.IsSet(round.Name))
+			}),
+		},
+		{
+			Name:  "trienode",
+			Usage: "decodes the trie node with the provided hash",
+			Flags: []cli.Flag{hash, trieType},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.printTrieNode(c.String(trieType.Name), c.String(hash.Name))
+			}),
+		},
+		{
+			Name:  "walktrie",
+			Usage: "walks the trie from the provided root hash and reports the missing and corrupted nodes",
+			Flags: []cli.Flag{hash, trieType},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				return di.walkTrie(c.String(trieType.Name), c.String(hash.Name))
+			}),
+		},
+		{
+			Name:  "truncate",
+			Usage: "truncates the self shard chain back to the provided nonce, so the node resyncs from there",
+			Flags: []cli.Flag{nonce, dryRun, force},
+			Action: withInspector(func(di *dbInspector, c *cli.Context) error {
+				if !c.IsSet(nonce.Name) {
+					return errMissingNonce
+				}
+
+				return di.truncate(c.Uint64(nonce.Name), c.Bool(dryRun.Name), c.Bool(force.Name))
+			}),
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting the db", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func withInspector(handler func(di *dbInspector, c *cli.Context) error) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		if len(argsConfig.chainID) == 0 {
+			return errMissingChainID
+		}
+		if argsConfig.numOfShards == 0 {
+			return errInvalidNumOfShards
+		}
+
+		di, err := newDBInspector()
+		if err != nil {
+			return err
+		}
+		defer di.close()
+
+		return handler(di, c)
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+const (
+	accountsTrie = "accounts"
+	peerTrie     = "peer"
+
+	maxReportedNodes        = 100
+	logWalkProgressInterval = 100000
+)
+
+var errUnknownTrie = errors.New("unknown trie")
+var errTrieIncomplete = errors.New("the trie has missing or corrupted nodes")
+
+// trieDatabases holds the main database of a trie together with its snapshots, searched in this order
+type trieDatabases struct {
+	persisters []storage.Persister
+}
+
+func (td *trieDatabases) get(hash []byte) ([]byte, error) {
+	for _, persister := range td.persisters {
+		value, err := persister.Get(hash)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+func (td *trieDatabases) close() {
+	for _, persister := range td.persisters {
+		log.LogIfError(persister.Close())
+	}
+}
+
+func (di *dbInspector) openTrieDatabases(trieName string) (*trieDatabases, error) {
+	var trieStorageConfig config.StorageConfig
+	switch trieName {
+	case accountsTrie:
+		trieStorageConfig = di.generalConfig.AccountsTrieStorage
+	case peerTrie:
+		trieStorageConfig = di.generalConfig.PeerAccountsTrieStorage
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTrie, trieName)
+	}
+
+	shardID := core.GetShardIdString(di.shardCoordinator.SelfId())
+	mainDBPath := di.pathManager.PathForStatic(shardID, trieStorageConfig.DB.FilePath)
+	_, err := os.Stat(mainDBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mainDB, err := storageFactory.NewPersisterFactory(trieStorageConfig.DB).Create(mainDBPath)
+	if err != nil {
+		return nil, err
+	}
+	databases := &trieDatabases{
+		persisters: []storage.Persister{mainDB},
+	}
+
+	trieStoragePath, _ := filepath.Split(mainDBPath)
+	snapshotsPath := filepath.Join(trieStoragePath, di.generalConfig.TrieSnapshotDB.FilePath)
+	snapshots, err := listSubdirectories(snapshotsPath)
+	if err != nil {
+		log.Debug("no trie snapshots found", "path", snapshotsPath)
+		return databases, nil
+	}
+
+	snapshotsFactory := storageFactory.NewPersisterFactory(di.generalConfig.TrieSnapshotDB)
+	for _, snapshot := range snapshots {
+		snapshotDB, errCreate := snapshotsFactory.Create(filepath.Join(snapshotsPath, snapshot))
+		if errCreate != nil {
+			databases.close()
+			return nil, errCreate
+		}
+
+		databases.persisters = append(databases.persisters, snapshotDB)
+	}
+
+	return databases, nil
+}
+
+func (di *dbInspector) printTrieNode(trieName string, hexHash string) error {
+	hash, err := decodeHash(hexHash)
+	if err != nil {
+		return err
+	}
+
+	databases, err := di.openTrieDatabases(trieName)
+	if err != nil {
+		return err
+	}
+	defer databases.close()
+
+	encNode, err := databases.get(hash)
+	if err != nil {
+		return err
+	}
+
+	nodeInfo, err := trie.DecodeNodeInfo(encNode, di.marshalizer, di.hasher)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(nodeInfo.Hash, hash) {
+		log.Warn("the trie node is corrupted, its hash does not match the key it is saved under",
+			"key", hexHash,
+			"computed hash", hex.EncodeToString(nodeInfo.Hash),
+		)
+	}
+
+	return printDecoded(fmt.Sprintf("%s trie node %s", trieName, hexHash), nodeInfo)
+}
+
+// walkTrie visits all the nodes reachable from the provided root hash, checking that each one can be found and
+// decoded and that its hash matches the key it is saved under
+func (di *dbInspector) walkTrie(trieName string, hexRootHash string) error {
+	rootHash, err := decodeHash(hexRootHash)
+	if err != nil {
+		return err
+	}
+
+	databases, err := di.openTrieDatabases(trieName)
+	if err != nil {
+		return err
+	}
+	defer databases.close()
+
+	missing := make([][]byte, 0)
+	corrupted := make([][]byte, 0)
+	visited := make(map[string]struct{})
+	numNodes := 0
+	numLeaves := 0
+
+	pending := [][]byte{rootHash}
+	for len(pending) > 0 {
+		hash := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		_, isVisited := visited[string(hash)]
+		if isVisited {
+			continue
+		}
+		visited[string(hash)] = struct{}{}
+
+		encNode, errGet := databases.get(hash)
+		if errGet != nil {
+			missing = append(missing, hash)
+			continue
+		}
+
+		nodeInfo, errDecode := trie.DecodeNodeInfo(encNode, di.marshalizer, di.hasher)
+		if errDecode != nil || !bytes.Equal(nodeInfo.Hash, hash) {
+			corrupted = append(corrupted, hash)
+			continue
+		}
+
+		numNodes++
+		if nodeInfo.Type == trie.LeafNodeType {
+			numLeaves++
+		}
+		if numNodes%logWalkProgressInterval == 0 {
+			log.Info("walking the trie", "num nodes", numNodes, "num missing", len(missing))
+		}
+
+		pending = append(pending, nodeInfo.ChildrenHashes...)
+	}
+
+	fmt.Printf("%s trie %s: %d nodes, %d leaves, %d missing nodes, %d corrupted nodes\n",
+		trieName, hexRootHash, numNodes, numLeaves, len(missing), len(corrupted))
+	printHashes("missing", missing)
+	printHashes("corrupted", corrupted)
+
+	if len(missing) > 0 || len(corrupted) > 0 {
+		return errTrieIncomplete
+	}
+
+	return nil
+}
+
+func printHashes(title string, hashes [][]byte) {
+	for i, hash := range hashes {
+		if i == maxReportedNodes {
+			fmt.Printf("  ... and %d more %s nodes\n", len(hashes)-maxReportedNodes, title)
+			return
+		}
+
+		fmt.Printf("  %s node %s\n", title, hex.EncodeToString(hash))
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDbInspector_PrintTrieNode(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key1": "value1", "key2": "value2"})
+	defer cleanup()
+
+	assert.Nil(t, di.printTrieNode(accountsTrie, hex.EncodeToString(rootHash)))
+	assert.NotNil(t, di.printTrieNode(accountsTrie, hex.EncodeToString([]byte("missing"))))
+	assert.Equal(t, errMissingHash, di.printTrieNode(accountsTrie, ""))
+	assert.True(t, errors.Is(di.printTrieNode("unknown", hex.EncodeToString(rootHash)), errUnknownTrie))
+}
+
+func TestDbInspector_WalkTrie(t *testing.T) {
+	t.Parallel()
+
+	di, rootHash, cleanup := createTestInspector(t, map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"})
+	defer cleanup()
+
+	assert.Nil(t, di.walkTrie(accountsTrie, hex.EncodeToString(rootHash)))
+	assert.Equal(t, errTrieIncomplete, di.walkTrie(accountsTrie, hex.EncodeToString([]byte("missing root"))))
+}
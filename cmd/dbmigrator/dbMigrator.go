@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+)
+
+const (
+	// levelDBMarkerFile is the file found in the directory of each LevelDB database
+	levelDBMarkerFile   = "CURRENT"
+	logProgressInterval = 100000
+
+	migrationBatchDelaySeconds = 1
+	migrationMaxBatchSize      = 10000
+	migrationMaxOpenFiles      = 10
+)
+
+var errDestinationNotEmpty = errors.New("destination is not empty")
+var errNoLevelDBUnitFound = errors.New("no LevelDB unit found")
+var errMigratedUnitMismatch = errors.New("the migrated unit does not match the source")
+
+type dbMigrator struct {
+	source      string
+	destination string
+	compression badgerdb.CompressionType
+}
+
+func newDBMigrator(source string, destination string, compression badgerdb.CompressionType) (*dbMigrator, error) {
+	_, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(destination)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(files) > 0 {
+		return nil, fmt.Errorf("%w: %s", errDestinationNotEmpty, destination)
+	}
+
+	return &dbMigrator{
+		source:      source,
+		destination: destination,
+		compression: compression,
+	}, nil
+}
+
+// migrate copies each LevelDB unit found in the source tree at the same relative path in the destination
+func (dm *dbMigrator) migrate() error {
+	units, err := dm.findLevelDBUnits()
+	if err != nil {
+		return err
+	}
+	if len(units) == 0 {
+		return fmt.Errorf("%w in %s", errNoLevelDBUnitFound, dm.source)
+	}
+
+	for _, unitPath := range units {
+		relativePath, errRel := filepath.Rel(dm.source, unitPath)
+		if errRel != nil {
+			return errRel
+		}
+
+		err = dm.migrateUnit(unitPath, filepath.Join(dm.destination, relativePath))
+		if err != nil {
+			return fmt.Errorf("%w while migrating %s", err, unitPath)
+		}
+	}
+
+	log.Info("migration finished", "num units", len(units))
+
+	return nil
+}
+
+func (dm *dbMigrator) findLevelDBUnits() ([]string, error) {
+	units := make([]string, 0)
+	err := filepath.Walk(dm.source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != levelDBMarkerFile {
+			return nil
+		}
+
+		units = append(units, filepath.Dir(path))
+
+		return nil
+	})
+
+	return units, err
+}
+
+func (dm *dbMigrator) migrateUnit(sourcePath string, destinationPath string) error {
+	sourceDB, err := leveldb.NewDB(sourcePath, migrationBatchDelaySeconds, migrationMaxBatchSize, migrationMaxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(sourceDB.Close())
+	}()
+
+	destinationDB, err := badgerdb.NewDB(destinationPath, migrationBatchDelaySeconds, migrationMaxBatchSize, dm.compression)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(destinationDB.Close())
+	}()
+
+	numPairs := 0
+	var errPut error
+	err = sourceDB.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destinationDB.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numPairs++
+		if numPairs%logProgressInterval == 0 {
+			log.Info("migrating unit", "unit", sourcePath, "num pairs", numPairs)
+		}
+
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if errPut != nil {
+		return errPut
+	}
+
+	numWrittenPairs := 0
+	err = destinationDB.RangeKeys(func(_ []byte, _ []byte) bool {
+		numWrittenPairs++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if numWrittenPairs != numPairs {
+		return fmt.Errorf("%w: %d pairs read, %d pairs written", errMigratedUnitMismatch, numPairs, numWrittenPairs)
+	}
+
+	statistics := destinationDB.PersisterStatistics()
+
+	log.Info("unit migrated",
+		"source", sourcePath,
+		"destination", destinationPath,
+		"num pairs", numPairs,
+		"disk size", core.ConvertBytes(statistics.DiskSize),
+	)
+
+	return nil
+}
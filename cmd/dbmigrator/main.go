@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	source      string
+	destination string
+	compression string
+}
+
+var (
+	dbMigratorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// source defines a flag for the path of the LevelDB unit, or of a directory tree holding LevelDB units
+	source = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The LevelDB unit to be migrated or a directory holding LevelDB units, such as a node's db folder. Example: ./db",
+		Destination: &argsConfig.source,
+	}
+
+	// destination defines a flag for the path where the migrated units will be written
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The directory where the migrated units are written, keeping the source's directories layout. Example: ./db-migrated",
+		Destination: &argsConfig.destination,
+	}
+
+	// compression defines a flag for the compression applied on the values of the migrated units
+	compression = cli.StringFlag{
+		Name:        "compression",
+		Usage:       "The compression applied on the tables of the migrated units. Possible values: None, Snappy",
+		Value:       string(badgerdb.SnappyCompression),
+		Destination: &argsConfig.compression,
+	}
+
+	argsConfig = &cfg{}
+
+	errMissingSource      = errors.New("missing source path")
+	errMissingDestination = errors.New("missing destination path")
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigratorHelpTemplate
+	app.Name = "DB migrator Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will copy the LevelDB units of a stopped node into the BadgerDB format. The migrated units " +
+		"are used by setting their DB type to BadgerDB in the node's configuration file"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		compression,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return migrate()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error migrating the db", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func migrate() error {
+	if len(argsConfig.source) == 0 {
+		return errMissingSource
+	}
+	if len(argsConfig.destination) == 0 {
+		return errMissingDestination
+	}
+
+	migrator, err := newDBMigrator(argsConfig.source, argsConfig.destination, badgerdb.CompressionType(argsConfig.compression))
+	if err != nil {
+		return err
+	}
+
+	return migrator.migrate()
+}
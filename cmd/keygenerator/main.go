@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
@@ -19,13 +21,19 @@ import (
 )
 
 type cfg struct {
-	numKeys int
-	keyType string
+	numKeys      int
+	keyType      string
+	encrypt      bool
+	passwordFile string
+	kdf          string
+	password     []byte
 }
 
 const keysFolderPattern = "node-%d"
 const blsPubkeyLen = 96
 const txSignPubkeyLen = 32
+const keystorePasswordEnvVariable = "ELROND_KEYSTORE_PASSWORD"
+const keystoreFileExtension = ".json"
 
 var (
 	fileGenHelpTemplate = `NAME:
@@ -36,6 +44,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -60,6 +71,51 @@ VERSION:
 		Destination: &argsConfig.keyType,
 	}
 
+	// encrypt defines a flag for generating password protected JSON keystore files instead of PEM files
+	encrypt = cli.BoolFlag{
+		Name:        "encrypt",
+		Usage:       "If set, the keys are saved in password protected JSON keystore files instead of PEM files",
+		Destination: &argsConfig.encrypt,
+	}
+
+	// passwordFile defines a flag for the file containing the keystore password
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The `filepath` for the file which contains the keystore password. If not set, the password is read " +
+			"from the " + keystorePasswordEnvVariable + " environment variable",
+		Value:       "",
+		Destination: &argsConfig.passwordFile,
+	}
+
+	// kdf defines a flag for the key derivation function used by the keystore
+	kdf = cli.StringFlag{
+		Name:        "kdf",
+		Usage:       "The key derivation function used to protect the keystore. Available options: scrypt, argon2id",
+		Value:       keystore.KdfScrypt,
+		Destination: &argsConfig.kdf,
+	}
+
+	// pemFile defines a flag for the PEM file to be converted in a keystore file
+	pemFile = cli.StringFlag{
+		Name:  "pem-file",
+		Usage: "The `filepath` for the PEM file which should be converted",
+		Value: validatorKeyFileName,
+	}
+
+	// skIndex defines a flag for the index of the private key in the PEM file
+	skIndex = cli.IntFlag{
+		Name:  "sk-index",
+		Usage: "The index in the PEM file of the private key to be converted",
+		Value: 0,
+	}
+
+	// outputFile defines a flag for the generated keystore file
+	outputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `filepath` for the generated keystore file. Defaults to the PEM file path with the .json extension",
+		Value: "",
+	}
+
 	argsConfig = &cfg{}
 
 	walletKeyFileName    = "walletKey.pem"
@@ -73,7 +129,8 @@ func main() {
 	cli.AppHelpTemplate = fileGenHelpTemplate
 	app.Name = "Key generation Tool"
 	app.Version = "v1.0.0"
-	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s)"
+	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s), or their " +
+		"password protected .json keystore counterparts"
 	app.Authors = []cli.Author{
 		{
 			Name:  "The Elrond Team",
@@ -83,6 +140,24 @@ func main() {
 	app.Flags = []cli.Flag{
 		numKeys,
 		keyType,
+		encrypt,
+		passwordFile,
+		kdf,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "convert",
+			Usage: "Converts an existing PEM file in a password protected JSON keystore file",
+			Flags: []cli.Flag{
+				pemFile,
+				keyType,
+				skIndex,
+				outputFile,
+				passwordFile,
+				kdf,
+			},
+			Action: convertPemFile,
+		},
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -143,6 +218,14 @@ func backupFileIfExists(filename string) {
 }
 
 func generateAllFiles() error {
+	if argsConfig.encrypt {
+		var err error
+		argsConfig.password, err = keystore.ReadPassword(argsConfig.passwordFile, keystorePasswordEnvVariable)
+		if err != nil {
+			return err
+		}
+	}
+
 	for i := 0; i < argsConfig.numKeys; i++ {
 		err := generateOneSetOfFiles(i, argsConfig.numKeys)
 		if err != nil {
@@ -171,7 +254,7 @@ func generateOneSetOfFiles(index int, numKeys int) error {
 	}
 }
 
-func generateBlockKey(index int, numKeys int) error {
+func createBlockKeyComponents() (crypto.KeyGenerator, core.PubkeyConverter, error) {
 	pubkeyConverter, err := factory.NewPubkeyConverter(
 		config.PubkeyConfig{
 			Length: blsPubkeyLen,
@@ -179,26 +262,40 @@ func generateBlockKey(index int, numKeys int) error {
 		},
 	)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	genForBlockSigningSk := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
-
-	return generateAndSave(index, numKeys, validatorKeyFileName, genForBlockSigningSk, pubkeyConverter)
+	return signing.NewKeyGenerator(mcl.NewSuiteBLS12()), pubkeyConverter, nil
 }
 
-func generateTxKey(index int, numKeys int) error {
+func createTxKeyComponents() (crypto.KeyGenerator, core.PubkeyConverter, error) {
 	pubkeyConverter, err := factory.NewPubkeyConverter(
 		config.PubkeyConfig{
 			Length: txSignPubkeyLen,
 			Type:   factory.Bech32Format,
 		},
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signing.NewKeyGenerator(ed25519.NewEd25519()), pubkeyConverter, nil
+}
+
+func generateBlockKey(index int, numKeys int) error {
+	genForBlockSigningSk, pubkeyConverter, err := createBlockKeyComponents()
 	if err != nil {
 		return err
 	}
 
-	genForBlockSigningSk := signing.NewKeyGenerator(ed25519.NewEd25519())
+	return generateAndSave(index, numKeys, validatorKeyFileName, genForBlockSigningSk, pubkeyConverter)
+}
+
+func generateTxKey(index int, numKeys int) error {
+	genForBlockSigningSk, pubkeyConverter, err := createTxKeyComponents()
+	if err != nil {
+		return err
+	}
 
 	return generateAndSave(index, numKeys, walletKeyFileName, genForBlockSigningSk, pubkeyConverter)
 }
@@ -209,6 +306,10 @@ func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSig
 		return err
 	}
 
+	if argsConfig.encrypt {
+		baseFilename = keystoreFileName(baseFilename)
+	}
+
 	filename := filepath.Join(folder, baseFilename)
 	backupFileIfExists(filename)
 
@@ -217,6 +318,15 @@ func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSig
 		return err
 	}
 
+	if argsConfig.encrypt {
+		sk, pk, errGenerate := generateKeys(genForBlockSigningSk)
+		if errGenerate != nil {
+			return errGenerate
+		}
+
+		return saveKeystoreFile(filename, sk, pubkeyConverter.Encode(pk), argsConfig.password, argsConfig.kdf)
+	}
+
 	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
 	if err != nil {
 		return err
@@ -235,3 +345,98 @@ func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSig
 
 	return core.SaveSkToPemFile(file, pkString, []byte(hex.EncodeToString(sk)))
 }
+
+func convertPemFile(ctx *cli.Context) error {
+	var keyGen crypto.KeyGenerator
+	var pubkeyConverter core.PubkeyConverter
+	var err error
+
+	switch ctx.String(keyType.Name) {
+	case "validator":
+		keyGen, pubkeyConverter, err = createBlockKeyComponents()
+	case "wallet":
+		keyGen, pubkeyConverter, err = createTxKeyComponents()
+	default:
+		return fmt.Errorf("unknown key type %s, available options: validator, wallet", ctx.String(keyType.Name))
+	}
+	if err != nil {
+		return err
+	}
+
+	pemFileName := ctx.String(pemFile.Name)
+	encodedSk, pkString, err := core.LoadSkPkFromPemFile(pemFileName, ctx.Int(skIndex.Name))
+	if err != nil {
+		return err
+	}
+
+	sk, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	err = checkKeyPair(keyGen, pubkeyConverter, sk, pkString)
+	if err != nil {
+		return err
+	}
+
+	password, err := keystore.ReadPassword(ctx.String(passwordFile.Name), keystorePasswordEnvVariable)
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.String(outputFile.Name)
+	if len(filename) == 0 {
+		filename = keystoreFileName(pemFileName)
+	}
+	backupFileIfExists(filename)
+
+	err = saveKeystoreFile(filename, sk, pkString, password, ctx.String(kdf.Name))
+	if err != nil {
+		return err
+	}
+
+	log.Info("converted PEM file", "pem file", pemFileName, "keystore file", filename, "public key", pkString)
+
+	return nil
+}
+
+func checkKeyPair(keyGen crypto.KeyGenerator, pubkeyConverter core.PubkeyConverter, sk []byte, pkString string) error {
+	privateKey, err := keyGen.PrivateKeyFromByteArray(sk)
+	if err != nil {
+		return err
+	}
+
+	pk, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	if pubkeyConverter.Encode(pk) != pkString {
+		return fmt.Errorf("public key mismatch between the computed one and %s read from the PEM file", pkString)
+	}
+
+	return nil
+}
+
+func saveKeystoreFile(filename string, sk []byte, pkString string, password []byte, kdfName string) error {
+	var kdfParams keystore.KdfParams
+	switch kdfName {
+	case keystore.KdfScrypt:
+		kdfParams = keystore.NewScryptParams()
+	case keystore.KdfArgon2id:
+		kdfParams = keystore.NewArgon2idParams()
+	default:
+		return fmt.Errorf("%w: %s", keystore.ErrUnsupportedKdf, kdfName)
+	}
+
+	keyFile, err := keystore.EncryptKey(sk, pkString, password, kdfName, kdfParams)
+	if err != nil {
+		return err
+	}
+
+	return keystore.SaveKeyFile(filename, keyFile)
+}
+
+func keystoreFileName(filename string) string {
+	return strings.TrimSuffix(filename, filepath.Ext(filename)) + keystoreFileExtension
+}
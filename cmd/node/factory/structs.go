@@ -2,11 +2,14 @@ package factory
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
@@ -37,6 +40,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -44,6 +48,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -52,6 +57,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/networksharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -103,6 +112,8 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	EvidencePool             storage.Cacher
+	EvidenceVerifier         slashing.EvidenceVerifier
 }
 
 type processComponentsFactoryArgs struct {
@@ -324,6 +335,17 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 	}
 
 	args.txLogsProcessor = txLogsProcessor
+
+	txsMetadataRecorder, err := createTxsMetadataRecorder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	txsHistoryRecorder, err := createTxsHistoryRecorder(args)
+	if err != nil {
+		return nil, err
+	}
+
 	genesisBlocks, err := generateGenesisHeadersAndApplyInitialBalances(args)
 	if err != nil {
 		return nil, err
@@ -438,6 +460,11 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	evidencePool, evidenceVerifier, err := newEquivocationEvidenceComponents(args)
+	if err != nil {
+		return nil, err
+	}
+
 	blockProcessor, err := newBlockProcessor(
 		args,
 		requestHandler,
@@ -449,6 +476,10 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		blockTracker,
 		pendingMiniBlocksHandler,
 		txLogsProcessor,
+		txsMetadataRecorder,
+		txsHistoryRecorder,
+		evidencePool,
+		evidenceVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -487,6 +518,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		EvidencePool:             evidencePool,
+		EvidenceVerifier:         evidenceVerifier,
 	}, nil
 }
 
@@ -760,6 +793,8 @@ func newShardInterceptorContainerFactory(
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		ChainID:                 dataCore.ChainID,
+		MinTransactionVersion:   dataCore.MinTransactionVersion,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -815,6 +850,8 @@ func newMetaInterceptorContainerFactory(
 		WhiteListerVerifiedTxs:  whiteListerVerifiedTxs,
 		AntifloodHandler:        network.InputAntifloodHandler,
 		NonceConverter:          dataCore.Uint64ByteSliceConverter,
+		ChainID:                 dataCore.ChainID,
+		MinTransactionVersion:   dataCore.MinTransactionVersion,
 	}
 	interceptorContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorsContainerFactoryArgs)
 	if err != nil {
@@ -1009,6 +1046,10 @@ func newBlockProcessor(
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txLogsProcessor process.TransactionLogProcessor,
+	txsMetadataRecorder process.TransactionsMetadataRecorder,
+	txsHistoryRecorder process.TransactionsHistoryRecorder,
+	evidencePool storage.Cacher,
+	evidenceVerifier slashing.EvidenceVerifier,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1035,6 +1076,8 @@ func newBlockProcessor(
 			processArgs.minSizeInBytes,
 			processArgs.maxSizeInBytes,
 			txLogsProcessor,
+			txsMetadataRecorder,
+			txsHistoryRecorder,
 			processArgs.version,
 		)
 	}
@@ -1064,7 +1107,11 @@ func newBlockProcessor(
 			processArgs.ratingsData,
 			processArgs.nodesConfig,
 			txLogsProcessor,
+			txsMetadataRecorder,
+			txsHistoryRecorder,
 			processArgs.systemSCConfig,
+			evidencePool,
+			evidenceVerifier,
 			processArgs.version,
 		)
 	}
@@ -1072,6 +1119,33 @@ func newBlockProcessor(
 	return nil, errors.New("could not create block processor")
 }
 
+func createTxsHistoryRecorder(args *processComponentsFactoryArgs) (process.TransactionsHistoryRecorder, error) {
+	if !args.coreComponents.Config.TxsHistory.Enabled {
+		return disabledTxHistory.NewHistoryRecorder(), nil
+	}
+
+	return txhistory.NewHistoryRecorder(txhistory.ArgsHistoryRecorder{
+		HistoryStorer:    args.data.BlockCommitStore.GetStorer(dataRetriever.TransactionsHistoryUnit),
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		ShardCoordinator: args.shardCoordinator,
+	})
+}
+
+func createTxsMetadataRecorder(args *processComponentsFactoryArgs) (process.TransactionsMetadataRecorder, error) {
+	if !args.coreComponents.Config.TxsMetadata.Enabled {
+		return disabledTxStatus.NewMetadataRecorder(), nil
+	}
+
+	return txstatus.NewMetadataRecorder(txstatus.ArgsMetadataRecorder{
+		MetadataStorer:           args.data.BlockCommitStore.GetStorer(dataRetriever.TransactionsMetadataUnit),
+		MiniblocksMetadataStorer: args.data.BlockCommitStore.GetStorer(dataRetriever.MiniblocksMetadataUnit),
+		MiniBlocksStorer:         args.data.BlockCommitStore.GetStorer(dataRetriever.MiniBlockUnit),
+		Marshalizer:              args.coreData.InternalMarshalizer,
+		Hasher:                   args.coreData.Hasher,
+		SelfShardID:              args.shardCoordinator.SelfId(),
+	})
+}
+
 func newShardBlockProcessor(
 	config *config.Config,
 	requestHandler process.RequestHandler,
@@ -1093,14 +1167,18 @@ func newShardBlockProcessor(
 	minSizeInBytes uint32,
 	maxSizeInBytes uint32,
 	txLogsProcessor process.TransactionLogProcessor,
+	txsMetadataRecorder process.TransactionsMetadataRecorder,
+	txsHistoryRecorder process.TransactionsHistoryRecorder,
 	version string,
 ) (process.BlockProcessor, error) {
 	argsParser := vmcommon.NewAtArgumentParser()
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     core.InternalMarshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      core.InternalMarshalizer,
+		Accounts:         stateComponents.AccountsAdapter,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -1136,7 +1214,7 @@ func newShardBlockProcessor(
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		data.BlockCommitStore,
 		data.Datapool,
 	)
 	if err != nil {
@@ -1227,6 +1305,7 @@ func newShardBlockProcessor(
 		economics,
 		receiptTxInterim,
 		badTxInterim,
+		scForwarder,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
@@ -1249,7 +1328,7 @@ func newShardBlockProcessor(
 
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		data.BlockCommitStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -1302,7 +1381,7 @@ func newShardBlockProcessor(
 		ForkDetector:           forkDetector,
 		Hasher:                 core.Hasher,
 		Marshalizer:            core.InternalMarshalizer,
-		Store:                  data.Store,
+		Store:                  data.BlockCommitStore,
 		ShardCoordinator:       shardCoordinator,
 		NodesCoordinator:       nodesCoordinator,
 		Uint64Converter:        core.Uint64ByteSliceConverter,
@@ -1320,6 +1399,9 @@ func newShardBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		TxsMetadataRecorder:    txsMetadataRecorder,
+		TxsHistoryRecorder:     txsHistoryRecorder,
+		BlockCommitJournal:     data.BlockCommitJournal,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1363,11 +1445,25 @@ func newMetaBlockProcessor(
 	ratingsData process.RatingsInfoHandler,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	txLogsProcessor process.TransactionLogProcessor,
+	txsMetadataRecorder process.TransactionsMetadataRecorder,
+	txsHistoryRecorder process.TransactionsHistoryRecorder,
 	systemSCConfig *config.SystemSmartContractsConfig,
+	evidencePool storage.Cacher,
+	evidenceVerifier slashing.EvidenceVerifier,
 	version string,
 ) (process.BlockProcessor, error) {
 
-	builtInFuncs := builtInFunctions.NewBuiltInFunctionContainer()
+	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:           gasSchedule,
+		Marshalizer:      core.InternalMarshalizer,
+		Accounts:         stateComponents.AccountsAdapter,
+		ShardCoordinator: shardCoordinator,
+	}
+	builtInFuncs, err := builtInFunctions.CreateMetaBuiltInFunctionContainer(argsBuiltIn)
+	if err != nil {
+		return nil, err
+	}
+
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:         stateComponents.AccountsAdapter,
 		PubkeyConv:       stateComponents.AddressPubkeyConverter,
@@ -1376,7 +1472,7 @@ func newMetaBlockProcessor(
 		ShardCoordinator: shardCoordinator,
 		Marshalizer:      core.InternalMarshalizer,
 		Uint64Converter:  core.Uint64ByteSliceConverter,
-		BuiltInFunctions: builtInFuncs, // no built-in functions for meta.
+		BuiltInFunctions: builtInFuncs,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(
 		argsHook,
@@ -1405,7 +1501,7 @@ func newMetaBlockProcessor(
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		data.BlockCommitStore,
 		data.Datapool,
 	)
 	if err != nil {
@@ -1496,7 +1592,7 @@ func newMetaBlockProcessor(
 
 	preProcFactory, err := metachain.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		data.BlockCommitStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -1538,7 +1634,7 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
-	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economicsData)
+	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economicsData, vmFactory.BlockChainHookImpl(), stateComponents.AccountsAdapter)
 	if err != nil {
 		return nil, err
 	}
@@ -1560,6 +1656,30 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	systemVM, err := vmContainer.Get(factory.SystemVirtualMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	slashValue, ok := big.NewInt(0).SetString(systemSCConfig.EquivocationSlashingConfig.SlashValue, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", slashing.ErrInvalidSlashValue, systemSCConfig.EquivocationSlashingConfig.SlashValue)
+	}
+
+	argsEvidenceProcessor := slashing.ArgsEvidenceProcessor{
+		Verifier:            evidenceVerifier,
+		EvidencePool:        evidencePool,
+		Accounts:            stateComponents.AccountsAdapter,
+		SystemVM:            systemVM,
+		PeerStateUpdater:    smartContractToProtocol,
+		SlashValue:          slashValue,
+		MaxEvidencePerBlock: systemSCConfig.EquivocationSlashingConfig.MaxEvidencePerBlock,
+	}
+	evidenceProcessor, err := slashing.NewEvidenceProcessor(argsEvidenceProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	argsEpochStartData := metachainEpochStart.ArgsNewEpochStartData{
 		Marshalizer:       core.InternalMarshalizer,
 		Hasher:            core.Hasher,
@@ -1583,14 +1703,16 @@ func newMetaBlockProcessor(
 		NodesConfigProvider: nodesCoordinator,
 		RewardsHandler:      economicsData,
 		RoundTime:           rounder,
+		ScQuery:             scDataGetter,
+		ValidatorSettings:   economicsData,
 	}
 	epochEconomics, err := metachainEpochStart.NewEndOfEpochEconomicsDataCreator(argsEpochEconomics)
 	if err != nil {
 		return nil, err
 	}
 
-	rewardsStorage := data.Store.GetStorer(dataRetriever.RewardTransactionUnit)
-	miniBlockStorage := data.Store.GetStorer(dataRetriever.MiniBlockUnit)
+	rewardsStorage := data.BlockCommitStore.GetStorer(dataRetriever.RewardTransactionUnit)
+	miniBlockStorage := data.BlockCommitStore.GetStorer(dataRetriever.MiniBlockUnit)
 	argsEpochRewards := metachainEpochStart.ArgsNewRewardsCreator{
 		ShardCoordinator: shardCoordinator,
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
@@ -1628,7 +1750,7 @@ func newMetaBlockProcessor(
 		ForkDetector:           forkDetector,
 		Hasher:                 core.Hasher,
 		Marshalizer:            core.InternalMarshalizer,
-		Store:                  data.Store,
+		Store:                  data.BlockCommitStore,
 		ShardCoordinator:       shardCoordinator,
 		NodesCoordinator:       nodesCoordinator,
 		Uint64Converter:        core.Uint64ByteSliceConverter,
@@ -1646,6 +1768,9 @@ func newMetaBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		TxsMetadataRecorder:    txsMetadataRecorder,
+		TxsHistoryRecorder:     txsHistoryRecorder,
+		BlockCommitJournal:     data.BlockCommitJournal,
 	}
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
@@ -1657,6 +1782,7 @@ func newMetaBlockProcessor(
 		EpochRewardsCreator:          epochRewards,
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
+		EvidenceProcessor:            evidenceProcessor,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -1672,6 +1798,34 @@ func newMetaBlockProcessor(
 	return metaProcessor, nil
 }
 
+func newEquivocationEvidenceComponents(args *processComponentsFactoryArgs) (storage.Cacher, slashing.EvidenceVerifier, error) {
+	slashingConfig := args.systemSCConfig.EquivocationSlashingConfig
+	evidencePool, err := storageUnit.NewCache(storageUnit.LRUCache, uint32(slashingConfig.EvidencePoolCapacity), 1, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	consensusService, err := sposFactory.GetConsensusCoreFactory(args.mainConfig.Consensus.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argsVerifier := slashing.ArgsEvidenceVerifier{
+		Marshalizer:     args.coreData.InternalMarshalizer,
+		KeyGenerator:    args.crypto.BlockSignKeyGen,
+		SingleSigner:    args.crypto.SingleSigner,
+		Classifier:      consensusService,
+		ChainID:         []byte(args.nodesConfig.ChainID),
+		MaxEvidenceSize: slashingConfig.MaxEvidenceSizeInBytes,
+	}
+	evidenceVerifier, err := slashing.NewEvidenceVerifier(argsVerifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return evidencePool, evidenceVerifier, nil
+}
+
 func newValidatorStatisticsProcessor(
 	processComponents *processComponentsFactoryArgs,
 ) (process.ValidatorStatisticsProcessor, error) {
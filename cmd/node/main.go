@@ -23,7 +23,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/redundancy"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/signingHistory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -33,11 +35,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie/verifier"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -48,6 +56,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
@@ -82,12 +91,18 @@ const (
 	defaultStatsPath             = "stats"
 	defaultLogsPath              = "logs"
 	defaultDBPath                = "db"
+	defaultSigningHistoryPath    = "signingHistory"
 	defaultEpochString           = "Epoch"
 	defaultStaticDbString        = "Static"
 	defaultShardString           = "Shard"
 	metachainShardName           = "metachain"
 	secondsToWaitForP2PBootstrap = 20
 	maxNumGoRoutinesTxsByHashApi = 10
+	maxNumGoRoutinesBlocksApi    = 10
+	maxNumGoRoutinesAccountKeys  = 10
+	maxNumGoRoutinesTxsHistory   = 10
+
+	validatorKeystorePasswordEnvVariable = "ELROND_VALIDATOR_KEYSTORE_PASSWORD"
 )
 
 var (
@@ -261,6 +276,14 @@ VERSION:
 		Value: "",
 	}
 
+	// redundancyLevel defines the redundancy level of the machine. If set, will override the RedundancyLevel from prefs.toml
+	redundancyLevel = cli.Int64Flag{
+		Name: "redundancy-level",
+		Usage: "The redundancy level of the machine: 0 for the main machine of the validator key, a greater value for a " +
+			"hot-standby backup machine. Will override the level set in the preferences TOML file.",
+		Value: 0,
+	}
+
 	//useLogView is used when termui interface is not needed.
 	useLogView = cli.BoolFlag{
 		Name: "use-log-view",
@@ -274,6 +297,20 @@ VERSION:
 		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key.",
 		Value: "./config/validatorKey.pem",
 	}
+	// validatorKeystoreFile defines a flag for the path to the password protected validator key used in block signing
+	validatorKeystoreFile = cli.StringFlag{
+		Name: "validator-keystore-file",
+		Usage: "The `filepath` for the encrypted JSON keystore which contains the validator key. If set, it is used " +
+			"instead of the PEM file.",
+		Value: "",
+	}
+	// validatorKeystorePasswordFile defines a flag for the path to the file holding the validator keystore password
+	validatorKeystorePasswordFile = cli.StringFlag{
+		Name: "validator-keystore-password-file",
+		Usage: "The `filepath` for the file which contains the password of the validator keystore. If not set, the " +
+			"password is read from the " + validatorKeystorePasswordEnvVariable + " environment variable.",
+		Value: "",
+	}
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -402,12 +439,15 @@ func main() {
 		gasScheduleConfigurationFile,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		validatorKeystoreFile,
+		validatorKeystorePasswordFile,
 		port,
 		profileMode,
 		storageCleanup,
 		gopsEn,
 		nodeDisplayName,
 		identityFlagName,
+		redundancyLevel,
 		restApiInterface,
 		restApiDebug,
 		disableAnsiColor,
@@ -452,6 +492,111 @@ func getSuite(config *config.Config) (crypto.Suite, error) {
 	}
 }
 
+func createCryptoParams(
+	ctx *cli.Context,
+	validatorPubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+	generalConfig *config.Config,
+	remoteSignerConfig config.RemoteSignerConfig,
+	log logger.Logger,
+) (*mainFactory.CryptoParams, error) {
+	if remoteSignerConfig.Enabled {
+		return createRemoteCryptoParams(validatorPubkeyConverter, suite, generalConfig.Marshalizer.Type, remoteSignerConfig, log)
+	}
+
+	keystoreFileName := ctx.GlobalString(validatorKeystoreFile.Name)
+	if len(keystoreFileName) == 0 {
+		cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
+			validatorPubkeyConverter,
+			ctx.GlobalInt(validatorKeyIndex.Name),
+			ctx.GlobalString(validatorKeyPemFile.Name),
+			suite,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return cryptoParamsLoader.Get()
+	}
+
+	password, err := keystore.ReadPassword(
+		ctx.GlobalString(validatorKeystorePasswordFile.Name),
+		validatorKeystorePasswordEnvVariable,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the validator keystore password", err)
+	}
+
+	log.Info("loading the validator key from keystore", "file", keystoreFileName)
+	cryptoParamsLoader, err := mainFactory.NewKeystoreCryptoSigningParamsLoader(
+		validatorPubkeyConverter,
+		keystoreFileName,
+		password,
+		suite,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cryptoParamsLoader.Get()
+}
+
+func createRemoteCryptoParams(
+	validatorPubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+	marshalizerType string,
+	remoteSignerConfig config.RemoteSignerConfig,
+	log logger.Logger,
+) (*mainFactory.CryptoParams, error) {
+	marshalizer, err := factoryMarshalizer.NewMarshalizer(marshalizerType)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := remote.NewClientTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   remoteSignerConfig.CertificateFile,
+		KeyFile:           remoteSignerConfig.KeyFile,
+		CACertificateFile: remoteSignerConfig.CACertificateFile,
+		ServerName:        remoteSignerConfig.ServerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the remote signer certificates", err)
+	}
+
+	log.Info("using the validator key held by the remote signer",
+		"network", remoteSignerConfig.Network,
+		"address", remoteSignerConfig.Address,
+	)
+	keyGenerator := signing.NewKeyGenerator(suite)
+	remoteSigner, err := remote.NewSignerClient(remote.ArgsSignerClient{
+		Network:        remoteSignerConfig.Network,
+		Address:        remoteSignerConfig.Address,
+		TLSConfig:      tlsConfig,
+		KeyGenerator:   keyGenerator,
+		SingleSigner:   &mclsig.BlsSingleSigner{},
+		Marshalizer:    marshalizer,
+		RequestTimeout: time.Duration(remoteSignerConfig.RequestTimeoutInMilliseconds) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while connecting to the remote signer", err)
+	}
+
+	publicKeyBytes, err := remoteSigner.PublicKey().ToByteArray()
+	if err != nil {
+		_ = remoteSigner.Close()
+		return nil, err
+	}
+
+	return &mainFactory.CryptoParams{
+		KeyGenerator:    keyGenerator,
+		PrivateKey:      remoteSigner.PrivateKey(),
+		PublicKey:       remoteSigner.PublicKey(),
+		PublicKeyBytes:  publicKeyBytes,
+		PublicKeyString: validatorPubkeyConverter.Encode(publicKeyBytes),
+		RemoteSigner:    remoteSigner,
+	}, nil
+}
+
 func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	log.Trace("startNode called")
 	workingDir := getWorkingDir(ctx, log)
@@ -616,18 +761,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
-	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
-		validatorPubkeyConverter,
-		ctx.GlobalInt(validatorKeyIndex.Name),
-		validatorKeyPemFileName,
-		suite,
-	)
-	if err != nil {
-		return err
-	}
-
-	cryptoParams, err := cryptoParamsLoader.Get()
+	cryptoParams, err := createCryptoParams(ctx, validatorPubkeyConverter, suite, generalConfig, preferencesConfig.RemoteSigner, log)
 	if err != nil {
 		return fmt.Errorf("%w: consider regenerating your keys", err)
 	}
@@ -646,6 +780,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		preferencesConfig.Preferences.Identity = ctx.GlobalString(identityFlagName.Name)
 	}
 
+	if ctx.IsSet(redundancyLevel.Name) {
+		preferencesConfig.Preferences.RedundancyLevel = ctx.GlobalInt64(redundancyLevel.Name)
+	}
+
 	err = cleanupStorageIfNecessary(workingDir, ctx, log)
 	if err != nil {
 		return err
@@ -686,6 +824,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ShardCoordinator:                     genesisShardCoordinator,
 		KeyGen:                               cryptoParams.KeyGenerator,
 		PrivKey:                              cryptoParams.PrivateKey,
+		RemoteSigner:                         cryptoParams.RemoteSigner,
 		ActivateBLSPubKeyMessageVerification: economicsConfig.ValidatorSettings.ActivateBLSPubKeyMessageVerification,
 	}
 	cryptoComponentsFactory, err := mainFactory.NewCryptoComponentsFactory(cryptoArgs)
@@ -863,6 +1002,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		Rounder:                    rounder,
 		AddressPubkeyConverter:     addressPubkeyConverter,
 		LatestStorageDataProvider:  latestStorageDataProvider,
+		StatusHandler:              coreComponents.StatusHandler,
 	}
 	bootstrapper, err := bootstrap.NewEpochStartBootstrap(epochStartBootstrapArgs)
 	if err != nil {
@@ -910,13 +1050,14 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
 
 	dataArgs := mainFactory.DataComponentsFactoryArgs{
-		Config:             *generalConfig,
-		EconomicsData:      economicsData,
-		ShardCoordinator:   shardCoordinator,
-		Core:               coreComponents,
-		PathManager:        pathManager,
-		EpochStartNotifier: epochStartNotifier,
-		CurrentEpoch:       storerEpoch,
+		Config:              *generalConfig,
+		EconomicsData:       economicsData,
+		ShardCoordinator:    shardCoordinator,
+		Core:                coreComponents,
+		PathManager:         pathManager,
+		EpochStartNotifier:  epochStartNotifier,
+		CurrentEpoch:        storerEpoch,
+		TrieStorageManagers: triesComponents.TrieStorageManagers,
 	}
 	dataComponentsFactory, err := mainFactory.NewDataComponentsFactory(dataArgs)
 	if err != nil {
@@ -1180,6 +1321,40 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		elasticIndexer.SetTxLogsProcessor(processComponents.TxLogsProcessor)
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
 	}
+	var validatorSigningHistory consensus.SigningHistoryHandler = cryptoParams.RemoteSigner
+	var signingHistoryPersister signingHistory.HistoryPersister
+	if check.IfNil(cryptoParams.RemoteSigner) {
+		log.Trace("creating signing history")
+		signingHistoryPersister, err = signingHistory.CreatePersister(filepath.Join(workingDir, defaultSigningHistoryPath))
+		if err != nil {
+			return err
+		}
+		validatorSigningHistory, err = signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+			Persister: signingHistoryPersister,
+			PublicKey: cryptoParams.PublicKeyBytes,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Info("the signing history is kept by the remote signer")
+	}
+
+	log.Trace("creating node redundancy handler")
+	nodeRedundancyHandler, err := redundancy.NewNodeRedundancy(redundancy.ArgsNodeRedundancy{
+		RedundancyLevel:  preferencesConfig.Preferences.RedundancyLevel,
+		MaxMissedRounds:  preferencesConfig.Preferences.MaxMissedRoundsForFailover,
+		Messenger:        networkComponents.NetMessenger,
+		AppStatusHandler: coreComponents.StatusHandler,
+	})
+	if err != nil {
+		return err
+	}
+	if nodeRedundancyHandler.IsRedundancyNode() {
+		log.Info("node is running as a backup machine, in standby while the main machine is active",
+			"redundancy level", preferencesConfig.Preferences.RedundancyLevel)
+	}
+
 	log.Trace("creating node structure")
 	currentNode, err := createNode(
 		generalConfig,
@@ -1207,6 +1382,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		whiteListerVerifiedTxs,
 		chanStopNodeProcess,
 		hardForkTrigger,
+		validatorSigningHistory,
+		nodeRedundancyHandler,
 	)
 	if err != nil {
 		return err
@@ -1225,6 +1402,37 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		indexValidatorsListIfNeeded(elasticIndexer, nodesCoordinator, processComponents.EpochStartTrigger.Epoch(), log)
 	}
 
+	log.Trace("creating trie verifier")
+	trieVerifier, err := verifier.NewTrieVerifier(verifier.ArgsTrieVerifier{
+		ChainHandler:           dataComponents.Blkc,
+		ForkDetector:           processComponents.ForkDetector,
+		Store:                  dataComponents.Store,
+		AccountsStorageManager: triesComponents.TrieStorageManagers[trieFactory.UserAccountTrie],
+		PeerStorageManager:     triesComponents.TrieStorageManagers[trieFactory.PeerAccountTrie],
+		TrieNodesCacher:        dataComponents.Datapool.TrieNodes(),
+		RequestHandler:         processComponents.RequestHandler,
+		Marshalizer:            coreComponents.InternalMarshalizer,
+		Hasher:                 coreComponents.Hasher,
+		ShardID:                shardCoordinator.SelfId(),
+		GarbageCollectionEnabled: generalConfig.Debug.TrieVerifier.GarbageCollectionEnabled &&
+			!generalConfig.StoragePruning.Enabled && !generalConfig.StateTriesConfig.ArchiveMode,
+	})
+	if err != nil {
+		return err
+	}
+	if generalConfig.Debug.TrieVerifier.BackgroundIntervalInMinutes > 0 {
+		interval := time.Duration(generalConfig.Debug.TrieVerifier.BackgroundIntervalInMinutes) * time.Minute
+		err = trieVerifier.StartBackgroundVerification(interval)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = currentNode.AddQueryHandler(nodeDebugFactory.TrieVerifierDebugger, trieVerifier)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating api resolver structure")
 	apiResolver, err := createApiResolver(
 		generalConfig,
@@ -1267,6 +1475,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = metrics.StartPersistersStatisticsPolling(
+		coreComponents.StatusHandler,
+		statusPollingInterval,
+		dataComponents.Store,
+		shardCoordinator.NumberOfShards(),
+		triesComponents.TrieStorageManagers,
+	)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating elrond node facade")
 	restAPIServerDebugMode := ctx.GlobalBool(restApiDebug.Name)
 
@@ -1311,10 +1530,26 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		log.Info("terminating at internal stop signal", "reason", sig.Reason)
 	}
 
+	log.Debug("closing trie verifier...")
+	err = trieVerifier.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
 
+	if !check.IfNil(signingHistoryPersister) {
+		log.Debug("closing signing history...")
+		err = signingHistoryPersister.Close()
+		log.LogIfError(err)
+	}
+
+	if !check.IfNil(cryptoParams.RemoteSigner) {
+		log.Debug("closing remote signer connection...")
+		err = cryptoParams.RemoteSigner.Close()
+		log.LogIfError(err)
+	}
+
 	dataTries := triesComponents.TriesContainer.GetAll()
 	for _, trie := range dataTries {
 		err = trie.ClosePersister()
@@ -1896,9 +2131,13 @@ func createHardForkTrigger(
 		HeaderSigVerifier:        process.HeaderSigVerifier,
 		HeaderIntegrityVerifier:  process.HeaderIntegrityVerifier,
 		MaxTrieLevelInMemory:     config.StateTriesConfig.MaxStateTrieLevelInMemory,
+		TrieSyncConfig:           config.TrieSync,
+		StatusHandler:            coreData.StatusHandler,
 		InputAntifloodHandler:    network.InputAntifloodHandler,
 		OutputAntifloodHandler:   network.OutputAntifloodHandler,
 		ValidityAttester:         process.BlockTracker,
+		ChainID:                  coreData.ChainID,
+		MinTransactionVersion:    coreData.MinTransactionVersion,
 	}
 	hardForkExportFactory, err := exportFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -1951,6 +2190,8 @@ func createNode(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	hardForkTrigger node.HardforkTrigger,
+	signingHistory consensus.SigningHistoryHandler,
+	nodeRedundancyHandler consensus.NodeRedundancyHandler,
 ) (*node.Node, error) {
 	var err error
 	var consensusGroupSize uint32
@@ -1986,6 +2227,21 @@ func createNode(
 		return nil, err
 	}
 
+	apiBlocksThrottler, err := throttler.NewNumGoRoutinesThrottler(maxNumGoRoutinesBlocksApi)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAccountKeysThrottler, err := throttler.NewNumGoRoutinesThrottler(maxNumGoRoutinesAccountKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTxsHistoryThrottler, err := throttler.NewNumGoRoutinesThrottler(maxNumGoRoutinesTxsHistory)
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *node.Node
 	nd, err = node.NewNode(
 		node.WithMessenger(network.NetMessenger),
@@ -2029,12 +2285,14 @@ func createNode(
 		node.WithPeerBlackListHandler(network.PeerBlackListHandler),
 		node.WithNetworkShardingCollector(networkShardingCollector),
 		node.WithBootStorer(process.BootStorer),
+		node.WithBlockCommitJournal(data.BlockCommitJournal),
 		node.WithRequestedItemsHandler(requestedItemsHandler),
 		node.WithHeaderSigVerifier(process.HeaderSigVerifier),
 		node.WithHeaderIntegrityVerifier(process.HeaderIntegrityVerifier),
 		node.WithValidatorStatistics(process.ValidatorsStatistics),
 		node.WithValidatorsProvider(process.ValidatorsProvider),
 		node.WithChainID(coreData.ChainID),
+		node.WithMinTransactionVersion(coreData.MinTransactionVersion),
 		node.WithBlockTracker(process.BlockTracker),
 		node.WithRequestHandler(process.RequestHandler),
 		node.WithInputAntifloodHandler(network.InputAntifloodHandler),
@@ -2046,6 +2304,13 @@ func createNode(
 		node.WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		node.WithNodeStopChannel(chanStopNodeProcess),
 		node.WithApiTransactionByHashThrottler(apiTxsByHashThrottler),
+		node.WithApiBlockThrottler(apiBlocksThrottler),
+		node.WithApiAccountKeysThrottler(apiAccountKeysThrottler),
+		node.WithApiTransactionsHistoryThrottler(apiTxsHistoryThrottler),
+		node.WithEquivocationEvidencePool(process.EvidencePool),
+		node.WithEquivocationEvidenceVerifier(process.EvidenceVerifier),
+		node.WithSigningHistory(signingHistory),
+		node.WithNodeRedundancyHandler(nodeRedundancyHandler),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2187,9 +2452,11 @@ func createApiResolver(
 	var err error
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      marshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -2238,7 +2505,7 @@ func createApiResolver(
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), accnts)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +80,12 @@ func InitMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricNoncesPassedInCurrentEpoch, initUint)
 	appStatusHandler.SetStringValue(core.MetricLeaderPercentage, fmt.Sprintf("%f", economicsConfig.RewardsSettings.LeaderPercentage))
 	appStatusHandler.SetStringValue(core.MetricDenominationCoefficient, economicsConfig.RewardsSettings.DenominationCoefficientForView)
+	appStatusHandler.SetStringValue(core.MetricMinInflation, fmt.Sprintf("%f", economicsConfig.GlobalSettings.MinimumInflation))
+	appStatusHandler.SetStringValue(core.MetricMaxInflation, fmt.Sprintf("%f", economicsConfig.GlobalSettings.MaximumInflation))
+	appStatusHandler.SetStringValue(core.MetricOptimalStakingRatio, fmt.Sprintf("%f", economicsConfig.GlobalSettings.OptimalStakingRatio))
+	appStatusHandler.SetStringValue(core.MetricInflationRate, initString)
+	appStatusHandler.SetStringValue(core.MetricTotalStaked, initString)
+	appStatusHandler.SetStringValue(core.MetricNodePrice, economicsConfig.ValidatorSettings.GenesisNodePrice)
 	appStatusHandler.SetUInt64Value(core.MetricNumConnectedPeers, initUint)
 	appStatusHandler.SetStringValue(core.MetricNumConnectedPeersClassification, initString)
 
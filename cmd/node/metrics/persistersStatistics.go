@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type persistersStatisticsMetrics struct {
+	numKeys        string
+	liveDataSize   string
+	diskSize       string
+	numFiles       string
+	numCompactions string
+}
+
+var chainStorageMetrics = persistersStatisticsMetrics{
+	numKeys:        core.MetricChainStorageNumKeys,
+	liveDataSize:   core.MetricChainStorageLiveDataSize,
+	diskSize:       core.MetricChainStorageDiskSize,
+	numFiles:       core.MetricChainStorageNumFiles,
+	numCompactions: core.MetricChainStorageNumCompactions,
+}
+
+var trieStorageMetrics = persistersStatisticsMetrics{
+	numKeys:        core.MetricTrieStorageNumKeys,
+	liveDataSize:   core.MetricTrieStorageLiveDataSize,
+	diskSize:       core.MetricTrieStorageDiskSize,
+	numFiles:       core.MetricTrieStorageNumFiles,
+	numCompactions: core.MetricTrieStorageNumCompactions,
+}
+
+// StartPersistersStatisticsPolling will periodically save in status handler the accumulated size and compaction
+// statistics of the chain storage units and of the tries storage, for the persisters able to report them
+func StartPersistersStatisticsPolling(
+	ash core.AppStatusHandler,
+	pollingInterval time.Duration,
+	store dataRetriever.StorageService,
+	numShards uint32,
+	trieStorageManagers map[string]data.StorageManager,
+) error {
+	if check.IfNil(ash) {
+		return errors.New("nil AppStatusHandler")
+	}
+	if check.IfNil(store) {
+		return errors.New("nil storage service")
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
+	if err != nil {
+		return errors.New("cannot init AppStatusPolling")
+	}
+
+	chainProviders := getChainStorageStatisticsProviders(store, numShards)
+	trieProviders := getTrieStorageStatisticsProviders(trieStorageManagers)
+	err = appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		savePersistersStatistics(appStatusHandler, chainProviders, chainStorageMetrics)
+		savePersistersStatistics(appStatusHandler, trieProviders, trieStorageMetrics)
+	})
+	if err != nil {
+		return errors.New("cannot register handler func for persisters statistics")
+	}
+
+	appStatusPollingHandler.Poll()
+
+	return nil
+}
+
+func getChainStorageStatisticsProviders(
+	store dataRetriever.StorageService,
+	numShards uint32,
+) []storage.PersisterStatisticsProvider {
+	units := make([]dataRetriever.UnitType, 0)
+	for unit := dataRetriever.TransactionUnit; unit <= dataRetriever.BlockCommitJournalUnit; unit++ {
+		units = append(units, unit)
+	}
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		units = append(units, dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardID))
+	}
+
+	providers := make([]storage.PersisterStatisticsProvider, 0, len(units))
+	for _, unit := range units {
+		provider, ok := store.GetStorer(unit).(storage.PersisterStatisticsProvider)
+		if ok {
+			providers = append(providers, provider)
+		}
+	}
+
+	return providers
+}
+
+func getTrieStorageStatisticsProviders(
+	trieStorageManagers map[string]data.StorageManager,
+) []storage.PersisterStatisticsProvider {
+	providers := make([]storage.PersisterStatisticsProvider, 0, len(trieStorageManagers))
+	for _, trieStorageManager := range trieStorageManagers {
+		if check.IfNil(trieStorageManager) {
+			continue
+		}
+
+		provider, ok := trieStorageManager.Database().(storage.PersisterStatisticsProvider)
+		if ok {
+			providers = append(providers, provider)
+		}
+	}
+
+	return providers
+}
+
+func savePersistersStatistics(
+	appStatusHandler core.AppStatusHandler,
+	providers []storage.PersisterStatisticsProvider,
+	metrics persistersStatisticsMetrics,
+) {
+	statistics := storage.PersisterStatistics{}
+	for _, provider := range providers {
+		statistics.Add(provider.PersisterStatistics())
+	}
+
+	appStatusHandler.SetUInt64Value(metrics.numKeys, statistics.NumKeys)
+	appStatusHandler.SetUInt64Value(metrics.liveDataSize, statistics.LiveDataSize)
+	appStatusHandler.SetUInt64Value(metrics.diskSize, statistics.DiskSize)
+	appStatusHandler.SetUInt64Value(metrics.numFiles, statistics.NumFiles)
+	appStatusHandler.SetUInt64Value(metrics.numCompactions, statistics.NumCompactions)
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/signingHistory"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	factoryHasher "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir        string
+	validatorKeyPem   string
+	validatorKeyIndex int
+	network           string
+	address           string
+	certificateFile   string
+	keyFile           string
+	caCertificateFile string
+	marshalizerType   string
+	hasherType        string
+	genesisRandSeeds  string
+	logLevel          string
+}
+
+const (
+	defaultSigningHistoryPath = "signingHistory"
+	unixSocketPermissions     = 0600
+)
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the path of the directory holding the signer's signing history
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The signer's working directory, containing the signingHistory folder. Example: ./",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// validatorKeyPemFile defines a flag for the path of the PEM file holding the validator BLS key
+	validatorKeyPemFile = cli.StringFlag{
+		Name:        "validator-key-pem-file",
+		Usage:       "The PEM file holding the validator BLS key",
+		Value:       "./config/validatorKey.pem",
+		Destination: &argsConfig.validatorKeyPem,
+	}
+
+	// validatorKeyIndex defines a flag for the index of the validator key in the PEM file
+	validatorKeyIndex = cli.IntFlag{
+		Name:        "sk-index",
+		Usage:       "The index of the validator key in the PEM file",
+		Value:       0,
+		Destination: &argsConfig.validatorKeyIndex,
+	}
+
+	// network defines a flag for the network the signer listens on
+	network = cli.StringFlag{
+		Name:        "network",
+		Usage:       "The network the signer listens on: unix or tcp",
+		Value:       remote.UnixNetwork,
+		Destination: &argsConfig.network,
+	}
+
+	// address defines a flag for the unix domain socket path or the TCP address the signer listens on
+	address = cli.StringFlag{
+		Name:        "address",
+		Usage:       "The unix domain socket path or the host:port address the signer listens on",
+		Value:       "./remotesigner.sock",
+		Destination: &argsConfig.address,
+	}
+
+	// certificateFile defines a flag for the PEM encoded certificate presented by the signer
+	certificateFile = cli.StringFlag{
+		Name:        "certificate",
+		Usage:       "The PEM encoded certificate presented by the signer to the node",
+		Value:       "./remotesigner.crt",
+		Destination: &argsConfig.certificateFile,
+	}
+
+	// keyFile defines a flag for the PEM encoded key of the signer certificate
+	keyFile = cli.StringFlag{
+		Name:        "key",
+		Usage:       "The PEM encoded key of the signer certificate",
+		Value:       "./remotesigner.key",
+		Destination: &argsConfig.keyFile,
+	}
+
+	// caCertificateFile defines a flag for the PEM encoded certificate of the CA trusted for the node certificates
+	caCertificateFile = cli.StringFlag{
+		Name:        "ca-certificate",
+		Usage:       "The PEM encoded certificate of the CA that signed both the node and the signer certificates",
+		Value:       "./ca.crt",
+		Destination: &argsConfig.caCertificateFile,
+	}
+
+	// marshalizerType defines a flag for the marshalizer used to read the signed payloads
+	marshalizerType = cli.StringFlag{
+		Name:        "marshalizer-type",
+		Usage:       "The marshalizer used to read the signed payloads, the [Marshalizer] type of the node config",
+		Value:       factoryMarshalizer.GogoProtobuf,
+		Destination: &argsConfig.marshalizerType,
+	}
+
+	// hasherType defines a flag for the hasher used to compute the signed header hashes
+	hasherType = cli.StringFlag{
+		Name:        "hasher-type",
+		Usage:       "The hasher used to compute the signed header hashes, the [Hasher] type of the node config",
+		Value:       "blake2b",
+		Destination: &argsConfig.hasherType,
+	}
+
+	// genesisRandSeeds defines a flag for the rand seeds of the genesis headers
+	genesisRandSeeds = cli.StringFlag{
+		Name: "genesis-rand-seeds",
+		Usage: "The comma separated, hex encoded rand seeds of the genesis headers of the shards the validator can " +
+			"propose blocks in. The first block after the genesis can not be proposed if its genesis rand seed is missing",
+		Value:       "",
+		Destination: &argsConfig.genesisRandSeeds,
+	}
+
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger level. Use DEBUG to log every request and response",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	errInvalidPublicKey = errors.New("the validator key does not match the public key found in the PEM file")
+
+	log = logger.GetOrCreate("remotesigner")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "Remote signer"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary is a reference remote signer holding a validator BLS key and signing on behalf of a " +
+		"node, while enforcing the slashing protection signing history"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		validatorKeyPemFile,
+		validatorKeyIndex,
+		network,
+		address,
+		certificateFile,
+		keyFile,
+		caCertificateFile,
+		marshalizerType,
+		hasherType,
+		genesisRandSeeds,
+		logLevel,
+	}
+	app.Action = func(_ *cli.Context) error {
+		return startSigner()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the remote signer", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func startSigner() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKeyBytes, err := loadValidatorKey(keyGenerator)
+	if err != nil {
+		return err
+	}
+
+	persister, err := signingHistory.CreatePersister(filepath.Join(argsConfig.workingDir, defaultSigningHistoryPath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := persister.Close()
+		log.LogIfError(errClose)
+	}()
+
+	history, err := signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Persister: persister,
+		PublicKey: publicKeyBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remote.NewServerTLSConfig(remote.ArgsTLSConfig{
+		CertificateFile:   argsConfig.certificateFile,
+		KeyFile:           argsConfig.keyFile,
+		CACertificateFile: argsConfig.caCertificateFile,
+	})
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := factoryMarshalizer.NewMarshalizer(argsConfig.marshalizerType)
+	if err != nil {
+		return err
+	}
+	hasher, err := factoryHasher.NewHasher(argsConfig.hasherType)
+	if err != nil {
+		return err
+	}
+	classifier, err := bls.NewConsensusService()
+	if err != nil {
+		return err
+	}
+
+	randSeeds, err := decodeGenesisRandSeeds()
+	if err != nil {
+		return err
+	}
+
+	server, err := remote.NewSignerServer(remote.ArgsSignerServer{
+		PrivateKey:       privateKey,
+		SingleSigner:     &mclsig.BlsSingleSigner{},
+		LowLevelSigner:   &mclmultisig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: multisig.BlsHashSize}},
+		SigningHistory:   history,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		Classifier:       classifier,
+		TLSConfig:        tlsConfig,
+		GenesisRandSeeds: randSeeds,
+	})
+	if err != nil {
+		return err
+	}
+
+	listener, err := createListener()
+	if err != nil {
+		return err
+	}
+
+	log.Info("remote signer started",
+		"pk", hex.EncodeToString(publicKeyBytes),
+		"network", argsConfig.network,
+		"address", argsConfig.address,
+	)
+
+	chanServeErr := make(chan error, 1)
+	go func() {
+		chanServeErr <- server.Serve(listener)
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-sigs:
+		log.Info("terminating at user's signal...")
+	case err = <-chanServeErr:
+		log.Error("remote signer stopped serving", "error", err)
+	}
+
+	return server.Close()
+}
+
+func loadValidatorKey(keyGenerator crypto.KeyGenerator) (crypto.PrivateKey, []byte, error) {
+	encodedSk, pkString, err := core.LoadSkPkFromPemFile(argsConfig.validatorKeyPem, argsConfig.validatorKeyIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	privateKey, err := keyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, nil, err
+	}
+	if hex.EncodeToString(publicKeyBytes) != pkString {
+		return nil, nil, errInvalidPublicKey
+	}
+
+	return privateKey, publicKeyBytes, nil
+}
+
+func decodeGenesisRandSeeds() ([][]byte, error) {
+	randSeeds := make([][]byte, 0)
+	for _, encodedRandSeed := range strings.Split(argsConfig.genesisRandSeeds, ",") {
+		encodedRandSeed = strings.TrimSpace(encodedRandSeed)
+		if len(encodedRandSeed) == 0 {
+			continue
+		}
+
+		randSeed, err := hex.DecodeString(encodedRandSeed)
+		if err != nil {
+			return nil, fmt.Errorf("%w for genesis rand seed %s", err, encodedRandSeed)
+		}
+		randSeeds = append(randSeeds, randSeed)
+	}
+
+	return randSeeds, nil
+}
+
+func createListener() (net.Listener, error) {
+	if argsConfig.network == remote.UnixNetwork {
+		err := os.Remove(argsConfig.address)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen(argsConfig.network, argsConfig.address)
+	if err != nil {
+		return nil, err
+	}
+	if argsConfig.network == remote.UnixNetwork {
+		err = os.Chmod(argsConfig.address, unixSocketPermissions)
+		if err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+	}
+
+	return listener, nil
+}
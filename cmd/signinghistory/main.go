@@ -0,0 +1,207 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/signingHistory"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir string
+	file       string
+}
+
+const (
+	defaultSigningHistoryPath = "signingHistory"
+	exportedFilePermissions   = 0600
+)
+
+var (
+	signingHistoryHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the path of the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, containing the signingHistory folder. Example: ./",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// file defines a flag for the path of the JSON file holding the portable signing history
+	file = cli.StringFlag{
+		Name:        "file",
+		Usage:       "The JSON file holding the portable signing history. Example: ./signingHistory.json",
+		Destination: &argsConfig.file,
+	}
+
+	// publicKey defines a flag for the hex encoded validator public key whose signing history is exported
+	publicKey = cli.StringFlag{
+		Name:  "public-key",
+		Usage: "The hex encoded validator public key whose signing history is exported",
+	}
+
+	argsConfig = &cfg{}
+
+	errMissingFile      = errors.New("missing signing history file")
+	errMissingPublicKey = errors.New("missing public key")
+
+	log = logger.GetOrCreate("signinghistory")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = signingHistoryHelpTemplate
+	app.Name = "Signing history Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will export or import the signing history of a stopped node, when migrating the " +
+		"validator to a new machine"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		file,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "export",
+			Usage: "writes the signing history of the provided validator public key in the JSON file",
+			Flags: []cli.Flag{publicKey},
+			Action: func(c *cli.Context) error {
+				return exportHistory(c.String(publicKey.Name))
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "adds the signing history found in the JSON file to the node's signing history",
+			Action: func(_ *cli.Context) error {
+				return importHistory()
+			},
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error handling the signing history", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func exportHistory(hexPublicKey string) error {
+	if len(argsConfig.file) == 0 {
+		return errMissingFile
+	}
+	if len(hexPublicKey) == 0 {
+		return errMissingPublicKey
+	}
+	pubKey, err := hex.DecodeString(hexPublicKey)
+	if err != nil {
+		return err
+	}
+
+	var history *signingHistory.History
+	err = withSigningHistory(pubKey, func(sh signingHistoryHandler) error {
+		history, err = sh.Export()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(argsConfig.file, buff, exportedFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("signing history exported",
+		"file", argsConfig.file,
+		"num signed payloads", len(history.SignedPayloads),
+	)
+
+	return nil
+}
+
+func importHistory() error {
+	if len(argsConfig.file) == 0 {
+		return errMissingFile
+	}
+
+	buff, err := ioutil.ReadFile(argsConfig.file)
+	if err != nil {
+		return err
+	}
+
+	history := &signingHistory.History{}
+	err = json.Unmarshal(buff, history)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := hex.DecodeString(history.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	return withSigningHistory(pubKey, func(sh signingHistoryHandler) error {
+		return sh.Import(history)
+	})
+}
+
+type signingHistoryHandler interface {
+	Export() (*signingHistory.History, error)
+	Import(history *signingHistory.History) error
+}
+
+func withSigningHistory(pubKey []byte, handler func(sh signingHistoryHandler) error) error {
+	persister, err := signingHistory.CreatePersister(filepath.Join(argsConfig.workingDir, defaultSigningHistoryPath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := persister.Close()
+		log.LogIfError(errClose)
+	}()
+
+	sh, err := signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Persister: persister,
+		PublicKey: pubKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	return handler(sh)
+}
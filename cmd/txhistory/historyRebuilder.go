@@ -0,0 +1,269 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const logProgressInterval = 1000
+
+var errHeaderNotFound = errors.New("header not found")
+
+type headerInfo struct {
+	hash  []byte
+	epoch uint32
+}
+
+type historyRebuilder struct {
+	store            dataRetriever.StorageService
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+	recorder         process.TransactionsHistoryRecorder
+	headersUnit      dataRetriever.UnitType
+}
+
+func newHistoryRebuilder(
+	store dataRetriever.StorageService,
+	marshalizer marshal.Marshalizer,
+	shardCoordinator sharding.Coordinator,
+) (*historyRebuilder, error) {
+	recorder, err := txhistory.NewHistoryRecorder(txhistory.ArgsHistoryRecorder{
+		HistoryStorer:    store.GetStorer(dataRetriever.TransactionsHistoryUnit),
+		Marshalizer:      marshalizer,
+		ShardCoordinator: shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headersUnit := dataRetriever.BlockHeaderUnit
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		headersUnit = dataRetriever.MetaBlockUnit
+	}
+
+	return &historyRebuilder{
+		store:            store,
+		marshalizer:      marshalizer,
+		shardCoordinator: shardCoordinator,
+		recorder:         recorder,
+		headersUnit:      headersUnit,
+	}, nil
+}
+
+// rebuild walks back the chain of headers starting from the last committed one and then records, from the oldest
+// to the newest, all the blocks found in storage. Rebuilding an existing index is safe, as the recorder replaces the
+// entries of the blocks which are recorded again
+func (hr *historyRebuilder) rebuild(lastRound int64) error {
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(hr.marshalizer, hr.store.GetStorer(dataRetriever.BootstrapUnit))
+	if err != nil {
+		return err
+	}
+	bootstrapData, err := bootStorer.Get(lastRound)
+	if err != nil {
+		return err
+	}
+
+	headers := hr.collectHeaders(bootstrapData.LastHeader.Hash, bootstrapData.LastHeader.Epoch)
+	log.Info("rebuilding the transactions history", "num blocks", len(headers))
+
+	for i := len(headers) - 1; i >= 0; i-- {
+		err = hr.recordBlock(headers[i])
+		if err != nil {
+			return err
+		}
+
+		numRecorded := len(headers) - i
+		if numRecorded%logProgressInterval == 0 {
+			log.Info("rebuilding the transactions history", "recorded blocks", numRecorded)
+		}
+	}
+
+	log.Info("transactions history rebuilt", "num blocks", len(headers))
+
+	return nil
+}
+
+func (hr *historyRebuilder) collectHeaders(lastHeaderHash []byte, lastEpoch uint32) []headerInfo {
+	headers := make([]headerInfo, 0)
+
+	hash := lastHeaderHash
+	epoch := lastEpoch
+	for {
+		header, headerEpoch, err := hr.getHeader(hash, epoch)
+		if err != nil {
+			log.Debug("stopped walking back the chain of headers", "hash", hash, "error", err.Error())
+			return headers
+		}
+
+		headers = append(headers, headerInfo{hash: hash, epoch: headerEpoch})
+		if header.GetNonce() == 0 {
+			return headers
+		}
+
+		hash = header.GetPrevHash()
+		epoch = headerEpoch
+	}
+}
+
+// getHeader searches the header starting from the provided epoch down to the genesis epoch, as the epoch of the
+// previous header is not known when walking back the chain
+func (hr *historyRebuilder) getHeader(hash []byte, maxEpoch uint32) (data.HeaderHandler, uint32, error) {
+	storer := hr.store.GetStorer(hr.headersUnit)
+	for epoch := int64(maxEpoch); epoch >= 0; epoch-- {
+		headerBytes, err := storer.GetFromEpoch(hash, uint32(epoch))
+		if err != nil {
+			continue
+		}
+
+		header, err := hr.unmarshalHeader(headerBytes)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return header, uint32(epoch), nil
+	}
+
+	return nil, 0, errHeaderNotFound
+}
+
+func (hr *historyRebuilder) unmarshalHeader(headerBytes []byte) (data.HeaderHandler, error) {
+	if hr.shardCoordinator.SelfId() == core.MetachainShardId {
+		header := &block.MetaBlock{}
+		err := hr.marshalizer.Unmarshal(header, headerBytes)
+		return header, err
+	}
+
+	header := &block.Header{}
+	err := hr.marshalizer.Unmarshal(header, headerBytes)
+	return header, err
+}
+
+func (hr *historyRebuilder) recordBlock(info headerInfo) error {
+	header, _, err := hr.getHeader(info.hash, info.epoch)
+	if err != nil {
+		return err
+	}
+
+	body := &block.Body{}
+	txs := make(map[string]data.TransactionHandler)
+	for _, miniBlockHeader := range getMiniBlockHeaders(header) {
+		miniBlock, errGet := hr.getMiniBlock(miniBlockHeader.Hash, info.epoch)
+		if errGet != nil {
+			log.Debug("miniblock not found", "hash", miniBlockHeader.Hash, "error", errGet.Error())
+			continue
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+		hr.loadTransactions(miniBlock, info.epoch, txs)
+	}
+
+	hr.store.SetEpochForPutOperation(info.epoch)
+
+	return hr.recorder.RecordBlock(header, body, txs)
+}
+
+func getMiniBlockHeaders(header data.HeaderHandler) []block.MiniBlockHeader {
+	switch h := header.(type) {
+	case *block.Header:
+		return h.MiniBlockHeaders
+	case *block.MetaBlock:
+		return h.MiniBlockHeaders
+	default:
+		return nil
+	}
+}
+
+func (hr *historyRebuilder) getMiniBlock(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	miniBlockBytes, err := hr.store.GetStorer(dataRetriever.MiniBlockUnit).GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = hr.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (hr *historyRebuilder) loadTransactions(
+	miniBlock *block.MiniBlock,
+	epoch uint32,
+	txs map[string]data.TransactionHandler,
+) {
+	unit, ok := getStorageUnitForMiniBlockType(miniBlock.Type)
+	if !ok {
+		return
+	}
+
+	storer := hr.store.GetStorer(unit)
+	for _, txHash := range miniBlock.TxHashes {
+		tx, err := hr.getTransaction(storer, unit, txHash, epoch)
+		if err != nil {
+			log.Debug("transaction not found", "hash", txHash, "error", err.Error())
+			continue
+		}
+
+		txs[string(txHash)] = tx
+	}
+}
+
+func getStorageUnitForMiniBlockType(miniBlockType block.Type) (dataRetriever.UnitType, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+func (hr *historyRebuilder) getTransaction(
+	storer storage.Storer,
+	unit dataRetriever.UnitType,
+	txHash []byte,
+	epoch uint32,
+) (data.TransactionHandler, error) {
+	txBytes, err := storer.GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx data.TransactionHandler
+	switch unit {
+	case dataRetriever.TransactionUnit:
+		tx = &transaction.Transaction{}
+	case dataRetriever.UnsignedTransactionUnit:
+		tx = &smartContractResult.SmartContractResult{}
+	case dataRetriever.RewardTransactionUnit:
+		tx = &rewardTx.RewardTx{}
+	default:
+		return nil, fmt.Errorf("unexpected storage unit %s", unit.String())
+	}
+
+	err = hr.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
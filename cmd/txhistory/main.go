@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	factoryHasher "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir  string
+	configFile  string
+	chainID     string
+	numOfShards uint
+}
+
+const (
+	defaultDBPath         = "db"
+	defaultEpochString    = "Epoch"
+	defaultStaticDbString = "Static"
+	defaultShardString    = "Shard"
+)
+
+var (
+	txHistoryHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the path of the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, containing the db folder. Example: ./",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// configurationFile defines a flag for the path of the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's main configuration file. Example: ./config/config.toml",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+
+	// chainID defines a flag for the chain ID, used as the name of the folder holding the node's databases
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID of the network, as found in the node's db folder. Example: 1",
+		Destination: &argsConfig.chainID,
+	}
+
+	// numOfShards defines a flag for the number of shards of the network
+	numOfShards = cli.UintFlag{
+		Name:        "num-of-shards",
+		Usage:       "The number of shards of the network, excluding the metachain. Example: 2",
+		Destination: &argsConfig.numOfShards,
+	}
+
+	argsConfig = &cfg{}
+
+	errMissingChainID     = errors.New("missing chain ID")
+	errInvalidNumOfShards = errors.New("invalid number of shards")
+
+	log = logger.GetOrCreate("txhistory")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = txHistoryHelpTemplate
+	app.Name = "Transactions history Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will rebuild, from the blocks found in the db of a stopped node, the transactions history " +
+		"index of each address"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		chainID,
+		numOfShards,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return rebuildHistory()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error rebuilding the transactions history", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func rebuildHistory() error {
+	if len(argsConfig.chainID) == 0 {
+		return errMissingChainID
+	}
+	if argsConfig.numOfShards == 0 {
+		return errInvalidNumOfShards
+	}
+
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	// the index is rebuilt even if it is not (yet) enabled in the configuration file
+	generalConfig.TxsHistory.Enabled = true
+
+	marshalizer, err := factoryMarshalizer.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := factoryHasher.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return err
+	}
+	latestStorageDataProvider, err := factory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		marshalizer,
+		hasher,
+		*generalConfig,
+		argsConfig.chainID,
+		argsConfig.workingDir,
+		defaultDBPath,
+		defaultEpochString,
+		defaultShardString,
+	)
+	if err != nil {
+		return err
+	}
+	latestData, err := latestStorageDataProvider.Get()
+	if err != nil {
+		return err
+	}
+
+	log.Info("found the latest data in storage",
+		"epoch", latestData.Epoch,
+		"shard", latestData.ShardID,
+		"round", latestData.LastRound,
+	)
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(uint32(argsConfig.numOfShards), latestData.ShardID)
+	if err != nil {
+		return err
+	}
+
+	store, err := createStorageService(generalConfig, shardCoordinator, latestData.Epoch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := store.CloseAll()
+		log.LogIfError(errClose)
+	}()
+
+	rebuilder, err := newHistoryRebuilder(store, marshalizer, shardCoordinator)
+	if err != nil {
+		return err
+	}
+
+	return rebuilder.rebuild(latestData.LastRound)
+}
+
+func createStorageService(
+	generalConfig *config.Config,
+	shardCoordinator sharding.Coordinator,
+	currentEpoch uint32,
+) (dataRetriever.StorageService, error) {
+	dbPath := filepath.Join(argsConfig.workingDir, defaultDBPath, argsConfig.chainID)
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPath,
+		fmt.Sprintf("%s_%s", defaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPath,
+		defaultStaticDbString,
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathManager, err := pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+	if err != nil {
+		return nil, err
+	}
+
+	storageServiceFactory, err := storageFactory.NewStorageServiceFactory(
+		generalConfig,
+		shardCoordinator,
+		pathManager,
+		notifier.NewEpochStartSubscriptionHandler(),
+		currentEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageServiceFactory.CreateForMeta()
+	}
+
+	return storageServiceFactory.CreateForShard()
+}
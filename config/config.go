@@ -23,6 +23,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       string
 }
 
 // BloomFilterConfig will map the json bloom filter configuration
@@ -100,8 +101,9 @@ type Config struct {
 	MetaHdrNonceHashStorage    StorageConfig
 	StatusMetricsStorage       StorageConfig
 
-	BootstrapStorage StorageConfig
-	MetaBlockStorage StorageConfig
+	BootstrapStorage          StorageConfig
+	MetaBlockStorage          StorageConfig
+	BlockCommitJournalStorage StorageConfig
 
 	AccountsTrieStorage      StorageConfig
 	PeerAccountsTrieStorage  StorageConfig
@@ -109,6 +111,7 @@ type Config struct {
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
+	TrieSync                 TrieSyncConfig
 	BadBlocksCache           CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
@@ -140,6 +143,8 @@ type Config struct {
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
+	TxsMetadata         TxsMetadataConfig
+	TxsHistory          TxsHistoryConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -156,6 +161,22 @@ type StoragePruningConfig struct {
 	FullArchive         bool
 	NumEpochsToKeep     uint64
 	NumActivePersisters uint64
+	ArchiveEnabled      bool
+	ArchivePath         string
+}
+
+// TxsMetadataConfig will hold the settings for the transactions and miniblocks metadata used to locate transactions
+// and to compute their status
+type TxsMetadataConfig struct {
+	Enabled           bool
+	Storage           StorageConfig
+	MiniblocksStorage StorageConfig
+}
+
+// TxsHistoryConfig will hold the settings for the per address transactions history index
+type TxsHistoryConfig struct {
+	Enabled bool
+	Storage StorageConfig
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -184,6 +205,7 @@ type GeneralSettingsConfig struct {
 	StatusPollingIntervalSec int
 	MaxComputableRounds      uint64
 	StartInEpochEnabled      bool
+	MinTransactionVersion    uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -197,6 +219,7 @@ type StateTriesConfig struct {
 	CheckpointRoundsModulus     uint
 	AccountsStatePruningEnabled bool
 	PeerStatePruningEnabled     bool
+	ArchiveMode                 bool
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 }
@@ -208,6 +231,12 @@ type TrieStorageManagerConfig struct {
 	MaxSnapshots       uint8
 }
 
+// TrieSyncConfig will hold the settings used when synchronizing the state tries from the network
+type TrieSyncConfig struct {
+	TrieSyncerVersion uint32
+	MaxInFlightNodes  int
+}
+
 // WebServerAntifloodConfig will hold the anti-lflooding parameters for the web server
 type WebServerAntifloodConfig struct {
 	SimultaneousRequests         uint32
@@ -312,6 +341,7 @@ type HardforkConfig struct {
 // DebugConfig will hold debugging configuration
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
+	TrieVerifier        TrieVerifierDebugConfig
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -325,6 +355,12 @@ type InterceptorResolverDebugConfig struct {
 	DebugLineExpiration        int
 }
 
+// TrieVerifierDebugConfig will hold the state trie verifier debug configuration
+type TrieVerifierDebugConfig struct {
+	BackgroundIntervalInMinutes int
+	GarbageCollectionEnabled    bool
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	APIPackages map[string]APIPackageConfig
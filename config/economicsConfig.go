@@ -2,9 +2,10 @@ package config
 
 // GlobalSettings will hold general economic values
 type GlobalSettings struct {
-	TotalSupply      string
-	MinimumInflation float64
-	MaximumInflation float64
+	TotalSupply         string
+	MinimumInflation    float64
+	MaximumInflation    float64
+	OptimalStakingRatio float64
 }
 
 // RewardsSettings will hold economics rewards settings
@@ -2,7 +2,8 @@ package config
 
 // Preferences will hold the configuration related to node's preferences
 type Preferences struct {
-	Preferences PreferencesConfig
+	Preferences  PreferencesConfig
+	RemoteSigner RemoteSignerConfig
 }
 
 // PreferencesConfig will hold the fields which are node specific such as the display name
@@ -10,4 +11,18 @@ type PreferencesConfig struct {
 	DestinationShardAsObserver string
 	NodeDisplayName            string
 	Identity                   string
+	RedundancyLevel            int64
+	MaxMissedRoundsForFailover uint64
+}
+
+// RemoteSignerConfig will hold the configuration of the remote signer process holding the validator key
+type RemoteSignerConfig struct {
+	Enabled                      bool
+	Network                      string
+	Address                      string
+	ServerName                   string
+	CertificateFile              string
+	KeyFile                      string
+	CACertificateFile            string
+	RequestTimeoutInMilliseconds uint32
 }
@@ -2,7 +2,8 @@ package config
 
 // SystemSmartContractsConfig defines the system smart contract configs
 type SystemSmartContractsConfig struct {
-	ESDTSystemSCConfig ESDTSystemSCConfig
+	ESDTSystemSCConfig         ESDTSystemSCConfig
+	EquivocationSlashingConfig EquivocationSlashingConfig
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
@@ -10,3 +11,12 @@ type ESDTSystemSCConfig struct {
 	BaseIssuingCost string
 	OwnerAddress    string
 }
+
+// EquivocationSlashingConfig defines how the metachain punishes the validators caught signing conflicting
+// consensus messages in the same round
+type EquivocationSlashingConfig struct {
+	SlashValue             string
+	MaxEvidencePerBlock    int
+	MaxEvidenceSizeInBytes int
+	EvidencePoolCapacity   int
+}
@@ -163,18 +163,31 @@ func TestTomlEconomicsParser(t *testing.T) {
 func TestTomlPreferencesParser(t *testing.T) {
 	nodeDisplayName := "test-name"
 	destinationShardAsObs := "3"
+	remoteSignerAddress := "./remotesigner.sock"
 
 	cfgPreferencesExpected := Preferences{
 		Preferences: PreferencesConfig{
 			NodeDisplayName:            nodeDisplayName,
 			DestinationShardAsObserver: destinationShardAsObs,
 		},
+		RemoteSigner: RemoteSignerConfig{
+			Enabled:                      true,
+			Network:                      "unix",
+			Address:                      remoteSignerAddress,
+			RequestTimeoutInMilliseconds: 500,
+		},
 	}
 
 	testString := `
 [Preferences]
 	NodeDisplayName = "` + nodeDisplayName + `"
 	DestinationShardAsObserver = "` + destinationShardAsObs + `"
+
+[RemoteSigner]
+	Enabled = true
+	Network = "unix"
+	Address = "` + remoteSignerAddress + `"
+	RequestTimeoutInMilliseconds = 500
 `
 
 	cfg := Preferences{}
@@ -89,6 +89,24 @@ type P2PAntifloodHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SigningHistoryHandler keeps the record of the header hashes signed by the validator in each round and refuses to
+// sign a header hash conflicting with the one already signed in the same round
+type SigningHistoryHandler interface {
+	CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	IsInterfaceNil() bool
+}
+
+// NodeRedundancyHandler tracks the activity of the other machines running with the same validator key, so a backup
+// machine takes part in the consensus only while the main machine is inactive
+type NodeRedundancyHandler interface {
+	IsRedundancyNode() bool
+	IsMainMachineActive() bool
+	AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID p2p.PeerID)
+	IsInterfaceNil() bool
+}
+
 // HeadersPoolSubscriber can subscribe for notifications when a new block header is added to the headers pool
 type HeadersPoolSubscriber interface {
 	RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte))
@@ -31,6 +31,8 @@ type ConsensusCoreMock struct {
 	validatorGroupSelector sharding.NodesCoordinator
 	epochStartNotifier     epochStart.RegistrationHandler
 	antifloodHandler       consensus.P2PAntifloodHandler
+	signingHistory         consensus.SigningHistoryHandler
+	nodeRedundancyHandler  consensus.NodeRedundancyHandler
 }
 
 // GetAntiFloodHandler -
@@ -173,6 +175,16 @@ func (ccm *ConsensusCoreMock) SetValidatorGroupSelector(validatorGroupSelector s
 	ccm.validatorGroupSelector = validatorGroupSelector
 }
 
+// SetSigningHistory -
+func (ccm *ConsensusCoreMock) SetSigningHistory(signingHistory consensus.SigningHistoryHandler) {
+	ccm.signingHistory = signingHistory
+}
+
+// SetNodeRedundancyHandler -
+func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) {
+	ccm.nodeRedundancyHandler = nodeRedundancyHandler
+}
+
 // PrivateKey -
 func (ccm *ConsensusCoreMock) PrivateKey() crypto.PrivateKey {
 	return ccm.blsPrivateKey
@@ -183,6 +195,16 @@ func (ccm *ConsensusCoreMock) SingleSigner() crypto.SingleSigner {
 	return ccm.blsSingleSigner
 }
 
+// SigningHistory -
+func (ccm *ConsensusCoreMock) SigningHistory() consensus.SigningHistoryHandler {
+	return ccm.signingHistory
+}
+
+// NodeRedundancyHandler -
+func (ccm *ConsensusCoreMock) NodeRedundancyHandler() consensus.NodeRedundancyHandler {
+	return ccm.nodeRedundancyHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
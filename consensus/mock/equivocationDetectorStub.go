@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	AddMessageCalled func(cnsMsg *consensus.Message)
+}
+
+// AddMessage -
+func (eds *EquivocationDetectorStub) AddMessage(cnsMsg *consensus.Message) {
+	if eds.AddMessageCalled != nil {
+		eds.AddMessageCalled(cnsMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
@@ -1,8 +1,13 @@
 package mock
 
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
 // MessengerStub -
 type MessengerStub struct {
 	BroadcastCalled func(topic string, buff []byte)
+	IDCalled        func() p2p.PeerID
 }
 
 // Broadcast -
@@ -10,6 +15,15 @@ func (ms *MessengerStub) Broadcast(topic string, buff []byte) {
 	ms.BroadcastCalled(topic, buff)
 }
 
+// ID -
+func (ms *MessengerStub) ID() p2p.PeerID {
+	if ms.IDCalled != nil {
+		return ms.IDCalled()
+	}
+
+	return ""
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	return ms == nil
@@ -130,6 +130,8 @@ func InitConsensusCore() *ConsensusCoreMock {
 	epochStartSubscriber := &EpochStartNotifierStub{}
 	antifloodHandler := &P2PAntifloodHandlerStub{}
 	headerPoolSubscriber := &HeadersCacherStub{}
+	signingHistory := &SigningHistoryStub{}
+	nodeRedundancyHandler := &NodeRedundancyHandlerStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain,
@@ -149,6 +151,8 @@ func InitConsensusCore() *ConsensusCoreMock {
 		validatorGroupSelector,
 		epochStartSubscriber,
 		antifloodHandler,
+		signingHistory,
+		nodeRedundancyHandler,
 	}
 
 	return container
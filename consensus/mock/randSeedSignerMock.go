@@ -0,0 +1,17 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// RandSeedSignerMock -
+type RandSeedSignerMock struct {
+	SingleSignerMock
+	SignRandSeedStub func(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error)
+}
+
+// SignRandSeed -
+func (s *RandSeedSignerMock) SignRandSeed(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error) {
+	return s.SignRandSeedStub(private, prevHeader, round)
+}
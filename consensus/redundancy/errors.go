@@ -0,0 +1,17 @@
+package redundancy
+
+import (
+	"errors"
+)
+
+// ErrInvalidRedundancyLevel is raised when the redundancy level of the machine is negative
+var ErrInvalidRedundancyLevel = errors.New("invalid redundancy level")
+
+// ErrInvalidMaxMissedRounds is raised when a backup machine is configured with a zero number of missed rounds
+var ErrInvalidMaxMissedRounds = errors.New("invalid max missed rounds before failover")
+
+// ErrNilMessenger is raised when a valid messenger is expected but nil used
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilAppStatusHandler is raised when a valid app status handler is expected but nil used
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
@@ -0,0 +1,12 @@
+package redundancy
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// P2PMessenger defines a subset of the p2p.Messenger interface, used to tell apart the consensus messages sent by
+// this machine from the ones sent by the other machines running with the same validator key
+type P2PMessenger interface {
+	ID() p2p.PeerID
+	IsInterfaceNil() bool
+}
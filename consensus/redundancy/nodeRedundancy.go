@@ -0,0 +1,171 @@
+package redundancy
+
+import (
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.GetOrCreate("consensus/redundancy")
+
+// ArgsNodeRedundancy is the argument DTO used to create a new node redundancy instance
+type ArgsNodeRedundancy struct {
+	RedundancyLevel  int64
+	MaxMissedRounds  uint64
+	Messenger        P2PMessenger
+	AppStatusHandler core.AppStatusHandler
+}
+
+// nodeRedundancy keeps track of the rounds in which the validator key was part of the consensus group without any
+// consensus message being received from the other machines running with the same key. A backup machine, having a
+// redundancy level greater than 0, considers the main machine inactive after redundancy level * max missed rounds
+// such rounds and becomes active until a consensus message signed with the validator key is received again
+type nodeRedundancy struct {
+	redundancyLevel     int64
+	maxMissedRounds     uint64
+	messenger           P2PMessenger
+	appStatusHandler    core.AppStatusHandler
+	mutNodeRedundancy   sync.RWMutex
+	lastRoundIndexCheck int64
+	isCheckPending      bool
+	missedRounds        uint64
+}
+
+// NewNodeRedundancy creates a new node redundancy instance
+func NewNodeRedundancy(args ArgsNodeRedundancy) (*nodeRedundancy, error) {
+	if args.RedundancyLevel < 0 {
+		return nil, ErrInvalidRedundancyLevel
+	}
+	if args.RedundancyLevel > 0 && args.MaxMissedRounds == 0 {
+		return nil, ErrInvalidMaxMissedRounds
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	nr := &nodeRedundancy{
+		redundancyLevel:     args.RedundancyLevel,
+		maxMissedRounds:     args.MaxMissedRounds,
+		messenger:           args.Messenger,
+		appStatusHandler:    args.AppStatusHandler,
+		lastRoundIndexCheck: -1,
+	}
+
+	nr.appStatusHandler.SetInt64Value(core.MetricRedundancyLevel, nr.redundancyLevel)
+	nr.updateMetrics()
+
+	return nr, nil
+}
+
+// IsRedundancyNode returns true if the machine is a backup of the main machine running with the same validator key
+func (nr *nodeRedundancy) IsRedundancyNode() bool {
+	return nr.redundancyLevel > 0
+}
+
+// IsMainMachineActive returns true if the main machine, or a backup having a lower redundancy level, is still seen
+// taking part in the consensus
+func (nr *nodeRedundancy) IsMainMachineActive() bool {
+	nr.mutNodeRedundancy.RLock()
+	defer nr.mutNodeRedundancy.RUnlock()
+
+	return nr.isMainMachineActive()
+}
+
+func (nr *nodeRedundancy) isMainMachineActive() bool {
+	if !nr.IsRedundancyNode() {
+		return true
+	}
+
+	return nr.missedRounds < uint64(nr.redundancyLevel)*nr.maxMissedRounds
+}
+
+// AdjustInactivityIfNeeded is called at the start of each round. It counts a missed round if the validator key was
+// part of the previous checked consensus group and no consensus message signed with it was received in the meantime
+func (nr *nodeRedundancy) AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+	if !nr.IsRedundancyNode() {
+		return
+	}
+
+	nr.mutNodeRedundancy.Lock()
+	defer nr.mutNodeRedundancy.Unlock()
+
+	if roundIndex <= nr.lastRoundIndexCheck {
+		return
+	}
+	nr.lastRoundIndexCheck = roundIndex
+
+	if nr.isCheckPending {
+		wasMainMachineActive := nr.isMainMachineActive()
+		nr.missedRounds++
+		log.Debug("main machine missed a round",
+			"round", roundIndex-1,
+			"missed rounds", nr.missedRounds,
+		)
+
+		if wasMainMachineActive && !nr.isMainMachineActive() {
+			log.Warn("main machine is inactive, this backup machine takes part in the consensus",
+				"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(selfPubKey))),
+				"missed rounds", nr.missedRounds,
+			)
+		}
+	}
+
+	nr.isCheckPending = isPubKeyInConsensusGroup(selfPubKey, consensusPubKeys)
+	nr.updateMetrics()
+}
+
+func isPubKeyInConsensusGroup(pubKey string, consensusPubKeys []string) bool {
+	for _, consensusPubKey := range consensusPubKeys {
+		if consensusPubKey == pubKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResetInactivityIfNeeded resets the missed rounds counter when a consensus message signed with the validator key is
+// received from another machine, so an active backup machine steps back as soon as the main machine reappears
+func (nr *nodeRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID p2p.PeerID) {
+	if selfPubKey != consensusMsgPubKey || consensusMsgPeerID == nr.messenger.ID() {
+		return
+	}
+	if !nr.IsRedundancyNode() {
+		log.Warn("consensus message signed with this validator key was received from another machine",
+			"pid", consensusMsgPeerID.Pretty(),
+		)
+		return
+	}
+
+	nr.mutNodeRedundancy.Lock()
+	defer nr.mutNodeRedundancy.Unlock()
+
+	if !nr.isMainMachineActive() {
+		log.Info("main machine is active again, this backup machine steps back from the consensus",
+			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(selfPubKey))),
+			"pid", consensusMsgPeerID.Pretty(),
+		)
+	}
+
+	nr.missedRounds = 0
+	nr.isCheckPending = false
+	nr.updateMetrics()
+}
+
+func (nr *nodeRedundancy) updateMetrics() {
+	nr.appStatusHandler.SetUInt64Value(core.MetricRedundancyMissedRounds, nr.missedRounds)
+	nr.appStatusHandler.SetStringValue(core.MetricRedundancyIsMainActive, strconv.FormatBool(nr.isMainMachineActive()))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nr *nodeRedundancy) IsInterfaceNil() bool {
+	return nr == nil
+}
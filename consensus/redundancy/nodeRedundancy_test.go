@@ -0,0 +1,235 @@
+package redundancy_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/redundancy"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	selfPubKey   = "validator public key"
+	selfPeerID   = p2p.PeerID("self peer")
+	mainPeerID   = p2p.PeerID("main peer")
+	otherPubKey  = "other public key"
+	missedRounds = 3
+)
+
+type metricsHolder struct {
+	mutMetrics sync.Mutex
+	metrics    map[string]interface{}
+}
+
+func (mh *metricsHolder) set(key string, value interface{}) {
+	mh.mutMetrics.Lock()
+	mh.metrics[key] = value
+	mh.mutMetrics.Unlock()
+}
+
+func (mh *metricsHolder) get(key string) interface{} {
+	mh.mutMetrics.Lock()
+	defer mh.mutMetrics.Unlock()
+
+	return mh.metrics[key]
+}
+
+func createMockArgsNodeRedundancy(redundancyLevel int64) (redundancy.ArgsNodeRedundancy, *metricsHolder) {
+	holder := &metricsHolder{metrics: make(map[string]interface{})}
+
+	return redundancy.ArgsNodeRedundancy{
+		RedundancyLevel: redundancyLevel,
+		MaxMissedRounds: missedRounds,
+		Messenger: &mock.MessengerStub{
+			IDCalled: func() p2p.PeerID {
+				return selfPeerID
+			},
+		},
+		AppStatusHandler: &mock.AppStatusHandlerStub{
+			SetInt64ValueHandler: func(key string, value int64) {
+				holder.set(key, value)
+			},
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				holder.set(key, value)
+			},
+			SetStringValueHandler: func(key string, value string) {
+				holder.set(key, value)
+			},
+		},
+	}, holder
+}
+
+func missRounds(nr consensus.NodeRedundancyHandler, fromRound int64, numRounds int64) {
+	for round := fromRound; round <= fromRound+numRounds; round++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{otherPubKey, selfPubKey}, round)
+	}
+}
+
+func TestNewNodeRedundancy_InvalidRedundancyLevelShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(-1)
+	nr, err := redundancy.NewNodeRedundancy(args)
+
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, redundancy.ErrInvalidRedundancyLevel, err)
+}
+
+func TestNewNodeRedundancy_ZeroMaxMissedRoundsOnBackupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	args.MaxMissedRounds = 0
+	nr, err := redundancy.NewNodeRedundancy(args)
+
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, redundancy.ErrInvalidMaxMissedRounds, err)
+}
+
+func TestNewNodeRedundancy_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	args.Messenger = nil
+	nr, err := redundancy.NewNodeRedundancy(args)
+
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, redundancy.ErrNilMessenger, err)
+}
+
+func TestNewNodeRedundancy_NilAppStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	args.AppStatusHandler = nil
+	nr, err := redundancy.NewNodeRedundancy(args)
+
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, redundancy.ErrNilAppStatusHandler, err)
+}
+
+func TestNewNodeRedundancy_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args, holder := createMockArgsNodeRedundancy(2)
+	nr, err := redundancy.NewNodeRedundancy(args)
+
+	assert.False(t, check.IfNil(nr))
+	assert.Nil(t, err)
+	assert.True(t, nr.IsRedundancyNode())
+	assert.True(t, nr.IsMainMachineActive())
+	assert.Equal(t, int64(2), holder.get(core.MetricRedundancyLevel))
+	assert.Equal(t, "true", holder.get(core.MetricRedundancyIsMainActive))
+	assert.Equal(t, uint64(0), holder.get(core.MetricRedundancyMissedRounds))
+}
+
+func TestNodeRedundancy_MainMachineShouldNeverBeInactive(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(0)
+	args.MaxMissedRounds = 0
+	nr, _ := redundancy.NewNodeRedundancy(args)
+
+	missRounds(nr, 1, 100)
+
+	assert.False(t, nr.IsRedundancyNode())
+	assert.True(t, nr.IsMainMachineActive())
+}
+
+func TestNodeRedundancy_AdjustInactivityShouldCountOnlyTheRoundsInConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	args, holder := createMockArgsNodeRedundancy(1)
+	nr, _ := redundancy.NewNodeRedundancy(args)
+
+	for round := int64(1); round < 100; round++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{otherPubKey}, round)
+	}
+	assert.True(t, nr.IsMainMachineActive())
+
+	missRounds(nr, 100, missedRounds-1)
+	assert.True(t, nr.IsMainMachineActive())
+	assert.Equal(t, uint64(missedRounds-1), holder.get(core.MetricRedundancyMissedRounds))
+
+	nr.AdjustInactivityIfNeeded(selfPubKey, []string{otherPubKey}, 100+missedRounds)
+	assert.False(t, nr.IsMainMachineActive())
+	assert.Equal(t, "false", holder.get(core.MetricRedundancyIsMainActive))
+}
+
+func TestNodeRedundancy_AdjustInactivityShouldIgnoreAlreadyCheckedRounds(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	nr, _ := redundancy.NewNodeRedundancy(args)
+
+	for i := 0; i < 10; i++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 1)
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 2)
+	}
+
+	assert.True(t, nr.IsMainMachineActive())
+}
+
+func TestNodeRedundancy_BackupShouldWaitLongerForHigherRedundancyLevels(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	firstBackup, _ := redundancy.NewNodeRedundancy(args)
+	args, _ = createMockArgsNodeRedundancy(2)
+	secondBackup, _ := redundancy.NewNodeRedundancy(args)
+
+	missRounds(firstBackup, 1, missedRounds)
+	missRounds(secondBackup, 1, missedRounds)
+	assert.False(t, firstBackup.IsMainMachineActive())
+	assert.True(t, secondBackup.IsMainMachineActive())
+
+	missRounds(secondBackup, missedRounds+2, missedRounds)
+	assert.False(t, secondBackup.IsMainMachineActive())
+}
+
+func TestNodeRedundancy_ResetInactivityShouldStepBackWhenTheMainMachineReappears(t *testing.T) {
+	t.Parallel()
+
+	args, holder := createMockArgsNodeRedundancy(1)
+	nr, _ := redundancy.NewNodeRedundancy(args)
+	missRounds(nr, 1, missedRounds)
+	assert.False(t, nr.IsMainMachineActive())
+
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, mainPeerID)
+
+	assert.True(t, nr.IsMainMachineActive())
+	assert.Equal(t, "true", holder.get(core.MetricRedundancyIsMainActive))
+	assert.Equal(t, uint64(0), holder.get(core.MetricRedundancyMissedRounds))
+}
+
+func TestNodeRedundancy_ResetInactivityShouldIgnoreOtherKeysAndOwnMessages(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	nr, _ := redundancy.NewNodeRedundancy(args)
+	missRounds(nr, 1, missedRounds)
+
+	nr.ResetInactivityIfNeeded(selfPubKey, otherPubKey, mainPeerID)
+	nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, selfPeerID)
+
+	assert.False(t, nr.IsMainMachineActive())
+}
+
+func TestNodeRedundancy_ResetInactivityShouldDiscardThePendingRound(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgsNodeRedundancy(1)
+	nr, _ := redundancy.NewNodeRedundancy(args)
+
+	for round := int64(1); round <= 10*missedRounds; round++ {
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, round)
+		nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, mainPeerID)
+	}
+
+	assert.True(t, nr.IsMainMachineActive())
+}
@@ -0,0 +1,30 @@
+package signingHistory
+
+import (
+	"errors"
+)
+
+// ErrNilPersister is raised when a valid persister is expected but nil used
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrEmptyPublicKey is raised when the public key of the validator is empty
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrEmptyHeaderHash is raised when a signed header hash is empty
+var ErrEmptyHeaderHash = errors.New("empty header hash")
+
+// ErrConflictingSignature is raised when the validator is asked to sign a payload conflicting with the one already
+// signed for the same round
+var ErrConflictingSignature = errors.New("conflicting signature for an already signed round")
+
+// ErrNilHistory is raised when a valid signing history is expected but nil used
+var ErrNilHistory = errors.New("nil signing history")
+
+// ErrUnsupportedHistoryVersion is raised when the imported signing history has an unknown format version
+var ErrUnsupportedHistoryVersion = errors.New("unsupported signing history version")
+
+// ErrPublicKeyMismatch is raised when the imported signing history belongs to another validator key
+var ErrPublicKeyMismatch = errors.New("signing history public key mismatch")
+
+// ErrInvalidSignatureType is raised when a signed payload has an unknown signature type
+var ErrInvalidSignatureType = errors.New("invalid signature type")
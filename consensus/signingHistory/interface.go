@@ -0,0 +1,12 @@
+package signingHistory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// HistoryPersister defines the persistence medium of the signing history, which has to be iterated in ascending key
+// order when the history is exported
+type HistoryPersister interface {
+	storage.Persister
+	storage.SortedKeysRanger
+}
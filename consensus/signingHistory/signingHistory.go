@@ -0,0 +1,316 @@
+package signingHistory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+)
+
+var log = logger.GetOrCreate("consensus/signinghistory")
+
+const (
+	// LeaderSignatureType is the type of the signed payload recorded when the validator proposes and signs a header
+	LeaderSignatureType = "leaderSignature"
+	// SignatureShareType is the type of the signed payload recorded when the validator signs a proposed header
+	SignatureShareType = "signatureShare"
+	// HistoryVersion is the version of the portable signing history format
+	HistoryVersion = uint32(1)
+
+	leaderSignatureKeyMarker = byte(1)
+	signatureShareKeyMarker  = byte(2)
+	// the signature type marker and the big endian round follow the public key in the record keys
+	keySuffixLength = 1 + 8
+
+	// every record is written synchronously, so it is on the disk before the signature leaves the node
+	persisterMaxBatchSize      = 1
+	persisterBatchDelaySeconds = 1
+	persisterMaxOpenFiles      = 10
+)
+
+// SignedPayload holds the details of a payload signed by the validator, in the portable format used when the
+// signing history is exported or imported
+type SignedPayload struct {
+	Type       string `json:"type"`
+	Epoch      uint32 `json:"epoch"`
+	ShardID    uint32 `json:"shardId"`
+	Round      uint64 `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
+
+// History is the portable representation of the signing history of a validator key
+type History struct {
+	Version        uint32          `json:"version"`
+	PublicKey      string          `json:"publicKey"`
+	SignedPayloads []SignedPayload `json:"signedPayloads"`
+}
+
+// ArgsSigningHistory is the argument DTO used to create a new signing history instance
+type ArgsSigningHistory struct {
+	Persister HistoryPersister
+	PublicKey []byte
+}
+
+// signingHistory records, for each round, the header hash signed by the validator as leader and the one signed with
+// a signature share, refusing to sign a different header hash for an already signed round. The records are kept by
+// public key, so the same database can be used when the validator key is changed
+type signingHistory struct {
+	mutHistory sync.Mutex
+	persister  HistoryPersister
+	publicKey  []byte
+}
+
+// NewSigningHistory creates a new signing history instance
+func NewSigningHistory(args ArgsSigningHistory) (*signingHistory, error) {
+	if check.IfNil(args.Persister) {
+		return nil, ErrNilPersister
+	}
+	if len(args.PublicKey) == 0 {
+		return nil, ErrEmptyPublicKey
+	}
+
+	return &signingHistory{
+		persister: args.Persister,
+		publicKey: args.PublicKey,
+	}, nil
+}
+
+// CreatePersister opens the signing history database found at the provided path, every write being flushed to the
+// disk before returning
+func CreatePersister(path string) (HistoryPersister, error) {
+	return leveldb.NewDB(path, persisterBatchDelaySeconds, persisterMaxBatchSize, persisterMaxOpenFiles)
+}
+
+// CheckAndRecordLeaderSignature records the header hash proposed and signed by the validator as leader in the
+// provided round. It errors if another header hash was already signed as leader in the same round
+func (sh *signingHistory) CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	return sh.checkAndRecord(createSignedPayload(LeaderSignatureType, epoch, shardID, round, headerHash))
+}
+
+// CheckAndRecordSignatureShare records the header hash signed by the validator with a signature share in the
+// provided round. It errors if another header hash was already signed with a signature share in the same round
+func (sh *signingHistory) CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	return sh.checkAndRecord(createSignedPayload(SignatureShareType, epoch, shardID, round, headerHash))
+}
+
+func createSignedPayload(signatureType string, epoch uint32, shardID uint32, round uint64, headerHash []byte) *SignedPayload {
+	return &SignedPayload{
+		Type:       signatureType,
+		Epoch:      epoch,
+		ShardID:    shardID,
+		Round:      round,
+		HeaderHash: hex.EncodeToString(headerHash),
+	}
+}
+
+func (sh *signingHistory) checkAndRecord(payload *SignedPayload) error {
+	key, err := sh.computeKey(payload)
+	if err != nil {
+		return err
+	}
+
+	sh.mutHistory.Lock()
+	defer sh.mutHistory.Unlock()
+
+	isRecorded, err := sh.checkPayload(key, payload)
+	if err != nil {
+		log.Warn("signing refused",
+			"pk", core.GetTrimmedPk(hex.EncodeToString(sh.publicKey)),
+			"error", err.Error(),
+		)
+		return err
+	}
+	if isRecorded {
+		return nil
+	}
+
+	return sh.savePayload(key, payload)
+}
+
+// checkPayload returns true if the same payload was already recorded and an error if a conflicting one was
+func (sh *signingHistory) checkPayload(key []byte, payload *SignedPayload) (bool, error) {
+	recorded, err := sh.getPayload(key)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if *recorded == *payload {
+		return true, nil
+	}
+
+	return false, fmt.Errorf("%w: %s in round %d, epoch %d, shard %d for header hash %s, requested epoch %d, shard %d for header hash %s",
+		ErrConflictingSignature,
+		payload.Type,
+		payload.Round,
+		recorded.Epoch,
+		recorded.ShardID,
+		recorded.HeaderHash,
+		payload.Epoch,
+		payload.ShardID,
+		payload.HeaderHash,
+	)
+}
+
+func (sh *signingHistory) getPayload(key []byte) (*SignedPayload, error) {
+	err := sh.persister.Has(key)
+	if err != nil {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	buff, err := sh.persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &SignedPayload{}
+	err = json.Unmarshal(buff, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func (sh *signingHistory) savePayload(key []byte, payload *SignedPayload) error {
+	buff, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return sh.persister.Put(key, buff)
+}
+
+// computeKey returns the public key, followed by the signature type marker and the big endian round, so the records
+// of a public key are iterated by type and round
+func (sh *signingHistory) computeKey(payload *SignedPayload) ([]byte, error) {
+	if len(payload.HeaderHash) == 0 {
+		return nil, ErrEmptyHeaderHash
+	}
+
+	var marker byte
+	switch payload.Type {
+	case LeaderSignatureType:
+		marker = leaderSignatureKeyMarker
+	case SignatureShareType:
+		marker = signatureShareKeyMarker
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignatureType, payload.Type)
+	}
+
+	key := make([]byte, len(sh.publicKey)+keySuffixLength)
+	copy(key, sh.publicKey)
+	key[len(sh.publicKey)] = marker
+	binary.BigEndian.PutUint64(key[len(sh.publicKey)+1:], payload.Round)
+
+	return key, nil
+}
+
+// Export returns the signing history of the validator key
+func (sh *signingHistory) Export() (*History, error) {
+	sh.mutHistory.Lock()
+	defer sh.mutHistory.Unlock()
+
+	history := &History{
+		Version:        HistoryVersion,
+		PublicKey:      hex.EncodeToString(sh.publicKey),
+		SignedPayloads: make([]SignedPayload, 0),
+	}
+
+	var errUnmarshal error
+	err := sh.persister.RangeKeys(func(key []byte, value []byte) bool {
+		if !bytes.HasPrefix(key, sh.publicKey) || len(key) != len(sh.publicKey)+keySuffixLength {
+			return true
+		}
+
+		payload := SignedPayload{}
+		errUnmarshal = json.Unmarshal(value, &payload)
+		if errUnmarshal != nil {
+			return false
+		}
+
+		history.SignedPayloads = append(history.SignedPayloads, payload)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	return history, nil
+}
+
+// Import adds the provided signing history, exported on another machine, to the records of the validator key. Nothing
+// is imported if the history belongs to another key or if it conflicts with the already recorded payloads
+func (sh *signingHistory) Import(history *History) error {
+	if history == nil {
+		return ErrNilHistory
+	}
+	if history.Version != HistoryVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedHistoryVersion, history.Version)
+	}
+	if history.PublicKey != hex.EncodeToString(sh.publicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	sh.mutHistory.Lock()
+	defer sh.mutHistory.Unlock()
+
+	imported := make(map[string]*SignedPayload)
+	for i := range history.SignedPayloads {
+		payload := &history.SignedPayloads[i]
+		_, err := hex.DecodeString(payload.HeaderHash)
+		if err != nil {
+			return err
+		}
+
+		key, err := sh.computeKey(payload)
+		if err != nil {
+			return err
+		}
+
+		previous, isDuplicated := imported[string(key)]
+		if isDuplicated && *previous != *payload {
+			return fmt.Errorf("%w: %s in round %d is recorded twice in the imported history",
+				ErrConflictingSignature, payload.Type, payload.Round)
+		}
+
+		_, err = sh.checkPayload(key, payload)
+		if err != nil {
+			return err
+		}
+
+		imported[string(key)] = payload
+	}
+
+	for key, payload := range imported {
+		err := sh.savePayload([]byte(key), payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("signing history imported",
+		"pk", core.GetTrimmedPk(history.PublicKey),
+		"num signed payloads", len(history.SignedPayloads),
+	)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHistory) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,275 @@
+package signingHistory
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPublicKey = []byte("validator public key")
+
+func createMockArgsSigningHistory() ArgsSigningHistory {
+	return ArgsSigningHistory{
+		Persister: memorydb.New(),
+		PublicKey: testPublicKey,
+	}
+}
+
+func TestNewSigningHistoryNilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHistory()
+	args.Persister = nil
+
+	sh, err := NewSigningHistory(args)
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, ErrNilPersister, err)
+}
+
+func TestNewSigningHistoryEmptyPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHistory()
+	args.PublicKey = nil
+
+	sh, err := NewSigningHistory(args)
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, ErrEmptyPublicKey, err)
+}
+
+func TestNewSigningHistoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sh, err := NewSigningHistory(createMockArgsSigningHistory())
+	assert.False(t, check.IfNil(sh))
+	assert.Nil(t, err)
+}
+
+func TestSigningHistory_CheckAndRecordEmptyHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	err := sh.CheckAndRecordLeaderSignature(1, 0, 10, nil)
+	assert.Equal(t, ErrEmptyHeaderHash, err)
+
+	err = sh.CheckAndRecordSignatureShare(1, 0, 10, nil)
+	assert.Equal(t, ErrEmptyHeaderHash, err)
+}
+
+func TestSigningHistory_CheckAndRecordSamePayloadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	err := sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	assert.Nil(t, err)
+	err = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	assert.Nil(t, err)
+
+	err = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+	assert.Nil(t, err)
+	err = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+	assert.Nil(t, err)
+}
+
+func TestSigningHistory_CheckAndRecordConflictingPayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	_ = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+
+	err := sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash B"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+
+	err = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash B"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+
+	err = sh.CheckAndRecordSignatureShare(1, 1, 10, []byte("hash A"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+
+	err = sh.CheckAndRecordSignatureShare(2, 0, 10, []byte("hash A"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+}
+
+func TestSigningHistory_CheckAndRecordDifferentRoundsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	err := sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+	assert.Nil(t, err)
+	err = sh.CheckAndRecordSignatureShare(1, 0, 11, []byte("hash B"))
+	assert.Nil(t, err)
+}
+
+func TestSigningHistory_CheckAndRecordShouldKeepTheRecordsByPublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHistory()
+	sh, _ := NewSigningHistory(args)
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+
+	args.PublicKey = []byte("another public key")
+	otherSh, _ := NewSigningHistory(args)
+
+	err := otherSh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash B"))
+	assert.Nil(t, err)
+}
+
+func TestSigningHistory_CheckAndRecordShouldSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "signingHistory")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister, err := CreatePersister(dir)
+	require.Nil(t, err)
+	sh, _ := NewSigningHistory(ArgsSigningHistory{Persister: persister, PublicKey: testPublicKey})
+	err = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	require.Nil(t, err)
+	_ = persister.Close()
+
+	persister, err = CreatePersister(dir)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+	sh, _ = NewSigningHistory(ArgsSigningHistory{Persister: persister, PublicKey: testPublicKey})
+
+	err = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash B"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+}
+
+func TestSigningHistory_ExportShouldReturnTheRecordsOfThePublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHistory()
+	sh, _ := NewSigningHistory(args)
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 11, []byte("hash B"))
+	_ = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+
+	args.PublicKey = []byte("another public key")
+	otherSh, _ := NewSigningHistory(args)
+	_ = otherSh.CheckAndRecordSignatureShare(1, 0, 12, []byte("hash C"))
+
+	history, err := sh.Export()
+	require.Nil(t, err)
+
+	expectedHistory := &History{
+		Version:   HistoryVersion,
+		PublicKey: hex.EncodeToString(testPublicKey),
+		SignedPayloads: []SignedPayload{
+			{Type: LeaderSignatureType, Epoch: 1, ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash A"))},
+			{Type: SignatureShareType, Epoch: 1, ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash A"))},
+			{Type: SignatureShareType, Epoch: 1, ShardID: 0, Round: 11, HeaderHash: hex.EncodeToString([]byte("hash B"))},
+		},
+	}
+	assert.Equal(t, expectedHistory, history)
+}
+
+func TestSigningHistory_ImportInvalidHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	err := sh.Import(nil)
+	assert.Equal(t, ErrNilHistory, err)
+
+	err = sh.Import(&History{Version: HistoryVersion + 1, PublicKey: hex.EncodeToString(testPublicKey)})
+	assert.True(t, errors.Is(err, ErrUnsupportedHistoryVersion))
+
+	err = sh.Import(&History{Version: HistoryVersion, PublicKey: hex.EncodeToString([]byte("another public key"))})
+	assert.Equal(t, ErrPublicKeyMismatch, err)
+
+	err = sh.Import(&History{
+		Version:        HistoryVersion,
+		PublicKey:      hex.EncodeToString(testPublicKey),
+		SignedPayloads: []SignedPayload{{Type: "unknown", Round: 10, HeaderHash: "aa"}},
+	})
+	assert.True(t, errors.Is(err, ErrInvalidSignatureType))
+
+	err = sh.Import(&History{
+		Version:        HistoryVersion,
+		PublicKey:      hex.EncodeToString(testPublicKey),
+		SignedPayloads: []SignedPayload{{Type: SignatureShareType, Round: 10, HeaderHash: "not hex"}},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestSigningHistory_ImportConflictingHistoryShouldNotImportAnything(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 11, []byte("hash B"))
+
+	history := &History{
+		Version:   HistoryVersion,
+		PublicKey: hex.EncodeToString(testPublicKey),
+		SignedPayloads: []SignedPayload{
+			{Type: SignatureShareType, Epoch: 1, ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash A"))},
+			{Type: SignatureShareType, Epoch: 1, ShardID: 0, Round: 11, HeaderHash: hex.EncodeToString([]byte("hash C"))},
+		},
+	}
+	err := sh.Import(history)
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+
+	err = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash D"))
+	assert.Nil(t, err)
+}
+
+func TestSigningHistory_ImportDuplicatedConflictingPayloadsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+
+	history := &History{
+		Version:   HistoryVersion,
+		PublicKey: hex.EncodeToString(testPublicKey),
+		SignedPayloads: []SignedPayload{
+			{Type: LeaderSignatureType, Epoch: 1, ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash A"))},
+			{Type: LeaderSignatureType, Epoch: 1, ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash B"))},
+		},
+	}
+	err := sh.Import(history)
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+}
+
+func TestSigningHistory_ExportImportShouldMigrateTheHistory(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+	_ = sh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash A"))
+	_ = sh.CheckAndRecordSignatureShare(1, 0, 10, []byte("hash A"))
+	_ = sh.CheckAndRecordSignatureShare(2, 0, 11, []byte("hash B"))
+
+	history, _ := sh.Export()
+
+	migratedSh, _ := NewSigningHistory(createMockArgsSigningHistory())
+	_ = migratedSh.CheckAndRecordSignatureShare(2, 0, 12, []byte("hash C"))
+	err := migratedSh.Import(history)
+	require.Nil(t, err)
+
+	err = migratedSh.CheckAndRecordLeaderSignature(1, 0, 10, []byte("hash B"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+	err = migratedSh.CheckAndRecordSignatureShare(2, 0, 11, []byte("hash A"))
+	assert.True(t, errors.Is(err, ErrConflictingSignature))
+	err = migratedSh.CheckAndRecordSignatureShare(2, 0, 11, []byte("hash B"))
+	assert.Nil(t, err)
+
+	migratedHistory, _ := migratedSh.Export()
+	assert.Equal(t, 4, len(migratedHistory.SignedPayloads))
+}
@@ -119,6 +119,13 @@ func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHand
 		return false
 	}
 
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	err = sr.SigningHistory().CheckAndRecordLeaderSignature(header.GetEpoch(), header.GetShardID(), header.GetRound(), headerHash)
+	if err != nil {
+		log.Debug("sendBlock.CheckAndRecordLeaderSignature", "error", err.Error())
+		return false
+	}
+
 	if sr.couldBeSentTogether(marshalizedBody, marshalizedHeader) {
 		return sr.sendBlockBodyAndHeader(body, header, marshalizedBody, marshalizedHeader)
 	}
@@ -267,24 +274,25 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	var nonce uint64
 	var prevHash []byte
-	var prevRandSeed []byte
+	var prevHeader data.HeaderHandler
 
 	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
 	if check.IfNil(currentHeader) {
 		nonce = 1
 		prevHash = sr.Blockchain().GetGenesisHeaderHash()
-		prevRandSeed = sr.Blockchain().GetGenesisHeader().GetRandSeed()
+		prevHeader = sr.Blockchain().GetGenesisHeader()
 	} else {
 		nonce = currentHeader.GetNonce() + 1
 		prevHash = sr.Blockchain().GetCurrentBlockHeaderHash()
-		prevRandSeed = currentHeader.GetRandSeed()
+		prevHeader = currentHeader
 	}
+	prevRandSeed := prevHeader.GetRandSeed()
 
 	round := uint64(sr.Rounder().Index())
 	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	randSeed, err := sr.signRandSeed(prevHeader, round)
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +306,17 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	return hdr, nil
 }
 
+// signRandSeed signs the rand seed of the previous header. A remote signer is sent the whole previous header, as it
+// only signs the rand seeds it derives itself
+func (sr *subroundBlock) signRandSeed(prevHeader data.HeaderHandler, round uint64) ([]byte, error) {
+	randSeedSigner, ok := sr.SingleSigner().(spos.RandSeedSigner)
+	if ok {
+		return randSeedSigner.SignRandSeed(sr.PrivateKey(), prevHeader, round)
+	}
+
+	return sr.SingleSigner().Sign(sr.PrivateKey(), prevHeader.GetRandSeed())
+}
+
 // receivedBlockBodyAndHeader method is called when a block body and a block header is received
 func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) bool {
 	sw := core.NewStopWatch()
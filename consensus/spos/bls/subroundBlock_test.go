@@ -10,9 +10,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func defaultSubroundForSRBlock(consensusState *spos.ConsensusState, ch chan bool,
@@ -311,6 +313,55 @@ func TestSubroundBlock_DoBlockJob(t *testing.T) {
 	assert.Equal(t, uint64(1), sr.Header.GetNonce())
 }
 
+func TestSubroundBlock_DoBlockJobConflictingLeaderSignatureShouldNotSendBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSigningHistory(&mock.SigningHistoryStub{
+		CheckAndRecordLeaderSignatureCalled: func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, uint64(1), round)
+			return errors.New("conflicting signature")
+		},
+	})
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "should have not broadcast the block")
+			return nil
+		},
+	})
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	r := sr.DoBlockJob()
+	assert.False(t, r)
+	assert.Nil(t, sr.Header)
+}
+
+func TestSubroundBlock_DoBlockJobInStandbyShouldNotSendBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "should have not broadcast the block")
+			return nil
+		},
+	})
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	sr.SetSelfInStandby(true)
+
+	r := sr.DoBlockJob()
+	assert.False(t, r)
+	assert.Nil(t, sr.Header)
+}
+
 func TestSubroundBlock_ReceivedBlock(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
@@ -736,6 +787,40 @@ func TestSubroundBlock_CreateHeaderNotNilCurrentHeader(t *testing.T) {
 	assert.Equal(t, expectedHeader, header)
 }
 
+func TestSubroundBlock_CreateHeaderShouldSendThePreviousHeaderToARandSeedSigner(t *testing.T) {
+	container := mock.InitConsensusCore()
+	currentHeader := &block.Header{Nonce: 1, RandSeed: []byte("current rand seed")}
+	var signedPrevHeader data.HeaderHandler
+	var signedRound uint64
+	container.SetSingleSigner(&mock.RandSeedSignerMock{
+		SingleSignerMock: mock.SingleSignerMock{
+			SignStub: func(_ crypto.PrivateKey, _ []byte) ([]byte, error) {
+				assert.Fail(t, "the rand seed should not be signed as a raw message")
+				return nil, nil
+			},
+		},
+		SignRandSeedStub: func(_ crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error) {
+			signedPrevHeader = prevHeader
+			signedRound = round
+			return []byte("new rand seed"), nil
+		},
+	})
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	sr := *initSubroundBlock(blockChain, container)
+
+	header, err := sr.CreateHeader()
+
+	require.Nil(t, err)
+	assert.Equal(t, []byte("new rand seed"), header.GetRandSeed())
+	assert.Equal(t, currentHeader.RandSeed, header.GetPrevRandSeed())
+	assert.True(t, signedPrevHeader == currentHeader)
+	assert.Equal(t, uint64(sr.Rounder().Index()), signedRound)
+}
+
 func TestSubroundBlock_CreateHeaderMultipleMiniBlocks(t *testing.T) {
 	mbHeaders := []block.MiniBlockHeader{
 		{Hash: []byte("mb1"), SenderShardID: 1, ReceiverShardID: 1},
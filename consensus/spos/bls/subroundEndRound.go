@@ -355,6 +355,11 @@ func (sr *subroundEndRound) isConsensusHeaderReceived() (bool, data.HeaderHandle
 }
 
 func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
+	err := sr.SigningHistory().CheckAndRecordLeaderSignature(sr.Header.GetEpoch(), sr.Header.GetShardID(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
 	headerClone := sr.Header.Clone()
 	headerClone.SetLeaderSignature(nil)
 
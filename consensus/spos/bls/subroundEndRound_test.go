@@ -490,6 +490,49 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobConflictingLeaderSignatureShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSigningHistory(&mock.SigningHistoryStub{
+		CheckAndRecordLeaderSignatureCalled: func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, uint64(7), round)
+			return errors.New("conflicting signature")
+		},
+	})
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		CommitBlockCalled: func(header data.HeaderHandler, body data.BodyHandler) error {
+			assert.Fail(t, "should have not committed the block")
+			return nil
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+	sr.Header = &block.Header{Round: 7}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+}
+
+func TestSubroundEndRound_DoEndRoundJobInStandbyShouldNotBroadcastTheBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastBlockCalled: func(handler data.BodyHandler, handler2 data.HeaderHandler) error {
+			assert.Fail(t, "should have not broadcast the block")
+			return nil
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+	sr.SetSelfInStandby(true)
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
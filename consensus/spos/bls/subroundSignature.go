@@ -65,13 +65,19 @@ func checkNewSubroundSignatureParams(
 
 // doSignatureJob method does the job of the subround Signature
 func (sr *subroundSignature) doSignatureJob() bool {
-	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) || sr.IsSelfInStandby() {
 		return true
 	}
 	if !sr.CanDoSubroundJob(sr.Current()) {
 		return false
 	}
 
+	err := sr.SigningHistory().CheckAndRecordSignatureShare(sr.Header.GetEpoch(), sr.Header.GetShardID(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		log.Debug("doSignatureJob.CheckAndRecordSignatureShare", "error", err.Error())
+		return false
+	}
+
 	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
 	if err != nil {
 		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
@@ -186,7 +192,7 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	}
 
 	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
-	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey()) && !sr.IsSelfInStandby()
 
 	threshold := sr.Threshold(sr.Current())
 	areSignaturesCollected, numSigs := sr.signaturesCollected(threshold)
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -248,6 +249,7 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, r)
 
 	sr.Data = []byte("X")
+	sr.Header = &block.Header{}
 
 	multiSignerMock := mock.InitMultiSignerMock()
 
@@ -279,6 +281,54 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobConflictingSignatureShouldNotSign(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSigningHistory(&mock.SigningHistoryStub{
+		CheckAndRecordSignatureShareCalled: func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, uint32(2), epoch)
+			assert.Equal(t, uint32(1), shardID)
+			assert.Equal(t, uint64(10), round)
+			assert.Equal(t, []byte("X"), headerHash)
+			return errors.New("conflicting signature")
+		},
+	})
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.CreateSignatureShareMock = func(msg []byte, bitmap []byte) ([]byte, error) {
+		assert.Fail(t, "should have not created the signature share")
+		return nil, nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	sr.Header = &block.Header{Epoch: 2, ShardID: 1, Round: 10}
+
+	r := sr.DoSignatureJob()
+	assert.False(t, r)
+}
+
+func TestSubroundSignature_DoSignatureJobInStandbyShouldNotSign(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.CreateSignatureShareMock = func(msg []byte, bitmap []byte) ([]byte, error) {
+		assert.Fail(t, "should have not created the signature share")
+		return nil, nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.SetSelfInStandby(true)
+	sr.Data = []byte("X")
+	sr.Header = &block.Header{}
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	isSelfJobDone, _ := sr.JobDone(sr.SelfPubKey(), bls.SrSignature)
+	assert.False(t, isSelfJobDone)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
@@ -390,6 +440,17 @@ func TestSubroundSignature_DoSignatureConsensusCheckShouldReturnTrueWhenSignatur
 	assert.True(t, sr.DoSignatureConsensusCheck())
 }
 
+func TestSubroundSignature_DoSignatureConsensusCheckInStandbyShouldReturnTrue(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	sr.SetSelfInStandby(true)
+
+	assert.True(t, sr.DoSignatureConsensusCheck())
+}
+
 func TestSubroundSignature_DoSignatureConsensusCheckShouldReturnFalseWhenSignaturesCollectedReturnFalse(t *testing.T) {
 	t.Parallel()
 
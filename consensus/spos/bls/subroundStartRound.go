@@ -124,8 +124,11 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	sr.NodeRedundancyHandler().AdjustInactivityIfNeeded(sr.SelfPubKey(), sr.ConsensusGroup(), sr.Rounder().Index())
+	sr.SetSelfInStandby(sr.NodeRedundancyHandler().IsRedundancyNode() && sr.NodeRedundancyHandler().IsMainMachineActive())
+
 	msg := ""
-	if leader == sr.SelfPubKey() {
+	if sr.IsSelfLeaderInCurrentRound() {
 		sr.AppStatusHandler().Increment(core.MetricCountLeader)
 		sr.AppStatusHandler().SetStringValue(core.MetricConsensusRoundState, "proposed")
 		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "proposer")
@@ -144,6 +147,9 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	if err != nil {
 		log.Debug("not in consensus group")
 		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "not in consensus group")
+	} else if sr.IsSelfInStandby() {
+		log.Debug("in consensus group, in standby while the main machine is active")
+		sr.AppStatusHandler().SetStringValue(core.MetricConsensusState, "standby")
 	} else {
 		if leader != sr.SelfPubKey() {
 			sr.AppStatusHandler().Increment(core.MetricCountConsensus)
@@ -414,6 +414,52 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldAdjustTheInactivityOfTheMainMachine(t *testing.T) {
+	t.Parallel()
+
+	adjustCalled := false
+	container := mock.InitConsensusCore()
+	container.SetNodeRedundancyHandler(&mock.NodeRedundancyHandlerStub{
+		AdjustInactivityIfNeededCalled: func(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+			adjustCalled = true
+			assert.Equal(t, container.Rounder().Index(), roundIndex)
+			assert.Contains(t, consensusPubKeys, selfPubKey)
+		},
+	})
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.True(t, adjustCalled)
+}
+
+func TestSubroundStartRound_InitCurrentRoundShouldSetStandbyWhileTheMainMachineIsActive(t *testing.T) {
+	t.Parallel()
+
+	isMainMachineActive := true
+	container := mock.InitConsensusCore()
+	container.SetNodeRedundancyHandler(&mock.NodeRedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		IsMainMachineActiveCalled: func() bool {
+			return isMainMachineActive
+		},
+	})
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.True(t, srStartRound.IsSelfInStandby())
+
+	isMainMachineActive = false
+	r = srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.False(t, srStartRound.IsSelfInStandby())
+}
+
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +31,8 @@ type ConsensusCore struct {
 	syncTimer                     ntp.SyncTimer
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	antifloodHandler              consensus.P2PAntifloodHandler
+	signingHistory                consensus.SigningHistoryHandler
+	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -51,6 +53,8 @@ type ConsensusCoreArgs struct {
 	SyncTimer                     ntp.SyncTimer
 	EpochStartRegistrationHandler epochStart.RegistrationHandler
 	AntifloodHandler              consensus.P2PAntifloodHandler
+	SigningHistory                consensus.SigningHistoryHandler
+	NodeRedundancyHandler         consensus.NodeRedundancyHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -74,6 +78,8 @@ func NewConsensusCore(
 		syncTimer:                     args.SyncTimer,
 		epochStartRegistrationHandler: args.EpochStartRegistrationHandler,
 		antifloodHandler:              args.AntifloodHandler,
+		signingHistory:                args.SigningHistory,
+		nodeRedundancyHandler:         args.NodeRedundancyHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -164,6 +170,17 @@ func (cc *ConsensusCore) SingleSigner() crypto.SingleSigner {
 	return cc.blsSingleSigner
 }
 
+// SigningHistory returns the signing history stored in the ConsensusStore used to refuse conflicting signatures
+func (cc *ConsensusCore) SigningHistory() consensus.SigningHistoryHandler {
+	return cc.signingHistory
+}
+
+// NodeRedundancyHandler returns the node redundancy handler stored in the ConsensusStore used to keep a backup machine
+// out of the consensus while the main machine is active
+func (cc *ConsensusCore) NodeRedundancyHandler() consensus.NodeRedundancyHandler {
+	return cc.nodeRedundancyHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -52,6 +52,12 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.GetAntiFloodHandler()) {
 		return ErrNilAntifloodHandler
 	}
+	if check.IfNil(container.SigningHistory()) {
+		return ErrNilSigningHistory
+	}
+	if check.IfNil(container.NodeRedundancyHandler()) {
+		return ErrNilNodeRedundancyHandler
+	}
 
 	return nil
 }
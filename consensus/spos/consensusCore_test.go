@@ -28,6 +28,8 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		SyncTimer:                     consensusCoreMock.SyncTimer(),
 		EpochStartRegistrationHandler: consensusCoreMock.EpochStartRegistrationHandler(),
 		AntifloodHandler:     		   consensusCoreMock.GetAntiFloodHandler(),
+		SigningHistory:                consensusCoreMock.SigningHistory(),
+		NodeRedundancyHandler:         consensusCoreMock.NodeRedundancyHandler(),
 	}
 	return args
 }
@@ -240,6 +242,34 @@ func TestConsensusCore_WithNilAntifloodHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilAntifloodHandler, err)
 }
 
+func TestConsensusCore_WithNilSigningHistoryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SigningHistory = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSigningHistory, err)
+}
+
+func TestConsensusCore_WithNilNodeRedundancyHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.NodeRedundancyHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +32,9 @@ type ConsensusState struct {
 	processingBlock    bool
 	mutProcessingBlock sync.RWMutex
 
+	selfInStandby    bool
+	mutSelfInStandby sync.RWMutex
+
 	*roundConsensus
 	*roundThreshold
 	*roundStatus
@@ -105,6 +108,10 @@ func (cns *ConsensusState) IsNodeLeaderInCurrentRound(node string) bool {
 
 // IsSelfLeaderInCurrentRound method checks if the current node is leader in the current round
 func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
+	if cns.IsSelfInStandby() {
+		return false
+	}
+
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
@@ -193,7 +200,7 @@ func (cns *ConsensusState) IsSubroundFinished(subroundID int) bool {
 
 // IsNodeSelf method returns true if the message is received from itself and false otherwise
 func (cns *ConsensusState) IsNodeSelf(node string) bool {
-	isNodeSelf := node == cns.SelfPubKey()
+	isNodeSelf := node == cns.SelfPubKey() && !cns.IsSelfInStandby()
 
 	return isNodeSelf
 }
@@ -298,3 +305,20 @@ func (cns *ConsensusState) SetProcessingBlock(processingBlock bool) {
 func (cns *ConsensusState) GetData() []byte {
 	return cns.Data
 }
+
+// SetSelfInStandby sets if the node is a backup machine in standby, while the main machine running with the same
+// validator key is active
+func (cns *ConsensusState) SetSelfInStandby(selfInStandby bool) {
+	cns.mutSelfInStandby.Lock()
+	cns.selfInStandby = selfInStandby
+	cns.mutSelfInStandby.Unlock()
+}
+
+// IsSelfInStandby returns true if the node is a backup machine in standby. In this case the node follows the consensus
+// as an observer, the messages signed with its validator key being the ones sent by the main machine
+func (cns *ConsensusState) IsSelfInStandby() bool {
+	cns.mutSelfInStandby.RLock()
+	defer cns.mutSelfInStandby.RUnlock()
+
+	return cns.selfInStandby
+}
@@ -103,6 +103,16 @@ func TestConsensusState_IsSelfLeaderInCurrentRoundShouldReturnTrue(t *testing.T)
 	assert.False(t, cns.IsSelfLeaderInCurrentRound())
 }
 
+func TestConsensusState_IsSelfLeaderInCurrentRoundInStandbyShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+	cns.SetSelfPubKey("1")
+	cns.SetSelfInStandby(true)
+
+	assert.False(t, cns.IsSelfLeaderInCurrentRound())
+}
+
 func TestConsensusState_GetLeaderShoudErrNilConsensusGroup(t *testing.T) {
 	t.Parallel()
 
@@ -299,6 +309,16 @@ func TestConsensusState_IsNodeSelfShouldReturnTrue(t *testing.T) {
 	assert.True(t, cns.IsNodeSelf(cns.SelfPubKey()))
 }
 
+func TestConsensusState_IsNodeSelfInStandbyShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+	cns.SetSelfInStandby(true)
+
+	assert.True(t, cns.IsSelfInStandby())
+	assert.False(t, cns.IsNodeSelf(cns.SelfPubKey()))
+}
+
 func TestConsensusState_IsBlockBodyAlreadyReceivedShouldReturnFalse(t *testing.T) {
 	t.Parallel()
 
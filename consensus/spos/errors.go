@@ -160,6 +160,12 @@ var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 // ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
 var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 
+// ErrNilSigningHistory signals that a nil signing history has been provided
+var ErrNilSigningHistory = errors.New("nil signing history")
+
+// ErrNilNodeRedundancyHandler signals that a nil node redundancy handler has been provided
+var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
 // ErrNilPoolAdder signals that a nil pool adder has been provided
 var ErrNilPoolAdder = errors.New("nil pool adder")
 
@@ -201,3 +207,6 @@ var ErrInvalidPublicKeyBitmapSize = errors.New("invalid public key bitmap size")
 
 // ErrInvalidCacheSize signals an invalid size provided for cache
 var ErrInvalidCacheSize = errors.New("invalid cache size")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
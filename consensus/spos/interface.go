@@ -48,6 +48,11 @@ type ConsensusCoreHandler interface {
 	PrivateKey() crypto.PrivateKey
 	// SingleSigner returns the single signer stored in the ConsensusStore used for randomness and leader's signature generation
 	SingleSigner() crypto.SingleSigner
+	// SigningHistory returns the signing history stored in the ConsensusStore used to refuse conflicting signatures
+	SigningHistory() consensus.SigningHistoryHandler
+	// NodeRedundancyHandler returns the node redundancy handler stored in the ConsensusStore used to keep a backup machine
+	// out of the consensus while the main machine is active
+	NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -128,6 +133,18 @@ type PoolAdder interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector watches the validated consensus messages for validators signing conflicting messages
+type EquivocationDetector interface {
+	AddMessage(cnsMsg *consensus.Message)
+	IsInterfaceNil() bool
+}
+
+// RandSeedSigner is a single signer which creates the rand seed of a new header from the previous header, instead of
+// signing the previous rand seed as a raw message
+type RandSeedSigner interface {
+	SignRandSeed(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error)
+}
+
 // RandSeedVerifier encapsulates methods that are check if header rand seed is correct
 type RandSeedVerifier interface {
 	VerifyRandSeed(header data.HeaderHandler) error
@@ -66,8 +66,10 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler      consensus.P2PAntifloodHandler
+	poolAdder             PoolAdder
+	equivocationDetector  EquivocationDetector
+	nodeRedundancyHandler consensus.NodeRedundancyHandler
 
 	signatureSize       int
 	publicKeySize       int
@@ -97,6 +99,8 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	EquivocationDetector     EquivocationDetector
+	NodeRedundancyHandler    consensus.NodeRedundancyHandler
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -130,6 +134,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		equivocationDetector:     args.EquivocationDetector,
+		nodeRedundancyHandler:    args.NodeRedundancyHandler,
 		signatureSize:            args.SignatureSize,
 		publicKeySize:            args.PublicKeySize,
 	}
@@ -223,6 +229,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
+	if check.IfNil(args.NodeRedundancyHandler) {
+		return ErrNilNodeRedundancyHandler
+	}
 
 	return nil
 }
@@ -345,6 +357,8 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	go wrk.updateNetworkShardingVals(message, cnsMsg)
 
+	wrk.equivocationDetector.AddMessage(cnsMsg)
+
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
 	isMessageWithBlockBodyAndHeader := wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
@@ -364,6 +378,16 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		wrk.doJobOnMessageWithSignature(cnsMsg)
 	}
 
+	wrk.nodeRedundancyHandler.ResetInactivityIfNeeded(
+		wrk.consensusState.SelfPubKey(),
+		string(cnsMsg.PubKey),
+		message.Peer(),
+	)
+	if wrk.nodeRedundancyHandler.IsRedundancyNode() && wrk.nodeRedundancyHandler.IsMainMachineActive() {
+		// the backup machine steps back as soon as the main machine is active, without waiting for the next round
+		wrk.consensusState.SetSelfInStandby(true)
+	}
+
 	errNotCritical := wrk.checkSelfState(cnsMsg)
 	if errNotCritical != nil {
 		log.Trace("checkSelfState", "error", errNotCritical.Error())
@@ -464,7 +488,7 @@ func (wrk *Worker) updateNetworkShardingVals(message p2p.MessageP2P, cnsMsg *con
 }
 
 func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
-	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
+	if wrk.consensusState.IsNodeSelf(string(cnsDta.PubKey)) {
 		return ErrMessageFromItself
 	}
 
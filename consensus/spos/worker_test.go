@@ -3,6 +3,7 @@ package spos_test
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -92,6 +93,8 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		NetworkShardingCollector: createMockNetworkShardingCollector(),
 		AntifloodHandler:         createMockP2PAntifloodHandler(),
 		PoolAdder:                poolAdder,
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
+		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 	}
@@ -356,6 +359,28 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_NewWorkerNodeRedundancyHandlerNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.NodeRedundancyHandler = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +960,103 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageShouldPassValidMessagesToEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	detectedMessages := make([]*consensus.Message, 0)
+	mutDetectedMessages := sync.Mutex{}
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddMessageCalled: func(cnsMsg *consensus.Message) {
+			mutDetectedMessages.Lock()
+			detectedMessages = append(detectedMessages, cnsMsg)
+			mutDetectedMessages.Unlock()
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	invalidMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		invalidSignature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(invalidMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+	assert.NotNil(t, err)
+
+	validMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ = wrk.Marshalizer().Marshal(validMsg)
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	mutDetectedMessages.Lock()
+	defer mutDetectedMessages.Unlock()
+
+	if assert.Equal(t, 1, len(detectedMessages)) {
+		assert.Equal(t, validMsg.PubKey, detectedMessages[0].PubKey)
+		assert.Equal(t, validMsg.Signature, detectedMessages[0].Signature)
+	}
+}
+
+func TestWorker_ProcessReceivedMessageShouldPassTheMessageSenderToNodeRedundancyHandler(t *testing.T) {
+	t.Parallel()
+
+	senderPeerID := p2p.PeerID("sender peer")
+	resetCalled := false
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.NodeRedundancyHandler = &mock.NodeRedundancyHandlerStub{
+		ResetInactivityIfNeededCalled: func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID p2p.PeerID) {
+			assert.Equal(t, workerArgs.ConsensusState.SelfPubKey(), selfPubKey)
+			assert.Equal(t, workerArgs.ConsensusState.SelfPubKey(), consensusMsgPubKey)
+			assert.Equal(t, senderPeerID, consensusMsgPeerID)
+			resetCalled = true
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().SelfPubKey()),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: senderPeerID}, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.True(t, resetCalled)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -956,6 +1078,42 @@ func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	assert.Equal(t, spos.ErrMessageFromItself, err)
 }
 
+func TestWorker_ProcessReceivedMessageFromMainMachineShouldSetStandby(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.NodeRedundancyHandler = &mock.NodeRedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		IsMainMachineActiveCalled: func() bool {
+			return true
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		signature,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().SelfPubKey()),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: "main machine"}, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.True(t, wrk.ConsensusState().IsSelfInStandby())
+	assert.Nil(t, wrk.CheckSelfState(cnsMsg))
+}
+
 func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
 )
 
 // NumInitCharactersForScAddress numbers of characters for smart contract address identifier
@@ -17,6 +18,10 @@ const ShardIdentiferLen = 2
 const metaChainShardIdentifier uint8 = 255
 const numInitCharactersForOnMetachainSC = 15
 
+const esdtGlobalSettingsAddressLen = 32
+const esdtGlobalSettingsPrefixByte uint8 = 255
+const shardIDLen = 4
+
 // IsSmartContractAddress verifies if a set address is of type smart contract
 func IsSmartContractAddress(rcvAddress []byte) bool {
 	if len(rcvAddress) <= NumInitCharactersForScAddress {
@@ -72,3 +77,13 @@ func IsSmartContractOnMetachain(identifier []byte, rcvAddress []byte) bool {
 		make([]byte, numInitCharactersForOnMetachainSC))
 	return isOnMetaChainSCAddress
 }
+
+// ESDTGlobalSettingsAddress returns the address of the account which holds, inside the given shard, the global
+// settings of the elrond standard digital tokens (e.g. which tokens are paused). The shard ID is written in the last
+// bytes of the address, so that the address is always assigned to the given shard
+func ESDTGlobalSettingsAddress(shardID uint32) []byte {
+	address := bytes.Repeat([]byte{esdtGlobalSettingsPrefixByte}, esdtGlobalSettingsAddressLen)
+	binary.BigEndian.PutUint32(address[esdtGlobalSettingsAddressLen-shardIDLen:], shardID)
+
+	return address
+}
@@ -75,3 +75,17 @@ func TestIsSmartContractOnMetachain_ShouldReturnTrue(t *testing.T) {
 	scAddress, _ := hex.DecodeString("000000000000000000000000000000000000000000000000000000b51e0eb3a1")
 	assert.True(t, IsSmartContractOnMetachain(identifier, scAddress))
 }
+
+func TestESDTGlobalSettingsAddress(t *testing.T) {
+	t.Parallel()
+
+	address0 := ESDTGlobalSettingsAddress(0)
+	address1 := ESDTGlobalSettingsAddress(1)
+
+	assert.Equal(t, 32, len(address0))
+	assert.NotEqual(t, address0, address1)
+	assert.False(t, IsSmartContractAddress(address0))
+	assert.Equal(t, uint8(0), address0[len(address0)-1])
+	assert.Equal(t, uint8(1), address1[len(address1)-1])
+	assert.Equal(t, address1, ESDTGlobalSettingsAddress(1))
+}
@@ -62,6 +62,10 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// EquivocationEvidenceTopic is the topic used for broadcasting the proofs of validators signing conflicting
+// consensus messages
+const EquivocationEvidenceTopic = "equivocationEvidence"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -179,6 +183,15 @@ const MetricNumMiniBlocks = "erd_num_mini_blocks"
 // MetricConsensusRoundState is the metric for consensus round state for a block
 const MetricConsensusRoundState = "erd_consensus_round_state"
 
+// MetricRedundancyLevel is the metric for the redundancy level of the machine, 0 meaning the main machine of the validator key
+const MetricRedundancyLevel = "erd_redundancy_level"
+
+// MetricRedundancyIsMainActive is the metric that tells if the main machine of the validator key is considered active
+const MetricRedundancyIsMainActive = "erd_redundancy_is_main_active"
+
+// MetricRedundancyMissedRounds is the metric for the number of consecutive rounds missed by the main machine
+const MetricRedundancyMissedRounds = "erd_redundancy_missed_rounds"
+
 // MetricCrossCheckBlockHeight is the metric that store cross block height
 const MetricCrossCheckBlockHeight = "erd_cross_check_block_height"
 
@@ -248,6 +261,28 @@ const MetricLeaderPercentage = "erd_leader_percentage"
 //MetricDenominationCoefficient is the metric for denomination coefficient that is used in views
 const MetricDenominationCoefficient = "erd_denomination_coefficient"
 
+// MetricMinInflation is the metric for the minimum yearly inflation rate
+const MetricMinInflation = "erd_min_inflation"
+
+// MetricMaxInflation is the metric for the maximum yearly inflation rate
+const MetricMaxInflation = "erd_max_inflation"
+
+// MetricOptimalStakingRatio is the metric for the staking ratio for which the inflation rate is maximum
+const MetricOptimalStakingRatio = "erd_optimal_staking_ratio"
+
+// MetricInflationRate is the metric for the yearly inflation rate computed at the start of the current epoch
+const MetricInflationRate = "erd_inflation_rate"
+
+// InflationRateDenominator is the denominator of the yearly inflation rate held by the epoch start economics, which
+// is expressed in basis points
+const InflationRateDenominator = 10000
+
+// MetricTotalStaked is the metric for the value staked by the eligible and waiting nodes at the start of the current epoch
+const MetricTotalStaked = "erd_total_staked"
+
+// MetricNodePrice is the metric for the node price at the start of the current epoch
+const MetricNodePrice = "erd_node_price"
+
 // MetricRoundAtEpochStart is the metric for storing the first round of the current epoch
 const MetricRoundAtEpochStart = "erd_round_at_epoch_start"
 
@@ -330,9 +365,19 @@ const MetaChainSystemSCsCost = "MetaChainSystemSCsCost"
 type TransactionStatus string
 
 const (
-	// TxStatusReceived represents the status of a transaction which was received but not yet executed
-	TxStatusReceived TransactionStatus = "received"
-	// TxStatusExecuted represents the status of a transaction which was received and executed
+	// TxStatusPending represents the status of a transaction which was received but not yet executed
+	TxStatusPending TransactionStatus = "pending"
+	// TxStatusPartiallyExecuted represents the status of a cross shard transaction which was executed in the source
+	// shard but was not yet executed in the destination shard
+	TxStatusPartiallyExecuted TransactionStatus = "partially-executed"
+	// TxStatusSuccess represents the status of a transaction which was successfully executed on all involved shards
+	TxStatusSuccess TransactionStatus = "success"
+	// TxStatusFail represents the status of a transaction which was executed, but its execution failed
+	TxStatusFail TransactionStatus = "fail"
+	// TxStatusInvalid represents the status of a transaction which was included in an invalid transactions miniblock
+	TxStatusInvalid TransactionStatus = "invalid"
+	// TxStatusExecuted represents the status of a transaction which was found in storage, but for which no
+	// execution metadata is available
 	TxStatusExecuted TransactionStatus = "executed"
 	// TxStatusUnknown represents the status returned for a missing transaction
 	TxStatusUnknown TransactionStatus = "unknown"
@@ -384,6 +429,60 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed by the trie syncers
+const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_processed_nodes"
+
+// MetricTrieSyncNumReceivedBytes is the metric that outputs the number of bytes of the trie nodes received by the
+// trie syncers
+const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_received_bytes"
+
+// MetricTrieSyncNumPendingNodes is the metric that outputs the number of trie nodes the current trie synchronization
+// still has to process
+const MetricTrieSyncNumPendingNodes = "erd_trie_sync_num_pending_nodes"
+
+// MetricTrieSyncEstimatedTimeLeftInSec is the metric that outputs the estimated number of seconds needed to process
+// the pending nodes of the current trie synchronization
+const MetricTrieSyncEstimatedTimeLeftInSec = "erd_trie_sync_estimated_time_left_in_sec"
+
+// MetricChainStorageNumKeys is the metric that outputs the number of keys held by the chain storage persisters able
+// to report statistics
+const MetricChainStorageNumKeys = "erd_chain_storage_num_keys"
+
+// MetricChainStorageLiveDataSize is the metric that outputs the number of live bytes held by the chain storage
+// persisters able to report statistics
+const MetricChainStorageLiveDataSize = "erd_chain_storage_live_data_size"
+
+// MetricChainStorageDiskSize is the metric that outputs the disk size of the chain storage persisters able to report
+// statistics
+const MetricChainStorageDiskSize = "erd_chain_storage_disk_size"
+
+// MetricChainStorageNumFiles is the metric that outputs the number of files of the chain storage persisters able to
+// report statistics
+const MetricChainStorageNumFiles = "erd_chain_storage_num_files"
+
+// MetricChainStorageNumCompactions is the metric that outputs the number of compactions done by the chain storage
+// persisters able to report statistics
+const MetricChainStorageNumCompactions = "erd_chain_storage_num_compactions"
+
+// MetricTrieStorageNumKeys is the metric that outputs the number of keys held by the tries persisters able to report
+// statistics
+const MetricTrieStorageNumKeys = "erd_trie_storage_num_keys"
+
+// MetricTrieStorageLiveDataSize is the metric that outputs the number of live bytes held by the tries persisters able
+// to report statistics
+const MetricTrieStorageLiveDataSize = "erd_trie_storage_live_data_size"
+
+// MetricTrieStorageDiskSize is the metric that outputs the disk size of the tries persisters able to report statistics
+const MetricTrieStorageDiskSize = "erd_trie_storage_disk_size"
+
+// MetricTrieStorageNumFiles is the metric that outputs the number of files of the tries persisters able to report
+// statistics
+const MetricTrieStorageNumFiles = "erd_trie_storage_num_files"
+
+// MetricTrieStorageNumCompactions is the metric that outputs the number of compactions done by the tries persisters
+// able to report statistics
+const MetricTrieStorageNumCompactions = "erd_trie_storage_num_compactions"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -411,6 +510,27 @@ const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
 // BuiltInFunctionESDTTransfer is the key for the elrond standard digital token transfer built-in function
 const BuiltInFunctionESDTTransfer = "ESDTTransfer"
 
+// BuiltInFunctionESDTMultiTransfer is the key for the multiple elrond standard digital tokens transfer built-in function
+const BuiltInFunctionESDTMultiTransfer = "ESDTMultiTransfer"
+
+// BuiltInFunctionESDTBurn is the key for the elrond standard digital token burn built-in function
+const BuiltInFunctionESDTBurn = "ESDTBurn"
+
+// BuiltInFunctionESDTFreeze is the key for the elrond standard digital token freeze built-in function
+const BuiltInFunctionESDTFreeze = "ESDTFreeze"
+
+// BuiltInFunctionESDTUnFreeze is the key for the elrond standard digital token unfreeze built-in function
+const BuiltInFunctionESDTUnFreeze = "ESDTUnFreeze"
+
+// BuiltInFunctionESDTWipe is the key for the elrond standard digital token wipe built-in function
+const BuiltInFunctionESDTWipe = "ESDTWipe"
+
+// BuiltInFunctionESDTPause is the key for the elrond standard digital token pause built-in function
+const BuiltInFunctionESDTPause = "ESDTPause"
+
+// BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
+const BuiltInFunctionESDTUnPause = "ESDTUnPause"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -426,6 +546,10 @@ const SleepTimeBetweenCreateDBRetries = 5 * time.Second
 // ElrondProtectedKeyPrefix is the key prefix which is protected from writing in the trie - only for special builtin functions
 const ElrondProtectedKeyPrefix = "ELROND"
 
+// ESDTKeyIdentifier is the key identifier, placed after the protected key prefix, of the elrond standard digital tokens
+// balances saved in the accounts' data tries
+const ESDTKeyIdentifier = "esdt"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -439,3 +563,7 @@ const ExtraDelayForRequestBlockInfo = 4 * time.Second
 
 // CommitMaxTime represents max time accepted for a put/commit action, after which a warn message is displayed
 const CommitMaxTime = time.Second
+
+// RelayedTransaction is the key for the relayed transaction, a transaction that carries a signed user transaction
+// whose gas is paid by the relayer
+const RelayedTransaction = "relayedTx"
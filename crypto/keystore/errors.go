@@ -0,0 +1,27 @@
+package keystore
+
+import "errors"
+
+// ErrEmptyPassword signals that an empty password has been provided
+var ErrEmptyPassword = errors.New("empty keystore password")
+
+// ErrEmptySecretKey signals that an empty secret key has been provided
+var ErrEmptySecretKey = errors.New("empty secret key")
+
+// ErrUnsupportedKeystoreVersion signals that the keystore file has an unsupported version
+var ErrUnsupportedKeystoreVersion = errors.New("unsupported keystore version")
+
+// ErrUnsupportedKdf signals that the keystore file uses an unsupported key derivation function
+var ErrUnsupportedKdf = errors.New("unsupported key derivation function")
+
+// ErrUnsupportedCipher signals that the keystore file uses an unsupported cipher
+var ErrUnsupportedCipher = errors.New("unsupported cipher")
+
+// ErrInvalidKdfParams signals that the key derivation function parameters are invalid
+var ErrInvalidKdfParams = errors.New("invalid key derivation function parameters")
+
+// ErrDecryptionFailed signals that the keystore could not be decrypted, usually because of a wrong password
+var ErrDecryptionFailed = errors.New("could not decrypt the keystore, check the password")
+
+// ErrNoPasswordProvided signals that neither a password file nor the password environment variable was provided
+var ErrNoPasswordProvided = errors.New("no keystore password provided")
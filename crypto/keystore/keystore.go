@@ -0,0 +1,283 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeyFileVersion is the current version of the keystore file format
+const KeyFileVersion = 1
+
+// CipherAES256GCM is the only cipher used to encrypt the secret keys
+const CipherAES256GCM = "aes-256-gcm"
+
+// KdfScrypt is the identifier of the scrypt key derivation function
+const KdfScrypt = "scrypt"
+
+// KdfArgon2id is the identifier of the argon2id key derivation function
+const KdfArgon2id = "argon2id"
+
+const saltLength = 32
+const derivedKeyLength = 32
+const keyFilePermissions = 0600
+
+// KdfParams holds the parameters of the key derivation function. Only the fields relevant for the
+// chosen function are filled
+type KdfParams struct {
+	Salt    string `json:"salt"`
+	DkLen   int    `json:"dklen"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+// CryptoData holds the encrypted secret key together with all the data needed to decrypt it
+type CryptoData struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Kdf          string       `json:"kdf"`
+	KdfParams    KdfParams    `json:"kdfparams"`
+}
+
+// KeyFile is the JSON representation of a password protected secret key
+type KeyFile struct {
+	Version   int        `json:"version"`
+	ID        string     `json:"id"`
+	PublicKey string     `json:"publicKey"`
+	Crypto    CryptoData `json:"crypto"`
+}
+
+// NewScryptParams returns the recommended scrypt parameters
+func NewScryptParams() KdfParams {
+	return KdfParams{
+		DkLen: derivedKeyLength,
+		N:     1 << 18,
+		R:     8,
+		P:     1,
+	}
+}
+
+// NewArgon2idParams returns the recommended argon2id parameters
+func NewArgon2idParams() KdfParams {
+	return KdfParams{
+		DkLen:   derivedKeyLength,
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+}
+
+// EncryptKey encrypts the provided secret key bytes with a key derived from the password. The public key, already
+// encoded, is stored in clear and is also authenticated by the cipher so that it can not be swapped
+func EncryptKey(skBytes []byte, publicKey string, password []byte, kdf string, params KdfParams) (*KeyFile, error) {
+	if len(skBytes) == 0 {
+		return nil, ErrEmptySecretKey
+	}
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	salt := make([]byte, saltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	params.Salt = hex.EncodeToString(salt)
+
+	derivedKey, err := deriveKey(password, kdf, params)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := aead.Seal(nil, nonce, skBytes, []byte(publicKey))
+
+	return &KeyFile{
+		Version:   KeyFileVersion,
+		ID:        id,
+		PublicKey: publicKey,
+		Crypto: CryptoData{
+			Cipher:     CipherAES256GCM,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: CipherParams{
+				Nonce: hex.EncodeToString(nonce),
+			},
+			Kdf:       kdf,
+			KdfParams: params,
+		},
+	}, nil
+}
+
+// Decrypt returns the secret key bytes stored in the key file
+func (kf *KeyFile) Decrypt(password []byte) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+	if kf.Version != KeyFileVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedKeystoreVersion, kf.Version)
+	}
+	if kf.Crypto.Cipher != CipherAES256GCM {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCipher, kf.Crypto.Cipher)
+	}
+
+	cipherText, err := hex.DecodeString(kf.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(kf.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(password, kf.Crypto.Kdf, kf.Crypto.KdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrDecryptionFailed
+	}
+
+	skBytes, err := aead.Open(nil, nonce, cipherText, []byte(kf.PublicKey))
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return skBytes, nil
+}
+
+// SaveKeyFile writes the key file as JSON at the provided path, readable only by the owner
+func SaveKeyFile(path string, keyFile *KeyFile) error {
+	buff, err := json.MarshalIndent(keyFile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buff, keyFilePermissions)
+}
+
+// LoadKeyFile reads a JSON key file from the provided path
+func LoadKeyFile(path string) (*KeyFile, error) {
+	keyFile := &KeyFile{}
+	err := core.LoadJsonFile(keyFile, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyFile, nil
+}
+
+// ReadPassword returns the keystore password read from the provided file or, if no file is given, from the
+// provided environment variable. Trailing new lines are removed
+func ReadPassword(passwordFile string, envVariable string) ([]byte, error) {
+	if len(passwordFile) > 0 {
+		buff, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return checkPassword(strings.TrimRight(string(buff), "\r\n"))
+	}
+
+	password, ok := os.LookupEnv(envVariable)
+	if !ok || len(envVariable) == 0 {
+		return nil, ErrNoPasswordProvided
+	}
+
+	return checkPassword(password)
+}
+
+func checkPassword(password string) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	return []byte(password), nil
+}
+
+func deriveKey(password []byte, kdf string, params KdfParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(salt) == 0 || params.DkLen != derivedKeyLength {
+		return nil, ErrInvalidKdfParams
+	}
+
+	switch kdf {
+	case KdfScrypt:
+		if params.N <= 1 || params.R <= 0 || params.P <= 0 {
+			return nil, ErrInvalidKdfParams
+		}
+		return scrypt.Key(password, salt, params.N, params.R, params.P, params.DkLen)
+	case KdfArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, ErrInvalidKdfParams
+		}
+		return argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, uint32(params.DkLen)), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKdf, kdf)
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func newRandomID() (string, error) {
+	buff := make([]byte, 16)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", err
+	}
+
+	// RFC 4122 version 4 identifier
+	buff[6] = (buff[6] & 0x0f) | 0x40
+	buff[8] = (buff[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buff[0:4], buff[4:6], buff[6:8], buff[8:10], buff[10:]), nil
+}
@@ -0,0 +1,218 @@
+package keystore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSkBytes = []byte("0123456789abcdef0123456789abcdef")
+
+const testPublicKey = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createLightScryptParams() KdfParams {
+	return KdfParams{
+		DkLen: derivedKeyLength,
+		N:     1 << 4,
+		R:     8,
+		P:     1,
+	}
+}
+
+func createLightArgon2idParams() KdfParams {
+	return KdfParams{
+		DkLen:   derivedKeyLength,
+		Time:    1,
+		Memory:  64,
+		Threads: 1,
+	}
+}
+
+func TestEncryptKey_EmptySecretKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(nil, testPublicKey, []byte("pass"), KdfScrypt, createLightScryptParams())
+
+	assert.Nil(t, keyFile)
+	assert.Equal(t, ErrEmptySecretKey, err)
+}
+
+func TestEncryptKey_EmptyPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, nil, KdfScrypt, createLightScryptParams())
+
+	assert.Nil(t, keyFile)
+	assert.Equal(t, ErrEmptyPassword, err)
+}
+
+func TestEncryptKey_UnsupportedKdfShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, []byte("pass"), "pbkdf2", createLightScryptParams())
+
+	assert.Nil(t, keyFile)
+	assert.True(t, errors.Is(err, ErrUnsupportedKdf))
+}
+
+func TestEncryptKey_InvalidKdfParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, []byte("pass"), KdfArgon2id, createLightScryptParams())
+
+	assert.Nil(t, keyFile)
+	assert.Equal(t, ErrInvalidKdfParams, err)
+}
+
+func TestEncryptKeyDecrypt_ScryptShouldWork(t *testing.T) {
+	t.Parallel()
+
+	password := []byte("password")
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, password, KdfScrypt, createLightScryptParams())
+	require.Nil(t, err)
+
+	assert.Equal(t, KeyFileVersion, keyFile.Version)
+	assert.Equal(t, testPublicKey, keyFile.PublicKey)
+	assert.Equal(t, KdfScrypt, keyFile.Crypto.Kdf)
+	assert.Equal(t, 36, len(keyFile.ID))
+
+	skBytes, err := keyFile.Decrypt(password)
+	assert.Nil(t, err)
+	assert.Equal(t, testSkBytes, skBytes)
+}
+
+func TestEncryptKeyDecrypt_Argon2idShouldWork(t *testing.T) {
+	t.Parallel()
+
+	password := []byte("password")
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, password, KdfArgon2id, createLightArgon2idParams())
+	require.Nil(t, err)
+
+	skBytes, err := keyFile.Decrypt(password)
+	assert.Nil(t, err)
+	assert.Equal(t, testSkBytes, skBytes)
+}
+
+func TestKeyFile_DecryptWrongPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, []byte("password"), KdfScrypt, createLightScryptParams())
+	require.Nil(t, err)
+
+	skBytes, err := keyFile.Decrypt([]byte("wrong password"))
+	assert.Nil(t, skBytes)
+	assert.Equal(t, ErrDecryptionFailed, err)
+}
+
+func TestKeyFile_DecryptTamperedPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	password := []byte("password")
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, password, KdfScrypt, createLightScryptParams())
+	require.Nil(t, err)
+
+	keyFile.PublicKey = "erd1another"
+	skBytes, err := keyFile.Decrypt(password)
+	assert.Nil(t, skBytes)
+	assert.Equal(t, ErrDecryptionFailed, err)
+}
+
+func TestKeyFile_DecryptUnsupportedVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	password := []byte("password")
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, password, KdfScrypt, createLightScryptParams())
+	require.Nil(t, err)
+
+	keyFile.Version = KeyFileVersion + 1
+	skBytes, err := keyFile.Decrypt(password)
+	assert.Nil(t, skBytes)
+	assert.True(t, errors.Is(err, ErrUnsupportedKeystoreVersion))
+}
+
+func TestSaveLoadKeyFile_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	password := []byte("password")
+	keyFile, err := EncryptKey(testSkBytes, testPublicKey, password, KdfScrypt, createLightScryptParams())
+	require.Nil(t, err)
+
+	path := filepath.Join(dir, "key.json")
+	err = SaveKeyFile(path, keyFile)
+	require.Nil(t, err)
+
+	loadedKeyFile, err := LoadKeyFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, keyFile, loadedKeyFile)
+
+	skBytes, err := loadedKeyFile.Decrypt(password)
+	assert.Nil(t, err)
+	assert.Equal(t, testSkBytes, skBytes)
+}
+
+func TestReadPassword_FromFileShouldTrimNewLines(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path := filepath.Join(dir, "password.txt")
+	err = ioutil.WriteFile(path, []byte("secret pass\n"), 0600)
+	require.Nil(t, err)
+
+	password, err := ReadPassword(path, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret pass"), password)
+}
+
+func TestReadPassword_EmptyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path := filepath.Join(dir, "password.txt")
+	err = ioutil.WriteFile(path, []byte("\n"), 0600)
+	require.Nil(t, err)
+
+	password, err := ReadPassword(path, "")
+	assert.Nil(t, password)
+	assert.Equal(t, ErrEmptyPassword, err)
+}
+
+func TestReadPassword_FromEnvironmentShouldWork(t *testing.T) {
+	envVariable := "ELROND_KEYSTORE_TEST_PASSWORD"
+	_ = os.Setenv(envVariable, "env pass")
+	defer func() {
+		_ = os.Unsetenv(envVariable)
+	}()
+
+	password, err := ReadPassword("", envVariable)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("env pass"), password)
+}
+
+func TestReadPassword_NothingProvidedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	password, err := ReadPassword("", "ELROND_KEYSTORE_MISSING_VARIABLE")
+	assert.Nil(t, password)
+	assert.Equal(t, ErrNoPasswordProvided, err)
+}
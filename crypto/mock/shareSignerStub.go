@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// ShareSignerStub -
+type ShareSignerStub struct {
+	SignShareCalled func(privKey crypto.PrivateKey, message []byte) ([]byte, error)
+}
+
+// SignShare -
+func (sss *ShareSignerStub) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	if sss.SignShareCalled != nil {
+		return sss.SignShareCalled(privKey, message)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (sss *ShareSignerStub) IsInterfaceNil() bool {
+	return sss == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+// SigningHistoryStub -
+type SigningHistoryStub struct {
+	CheckAndRecordLeaderSignatureCalled func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureShareCalled  func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+}
+
+// CheckAndRecordLeaderSignature -
+func (shs *SigningHistoryStub) CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	if shs.CheckAndRecordLeaderSignatureCalled != nil {
+		return shs.CheckAndRecordLeaderSignatureCalled(epoch, shardID, round, headerHash)
+	}
+
+	return nil
+}
+
+// CheckAndRecordSignatureShare -
+func (shs *SigningHistoryStub) CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	if shs.CheckAndRecordSignatureShareCalled != nil {
+		return shs.CheckAndRecordSignatureShareCalled(epoch, shardID, round, headerHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (shs *SigningHistoryStub) IsInterfaceNil() bool {
+	return shs == nil
+}
@@ -0,0 +1,42 @@
+package remote
+
+const (
+	// LeaderSignatureType is the signature type of the header signature produced by the consensus leader
+	LeaderSignatureType = "leaderSignature"
+	// SignatureShareType is the signature type of the header hash signature share produced by a consensus member
+	SignatureShareType = "signatureShare"
+
+	// HeaderPayloadType is the payload type of a header signed by the consensus leader
+	HeaderPayloadType = "header"
+	// ConsensusMessagePayloadType is the payload type of a consensus message sent by the validator
+	ConsensusMessagePayloadType = "consensusMessage"
+	// RandSeedPayloadType is the payload type of the previous header, whose rand seed is signed by the consensus leader
+	// in order to create the rand seed of the header proposed in the request round
+	RandSeedPayloadType = "randSeed"
+	// HeartbeatPayloadType is the payload type of a heartbeat message sent by the validator
+	HeartbeatPayloadType = "heartbeat"
+
+	serviceName          = "RemoteSigner"
+	publicKeyMethod      = serviceName + ".PublicKey"
+	checkAndRecordMethod = serviceName + ".CheckAndRecord"
+	signMethod           = serviceName + ".Sign"
+	signShareMethod      = serviceName + ".SignShare"
+)
+
+// Request is the DTO sent by the node to the remote signer. The type is the signature type of a signing history check
+// or the payload type of a signing request, the remote signer deriving everything else it checks from the payload
+type Request struct {
+	PublicKey  []byte `json:"publicKey"`
+	Message    []byte `json:"message,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Epoch      uint32 `json:"epoch,omitempty"`
+	ShardID    uint32 `json:"shardId,omitempty"`
+	Round      uint64 `json:"round,omitempty"`
+	HeaderHash []byte `json:"headerHash,omitempty"`
+}
+
+// Response is the DTO sent by the remote signer as a reply to a node request
+type Response struct {
+	PublicKey []byte `json:"publicKey,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+}
@@ -0,0 +1,84 @@
+package remote
+
+import "errors"
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilLowLevelSigner signals that a nil low level BLS signer has been provided
+var ErrNilLowLevelSigner = errors.New("nil low level signer")
+
+// ErrNilShareSigner signals that a nil signature share signer has been provided
+var ErrNilShareSigner = errors.New("nil share signer")
+
+// ErrNilSigningHistory signals that a nil signing history has been provided
+var ErrNilSigningHistory = errors.New("nil signing history")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilConsensusMessageClassifier signals that a nil consensus message classifier has been provided
+var ErrNilConsensusMessageClassifier = errors.New("nil consensus message classifier")
+
+// ErrNilTLSConfig signals that a nil TLS configuration has been provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrNilListener signals that a nil listener has been provided
+var ErrNilListener = errors.New("nil listener")
+
+// ErrEmptyAddress signals that an empty remote signer address has been provided
+var ErrEmptyAddress = errors.New("empty remote signer address")
+
+// ErrInvalidNetwork signals that the remote signer network is neither unix nor tcp
+var ErrInvalidNetwork = errors.New("invalid remote signer network")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrSigningRefused signals that the remote signer refused the request
+var ErrSigningRefused = errors.New("remote signer refused the request")
+
+// ErrRequestTimeout signals that the remote signer did not respond in time
+var ErrRequestTimeout = errors.New("remote signer request timeout")
+
+// ErrPublicKeyMismatch signals that the signing request was made for a key not held by the remote signer
+var ErrPublicKeyMismatch = errors.New("public key mismatch")
+
+// ErrPrivateKeyNotAvailable signals that the private key is kept by the remote signer and can not be exported
+var ErrPrivateKeyNotAvailable = errors.New("private key is kept by the remote signer")
+
+// ErrInvalidSignatureType signals that an invalid signature type was requested
+var ErrInvalidSignatureType = errors.New("invalid signature type")
+
+// ErrInvalidPayloadType signals that a signature was requested for an unknown payload type
+var ErrInvalidPayloadType = errors.New("invalid payload type")
+
+// ErrInvalidPayload signals that the payload to be signed does not match its payload type
+var ErrInvalidPayload = errors.New("invalid payload")
+
+// ErrNonCanonicalPayload signals that the payload to be signed is not the exact encoding of its content
+var ErrNonCanonicalPayload = errors.New("non canonical payload")
+
+// ErrAmbiguousPayload signals that the payload to be signed can also be read as a consensus message of the held key
+var ErrAmbiguousPayload = errors.New("payload can also be read as a consensus message")
+
+// ErrUnapprovedConsensusMessage signals that a consensus message was requested to be signed for a round and header
+// hash not approved by the signing history of the remote signer
+var ErrUnapprovedConsensusMessage = errors.New("consensus message requested for an unapproved header hash")
+
+// ErrUnapprovedSignatureShare signals that the signature share was requested for a header hash not approved by the
+// signing history of the remote signer
+var ErrUnapprovedSignatureShare = errors.New("signature share requested for an unapproved header hash")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrInvalidRandSeed signals that the rand seed of the previous header can not be signed, as it is neither a BLS
+// signature nor one of the trusted genesis rand seeds
+var ErrInvalidRandSeed = errors.New("invalid rand seed")
+
+// ErrInvalidCACertificate signals that the CA certificate file does not hold any PEM encoded certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
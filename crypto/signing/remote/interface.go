@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// SigningHistoryHandler keeps the record of the header hashes signed by the validator key in each round and refuses
+// to sign a header hash conflicting with the one already signed in the same round
+type SigningHistoryHandler interface {
+	CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	IsInterfaceNil() bool
+}
+
+// ShareSigner is able to create a BLS signature share with a validator key
+type ShareSigner interface {
+	SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ConsensusMessageClassifier is able to tell what each consensus message type carries
+type ConsensusMessageClassifier interface {
+	IsMessageWithBlockBodyAndHeader(consensus.MessageType) bool
+	IsMessageWithBlockBody(consensus.MessageType) bool
+	IsMessageWithBlockHeader(consensus.MessageType) bool
+	IsMessageWithSignature(consensus.MessageType) bool
+	IsMessageWithFinalInfo(consensus.MessageType) bool
+	IsInterfaceNil() bool
+}
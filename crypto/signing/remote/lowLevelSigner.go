@@ -0,0 +1,40 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.LowLevelSignerBLS = (*lowLevelSigner)(nil)
+
+// lowLevelSigner is the BLS low level signer used by the multi signer of a node whose validator key is held by a
+// remote signer. The signature shares are created remotely, while the verification and aggregation are done locally
+type lowLevelSigner struct {
+	crypto.LowLevelSignerBLS
+	shareSigner ShareSigner
+}
+
+// NewLowLevelSigner creates a BLS low level signer creating the signature shares with the provided share signer
+func NewLowLevelSigner(shareSigner ShareSigner, localSigner crypto.LowLevelSignerBLS) (*lowLevelSigner, error) {
+	if check.IfNil(shareSigner) {
+		return nil, ErrNilShareSigner
+	}
+	if localSigner == nil {
+		return nil, ErrNilLowLevelSigner
+	}
+
+	return &lowLevelSigner{
+		LowLevelSignerBLS: localSigner,
+		shareSigner:       shareSigner,
+	}, nil
+}
+
+// SignShare requests a BLS signature share over the message from the share signer
+func (lls *lowLevelSigner) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	return lls.shareSigner.SignShare(privKey, message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lls *lowLevelSigner) IsInterfaceNil() bool {
+	return lls == nil
+}
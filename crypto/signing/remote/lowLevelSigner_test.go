@@ -0,0 +1,51 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLowLevelSigner_NilShareSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lls, err := remote.NewLowLevelSigner(nil, &mclmultisig.BlsMultiSigner{})
+
+	assert.True(t, check.IfNil(lls))
+	assert.Equal(t, remote.ErrNilShareSigner, err)
+}
+
+func TestNewLowLevelSigner_NilLocalSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lls, err := remote.NewLowLevelSigner(&mock.ShareSignerStub{}, nil)
+
+	assert.True(t, check.IfNil(lls))
+	assert.Equal(t, remote.ErrNilLowLevelSigner, err)
+}
+
+func TestLowLevelSigner_SignShareShouldUseTheShareSigner(t *testing.T) {
+	t.Parallel()
+
+	expectedSignature := []byte("signature")
+	lls, err := remote.NewLowLevelSigner(
+		&mock.ShareSignerStub{
+			SignShareCalled: func(_ crypto.PrivateKey, message []byte) ([]byte, error) {
+				assert.Equal(t, []byte("message"), message)
+				return expectedSignature, nil
+			},
+		},
+		&mclmultisig.BlsMultiSigner{},
+	)
+	assert.Nil(t, err)
+
+	signature, err := lls.SignShare(nil, []byte("message"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSignature, signature)
+}
@@ -0,0 +1,95 @@
+package remote
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// a rand seed, other than the genesis one, is the BLS signature of the previous rand seed, so it can not be mistaken for
+// a 32 bytes header hash nor for any other signed payload, which are all longer
+const randSeedLength = 48
+
+// unmarshalCanonical unmarshals the buffer and checks that it is the exact encoding of the resulting object, so the
+// signed bytes can not carry anything that was not checked
+func unmarshalCanonical(marshalizer marshal.Marshalizer, obj interface{}, buff []byte) error {
+	err := marshalizer.Unmarshal(obj, buff)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(encoded, buff) {
+		return ErrNonCanonicalPayload
+	}
+
+	return nil
+}
+
+// unmarshalHeader unmarshals a shard header or a metachain header. The two encodings can not be mistaken for one
+// another, as the fields present in any header have different wire types at the same positions
+func unmarshalHeader(marshalizer marshal.Marshalizer, buff []byte) (data.HeaderHandler, error) {
+	shardHeader := &block.Header{}
+	err := unmarshalCanonical(marshalizer, shardHeader, buff)
+	if err == nil {
+		return shardHeader, nil
+	}
+
+	metaHeader := &block.MetaBlock{}
+	err = unmarshalCanonical(marshalizer, metaHeader, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaHeader, nil
+}
+
+func unmarshalConsensusMessage(marshalizer marshal.Marshalizer, buff []byte, publicKey []byte) (*consensus.Message, error) {
+	cnsMsg := &consensus.Message{}
+	err := unmarshalCanonical(marshalizer, cnsMsg, buff)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(cnsMsg.PubKey, publicKey) {
+		return nil, ErrPublicKeyMismatch
+	}
+
+	return cnsMsg, nil
+}
+
+func unmarshalHeartbeat(marshalizer marshal.Marshalizer, buff []byte, publicKey []byte) (*heartbeatData.Heartbeat, error) {
+	hb := &heartbeatData.Heartbeat{}
+	err := unmarshalCanonical(marshalizer, hb, buff)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hb.Pubkey, publicKey) {
+		return nil, ErrPublicKeyMismatch
+	}
+
+	return hb, nil
+}
+
+// getPayloadType returns the type of the payload to be signed with the given public key. A consensus message is
+// recognized first, as it is the only payload that can prove an equivocation. A rand seed is never signed as a raw
+// message, so it is not recognized here
+func getPayloadType(marshalizer marshal.Marshalizer, buff []byte, publicKey []byte) string {
+	_, err := unmarshalConsensusMessage(marshalizer, buff, publicKey)
+	if err == nil {
+		return ConsensusMessagePayloadType
+	}
+
+	_, err = unmarshalHeartbeat(marshalizer, buff, publicKey)
+	if err == nil {
+		return HeartbeatPayloadType
+	}
+
+	return HeaderPayloadType
+}
@@ -0,0 +1,38 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.PrivateKey = (*privateKey)(nil)
+
+// privateKey is the node side handle of the validator key kept by the remote signer. It only knows the public key,
+// used to address the signing requests, and can not be exported or used for local signing
+type privateKey struct {
+	publicKey crypto.PublicKey
+}
+
+// ToByteArray returns ErrPrivateKeyNotAvailable as the private key never leaves the remote signer
+func (pk *privateKey) ToByteArray() ([]byte, error) {
+	return nil, ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key of the validator key kept by the remote signer
+func (pk *privateKey) GeneratePublic() crypto.PublicKey {
+	return pk.publicKey
+}
+
+// Suite returns the suite of the validator key
+func (pk *privateKey) Suite() crypto.Suite {
+	return pk.publicKey.Suite()
+}
+
+// Scalar returns nil as the private key never leaves the remote signer
+func (pk *privateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pk *privateKey) IsInterfaceNil() bool {
+	return pk == nil
+}
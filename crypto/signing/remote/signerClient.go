@@ -0,0 +1,301 @@
+package remote
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const (
+	// UnixNetwork is the network used to reach a remote signer listening on a unix domain socket
+	UnixNetwork = "unix"
+	// TCPNetwork is the network used to reach a remote signer listening on a TCP address
+	TCPNetwork = "tcp"
+)
+
+var _ crypto.SingleSigner = (*signerClient)(nil)
+var _ ShareSigner = (*signerClient)(nil)
+var _ SigningHistoryHandler = (*signerClient)(nil)
+
+// ArgsSignerClient is the argument DTO used to create a new remote signer client
+type ArgsSignerClient struct {
+	Network        string
+	Address        string
+	TLSConfig      *tls.Config
+	KeyGenerator   crypto.KeyGenerator
+	SingleSigner   crypto.SingleSigner
+	Marshalizer    marshal.Marshalizer
+	RequestTimeout time.Duration
+}
+
+// signerClient is the node side of the remote signer. It is a single signer, a signature share signer and a signing
+// history handler, each call being forwarded to the remote signer process holding the validator key. The node uses the
+// same single signer for every payload signed with the validator key, so each signing request is typed after the
+// signed payload, which the remote signer checks before signing. The signatures are verified locally, with the
+// provided single signer. A broken connection is dialed again on the next request
+type signerClient struct {
+	network        string
+	address        string
+	tlsConfig      *tls.Config
+	singleSigner   crypto.SingleSigner
+	marshalizer    marshal.Marshalizer
+	requestTimeout time.Duration
+	mutClient      sync.Mutex
+	rpcClient      *rpc.Client
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+}
+
+// NewSignerClient creates a new remote signer client, connecting to the remote signer in order to fetch the public
+// key of the validator key it holds
+func NewSignerClient(args ArgsSignerClient) (*signerClient, error) {
+	if args.Network != UnixNetwork && args.Network != TCPNetwork {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidNetwork, args.Network)
+	}
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	sc := &signerClient{
+		network:        args.Network,
+		address:        args.Address,
+		tlsConfig:      args.TLSConfig,
+		singleSigner:   args.SingleSigner,
+		marshalizer:    args.Marshalizer,
+		requestTimeout: args.RequestTimeout,
+	}
+
+	response, err := sc.call(publicKeyMethod, &Request{})
+	if err != nil {
+		return nil, err
+	}
+
+	sc.publicKey, err = args.KeyGenerator.PublicKeyFromByteArray(response.PublicKey)
+	if err != nil {
+		_ = sc.Close()
+		return nil, err
+	}
+	sc.publicKeyBytes = response.PublicKey
+
+	return sc, nil
+}
+
+// PublicKey returns the public key of the validator key held by the remote signer
+func (sc *signerClient) PublicKey() crypto.PublicKey {
+	return sc.publicKey
+}
+
+// PrivateKey returns the handle of the validator key held by the remote signer, to be used wherever the node
+// expects the validator private key
+func (sc *signerClient) PrivateKey() crypto.PrivateKey {
+	return &privateKey{
+		publicKey: sc.publicKey,
+	}
+}
+
+// Sign requests a single signature over the message from the remote signer, typing the request after the message
+func (sc *signerClient) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	return sc.sign(signMethod, private, msg)
+}
+
+// Verify verifies a single signature locally
+func (sc *signerClient) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	return sc.singleSigner.Verify(public, msg, sig)
+}
+
+// SignRandSeed requests from the remote signer the rand seed of the header proposed in the provided round, which is
+// the signature over the rand seed of the previous header. The previous header is sent, so the remote signer derives
+// the signed message itself
+func (sc *signerClient) SignRandSeed(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error) {
+	if check.IfNil(private) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if check.IfNil(prevHeader) {
+		return nil, ErrNilHeader
+	}
+
+	publicKeyBytes, err := getPublicKeyBytes(private)
+	if err != nil {
+		return nil, err
+	}
+	prevHeaderBytes, err := sc.marshalizer.Marshal(prevHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := sc.call(signMethod, &Request{
+		PublicKey: publicKeyBytes,
+		Message:   prevHeaderBytes,
+		Type:      RandSeedPayloadType,
+		Round:     round,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+// SignShare requests a BLS signature share over the message from the remote signer
+func (sc *signerClient) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	return sc.sign(signShareMethod, privKey, message)
+}
+
+func (sc *signerClient) sign(method string, private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	if check.IfNil(private) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if len(msg) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	publicKeyBytes, err := getPublicKeyBytes(private)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &Request{
+		PublicKey: publicKeyBytes,
+		Message:   msg,
+	}
+	if method == signMethod {
+		request.Type = getPayloadType(sc.marshalizer, msg, publicKeyBytes)
+	}
+
+	response, err := sc.call(method, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+func getPublicKeyBytes(private crypto.PrivateKey) ([]byte, error) {
+	publicKey := private.GeneratePublic()
+	if check.IfNil(publicKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+
+	return publicKey.ToByteArray()
+}
+
+// CheckAndRecordLeaderSignature asks the remote signer to check the header hash against its signing history
+func (sc *signerClient) CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	return sc.checkAndRecord(LeaderSignatureType, epoch, shardID, round, headerHash)
+}
+
+// CheckAndRecordSignatureShare asks the remote signer to check the header hash against its signing history and to
+// approve it for the following signature share
+func (sc *signerClient) CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	return sc.checkAndRecord(SignatureShareType, epoch, shardID, round, headerHash)
+}
+
+func (sc *signerClient) checkAndRecord(signatureType string, epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	_, err := sc.call(checkAndRecordMethod, &Request{
+		PublicKey:  sc.publicKeyBytes,
+		Type:       signatureType,
+		Epoch:      epoch,
+		ShardID:    shardID,
+		Round:      round,
+		HeaderHash: headerHash,
+	})
+
+	return err
+}
+
+func (sc *signerClient) call(method string, request *Request) (*Response, error) {
+	sc.mutClient.Lock()
+	defer sc.mutClient.Unlock()
+
+	if sc.rpcClient == nil {
+		err := sc.connect()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &Response{}
+	rpcCall := sc.rpcClient.Go(method, request, response, make(chan *rpc.Call, 1))
+
+	select {
+	case <-rpcCall.Done:
+	case <-time.After(sc.requestTimeout):
+		sc.disconnect()
+		return nil, fmt.Errorf("%w for %s", ErrRequestTimeout, method)
+	}
+
+	if rpcCall.Error != nil {
+		_, isServerError := rpcCall.Error.(rpc.ServerError)
+		if isServerError {
+			return nil, fmt.Errorf("%w: %s", ErrSigningRefused, rpcCall.Error.Error())
+		}
+
+		sc.disconnect()
+		return nil, rpcCall.Error
+	}
+
+	return response, nil
+}
+
+func (sc *signerClient) connect() error {
+	dialer := &net.Dialer{
+		Timeout: sc.requestTimeout,
+	}
+	conn, err := tls.DialWithDialer(dialer, sc.network, sc.address, sc.tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	sc.rpcClient = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+	log.Debug("connected to the remote signer", "network", sc.network, "address", sc.address)
+
+	return nil
+}
+
+func (sc *signerClient) disconnect() {
+	if sc.rpcClient == nil {
+		return
+	}
+
+	_ = sc.rpcClient.Close()
+	sc.rpcClient = nil
+}
+
+// Close closes the connection to the remote signer
+func (sc *signerClient) Close() error {
+	sc.mutClient.Lock()
+	defer sc.mutClient.Unlock()
+
+	sc.disconnect()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *signerClient) IsInterfaceNil() bool {
+	return sc == nil
+}
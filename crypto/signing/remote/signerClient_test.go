@@ -0,0 +1,777 @@
+package remote_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/signingHistory"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serverName     = "remotesigner"
+	requestTimeout = 5 * time.Second
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = &blake2b.Blake2b{}
+var testGenesisRandSeed = []byte("genesis root hash used as seed..")
+
+type testCertificates struct {
+	dir         string
+	server      remote.ArgsTLSConfig
+	client      remote.ArgsTLSConfig
+	rogueClient remote.ArgsTLSConfig
+}
+
+type testSigner struct {
+	privateKey     crypto.PrivateKey
+	publicKey      crypto.PublicKey
+	keyGen         crypto.KeyGenerator
+	lowLevelSigner crypto.LowLevelSignerBLS
+	address        string
+	certificates   *testCertificates
+}
+
+func writePEM(t *testing.T, fileName string, blockType string, bytes []byte) {
+	buff := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes})
+	err := ioutil.WriteFile(fileName, buff, 0600)
+	require.Nil(t, err)
+}
+
+func createCertificate(
+	t *testing.T,
+	dir string,
+	name string,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certificateFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	writePEM(t, certificateFile, "CERTIFICATE", certificateBytes)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyBytes)
+
+	return certificate, key, certificateFile, keyFile
+}
+
+func createTemplate(serialNumber int64, commonName string, isCA bool, extKeyUsage x509.ExtKeyUsage) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{extKeyUsage}
+		template.DNSNames = []string{commonName}
+	}
+
+	return template
+}
+
+func createTestCertificates(t *testing.T) *testCertificates {
+	dir, err := ioutil.TempDir("", "remotesigner")
+	require.Nil(t, err)
+
+	ca, caKey, caFile, _ := createCertificate(t, dir, "ca", createTemplate(1, "ca", true, 0), nil, nil)
+	_, _, serverFile, serverKeyFile := createCertificate(t, dir, "server", createTemplate(2, serverName, false, x509.ExtKeyUsageServerAuth), ca, caKey)
+	_, _, clientFile, clientKeyFile := createCertificate(t, dir, "client", createTemplate(3, "node", false, x509.ExtKeyUsageClientAuth), ca, caKey)
+
+	rogueCA, rogueCAKey, _, _ := createCertificate(t, dir, "rogueCA", createTemplate(4, "rogue ca", true, 0), nil, nil)
+	_, _, rogueClientFile, rogueClientKeyFile := createCertificate(t, dir, "rogueClient", createTemplate(5, "rogue node", false, x509.ExtKeyUsageClientAuth), rogueCA, rogueCAKey)
+
+	return &testCertificates{
+		dir: dir,
+		server: remote.ArgsTLSConfig{
+			CertificateFile:   serverFile,
+			KeyFile:           serverKeyFile,
+			CACertificateFile: caFile,
+		},
+		client: remote.ArgsTLSConfig{
+			CertificateFile:   clientFile,
+			KeyFile:           clientKeyFile,
+			CACertificateFile: caFile,
+			ServerName:        serverName,
+		},
+		rogueClient: remote.ArgsTLSConfig{
+			CertificateFile:   rogueClientFile,
+			KeyFile:           rogueClientKeyFile,
+			CACertificateFile: caFile,
+			ServerName:        serverName,
+		},
+	}
+}
+
+func startTestSigner(t *testing.T) (*testSigner, func()) {
+	certificates := createTestCertificates(t)
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGen.GeneratePair()
+	publicKeyBytes, _ := publicKey.ToByteArray()
+	lowLevelSigner := &mclmultisig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: multisig.BlsHashSize}}
+
+	history, err := signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Persister: memorydb.New(),
+		PublicKey: publicKeyBytes,
+	})
+	require.Nil(t, err)
+
+	tlsConfig, err := remote.NewServerTLSConfig(certificates.server)
+	require.Nil(t, err)
+
+	server, err := remote.NewSignerServer(remote.ArgsSignerServer{
+		PrivateKey:       privateKey,
+		SingleSigner:     &mclsig.BlsSingleSigner{},
+		LowLevelSigner:   lowLevelSigner,
+		SigningHistory:   history,
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		Classifier:       createConsensusMessageClassifier(),
+		TLSConfig:        tlsConfig,
+		GenesisRandSeeds: [][]byte{testGenesisRandSeed},
+	})
+	require.Nil(t, err)
+
+	address := filepath.Join(certificates.dir, "signer.sock")
+	listener, err := net.Listen(remote.UnixNetwork, address)
+	require.Nil(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	ts := &testSigner{
+		privateKey:     privateKey,
+		publicKey:      publicKey,
+		keyGen:         keyGen,
+		lowLevelSigner: lowLevelSigner,
+		address:        address,
+		certificates:   certificates,
+	}
+
+	return ts, func() {
+		_ = server.Close()
+		_ = os.RemoveAll(certificates.dir)
+	}
+}
+
+func createMockArgsSignerClient(t *testing.T, ts *testSigner, tlsArgs remote.ArgsTLSConfig) remote.ArgsSignerClient {
+	tlsConfig, err := remote.NewClientTLSConfig(tlsArgs)
+	require.Nil(t, err)
+
+	return remote.ArgsSignerClient{
+		Network:        remote.UnixNetwork,
+		Address:        ts.address,
+		TLSConfig:      tlsConfig,
+		KeyGenerator:   ts.keyGen,
+		SingleSigner:   &mclsig.BlsSingleSigner{},
+		Marshalizer:    testMarshalizer,
+		RequestTimeout: requestTimeout,
+	}
+}
+
+func createMockArgsSignerClientWithoutSigner() remote.ArgsSignerClient {
+	return remote.ArgsSignerClient{
+		Network:        remote.TCPNetwork,
+		Address:        "127.0.0.1:0",
+		TLSConfig:      &tls.Config{},
+		KeyGenerator:   signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SingleSigner:   &mclsig.BlsSingleSigner{},
+		Marshalizer:    testMarshalizer,
+		RequestTimeout: requestTimeout,
+	}
+}
+
+func TestNewSignerClient_InvalidNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.Network = "udp"
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.True(t, errors.Is(err, remote.ErrInvalidNetwork))
+}
+
+func TestNewSignerClient_EmptyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.Address = ""
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, remote.ErrEmptyAddress, err)
+}
+
+func TestNewSignerClient_NilTLSConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.TLSConfig = nil
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, remote.ErrNilTLSConfig, err)
+}
+
+func TestNewSignerClient_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.KeyGenerator = nil
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+}
+
+func TestNewSignerClient_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.SingleSigner = nil
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, remote.ErrNilSingleSigner, err)
+}
+
+func TestNewSignerClient_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.Marshalizer = nil
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, remote.ErrNilMarshalizer, err)
+}
+
+func TestNewSignerClient_InvalidRequestTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerClientWithoutSigner()
+	args.RequestTimeout = 0
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, remote.ErrInvalidRequestTimeout, err)
+}
+
+func TestNewSignerClient_UnavailableSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	args := createMockArgsSignerClient(t, ts, ts.certificates.client)
+	args.Address = filepath.Join(ts.certificates.dir, "missing.sock")
+	client, err := remote.NewSignerClient(args)
+
+	assert.True(t, check.IfNil(client))
+	assert.NotNil(t, err)
+}
+
+func TestNewSignerClient_UntrustedClientCertificateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, err := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.rogueClient))
+
+	assert.True(t, check.IfNil(client))
+	assert.NotNil(t, err)
+}
+
+func TestNewSignerClient_ShouldFetchThePublicKey(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, err := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	require.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	expectedPublicKey, _ := ts.publicKey.ToByteArray()
+	publicKey, _ := client.PublicKey().ToByteArray()
+	assert.Equal(t, expectedPublicKey, publicKey)
+
+	privateKey := client.PrivateKey()
+	assert.Equal(t, client.PublicKey(), privateKey.GeneratePublic())
+	assert.Nil(t, privateKey.Scalar())
+	buff, err := privateKey.ToByteArray()
+	assert.Nil(t, buff)
+	assert.Equal(t, remote.ErrPrivateKeyNotAvailable, err)
+}
+
+func createTestHeader(round uint64, rootHash string) *block.Header {
+	return &block.Header{
+		Nonce:    round - 1,
+		Round:    round,
+		Epoch:    1,
+		PrevHash: []byte("previous hash"),
+		RandSeed: []byte("rand seed"),
+		RootHash: []byte(rootHash),
+		ChainID:  []byte("chain ID"),
+	}
+}
+
+func createTestProposal(t *testing.T, publicKey crypto.PublicKey, header *block.Header) []byte {
+	headerBuff, err := testMarshalizer.Marshal(header)
+	require.Nil(t, err)
+	publicKeyBytes, _ := publicKey.ToByteArray()
+
+	return marshalTestConsensusMessage(t, consensus.NewConsensusMessage(
+		testHasher.Compute(string(headerBuff)),
+		nil,
+		[]byte("body"),
+		headerBuff,
+		publicKeyBytes,
+		nil,
+		int(bls.MtBlockBodyAndHeader),
+		int64(header.Round),
+		header.ChainID,
+		nil,
+		nil,
+		nil,
+	))
+}
+
+func createTestSignatureMessage(t *testing.T, publicKey crypto.PublicKey, round int64, headerHash []byte) []byte {
+	publicKeyBytes, _ := publicKey.ToByteArray()
+
+	return marshalTestConsensusMessage(t, consensus.NewConsensusMessage(
+		headerHash,
+		[]byte("signature share"),
+		nil,
+		nil,
+		publicKeyBytes,
+		nil,
+		int(bls.MtSignature),
+		round,
+		[]byte("chain ID"),
+		nil,
+		nil,
+		nil,
+	))
+}
+
+func marshalTestConsensusMessage(t *testing.T, cnsMsg *consensus.Message) []byte {
+	buff, err := testMarshalizer.Marshal(cnsMsg)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func callTestSigner(t *testing.T, ts *testSigner, method string, request *remote.Request) error {
+	tlsConfig, err := remote.NewClientTLSConfig(ts.certificates.client)
+	require.Nil(t, err)
+	conn, err := tls.Dial(remote.UnixNetwork, ts.address, tlsConfig)
+	require.Nil(t, err)
+	rpcClient := rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+	defer func() {
+		_ = rpcClient.Close()
+	}()
+
+	return rpcClient.Call(method, request, &remote.Response{})
+}
+
+func createTestPrevHeader(ts *testSigner, nonce uint64, round uint64) *block.Header {
+	prevRandSeed, _ := (&mclsig.BlsSingleSigner{}).Sign(ts.privateKey, []byte("rand seed"))
+	header := createTestHeader(round, "root hash")
+	header.Nonce = nonce
+	header.RandSeed = prevRandSeed
+
+	return header
+}
+
+func TestSignerClient_SignRandSeedShouldSignThePreviousRandSeed(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	prevHeader := createTestPrevHeader(ts, 9, 10)
+
+	signature, err := client.SignRandSeed(client.PrivateKey(), prevHeader, 11)
+	require.Nil(t, err)
+
+	assert.Nil(t, client.Verify(ts.publicKey, prevHeader.RandSeed, signature))
+	expectedSignature, _ := (&mclsig.BlsSingleSigner{}).Sign(ts.privateKey, prevHeader.RandSeed)
+	assert.Equal(t, expectedSignature, signature)
+}
+
+func TestSignerClient_SignRandSeedAfterTheGenesisShouldOnlySignTheGenesisRandSeeds(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	genesisHeader := &block.Header{Nonce: 0, Round: 0, RandSeed: testGenesisRandSeed, ChainID: []byte("chain ID")}
+
+	signature, err := client.SignRandSeed(client.PrivateKey(), genesisHeader, 1)
+	require.Nil(t, err)
+	assert.Nil(t, client.Verify(ts.publicKey, testGenesisRandSeed, signature))
+
+	genesisHeader.RandSeed = []byte("other genesis rand seed")
+	signature, err = client.SignRandSeed(client.PrivateKey(), genesisHeader, 1)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignRandSeedForAnOlderRoundShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+
+	signature, err := client.SignRandSeed(client.PrivateKey(), createTestPrevHeader(ts, 9, 10), 10)
+
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_HeaderHashSentAsRandSeedShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	headerHash, _ := core.CalculateHash(testMarshalizer, testHasher, createTestHeader(10, "conflicting root hash"))
+	require.Equal(t, 32, len(headerHash))
+
+	signature, err := client.Sign(client.PrivateKey(), headerHash)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	publicKeyBytes, _ := ts.publicKey.ToByteArray()
+	err = callTestSigner(t, ts, "RemoteSigner.Sign", &remote.Request{
+		PublicKey: publicKeyBytes,
+		Message:   headerHash,
+		Type:      remote.RandSeedPayloadType,
+		Round:     11,
+	})
+	assert.NotNil(t, err)
+
+	for _, nonce := range []uint64{0, 9} {
+		prevHeader := createTestPrevHeader(ts, nonce, 9)
+		prevHeader.RandSeed = headerHash
+		signature, err = client.SignRandSeed(client.PrivateKey(), prevHeader, 10)
+		assert.Nil(t, signature, "nonce %d", nonce)
+		assert.True(t, errors.Is(err, remote.ErrSigningRefused), "nonce %d", nonce)
+	}
+}
+
+func TestSignerClient_SignHeaderShouldRefuseConflictingHeaders(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	header := createTestHeader(10, "root hash")
+	header.Signature = []byte("aggregated signature")
+	header.PubKeysBitmap = []byte{1}
+	headerBuff, _ := testMarshalizer.Marshal(header)
+
+	signature, err := client.Sign(client.PrivateKey(), headerBuff)
+	require.Nil(t, err)
+	assert.Nil(t, client.Verify(ts.publicKey, headerBuff, signature))
+
+	headerWithoutSignatures := createTestHeader(10, "root hash")
+	headerHash, _ := core.CalculateHash(testMarshalizer, testHasher, headerWithoutSignatures)
+	err = client.CheckAndRecordLeaderSignature(1, 0, 10, headerHash)
+	assert.Nil(t, err)
+
+	conflictingHeaderBuff, _ := testMarshalizer.Marshal(createTestHeader(10, "other root hash"))
+	signature, err = client.Sign(client.PrivateKey(), conflictingHeaderBuff)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignProposalShouldRefuseConflictingProposals(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	proposal := createTestProposal(t, ts.publicKey, createTestHeader(10, "root hash"))
+
+	signature, err := client.Sign(client.PrivateKey(), proposal)
+	require.Nil(t, err)
+	assert.Nil(t, client.Verify(ts.publicKey, proposal, signature))
+
+	signature, err = client.Sign(client.PrivateKey(), proposal)
+	require.Nil(t, err)
+	assert.NotNil(t, signature)
+
+	conflictingProposal := createTestProposal(t, ts.publicKey, createTestHeader(10, "other root hash"))
+	signature, err = client.Sign(client.PrivateKey(), conflictingProposal)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignSignatureMessageShouldOnlySignTheApprovedHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	headerHash := []byte("header hash")
+	signatureMessage := createTestSignatureMessage(t, ts.publicKey, 10, headerHash)
+
+	signature, err := client.Sign(client.PrivateKey(), signatureMessage)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	err = client.CheckAndRecordSignatureShare(1, 0, 10, headerHash)
+	require.Nil(t, err)
+
+	signature, err = client.Sign(client.PrivateKey(), signatureMessage)
+	require.Nil(t, err)
+	assert.Nil(t, client.Verify(ts.publicKey, signatureMessage, signature))
+
+	conflictingMessage := createTestSignatureMessage(t, ts.publicKey, 10, []byte("other header hash"))
+	signature, err = client.Sign(client.PrivateKey(), conflictingMessage)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignFinalInfoShouldOnlySignTheApprovedLeaderHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	publicKeyBytes, _ := ts.publicKey.ToByteArray()
+	createFinalInfo := func(headerHash []byte) []byte {
+		return marshalTestConsensusMessage(t, consensus.NewConsensusMessage(
+			headerHash,
+			nil,
+			nil,
+			nil,
+			publicKeyBytes,
+			nil,
+			int(bls.MtBlockHeaderFinalInfo),
+			10,
+			[]byte("chain ID"),
+			[]byte{1},
+			[]byte("aggregated signature"),
+			[]byte("leader signature"),
+		))
+	}
+	headerHash := []byte("header hash")
+
+	signature, err := client.Sign(client.PrivateKey(), createFinalInfo(headerHash))
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	err = client.CheckAndRecordLeaderSignature(1, 0, 10, headerHash)
+	require.Nil(t, err)
+
+	signature, err = client.Sign(client.PrivateKey(), createFinalInfo(headerHash))
+	require.Nil(t, err)
+	assert.NotNil(t, signature)
+
+	signature, err = client.Sign(client.PrivateKey(), createFinalInfo([]byte("other header hash")))
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignHeartbeatShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	publicKeyBytes, _ := ts.publicKey.ToByteArray()
+	hbBuff, _ := testMarshalizer.Marshal(&heartbeatData.Heartbeat{
+		Payload:       []byte("payload"),
+		Pubkey:        publicKeyBytes,
+		VersionNumber: "v1.0.0",
+		Pid:           []byte("pid"),
+	})
+
+	signature, err := client.Sign(client.PrivateKey(), hbBuff)
+	require.Nil(t, err)
+	assert.Nil(t, client.Verify(ts.publicKey, hbBuff, signature))
+}
+
+func TestSignerClient_SignArbitraryMessageShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+
+	signature, err := client.Sign(client.PrivateKey(), bytes.Repeat([]byte("message "), 20))
+
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerServer_SignMistypedConsensusMessageShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	publicKeyBytes, _ := ts.publicKey.ToByteArray()
+	proposal := createTestProposal(t, ts.publicKey, createTestHeader(10, "root hash"))
+	for _, payloadType := range []string{remote.HeaderPayloadType, remote.HeartbeatPayloadType, remote.RandSeedPayloadType, "raw"} {
+		err := callTestSigner(t, ts, "RemoteSigner.Sign", &remote.Request{
+			PublicKey: publicKeyBytes,
+			Message:   proposal,
+			Type:      payloadType,
+		})
+		assert.NotNil(t, err, payloadType)
+	}
+}
+
+func TestSignerClient_SignWithAnotherKeyShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	otherPrivateKey, _ := ts.keyGen.GeneratePair()
+
+	signature, err := client.Sign(otherPrivateKey, []byte("message"))
+
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_SignShareShouldOnlySignTheApprovedHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	headerHash := []byte("header hash")
+
+	signature, err := client.SignShare(client.PrivateKey(), headerHash)
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	err = client.CheckAndRecordSignatureShare(1, 0, 10, headerHash)
+	require.Nil(t, err)
+
+	signature, err = client.SignShare(client.PrivateKey(), []byte("other header hash"))
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	signature, err = client.SignShare(client.PrivateKey(), headerHash)
+	require.Nil(t, err)
+	assert.Nil(t, ts.lowLevelSigner.VerifySigShare(ts.publicKey, headerHash, signature))
+}
+
+func TestSignerClient_ConflictingHeaderHashShouldBeRefused(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+
+	err := client.CheckAndRecordLeaderSignature(1, 0, 10, []byte("header hash"))
+	assert.Nil(t, err)
+	err = client.CheckAndRecordLeaderSignature(1, 0, 10, []byte("header hash"))
+	assert.Nil(t, err)
+	err = client.CheckAndRecordLeaderSignature(1, 0, 10, []byte("conflicting header hash"))
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	err = client.CheckAndRecordSignatureShare(1, 0, 10, []byte("header hash"))
+	assert.Nil(t, err)
+	err = client.CheckAndRecordSignatureShare(1, 0, 10, []byte("conflicting header hash"))
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+
+	signature, err := client.SignShare(client.PrivateKey(), []byte("conflicting header hash"))
+	assert.Nil(t, signature)
+	assert.True(t, errors.Is(err, remote.ErrSigningRefused))
+}
+
+func TestSignerClient_ShouldReconnectAfterTheConnectionWasClosed(t *testing.T) {
+	t.Parallel()
+
+	ts, closer := startTestSigner(t)
+	defer closer()
+
+	client, _ := remote.NewSignerClient(createMockArgsSignerClient(t, ts, ts.certificates.client))
+	_ = client.Close()
+
+	signature, err := client.SignRandSeed(client.PrivateKey(), createTestPrevHeader(ts, 9, 10), 11)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, signature)
+}
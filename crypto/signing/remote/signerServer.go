@@ -0,0 +1,526 @@
+package remote
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("crypto/signing/remote")
+
+// ArgsSignerServer is the argument DTO used to create a new remote signer server
+type ArgsSignerServer struct {
+	PrivateKey     crypto.PrivateKey
+	SingleSigner   crypto.SingleSigner
+	LowLevelSigner crypto.LowLevelSignerBLS
+	SigningHistory SigningHistoryHandler
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+	Classifier     ConsensusMessageClassifier
+	TLSConfig      *tls.Config
+	// GenesisRandSeeds are the rand seeds of the genesis headers, the only rand seeds signed without being BLS
+	// signatures. The first block after the genesis can not be proposed if its genesis rand seed is not provided
+	GenesisRandSeeds [][]byte
+}
+
+// signerServer holds the validator key and serves the signing requests of the node over mutually authenticated TLS
+// connections, using the JSON-RPC protocol
+type signerServer struct {
+	rpcServer   *rpc.Server
+	tlsConfig   *tls.Config
+	mutListener sync.Mutex
+	listener    net.Listener
+}
+
+// NewSignerServer creates a new remote signer server
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	if check.IfNil(args.PrivateKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if args.LowLevelSigner == nil {
+		return nil, ErrNilLowLevelSigner
+	}
+	if check.IfNil(args.SigningHistory) {
+		return nil, ErrNilSigningHistory
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Classifier) {
+		return nil, ErrNilConsensusMessageClassifier
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+
+	handler, err := newSignerHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcServer := rpc.NewServer()
+	err = rpcServer.RegisterName(serviceName, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerServer{
+		rpcServer: rpcServer,
+		tlsConfig: args.TLSConfig,
+	}, nil
+}
+
+// Serve accepts connections on the provided listener until it is closed
+func (ss *signerServer) Serve(listener net.Listener) error {
+	if listener == nil {
+		return ErrNilListener
+	}
+
+	ss.mutListener.Lock()
+	ss.listener = listener
+	ss.mutListener.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go ss.serveConnection(tls.Server(conn, ss.tlsConfig))
+	}
+}
+
+func (ss *signerServer) serveConnection(conn *tls.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := conn.Handshake()
+	if err != nil {
+		log.Warn("remote signer refused connection", "error", err.Error())
+		return
+	}
+
+	client := ""
+	peerCertificates := conn.ConnectionState().PeerCertificates
+	if len(peerCertificates) > 0 {
+		client = peerCertificates[0].Subject.CommonName
+	}
+
+	log.Info("remote signer client connected", "client", client)
+	ss.rpcServer.ServeCodec(jsonrpc.NewServerCodec(conn))
+	log.Info("remote signer client disconnected", "client", client)
+}
+
+// Close stops accepting new connections
+func (ss *signerServer) Close() error {
+	ss.mutListener.Lock()
+	defer ss.mutListener.Unlock()
+
+	if ss.listener == nil {
+		return nil
+	}
+
+	return ss.listener.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *signerServer) IsInterfaceNil() bool {
+	return ss == nil
+}
+
+// signerHandler exposes the remote signer methods. Every header hash is checked against the signing history before
+// being approved and a signature share is only produced over the header hash approved last. The single signatures
+// are only produced over typed payloads: the headers and the consensus messages are unmarshaled, their round and
+// header hash being checked and recorded in the signing history before signing, so a compromised node can not obtain
+// conflicting signatures for the same round. A rand seed is derived from the previous header, never taken as is
+type signerHandler struct {
+	privateKey       crypto.PrivateKey
+	publicKey        []byte
+	singleSigner     crypto.SingleSigner
+	lowLevelSigner   crypto.LowLevelSignerBLS
+	signingHistory   SigningHistoryHandler
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	classifier       ConsensusMessageClassifier
+	genesisRandSeeds map[string]struct{}
+	mutApproval      sync.Mutex
+	approvedLeader   approvedPayload
+	approvedShare    approvedPayload
+}
+
+// approvedPayload is the last payload of a signature type approved by the signing history
+type approvedPayload struct {
+	epoch      uint32
+	shardID    uint32
+	round      uint64
+	headerHash []byte
+}
+
+func (ap *approvedPayload) matches(round uint64, headerHash []byte) bool {
+	return len(ap.headerHash) > 0 && ap.round == round && bytes.Equal(ap.headerHash, headerHash)
+}
+
+func newSignerHandler(args ArgsSignerServer) (*signerHandler, error) {
+	publicKey := args.PrivateKey.GeneratePublic()
+	if check.IfNil(publicKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	genesisRandSeeds := make(map[string]struct{}, len(args.GenesisRandSeeds))
+	for _, randSeed := range args.GenesisRandSeeds {
+		genesisRandSeeds[string(randSeed)] = struct{}{}
+	}
+
+	return &signerHandler{
+		privateKey:       args.PrivateKey,
+		publicKey:        publicKeyBytes,
+		singleSigner:     args.SingleSigner,
+		lowLevelSigner:   args.LowLevelSigner,
+		signingHistory:   args.SigningHistory,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		classifier:       args.Classifier,
+		genesisRandSeeds: genesisRandSeeds,
+	}, nil
+}
+
+// PublicKey returns the public key of the held validator key
+func (sh *signerHandler) PublicKey(_ *Request, response *Response) error {
+	response.PublicKey = sh.publicKey
+	log.Debug("remote signer response", "method", publicKeyMethod, "pk", hex.EncodeToString(sh.publicKey))
+
+	return nil
+}
+
+// CheckAndRecord checks the requested header hash against the signing history and records it as signed
+func (sh *signerHandler) CheckAndRecord(request *Request, _ *Response) error {
+	logRequest(checkAndRecordMethod, request)
+	err := sh.checkAndRecord(request)
+	logResponse(checkAndRecordMethod, nil, err)
+
+	return err
+}
+
+func (sh *signerHandler) checkAndRecord(request *Request) error {
+	err := sh.checkPublicKey(request)
+	if err != nil {
+		return err
+	}
+
+	payload := approvedPayload{
+		epoch:      request.Epoch,
+		shardID:    request.ShardID,
+		round:      request.Round,
+		headerHash: request.HeaderHash,
+	}
+
+	switch request.Type {
+	case LeaderSignatureType:
+		return sh.approveLeaderSignature(payload)
+	case SignatureShareType:
+		return sh.approveSignatureShare(payload)
+	default:
+		return ErrInvalidSignatureType
+	}
+}
+
+func (sh *signerHandler) approveLeaderSignature(payload approvedPayload) error {
+	sh.mutApproval.Lock()
+	defer sh.mutApproval.Unlock()
+
+	err := sh.signingHistory.CheckAndRecordLeaderSignature(payload.epoch, payload.shardID, payload.round, payload.headerHash)
+	if err != nil {
+		return err
+	}
+
+	sh.approvedLeader = payload
+	return nil
+}
+
+func (sh *signerHandler) approveSignatureShare(payload approvedPayload) error {
+	sh.mutApproval.Lock()
+	defer sh.mutApproval.Unlock()
+
+	err := sh.signingHistory.CheckAndRecordSignatureShare(payload.epoch, payload.shardID, payload.round, payload.headerHash)
+	if err != nil {
+		return err
+	}
+
+	sh.approvedShare = payload
+	return nil
+}
+
+// Sign creates a single signature over the requested payload, after checking it according to its type. For a rand
+// seed request, the signature is created over the rand seed of the previous header carried by the request
+func (sh *signerHandler) Sign(request *Request, response *Response) error {
+	logRequest(signMethod, request)
+	err := sh.sign(request, response)
+	logResponse(signMethod, response, err)
+
+	return err
+}
+
+func (sh *signerHandler) sign(request *Request, response *Response) error {
+	err := sh.checkPublicKey(request)
+	if err != nil {
+		return err
+	}
+
+	message, err := sh.checkPayload(request)
+	if err != nil {
+		return err
+	}
+
+	response.Signature, err = sh.singleSigner.Sign(sh.privateKey, message)
+
+	return err
+}
+
+// checkPayload checks the requested payload and returns the message to be signed
+func (sh *signerHandler) checkPayload(request *Request) ([]byte, error) {
+	if request.Type == ConsensusMessagePayloadType {
+		return request.Message, sh.checkConsensusMessage(request.Message)
+	}
+
+	_, err := unmarshalConsensusMessage(sh.marshalizer, request.Message, sh.publicKey)
+	if err == nil {
+		return nil, ErrAmbiguousPayload
+	}
+
+	switch request.Type {
+	case HeaderPayloadType:
+		return request.Message, sh.checkHeader(request.Message)
+	case RandSeedPayloadType:
+		return sh.getRandSeedToSign(request.Message, request.Round)
+	case HeartbeatPayloadType:
+		return request.Message, sh.checkHeartbeat(request.Message)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPayloadType, request.Type)
+	}
+}
+
+// getRandSeedToSign returns the rand seed of the previous header, to be signed as the rand seed of the header proposed
+// in the provided round. Only a BLS signature or a trusted genesis rand seed is returned, so a compromised node can not
+// pass off a header hash as a rand seed in order to obtain a signature share over it
+func (sh *signerHandler) getRandSeedToSign(buff []byte, round uint64) ([]byte, error) {
+	prevHeader, err := unmarshalHeader(sh.marshalizer, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+	if round <= prevHeader.GetRound() {
+		return nil, fmt.Errorf("%w: round %d is not after the previous header round %d",
+			ErrInvalidPayload, round, prevHeader.GetRound())
+	}
+
+	randSeed := prevHeader.GetRandSeed()
+	_, isGenesisRandSeed := sh.genesisRandSeeds[string(randSeed)]
+	isGenesisRandSeed = isGenesisRandSeed && prevHeader.GetNonce() == 0
+	if len(randSeed) != randSeedLength && !isGenesisRandSeed {
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidRandSeed, len(randSeed))
+	}
+
+	log.Debug("remote signer rand seed",
+		"shard", prevHeader.GetShardID(),
+		"round", round,
+		"previous nonce", prevHeader.GetNonce(),
+		"previous rand seed", randSeed,
+	)
+
+	return randSeed, nil
+}
+
+// checkHeader approves the header hash, computed without the signatures, as the leader signature of the header round
+func (sh *signerHandler) checkHeader(buff []byte) error {
+	header, err := unmarshalHeader(sh.marshalizer, buff)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetSignature(nil)
+	headerCopy.SetPubKeysBitmap(nil)
+	headerCopy.SetLeaderSignature(nil)
+	headerHash, err := core.CalculateHash(sh.marshalizer, sh.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("remote signer header",
+		"epoch", header.GetEpoch(),
+		"shard", header.GetShardID(),
+		"round", header.GetRound(),
+		"nonce", header.GetNonce(),
+		"header hash", headerHash,
+	)
+
+	return sh.approveLeaderSignature(approvedPayload{
+		epoch:      header.GetEpoch(),
+		shardID:    header.GetShardID(),
+		round:      header.GetRound(),
+		headerHash: headerHash,
+	})
+}
+
+// checkConsensusMessage checks and records the header hash carried by a consensus message. A proposal is recorded
+// as the leader signature of the carried header, while a signature share or a final info message has to be for the
+// round and header hash approved last for its signature type
+func (sh *signerHandler) checkConsensusMessage(buff []byte) error {
+	cnsMsg, err := unmarshalConsensusMessage(sh.marshalizer, buff, sh.publicKey)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+	if len(cnsMsg.Signature) != 0 || cnsMsg.RoundIndex < 0 {
+		return ErrInvalidPayload
+	}
+
+	round := uint64(cnsMsg.RoundIndex)
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	switch {
+	case sh.classifier.IsMessageWithBlockBodyAndHeader(msgType) || sh.classifier.IsMessageWithBlockHeader(msgType):
+		return sh.checkProposal(cnsMsg, round)
+	case sh.classifier.IsMessageWithBlockBody(msgType):
+		if len(cnsMsg.BlockHeaderHash) != 0 || len(cnsMsg.Header) != 0 {
+			return ErrInvalidPayload
+		}
+		return nil
+	case sh.classifier.IsMessageWithSignature(msgType):
+		return sh.checkApproved(&sh.approvedShare, round, cnsMsg.BlockHeaderHash, sh.signingHistory.CheckAndRecordSignatureShare)
+	case sh.classifier.IsMessageWithFinalInfo(msgType):
+		return sh.checkApproved(&sh.approvedLeader, round, cnsMsg.BlockHeaderHash, sh.signingHistory.CheckAndRecordLeaderSignature)
+	default:
+		return fmt.Errorf("%w: consensus message type %d", ErrInvalidPayload, cnsMsg.MsgType)
+	}
+}
+
+func (sh *signerHandler) checkProposal(cnsMsg *consensus.Message, round uint64) error {
+	header, err := unmarshalHeader(sh.marshalizer, cnsMsg.Header)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+
+	headerHash := sh.hasher.Compute(string(cnsMsg.Header))
+	if !bytes.Equal(headerHash, cnsMsg.BlockHeaderHash) || header.GetRound() != round {
+		return ErrInvalidPayload
+	}
+
+	return sh.approveLeaderSignature(approvedPayload{
+		epoch:      header.GetEpoch(),
+		shardID:    header.GetShardID(),
+		round:      round,
+		headerHash: headerHash,
+	})
+}
+
+func (sh *signerHandler) checkApproved(
+	approved *approvedPayload,
+	round uint64,
+	headerHash []byte,
+	checkAndRecord func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error,
+) error {
+	sh.mutApproval.Lock()
+	defer sh.mutApproval.Unlock()
+
+	if !approved.matches(round, headerHash) {
+		return ErrUnapprovedConsensusMessage
+	}
+
+	return checkAndRecord(approved.epoch, approved.shardID, round, headerHash)
+}
+
+func (sh *signerHandler) checkHeartbeat(buff []byte) error {
+	hb, err := unmarshalHeartbeat(sh.marshalizer, buff, sh.publicKey)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+	if len(hb.Signature) != 0 {
+		return ErrInvalidPayload
+	}
+
+	return nil
+}
+
+// SignShare creates a BLS signature share over the requested message, which has to be the header hash approved last
+func (sh *signerHandler) SignShare(request *Request, response *Response) error {
+	logRequest(signShareMethod, request)
+	err := sh.signShare(request, response)
+	logResponse(signShareMethod, response, err)
+
+	return err
+}
+
+func (sh *signerHandler) signShare(request *Request, response *Response) error {
+	err := sh.checkPublicKey(request)
+	if err != nil {
+		return err
+	}
+
+	sh.mutApproval.Lock()
+	isApproved := len(sh.approvedShare.headerHash) > 0 && bytes.Equal(sh.approvedShare.headerHash, request.Message)
+	sh.mutApproval.Unlock()
+	if !isApproved {
+		return ErrUnapprovedSignatureShare
+	}
+
+	response.Signature, err = sh.lowLevelSigner.SignShare(sh.privateKey, request.Message)
+
+	return err
+}
+
+func (sh *signerHandler) checkPublicKey(request *Request) error {
+	if !bytes.Equal(sh.publicKey, request.PublicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	return nil
+}
+
+func logRequest(method string, request *Request) {
+	log.Debug("remote signer request",
+		"method", method,
+		"pk", core.GetTrimmedPk(hex.EncodeToString(request.PublicKey)),
+		"type", request.Type,
+		"epoch", request.Epoch,
+		"shard", request.ShardID,
+		"round", request.Round,
+		"header hash", request.HeaderHash,
+		"message", request.Message,
+	)
+}
+
+func logResponse(method string, response *Response, err error) {
+	if err != nil {
+		log.Warn("remote signer refused request", "method", method, "error", err.Error())
+		return
+	}
+
+	var signature []byte
+	if response != nil {
+		signature = response.Signature
+	}
+	log.Debug("remote signer response", "method", method, "signature", signature)
+}
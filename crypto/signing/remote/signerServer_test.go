@@ -0,0 +1,175 @@
+package remote_test
+
+import (
+	"crypto/tls"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsSignerServer() remote.ArgsSignerServer {
+	privateKey, _ := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+
+	return remote.ArgsSignerServer{
+		PrivateKey:     privateKey,
+		SingleSigner:   &mclsig.BlsSingleSigner{},
+		LowLevelSigner: &mclmultisig.BlsMultiSigner{},
+		SigningHistory: &mock.SigningHistoryStub{},
+		Marshalizer:    &marshal.GogoProtoMarshalizer{},
+		Hasher:         &blake2b.Blake2b{},
+		Classifier:     createConsensusMessageClassifier(),
+		TLSConfig:      &tls.Config{},
+	}
+}
+
+func createConsensusMessageClassifier() remote.ConsensusMessageClassifier {
+	classifier, _ := bls.NewConsensusService()
+	return classifier
+}
+
+func TestNewSignerServer_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.PrivateKey = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+}
+
+func TestNewSignerServer_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.SingleSigner = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilSingleSigner, err)
+}
+
+func TestNewSignerServer_NilLowLevelSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.LowLevelSigner = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilLowLevelSigner, err)
+}
+
+func TestNewSignerServer_NilSigningHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.SigningHistory = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilSigningHistory, err)
+}
+
+func TestNewSignerServer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.Marshalizer = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilMarshalizer, err)
+}
+
+func TestNewSignerServer_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.Hasher = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilHasher, err)
+}
+
+func TestNewSignerServer_NilClassifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.Classifier = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilConsensusMessageClassifier, err)
+}
+
+func TestNewSignerServer_NilTLSConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSignerServer()
+	args.TLSConfig = nil
+	server, err := remote.NewSignerServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, remote.ErrNilTLSConfig, err)
+}
+
+func TestNewSignerServer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	server, err := remote.NewSignerServer(createMockArgsSignerServer())
+
+	assert.False(t, check.IfNil(server))
+	assert.Nil(t, err)
+	assert.Nil(t, server.Close())
+}
+
+func TestSignerServer_ServeNilListenerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, _ := remote.NewSignerServer(createMockArgsSignerServer())
+
+	assert.Equal(t, remote.ErrNilListener, server.Serve(nil))
+}
+
+func TestNewServerTLSConfig_InvalidCACertificateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	certificates := createTestCertificates(t)
+	defer func() {
+		_ = os.RemoveAll(certificates.dir)
+	}()
+	args := certificates.server
+	args.CACertificateFile = args.KeyFile
+	tlsConfig, err := remote.NewServerTLSConfig(args)
+
+	assert.Nil(t, tlsConfig)
+	assert.Equal(t, remote.ErrInvalidCACertificate, err)
+}
+
+func TestNewServerTLSConfig_ShouldRequireClientCertificates(t *testing.T) {
+	t.Parallel()
+
+	certificates := createTestCertificates(t)
+	defer func() {
+		_ = os.RemoveAll(certificates.dir)
+	}()
+	tlsConfig, err := remote.NewServerTLSConfig(certificates.server)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	assert.Equal(t, 1, len(tlsConfig.Certificates))
+}
@@ -0,0 +1,67 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// ArgsTLSConfig is the argument DTO used to create the mutually authenticated TLS configuration of the remote signer
+// and of its clients. Both sides present a certificate signed by the same CA, the only one trusted by the other side
+type ArgsTLSConfig struct {
+	CertificateFile   string
+	KeyFile           string
+	CACertificateFile string
+	ServerName        string
+}
+
+// NewServerTLSConfig creates the TLS configuration of the remote signer, requiring a client certificate signed by
+// the configured CA
+func NewServerTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, certPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewClientTLSConfig creates the TLS configuration used by the node to connect to the remote signer, the server
+// certificate being verified against the configured CA and server name
+func NewClientTLSConfig(args ArgsTLSConfig) (*tls.Config, error) {
+	certificate, certPool, err := loadCertificates(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+		ServerName:   args.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(args ArgsTLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(args.CertificateFile, args.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caCertificate, err := ioutil.ReadFile(args.CACertificateFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCertificate) {
+		return tls.Certificate{}, nil, ErrInvalidCACertificate
+	}
+
+	return certificate, certPool, nil
+}
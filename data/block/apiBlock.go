@@ -0,0 +1,54 @@
+package block
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// ApiBlock is the data transfer object which will be returned on the get block by nonce or by hash endpoints
+type ApiBlock struct {
+	Nonce           uint64               `json:"nonce"`
+	Round           uint64               `json:"round"`
+	Hash            string               `json:"hash"`
+	PrevBlockHash   string               `json:"prevBlockHash"`
+	Epoch           uint32               `json:"epoch"`
+	ShardID         uint32               `json:"shard"`
+	NumTxs          uint32               `json:"numTxs"`
+	RootHash        string               `json:"rootHash"`
+	Timestamp       uint64               `json:"timestamp"`
+	MiniBlocks      []*ApiMiniBlock      `json:"miniBlocks,omitempty"`
+	NotarizedBlocks []*ApiNotarizedBlock `json:"notarizedBlocks,omitempty"`
+}
+
+// ApiMiniBlock is the data transfer object which will hold a miniblock and, optionally, its transactions
+type ApiMiniBlock struct {
+	Hash             string                              `json:"hash"`
+	Type             string                              `json:"type"`
+	SourceShard      uint32                              `json:"sourceShard"`
+	DestinationShard uint32                              `json:"destinationShard"`
+	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
+}
+
+// ApiNotarizedBlock is the data transfer object which will hold a shard block notarized by a metachain block
+type ApiNotarizedBlock struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	ShardID uint32 `json:"shard"`
+	NumTxs  uint32 `json:"numTxs"`
+}
+
+// ApiHyperblock is the data transfer object which will hold a metachain block together with all the shard blocks
+// it notarized and all the transactions that could be resolved from those blocks. The hashes of the miniblocks that
+// are not available in the local storage are listed in MissingMiniBlocks, their transactions being left out
+type ApiHyperblock struct {
+	Nonce             uint64                              `json:"nonce"`
+	Round             uint64                              `json:"round"`
+	Hash              string                              `json:"hash"`
+	PrevBlockHash     string                              `json:"prevBlockHash"`
+	Epoch             uint32                              `json:"epoch"`
+	NumTxs            uint32                              `json:"numTxs"`
+	Timestamp         uint64                              `json:"timestamp"`
+	ShardBlocks       []*ApiNotarizedBlock                `json:"shardBlocks"`
+	Transactions      []*transaction.ApiTransactionResult `json:"transactions"`
+	MissingMiniBlocks []string                            `json:"missingMiniBlocks,omitempty"`
+}
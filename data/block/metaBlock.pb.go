@@ -401,6 +401,8 @@ type Economics struct {
 	NodePrice              *math_big.Int `protobuf:"bytes,6,opt,name=NodePrice,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"NodePrice,omitempty"`
 	PrevEpochStartRound    uint64        `protobuf:"varint,7,opt,name=PrevEpochStartRound,proto3" json:"PrevEpochStartRound,omitempty"`
 	PrevEpochStartHash     []byte        `protobuf:"bytes,8,opt,name=PrevEpochStartHash,proto3" json:"PrevEpochStartHash,omitempty"`
+	TotalStaked            *math_big.Int `protobuf:"bytes,9,opt,name=TotalStaked,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalStaked,omitempty"`
+	InflationRate          uint64        `protobuf:"varint,10,opt,name=InflationRate,proto3" json:"InflationRate,omitempty"`
 }
 
 func (m *Economics) Reset()      { *m = Economics{} }
@@ -487,6 +489,20 @@ func (m *Economics) GetPrevEpochStartHash() []byte {
 	return nil
 }
 
+func (m *Economics) GetTotalStaked() *math_big.Int {
+	if m != nil {
+		return m.TotalStaked
+	}
+	return nil
+}
+
+func (m *Economics) GetInflationRate() uint64 {
+	if m != nil {
+		return m.InflationRate
+	}
+	return 0
+}
+
 // EpochStart holds the block information for end-of-epoch
 type EpochStart struct {
 	LastFinalizedHeaders []EpochStartShardData `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
@@ -561,6 +577,7 @@ type MetaBlock struct {
 	DeveloperFees          *math_big.Int     `protobuf:"bytes,23,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
 	DevFeesInEpoch         *math_big.Int     `protobuf:"bytes,24,opt,name=DevFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DevFeesInEpoch,omitempty"`
 	TxCount                uint32            `protobuf:"varint,25,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	EquivocationEvidence   [][]byte          `protobuf:"bytes,26,rep,name=EquivocationEvidence,proto3" json:"EquivocationEvidence,omitempty"`
 }
 
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
@@ -759,6 +776,13 @@ func (m *MetaBlock) GetTxCount() uint32 {
 	return 0
 }
 
+func (m *MetaBlock) GetEquivocationEvidence() [][]byte {
+	if m != nil {
+		return m.EquivocationEvidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("proto.PeerAction", PeerAction_name, PeerAction_value)
 	proto.RegisterType((*PeerData)(nil), "proto.PeerData")
@@ -772,85 +796,88 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xdb, 0xa6, 0x6d, 0x5e, 0x9a, 0xd6, 0x9d, 0x76, 0xbb, 0xa6, 0x42, 0xde, 0x2a, 0xe2,
-	0x50, 0x90, 0xb6, 0x85, 0xb2, 0x82, 0x03, 0x07, 0xd4, 0x34, 0xad, 0x36, 0xec, 0x6e, 0x15, 0x39,
-	0xa5, 0x07, 0x6e, 0x13, 0x7b, 0xd6, 0x19, 0xd5, 0x9e, 0x09, 0xf6, 0xb8, 0xa5, 0x48, 0x2b, 0xf1,
-	0x11, 0x38, 0x22, 0x71, 0xe5, 0x80, 0xe0, 0x63, 0x70, 0xd9, 0xe3, 0x1e, 0xf7, 0x04, 0x6c, 0x7a,
-	0xe1, 0xb8, 0x48, 0x7c, 0x00, 0xe4, 0x19, 0x3b, 0x76, 0x1c, 0x17, 0xf6, 0x90, 0x3d, 0xb5, 0xef,
-	0xf7, 0xe6, 0xbd, 0x97, 0x79, 0xff, 0xe6, 0x67, 0x58, 0xf3, 0x89, 0xc0, 0x2d, 0x8f, 0xdb, 0x17,
-	0x7b, 0xc3, 0x80, 0x0b, 0x8e, 0xaa, 0xf2, 0xcf, 0xf6, 0x7d, 0x97, 0x8a, 0x41, 0xd4, 0xdf, 0xb3,
-	0xb9, 0xbf, 0xef, 0x72, 0x97, 0xef, 0x4b, 0xb8, 0x1f, 0x3d, 0x95, 0x92, 0x14, 0xe4, 0x7f, 0xca,
-	0x6a, 0xbb, 0xde, 0xcf, 0x5c, 0x34, 0xff, 0xd1, 0x60, 0xb9, 0x4b, 0x48, 0xd0, 0xc6, 0x02, 0x23,
-	0x03, 0x96, 0x0e, 0x1d, 0x27, 0x20, 0x61, 0x68, 0x68, 0x3b, 0xda, 0xee, 0x8a, 0x95, 0x8a, 0xe8,
-	0x5d, 0xa8, 0x75, 0xa3, 0xbe, 0x47, 0xed, 0x47, 0xe4, 0xda, 0x98, 0x93, 0xba, 0x0c, 0x40, 0xef,
-	0xc3, 0xe2, 0xa1, 0x2d, 0x28, 0x67, 0xc6, 0xfc, 0x8e, 0xb6, 0xbb, 0x7a, 0xb0, 0xae, 0x9c, 0xef,
-	0xc5, 0x8e, 0x95, 0xc2, 0x4a, 0x0e, 0xc4, 0x8e, 0xce, 0xa8, 0x4f, 0x7a, 0x02, 0xfb, 0x43, 0x63,
-	0x61, 0x47, 0xdb, 0x5d, 0xb0, 0x32, 0x00, 0xb9, 0x50, 0x3f, 0xc7, 0x5e, 0x44, 0x8e, 0x06, 0x98,
-	0xb9, 0xc4, 0xa8, 0xc6, 0x81, 0x5a, 0xc7, 0xbf, 0xfc, 0x71, 0xef, 0xd0, 0xc7, 0x62, 0xb0, 0xdf,
-	0xa7, 0xee, 0x5e, 0x87, 0x89, 0xcf, 0x72, 0xf7, 0x3d, 0xf6, 0x02, 0xce, 0x9c, 0x53, 0x22, 0xae,
-	0x78, 0x70, 0xb1, 0x4f, 0xa4, 0x74, 0xdf, 0xe5, 0xfb, 0x0e, 0x16, 0x78, 0xaf, 0x45, 0xdd, 0x0e,
-	0x13, 0x47, 0x38, 0x14, 0x24, 0xb0, 0xf2, 0x9e, 0x9b, 0xbf, 0x56, 0xa1, 0xd6, 0x1b, 0xe0, 0xc0,
-	0x91, 0xf7, 0x36, 0x01, 0x1e, 0x12, 0xec, 0x90, 0xe0, 0x21, 0x0e, 0x07, 0xc9, 0xf5, 0x72, 0x08,
-	0xb2, 0xe0, 0x8e, 0x3c, 0xfc, 0x84, 0x32, 0x2a, 0xf3, 0xaf, 0x74, 0xa1, 0x31, 0xbf, 0x33, 0xbf,
-	0x5b, 0x3f, 0xd8, 0x4a, 0xae, 0x5b, 0x50, 0xb7, 0x16, 0x9e, 0xff, 0x7e, 0xaf, 0x62, 0x95, 0x9b,
-	0xa2, 0x26, 0xac, 0x74, 0x03, 0x72, 0x69, 0x61, 0xe6, 0xf4, 0x08, 0x71, 0x64, 0x2e, 0x56, 0xac,
-	0x09, 0x0c, 0xbd, 0x07, 0x8d, 0x6e, 0xd4, 0x7f, 0x44, 0xae, 0xc3, 0x16, 0x15, 0x3e, 0x1e, 0xaa,
-	0x84, 0x58, 0x93, 0x60, 0x9c, 0xd2, 0x1e, 0x75, 0x19, 0x16, 0x51, 0x40, 0x8c, 0x45, 0x55, 0x9b,
-	0x31, 0x80, 0x36, 0xa1, 0x6a, 0xf1, 0x88, 0x39, 0xc6, 0xb2, 0x4c, 0xb6, 0x12, 0xd0, 0x36, 0x2c,
-	0xc7, 0x91, 0xe4, 0x7d, 0x6b, 0xd2, 0x64, 0x2c, 0xc7, 0x16, 0xa7, 0x9c, 0xd9, 0xc4, 0x00, 0x65,
-	0x21, 0x05, 0xc4, 0x61, 0xed, 0xd0, 0xb6, 0x23, 0x3f, 0xf2, 0xb0, 0x20, 0xce, 0x09, 0x21, 0xa1,
-	0xb1, 0x32, 0xcb, 0xf2, 0x14, 0xbd, 0xa3, 0x0b, 0x68, 0xb4, 0xc9, 0x25, 0xf1, 0xf8, 0x90, 0x04,
-	0x32, 0xdc, 0xea, 0x2c, 0xc3, 0x4d, 0xfa, 0x46, 0x07, 0xb0, 0x79, 0x1a, 0xf9, 0x5d, 0xc2, 0x1c,
-	0xca, 0xdc, 0x71, 0xad, 0x42, 0xa3, 0xbe, 0xa3, 0xed, 0x36, 0xac, 0x52, 0x1d, 0x7a, 0x00, 0x77,
-	0x1e, 0xe3, 0x50, 0x74, 0x98, 0xed, 0x45, 0x0e, 0x71, 0x9e, 0x10, 0x81, 0x55, 0xde, 0x1a, 0x32,
-	0x6f, 0xe5, 0xca, 0x78, 0xc6, 0x64, 0x43, 0x74, 0xda, 0x72, 0xc6, 0x1a, 0x56, 0x2a, 0xc6, 0x9a,
-	0xb3, 0x6f, 0x8e, 0x78, 0xc4, 0x84, 0xb1, 0xa4, 0x34, 0x89, 0xd8, 0xfc, 0x7b, 0x0e, 0x36, 0x8e,
-	0x87, 0xdc, 0x1e, 0xf4, 0x04, 0x0e, 0x44, 0xd6, 0xb7, 0xb7, 0xfb, 0xda, 0x84, 0xaa, 0x34, 0x90,
-	0xc5, 0x6d, 0x58, 0x4a, 0xc8, 0x7a, 0x61, 0x29, 0xdf, 0x0b, 0xe3, 0x7a, 0x2f, 0xe7, 0xeb, 0xfd,
-	0x7f, 0x33, 0xb1, 0x0d, 0xcb, 0x16, 0xe7, 0x42, 0x6a, 0xe7, 0x55, 0x07, 0xa5, 0x72, 0x9c, 0x99,
-	0x13, 0x1a, 0x84, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c, 0xa1,
-	0x8c, 0x86, 0x03, 0x95, 0x32, 0x65, 0xa5, 0xba, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x6e, 0xb1, 0x34,
-	0xe9, 0x74, 0x2e, 0xbe, 0xc1, 0x74, 0xde, 0x66, 0xdc, 0xfc, 0x71, 0x11, 0x6a, 0xc7, 0x36, 0x67,
-	0xdc, 0xa7, 0x76, 0x18, 0x2f, 0xa6, 0x33, 0x2e, 0xb0, 0xd7, 0x8b, 0x86, 0x43, 0xef, 0x5a, 0x6d,
-	0xc7, 0x99, 0x2d, 0xa6, 0x9c, 0x67, 0x14, 0xc2, 0xba, 0x14, 0xcf, 0x78, 0x9b, 0x86, 0x22, 0xa0,
-	0xfd, 0x48, 0x10, 0x95, 0xfd, 0x59, 0x85, 0x9b, 0xf6, 0x8f, 0xbe, 0x06, 0x5d, 0x82, 0xa7, 0xe4,
-	0xca, 0xbb, 0x7e, 0x42, 0x99, 0x20, 0x8e, 0xaa, 0xe9, 0xac, 0x62, 0x4e, 0xb9, 0x47, 0xcf, 0x60,
-	0xcb, 0x22, 0x57, 0x38, 0x70, 0xc2, 0x2e, 0x09, 0x64, 0xe2, 0xbb, 0x24, 0x38, 0xe5, 0x0e, 0x51,
-	0x3d, 0x32, 0xab, 0xc0, 0xb7, 0x04, 0x41, 0x57, 0xb0, 0x91, 0x68, 0x4e, 0x78, 0x70, 0xc4, 0x7d,
-	0x3f, 0x62, 0x54, 0x5c, 0xcf, 0xf6, 0xc1, 0x29, 0x8b, 0x80, 0x6c, 0xa8, 0xc5, 0x3f, 0xa0, 0x1b,
-	0x50, 0x3b, 0x59, 0xd6, 0xb3, 0x0a, 0x97, 0xf9, 0x45, 0x1f, 0xc2, 0x46, 0xbc, 0xcd, 0xb3, 0x95,
-	0x91, 0x9f, 0xfa, 0x32, 0x15, 0xda, 0x03, 0x34, 0x09, 0xcb, 0xb9, 0x5e, 0x96, 0x83, 0x57, 0xa2,
-	0x69, 0xfe, 0xa0, 0x01, 0x64, 0x10, 0x3a, 0x83, 0xcd, 0x64, 0x3a, 0xb1, 0x47, 0xbf, 0x25, 0x4e,
-	0x3a, 0x81, 0x9a, 0x9c, 0xc0, 0xed, 0x64, 0x02, 0x4b, 0x56, 0x58, 0x32, 0x85, 0xa5, 0xd6, 0xe8,
-	0x41, 0x6e, 0x02, 0xe5, 0x0c, 0xd4, 0x0f, 0xf4, 0xd4, 0x55, 0x8a, 0x27, 0x0e, 0xb2, 0x83, 0xcd,
-	0xdf, 0x6a, 0x50, 0xcb, 0xd6, 0xc3, 0x78, 0xb9, 0x69, 0xf9, 0xe5, 0x36, 0x5e, 0x8f, 0x73, 0xa5,
-	0xeb, 0x71, 0x3e, 0xbf, 0x1e, 0xff, 0x9b, 0xb1, 0x3c, 0x48, 0x78, 0x44, 0x87, 0x3d, 0xe5, 0x46,
-	0x55, 0x5e, 0x37, 0xfd, 0x8d, 0xc5, 0x4b, 0x66, 0x07, 0xd1, 0x47, 0x8a, 0x74, 0x49, 0x23, 0xb5,
-	0xa5, 0xd6, 0x72, 0x94, 0x29, 0x67, 0x33, 0x3e, 0x36, 0xf9, 0xca, 0x2f, 0x15, 0x5f, 0xf9, 0x5d,
-	0x58, 0x7b, 0x2c, 0xb3, 0x96, 0x9d, 0x51, 0xc5, 0x2b, 0xc2, 0xd3, 0x9c, 0xa2, 0x56, 0xc6, 0x29,
-	0xf2, 0xfc, 0x00, 0x0a, 0xfc, 0xa0, 0xc8, 0x5c, 0xea, 0x25, 0xcc, 0x25, 0x7e, 0x1d, 0x52, 0xfd,
-	0x4a, 0xf2, 0x3a, 0xe4, 0x75, 0xe9, 0xcb, 0xd1, 0x28, 0xbc, 0x1c, 0x9f, 0xc0, 0xd6, 0x39, 0xf6,
-	0xa8, 0x83, 0x05, 0x0f, 0x7a, 0x02, 0x8b, 0x70, 0x7c, 0x52, 0xbe, 0xfe, 0xd6, 0x2d, 0x5a, 0xf4,
-	0x10, 0xf4, 0xa9, 0xf5, 0xaf, 0xbf, 0xc1, 0xfa, 0xd7, 0xcb, 0x78, 0x99, 0x45, 0x6c, 0x42, 0x87,
-	0x22, 0x94, 0x71, 0xd7, 0xd5, 0xed, 0xf2, 0x18, 0xfa, 0x34, 0xdf, 0xfc, 0x06, 0x92, 0x9d, 0xb9,
-	0x3e, 0xd5, 0xe4, 0x49, 0x88, 0xfc, 0x9c, 0x18, 0xb0, 0x74, 0x34, 0xc0, 0x94, 0x75, 0xda, 0xc6,
-	0x86, 0x22, 0xd8, 0x89, 0x18, 0x17, 0xb0, 0xc7, 0x9f, 0x8a, 0x2b, 0x1c, 0x90, 0x73, 0x12, 0x84,
-	0x31, 0x97, 0xde, 0x54, 0x05, 0x2c, 0xc0, 0x65, 0x44, 0xec, 0xce, 0x5b, 0x25, 0x62, 0xcf, 0x60,
-	0xab, 0x00, 0x75, 0x98, 0x9a, 0x9e, 0xad, 0x99, 0xae, 0xea, 0xf2, 0x20, 0xd3, 0x3c, 0xf0, 0xee,
-	0x5b, 0xe4, 0x81, 0x3e, 0xac, 0xb6, 0xc9, 0x65, 0xfe, 0x8e, 0xc6, 0x2c, 0xa3, 0x15, 0x9c, 0xe7,
-	0x29, 0xdf, 0x3b, 0x13, 0x94, 0xef, 0x83, 0x9f, 0x34, 0x80, 0xec, 0xf3, 0x09, 0xad, 0x43, 0xa3,
-	0xc3, 0x2e, 0xe3, 0xde, 0x57, 0x80, 0x5e, 0x41, 0x9b, 0xa0, 0xc7, 0x07, 0x2c, 0xe2, 0xc6, 0x0f,
-	0x39, 0x96, 0xa8, 0x16, 0x1f, 0x8c, 0xd1, 0x2f, 0x59, 0x28, 0xf0, 0x05, 0x65, 0xae, 0x3e, 0x87,
-	0xb6, 0x00, 0xc9, 0xad, 0x42, 0x82, 0xfc, 0xd1, 0x79, 0xb4, 0xaa, 0x22, 0x7c, 0x81, 0xa9, 0x47,
-	0x1c, 0x7d, 0x01, 0xe9, 0xb0, 0xa2, 0x4c, 0x13, 0xa4, 0x8a, 0xd6, 0xa0, 0x1e, 0x23, 0x3d, 0x0f,
-	0xc7, 0x9c, 0x4b, 0x5f, 0x4c, 0x01, 0x2b, 0x5e, 0x7e, 0x17, 0x44, 0x5f, 0x6a, 0x7d, 0xfe, 0xe2,
-	0x95, 0x59, 0x79, 0xf9, 0xca, 0xac, 0xbc, 0x7e, 0x65, 0x6a, 0xdf, 0x8d, 0x4c, 0xed, 0xe7, 0x91,
-	0xa9, 0x3d, 0x1f, 0x99, 0xda, 0x8b, 0x91, 0xa9, 0xbd, 0x1c, 0x99, 0xda, 0x9f, 0x23, 0x53, 0xfb,
-	0x6b, 0x64, 0x56, 0x5e, 0x8f, 0x4c, 0xed, 0xfb, 0x1b, 0xb3, 0xf2, 0xe2, 0xc6, 0xac, 0xbc, 0xbc,
-	0x31, 0x2b, 0x5f, 0x55, 0xe5, 0x57, 0x68, 0x7f, 0x51, 0x4e, 0xcd, 0xc7, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xc3, 0xaf, 0xe2, 0x0c, 0xdc, 0x0e, 0x00, 0x00,
+	// 1287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x17, 0x2d, 0xcb, 0xb2, 0x46, 0x92, 0x4d, 0xaf, 0x1d, 0x87, 0x9f, 0xf1, 0x81, 0x11, 0x84,
+	0x1e, 0xd4, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xfe, 0x8b, 0xa8, 0x49, 0x0c, 0x81,
+	0x72, 0x7d, 0xe8, 0x6d, 0x45, 0x8e, 0xa5, 0x85, 0x29, 0xae, 0x42, 0x2e, 0xed, 0xba, 0x40, 0x80,
+	0x3e, 0x42, 0x8f, 0x7d, 0x80, 0x1e, 0x8a, 0xf4, 0x45, 0x72, 0xcc, 0x31, 0xa7, 0xb6, 0x51, 0x2e,
+	0x3d, 0xa6, 0x40, 0x81, 0x5e, 0x8b, 0x5d, 0x92, 0x22, 0x25, 0xd3, 0x6d, 0x0e, 0xca, 0xc9, 0x9e,
+	0x99, 0x9d, 0x19, 0xee, 0xec, 0xcc, 0x6f, 0x7e, 0x82, 0xd5, 0x21, 0x0a, 0xba, 0xef, 0x72, 0xfb,
+	0x62, 0x7b, 0xe4, 0x73, 0xc1, 0x49, 0x49, 0xfd, 0xd9, 0xba, 0xdf, 0x67, 0x62, 0x10, 0xf6, 0xb6,
+	0x6d, 0x3e, 0xdc, 0xe9, 0xf3, 0x3e, 0xdf, 0x51, 0xea, 0x5e, 0x78, 0xae, 0x24, 0x25, 0xa8, 0xff,
+	0x22, 0xaf, 0xad, 0x6a, 0x2f, 0x0d, 0xd1, 0xfc, 0x4b, 0x83, 0xe5, 0x0e, 0xa2, 0x7f, 0x48, 0x05,
+	0x25, 0x06, 0x94, 0xf7, 0x1c, 0xc7, 0xc7, 0x20, 0x30, 0xb4, 0x86, 0xd6, 0xaa, 0x59, 0x89, 0x48,
+	0xfe, 0x0f, 0x95, 0x4e, 0xd8, 0x73, 0x99, 0xfd, 0x08, 0xaf, 0x8d, 0x05, 0x65, 0x4b, 0x15, 0xe4,
+	0x43, 0x58, 0xda, 0xb3, 0x05, 0xe3, 0x9e, 0x51, 0x6c, 0x68, 0xad, 0x95, 0xdd, 0xb5, 0x28, 0xf8,
+	0xb6, 0x0c, 0x1c, 0x19, 0xac, 0xf8, 0x80, 0x0c, 0x74, 0xca, 0x86, 0xd8, 0x15, 0x74, 0x38, 0x32,
+	0x16, 0x1b, 0x5a, 0x6b, 0xd1, 0x4a, 0x15, 0xa4, 0x0f, 0xd5, 0x33, 0xea, 0x86, 0x78, 0x30, 0xa0,
+	0x5e, 0x1f, 0x8d, 0x92, 0x4c, 0xb4, 0x7f, 0xf4, 0xfc, 0xb7, 0x7b, 0x7b, 0x43, 0x2a, 0x06, 0x3b,
+	0x3d, 0xd6, 0xdf, 0x6e, 0x7b, 0xe2, 0x8b, 0xcc, 0x7d, 0x8f, 0x5c, 0x9f, 0x7b, 0xce, 0x09, 0x8a,
+	0x2b, 0xee, 0x5f, 0xec, 0xa0, 0x92, 0xee, 0xf7, 0xf9, 0x8e, 0x43, 0x05, 0xdd, 0xde, 0x67, 0xfd,
+	0xb6, 0x27, 0x0e, 0x68, 0x20, 0xd0, 0xb7, 0xb2, 0x91, 0x9b, 0xbf, 0x94, 0xa0, 0xd2, 0x1d, 0x50,
+	0xdf, 0x51, 0xf7, 0x36, 0x01, 0x1e, 0x22, 0x75, 0xd0, 0x7f, 0x48, 0x83, 0x41, 0x7c, 0xbd, 0x8c,
+	0x86, 0x58, 0x70, 0x47, 0x1d, 0x7e, 0xc2, 0x3c, 0xa6, 0xea, 0x1f, 0xd9, 0x02, 0xa3, 0xd8, 0x28,
+	0xb6, 0xaa, 0xbb, 0x9b, 0xf1, 0x75, 0x67, 0xcc, 0xfb, 0x8b, 0x2f, 0x7e, 0xbd, 0x57, 0xb0, 0xf2,
+	0x5d, 0x49, 0x13, 0x6a, 0x1d, 0x1f, 0x2f, 0x2d, 0xea, 0x39, 0x5d, 0x44, 0x47, 0xd5, 0xa2, 0x66,
+	0x4d, 0xe9, 0xc8, 0x07, 0x50, 0xef, 0x84, 0xbd, 0x47, 0x78, 0x1d, 0xec, 0x33, 0x31, 0xa4, 0xa3,
+	0xa8, 0x20, 0xd6, 0xb4, 0x52, 0x96, 0xb4, 0xcb, 0xfa, 0x1e, 0x15, 0xa1, 0x8f, 0xc6, 0x52, 0xf4,
+	0x36, 0x13, 0x05, 0xd9, 0x80, 0x92, 0xc5, 0x43, 0xcf, 0x31, 0x96, 0x55, 0xb1, 0x23, 0x81, 0x6c,
+	0xc1, 0xb2, 0xcc, 0xa4, 0xee, 0x5b, 0x51, 0x2e, 0x13, 0x59, 0x7a, 0x9c, 0x70, 0xcf, 0x46, 0x03,
+	0x22, 0x0f, 0x25, 0x10, 0x0e, 0xab, 0x7b, 0xb6, 0x1d, 0x0e, 0x43, 0x97, 0x0a, 0x74, 0x8e, 0x11,
+	0x03, 0xa3, 0x36, 0xcf, 0xe7, 0x99, 0x8d, 0x4e, 0x2e, 0xa0, 0x7e, 0x88, 0x97, 0xe8, 0xf2, 0x11,
+	0xfa, 0x2a, 0xdd, 0xca, 0x3c, 0xd3, 0x4d, 0xc7, 0x26, 0xbb, 0xb0, 0x71, 0x12, 0x0e, 0x3b, 0xe8,
+	0x39, 0xcc, 0xeb, 0x4f, 0xde, 0x2a, 0x30, 0xaa, 0x0d, 0xad, 0x55, 0xb7, 0x72, 0x6d, 0xe4, 0x01,
+	0xdc, 0x79, 0x4c, 0x03, 0xd1, 0xf6, 0x6c, 0x37, 0x74, 0xd0, 0x79, 0x82, 0x82, 0x46, 0x75, 0xab,
+	0xab, 0xba, 0xe5, 0x1b, 0xe5, 0x8c, 0xa9, 0x86, 0x68, 0x1f, 0xaa, 0x19, 0xab, 0x5b, 0x89, 0x28,
+	0x2d, 0xa7, 0xdf, 0x1e, 0xf0, 0xd0, 0x13, 0x46, 0x39, 0xb2, 0xc4, 0x62, 0xf3, 0xcf, 0x05, 0x58,
+	0x3f, 0x1a, 0x71, 0x7b, 0xd0, 0x15, 0xd4, 0x17, 0x69, 0xdf, 0xde, 0x1e, 0x6b, 0x03, 0x4a, 0xca,
+	0x41, 0x3d, 0x6e, 0xdd, 0x8a, 0x84, 0xb4, 0x17, 0xca, 0xd9, 0x5e, 0x98, 0xbc, 0xf7, 0x72, 0xf6,
+	0xbd, 0xff, 0x6b, 0x26, 0xb6, 0x60, 0xd9, 0xe2, 0x5c, 0x28, 0x6b, 0x31, 0xea, 0xa0, 0x44, 0x96,
+	0x95, 0x39, 0x66, 0x7e, 0x20, 0x92, 0x9a, 0x25, 0xb0, 0x15, 0x37, 0x79, 0xbe, 0x31, 0xa9, 0xe7,
+	0x31, 0xf3, 0x58, 0x30, 0x40, 0x67, 0x62, 0x88, 0xbb, 0x3e, 0xdf, 0x48, 0xce, 0xe0, 0xee, 0xec,
+	0xd3, 0x24, 0xd3, 0xb9, 0xf4, 0x0e, 0xd3, 0x79, 0x9b, 0x73, 0xf3, 0x79, 0x19, 0x2a, 0x47, 0x36,
+	0xf7, 0xf8, 0x90, 0xd9, 0x81, 0x04, 0xa6, 0x53, 0x2e, 0xa8, 0xdb, 0x0d, 0x47, 0x23, 0xf7, 0xda,
+	0xd0, 0xe6, 0xd9, 0x8a, 0xd9, 0xc8, 0x24, 0x80, 0x35, 0x25, 0x9e, 0xf2, 0x43, 0x16, 0x08, 0x9f,
+	0xf5, 0x42, 0x81, 0xc6, 0xc2, 0x3c, 0xd3, 0xdd, 0x8c, 0x4f, 0x9e, 0x82, 0xae, 0x94, 0x27, 0x78,
+	0xe5, 0x5e, 0x3f, 0x61, 0x9e, 0x40, 0xc7, 0x28, 0xce, 0x33, 0xe7, 0x8d, 0xf0, 0xe4, 0x19, 0x6c,
+	0x5a, 0x78, 0x45, 0x7d, 0x27, 0xe8, 0xa0, 0xaf, 0x0a, 0xdf, 0x41, 0xff, 0x84, 0x3b, 0x68, 0x2c,
+	0xce, 0x33, 0xf1, 0x2d, 0x49, 0xc8, 0x15, 0xac, 0xc7, 0x96, 0x63, 0xee, 0x1f, 0xf0, 0xe1, 0x30,
+	0xf4, 0x98, 0xb8, 0x9e, 0xef, 0xc2, 0xc9, 0xcb, 0x40, 0x6c, 0xa8, 0xc8, 0x0f, 0xe8, 0xf8, 0xcc,
+	0x8e, 0xc1, 0x7a, 0x5e, 0xe9, 0xd2, 0xb8, 0xe4, 0x63, 0x58, 0x97, 0x68, 0x9e, 0x42, 0x46, 0x76,
+	0xea, 0xf3, 0x4c, 0x64, 0x1b, 0xc8, 0xb4, 0x5a, 0xcd, 0xf5, 0xb2, 0x1a, 0xbc, 0x1c, 0x4b, 0x3a,
+	0x0f, 0x82, 0x5e, 0xa0, 0x63, 0x54, 0xe6, 0x79, 0x91, 0x6c, 0x64, 0xb9, 0x02, 0xdb, 0xde, 0xb9,
+	0x4b, 0x15, 0x89, 0xa0, 0x22, 0x59, 0x4a, 0xd3, 0xca, 0xe6, 0x8f, 0x1a, 0x40, 0xfa, 0x85, 0xe4,
+	0x14, 0x36, 0x62, 0xb0, 0xa0, 0x2e, 0xfb, 0x0e, 0x9d, 0x04, 0x10, 0x34, 0x05, 0x08, 0x5b, 0x31,
+	0x20, 0xe4, 0x20, 0x6a, 0x0c, 0x0a, 0xb9, 0xde, 0xe4, 0x41, 0x06, 0x10, 0xd4, 0x48, 0x56, 0x77,
+	0xf5, 0x24, 0x54, 0xa2, 0x8f, 0x03, 0xa4, 0x07, 0x9b, 0x7f, 0x57, 0xa0, 0x92, 0xa2, 0xd5, 0x04,
+	0x6b, 0xb5, 0x2c, 0xd6, 0x4e, 0xd0, 0x7a, 0x21, 0x17, 0xad, 0x8b, 0x59, 0xb4, 0xfe, 0x77, 0x02,
+	0xf5, 0x20, 0xa6, 0x35, 0x6d, 0xef, 0x9c, 0x1b, 0xa5, 0x46, 0x31, 0xf3, 0x8d, 0xb3, 0x97, 0x4c,
+	0x0f, 0x92, 0x4f, 0x22, 0x0e, 0xa8, 0x9c, 0x22, 0xd0, 0x5c, 0xcd, 0x30, 0xb8, 0x8c, 0xcf, 0xe4,
+	0xd8, 0x34, 0xe9, 0x28, 0xcf, 0x92, 0x8e, 0x16, 0xac, 0x3e, 0x56, 0x55, 0x4b, 0xcf, 0x44, 0xbd,
+	0x34, 0xab, 0xbe, 0x49, 0x71, 0x2a, 0x79, 0x14, 0x27, 0x4b, 0x57, 0x60, 0x86, 0xae, 0xcc, 0x12,
+	0xa9, 0x6a, 0x0e, 0x91, 0x92, 0xcb, 0x2a, 0xb1, 0xd7, 0xe2, 0x65, 0x95, 0xb5, 0x25, 0x8b, 0xac,
+	0x3e, 0xb3, 0xc8, 0x3e, 0x83, 0xcd, 0x33, 0xea, 0x32, 0x87, 0x0a, 0xee, 0x77, 0x05, 0x15, 0xc1,
+	0xe4, 0xa4, 0x22, 0x23, 0xd6, 0x2d, 0x56, 0xf2, 0x10, 0xf4, 0x1b, 0xdb, 0x48, 0x7f, 0x87, 0x6d,
+	0xa4, 0xe7, 0xd1, 0x44, 0x0b, 0x6d, 0x64, 0x23, 0x11, 0xa8, 0xbc, 0x6b, 0xd1, 0xed, 0xb2, 0x3a,
+	0xf2, 0x79, 0xb6, 0xf9, 0x0d, 0xa2, 0x3a, 0x73, 0xed, 0x46, 0x93, 0xc7, 0x29, 0xb2, 0x73, 0x62,
+	0x40, 0xf9, 0x60, 0x40, 0x99, 0xd7, 0x3e, 0x34, 0xd6, 0x23, 0xbe, 0x1f, 0x8b, 0xf2, 0x01, 0xbb,
+	0xfc, 0x5c, 0x5c, 0x51, 0x1f, 0xcf, 0xd0, 0x0f, 0x24, 0xb5, 0xdf, 0x88, 0x1e, 0x70, 0x46, 0x9d,
+	0xc7, 0x0b, 0xef, 0xbc, 0x57, 0x5e, 0xf8, 0x0c, 0x36, 0x67, 0x54, 0x6d, 0x2f, 0x9a, 0x9e, 0xcd,
+	0xb9, 0x6e, 0x8e, 0xfc, 0x24, 0x37, 0x69, 0xe9, 0xdd, 0xf7, 0x48, 0x4b, 0x87, 0xb0, 0x72, 0x88,
+	0x97, 0xd9, 0x3b, 0x1a, 0xf3, 0xcc, 0x36, 0x13, 0x3c, 0xcb, 0x40, 0xff, 0x37, 0xc5, 0x40, 0x25,
+	0x3f, 0x3e, 0x7a, 0x1a, 0xb2, 0x4b, 0x6e, 0x2b, 0xd0, 0x3d, 0xba, 0x64, 0x0e, 0x4a, 0x18, 0xdb,
+	0x6a, 0x14, 0x5b, 0x35, 0x2b, 0xd7, 0xf6, 0xd1, 0x4f, 0x1a, 0x40, 0xfa, 0x0b, 0x90, 0xac, 0x49,
+	0x24, 0xbf, 0x94, 0xf3, 0x12, 0x29, 0xf4, 0x02, 0xd9, 0x00, 0x5d, 0x1e, 0xb0, 0xb0, 0x2f, 0xb9,
+	0x88, 0xf2, 0xd6, 0x35, 0x79, 0x50, 0x6a, 0xbf, 0xf6, 0x02, 0x41, 0x2f, 0x98, 0xd7, 0xd7, 0x17,
+	0xc8, 0x26, 0x10, 0x85, 0x44, 0xe8, 0x67, 0x8f, 0x16, 0xc9, 0x4a, 0x94, 0xe1, 0x2b, 0xca, 0x5c,
+	0x74, 0xf4, 0x45, 0xa2, 0x43, 0x2d, 0x72, 0x8d, 0x35, 0x25, 0xb2, 0x0a, 0x55, 0xa9, 0xe9, 0xba,
+	0x54, 0xd2, 0x46, 0x7d, 0x29, 0x51, 0x58, 0xa8, 0x16, 0x8c, 0x5e, 0xde, 0xff, 0xf2, 0xe5, 0x6b,
+	0xb3, 0xf0, 0xea, 0xb5, 0x59, 0x78, 0xfb, 0xda, 0xd4, 0xbe, 0x1f, 0x9b, 0xda, 0xcf, 0x63, 0x53,
+	0x7b, 0x31, 0x36, 0xb5, 0x97, 0x63, 0x53, 0x7b, 0x35, 0x36, 0xb5, 0xdf, 0xc7, 0xa6, 0xf6, 0xc7,
+	0xd8, 0x2c, 0xbc, 0x1d, 0x9b, 0xda, 0x0f, 0x6f, 0xcc, 0xc2, 0xcb, 0x37, 0x66, 0xe1, 0xd5, 0x1b,
+	0xb3, 0xf0, 0x4d, 0x49, 0xfd, 0x90, 0xee, 0x2d, 0xa9, 0x49, 0xfb, 0xf4, 0x9f, 0x01, 0x00, 0x24,
+	0x53, 0xc7, 0x93, 0x9f, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -1087,6 +1114,15 @@ func (this *Economics) Equal(that interface{}) bool {
 	if !bytes.Equal(this.PrevEpochStartHash, that1.PrevEpochStartHash) {
 		return false
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalStaked, that1.TotalStaked) {
+			return false
+		}
+	}
+	if this.InflationRate != that1.InflationRate {
+		return false
+	}
 	return true
 }
 func (this *EpochStart) Equal(that interface{}) bool {
@@ -1239,6 +1275,14 @@ func (this *MetaBlock) Equal(that interface{}) bool {
 	if this.TxCount != that1.TxCount {
 		return false
 	}
+	if len(this.EquivocationEvidence) != len(that1.EquivocationEvidence) {
+		return false
+	}
+	for i := range this.EquivocationEvidence {
+		if !bytes.Equal(this.EquivocationEvidence[i], that1.EquivocationEvidence[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PeerData) GoString() string {
@@ -1312,7 +1356,7 @@ func (this *Economics) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&block.Economics{")
 	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
 	s = append(s, "TotalToDistribute: "+fmt.Sprintf("%#v", this.TotalToDistribute)+",\n")
@@ -1322,6 +1366,8 @@ func (this *Economics) GoString() string {
 	s = append(s, "NodePrice: "+fmt.Sprintf("%#v", this.NodePrice)+",\n")
 	s = append(s, "PrevEpochStartRound: "+fmt.Sprintf("%#v", this.PrevEpochStartRound)+",\n")
 	s = append(s, "PrevEpochStartHash: "+fmt.Sprintf("%#v", this.PrevEpochStartHash)+",\n")
+	s = append(s, "TotalStaked: "+fmt.Sprintf("%#v", this.TotalStaked)+",\n")
+	s = append(s, "InflationRate: "+fmt.Sprintf("%#v", this.InflationRate)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1346,7 +1392,7 @@ func (this *MetaBlock) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 28)
+	s := make([]string, 0, 29)
 	s = append(s, "&block.MetaBlock{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
@@ -1390,6 +1436,7 @@ func (this *MetaBlock) GoString() string {
 	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
 	s = append(s, "DevFeesInEpoch: "+fmt.Sprintf("%#v", this.DevFeesInEpoch)+",\n")
 	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	s = append(s, "EquivocationEvidence: "+fmt.Sprintf("%#v", this.EquivocationEvidence)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1688,6 +1735,22 @@ func (m *Economics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InflationRate != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.InflationRate))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalStaked)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalStaked, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.PrevEpochStartHash) > 0 {
 		i -= len(m.PrevEpochStartHash)
 		copy(dAtA[i:], m.PrevEpochStartHash)
@@ -1836,6 +1899,17 @@ func (m *MetaBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EquivocationEvidence) > 0 {
+		for iNdEx := len(m.EquivocationEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EquivocationEvidence[iNdEx])
+			copy(dAtA[i:], m.EquivocationEvidence[iNdEx])
+			i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.EquivocationEvidence[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
 	if m.TxCount != 0 {
 		i = encodeVarintMetaBlock(dAtA, i, uint64(m.TxCount))
 		i--
@@ -2245,6 +2319,14 @@ func (m *Economics) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetaBlock(uint64(l))
 	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalStaked)
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	if m.InflationRate != 0 {
+		n += 1 + sovMetaBlock(uint64(m.InflationRate))
+	}
 	return n
 }
 
@@ -2370,6 +2452,12 @@ func (m *MetaBlock) Size() (n int) {
 	if m.TxCount != 0 {
 		n += 2 + sovMetaBlock(uint64(m.TxCount))
 	}
+	if len(m.EquivocationEvidence) > 0 {
+		for _, b := range m.EquivocationEvidence {
+			l = len(b)
+			n += 2 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2457,6 +2545,8 @@ func (this *Economics) String() string {
 		`NodePrice:` + fmt.Sprintf("%v", this.NodePrice) + `,`,
 		`PrevEpochStartRound:` + fmt.Sprintf("%v", this.PrevEpochStartRound) + `,`,
 		`PrevEpochStartHash:` + fmt.Sprintf("%v", this.PrevEpochStartHash) + `,`,
+		`TotalStaked:` + fmt.Sprintf("%v", this.TotalStaked) + `,`,
+		`InflationRate:` + fmt.Sprintf("%v", this.InflationRate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2521,6 +2611,7 @@ func (this *MetaBlock) String() string {
 		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
 		`DevFeesInEpoch:` + fmt.Sprintf("%v", this.DevFeesInEpoch) + `,`,
 		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`EquivocationEvidence:` + fmt.Sprintf("%v", this.EquivocationEvidence) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3786,6 +3877,63 @@ func (m *Economics) Unmarshal(dAtA []byte) error {
 				m.PrevEpochStartHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalStaked = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			m.InflationRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -4715,6 +4863,38 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivocationEvidence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EquivocationEvidence = append(m.EquivocationEvidence, make([]byte, postIndex-iNdEx))
+			copy(m.EquivocationEvidence[len(m.EquivocationEvidence)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -30,3 +30,6 @@ var ErrInvalidCacheSize = errors.New("cache size is invalid")
 
 // ErrInvalidValue signals that an invalid value has been provided such as NaN to an integer field
 var ErrInvalidValue = errors.New("invalid value")
+
+// ErrBlockNotFound signals that the requested block could not be found in the storage
+var ErrBlockNotFound = errors.New("block not found")
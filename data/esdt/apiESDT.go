@@ -0,0 +1,25 @@
+package esdt
+
+// ApiESDTBalance is the data transfer object which will be returned for an elrond standard digital token held by
+// an account
+type ApiESDTBalance struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Balance         string `json:"balance"`
+	Frozen          bool   `json:"frozen"`
+}
+
+// ApiESDTToken is the data transfer object which will be returned for an elrond standard digital token, as it is
+// registered in the ESDT system smart contract
+type ApiESDTToken struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Issuer          string `json:"issuer"`
+	MintedValue     string `json:"mintedValue"`
+	BurntValue      string `json:"burntValue"`
+	Supply          string `json:"supply"`
+	Mintable        bool   `json:"mintable"`
+	Burnable        bool   `json:"burnable"`
+	CanPause        bool   `json:"canPause"`
+	Paused          bool   `json:"paused"`
+	CanFreeze       bool   `json:"canFreeze"`
+	CanWipe         bool   `json:"canWipe"`
+}
@@ -20,6 +20,10 @@ const (
 // ModifiedHashes is used to memorize all old hashes and new hashes from when a trie is committed
 type ModifiedHashes map[string]struct{}
 
+// LeafHandler is called for every leaf visited while iterating the leaves of a trie. Returning an error stops the
+// iteration and the error is returned to the caller
+type LeafHandler func(key []byte, value []byte) error
+
 // HeaderHandler defines getters and setters for header data holder
 type HeaderHandler interface {
 	GetShardID() uint32
@@ -161,6 +165,8 @@ type Trie interface {
 	Database() DBWriteCacher
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeaves() (map[string][]byte, error)
+	IterateLeaves(ctx context.Context, filter LeavesFilter, handler LeafHandler) error
+	GetProof(key []byte) ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
@@ -0,0 +1,71 @@
+package data
+
+import "bytes"
+
+const (
+	nibbleSize = 4
+	nibbleMask = 0x0f
+)
+
+// LeavesFilter restricts a trie leaves iteration to the keys that start with KeyPrefix and that are inside the
+// [StartKey, EndKey) interval. The interval bounds follow the order in which the trie visits its leaves, which is the
+// order of the key nibbles read from the last one to the first one, so a trie walk can seek to StartKey and stop at
+// EndKey. Empty fields are not checked
+type LeavesFilter struct {
+	KeyPrefix []byte
+	StartKey  []byte
+	EndKey    []byte
+}
+
+// IsKeyAccepted returns true if the provided key passes all the filter's conditions
+func (lf LeavesFilter) IsKeyAccepted(key []byte) bool {
+	if !bytes.HasPrefix(key, lf.KeyPrefix) {
+		return false
+	}
+	if len(lf.StartKey) > 0 && CompareKeysInTrieOrder(key, lf.StartKey) < 0 {
+		return false
+	}
+
+	return !lf.IsAfterEnd(key)
+}
+
+// IsAfterEnd returns true if the provided key is not before EndKey, meaning that no key visited after it can be accepted
+func (lf LeavesFilter) IsAfterEnd(key []byte) bool {
+	return len(lf.EndKey) > 0 && CompareKeysInTrieOrder(key, lf.EndKey) >= 0
+}
+
+// CompareKeysInTrieOrder compares two keys in the order in which the trie visits its leaves. The trie path of a key
+// holds the key nibbles starting from the last one, followed by a terminator that comes after any nibble. The result
+// is 0 if a == b, -1 if a is visited before b and +1 if a is visited after b
+func CompareKeysInTrieOrder(a []byte, b []byte) int {
+	numNibblesA := len(a) * 2
+	numNibblesB := len(b) * 2
+	for i := 0; i < numNibblesA && i < numNibblesB; i++ {
+		nibbleA := nibbleFromEnd(a, i)
+		nibbleB := nibbleFromEnd(b, i)
+		if nibbleA < nibbleB {
+			return -1
+		}
+		if nibbleA > nibbleB {
+			return 1
+		}
+	}
+
+	switch {
+	case numNibblesA == numNibblesB:
+		return 0
+	case numNibblesA < numNibblesB:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func nibbleFromEnd(key []byte, index int) byte {
+	keyByte := key[len(key)-1-index/2]
+	if index%2 == 0 {
+		return keyByte & nibbleMask
+	}
+
+	return keyByte >> nibbleSize
+}
@@ -0,0 +1,73 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeavesFilter_EmptyFilterAcceptsAllKeys(t *testing.T) {
+	t.Parallel()
+
+	lf := data.LeavesFilter{}
+
+	assert.True(t, lf.IsKeyAccepted(nil))
+	assert.True(t, lf.IsKeyAccepted([]byte("key")))
+}
+
+func TestLeavesFilter_KeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	lf := data.LeavesFilter{KeyPrefix: []byte("ELRONDesdt")}
+
+	assert.True(t, lf.IsKeyAccepted([]byte("ELRONDesdtTKN-0a1b2c")))
+	assert.True(t, lf.IsKeyAccepted([]byte("ELRONDesdt")))
+	assert.False(t, lf.IsKeyAccepted([]byte("ELROND")))
+	assert.False(t, lf.IsKeyAccepted([]byte("user key")))
+}
+
+func TestLeavesFilter_KeyInterval(t *testing.T) {
+	t.Parallel()
+
+	lf := data.LeavesFilter{
+		StartKey: []byte("b"),
+		EndKey:   []byte("d"),
+	}
+
+	assert.False(t, lf.IsKeyAccepted([]byte("a")))
+	assert.True(t, lf.IsKeyAccepted([]byte("b")))
+	assert.True(t, lf.IsKeyAccepted([]byte("c")))
+	assert.True(t, lf.IsKeyAccepted([]byte("zzc")))
+	assert.False(t, lf.IsKeyAccepted([]byte("czzz")))
+	assert.False(t, lf.IsKeyAccepted([]byte("d")))
+	assert.False(t, lf.IsKeyAccepted([]byte("e")))
+	assert.True(t, lf.IsAfterEnd([]byte("d")))
+	assert.False(t, lf.IsAfterEnd([]byte("c")))
+}
+
+func TestCompareKeysInTrieOrder(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, data.CompareKeysInTrieOrder([]byte{0x12, 0x34}, []byte{0x12, 0x34}))
+	assert.Equal(t, -1, data.CompareKeysInTrieOrder([]byte{0x12, 0x34}, []byte{0x11, 0x35}))
+	assert.Equal(t, 1, data.CompareKeysInTrieOrder([]byte{0x12, 0x34}, []byte{0x13, 0x24}))
+	assert.Equal(t, -1, data.CompareKeysInTrieOrder([]byte{0x12, 0x34}, []byte{0x34}))
+	assert.Equal(t, 1, data.CompareKeysInTrieOrder([]byte{0x34}, []byte{0x12, 0x34}))
+	assert.Equal(t, 1, data.CompareKeysInTrieOrder(nil, []byte{0x34}))
+}
+
+func TestLeavesFilter_AllConditions(t *testing.T) {
+	t.Parallel()
+
+	lf := data.LeavesFilter{
+		KeyPrefix: []byte("k"),
+		StartKey:  []byte("k2"),
+		EndKey:    []byte("k5"),
+	}
+
+	assert.False(t, lf.IsKeyAccepted([]byte("k1")))
+	assert.True(t, lf.IsKeyAccepted([]byte("k3")))
+	assert.False(t, lf.IsKeyAccepted([]byte("k7")))
+	assert.False(t, lf.IsKeyAccepted([]byte("j3")))
+}
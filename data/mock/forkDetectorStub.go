@@ -0,0 +1,20 @@
+package mock
+
+// ForkDetectorStub -
+type ForkDetectorStub struct {
+	GetHighestFinalBlockNonceCalled func() uint64
+}
+
+// GetHighestFinalBlockNonce -
+func (fds *ForkDetectorStub) GetHighestFinalBlockNonce() uint64 {
+	if fds.GetHighestFinalBlockNonceCalled != nil {
+		return fds.GetHighestFinalBlockNonceCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (fds *ForkDetectorStub) IsInterfaceNil() bool {
+	return fds == nil
+}
@@ -0,0 +1,14 @@
+package state
+
+// AccountQueryOptions holds the optional parameters of an account query. When a block nonce or a block hash is set,
+// the query is answered from the state found under the root hash of that block instead of the current state
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsHistorical returns true if the query should be answered from the state of a past block
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -561,30 +562,30 @@ func (adb *AccountsDB) RecreateAllTries(rootHash []byte) (map[string]data.Trie,
 		return nil, err
 	}
 
-	leafs, err := recreatedTrie.GetAllLeaves()
-	if err != nil {
-		return nil, err
-	}
-
 	allTries := make(map[string]data.Trie)
 	allTries[string(rootHash)] = recreatedTrie
 
-	for _, leaf := range leafs {
+	err = recreatedTrie.IterateLeaves(context.Background(), data.LeavesFilter{}, func(_ []byte, value []byte) error {
 		account := &userAccount{}
-		err = adb.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := adb.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
 		}
 
 		if len(account.RootHash) > 0 {
-			dataTrie, err := adb.mainTrie.Recreate(account.RootHash)
-			if err != nil {
-				return nil, err
+			dataTrie, errRecreate := adb.mainTrie.Recreate(account.RootHash)
+			if errRecreate != nil {
+				return errRecreate
 			}
 
 			allTries[string(account.RootHash)] = dataTrie
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return allTries, nil
@@ -636,23 +637,22 @@ func (adb *AccountsDB) SnapshotState(rootHash []byte) {
 }
 
 func (adb *AccountsDB) snapshotUserAccountDataTrie(rootHash []byte) {
-	leafs, err := adb.GetAllLeaves(rootHash)
-	if err != nil {
-		log.Error("incomplete snapshot as getAllLeaves error", "error", err)
-		return
-	}
-
-	for _, leaf := range leafs {
+	err := adb.IterateLeaves(context.Background(), rootHash, data.LeavesFilter{}, func(_ []byte, value []byte) error {
 		account := &userAccount{}
-		err = adb.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := adb.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
 		}
 
 		if len(account.RootHash) > 0 {
 			adb.mainTrie.SetCheckpoint(account.RootHash)
 		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error("incomplete snapshot as iterateLeaves error", "error", err)
 	}
 }
 
@@ -678,23 +678,143 @@ func (adb *AccountsDB) IsPruningEnabled() bool {
 
 // GetAllLeaves returns all the leaves from a given rootHash
 func (adb *AccountsDB) GetAllLeaves(rootHash []byte) (map[string][]byte, error) {
+	allAccounts := make(map[string][]byte)
+	err := adb.IterateLeaves(context.Background(), rootHash, data.LeavesFilter{}, func(key []byte, value []byte) error {
+		allAccounts[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allAccounts, nil
+}
+
+// IterateLeaves calls the handler for every leaf accepted by the filter from the main trie that has the given root
+// hash. The accounts DB is locked only while the trie is recreated, so the handler can call back into the accounts DB
+func (adb *AccountsDB) IterateLeaves(ctx context.Context, rootHash []byte, filter data.LeavesFilter, handler data.LeafHandler) error {
+	adb.mutOp.Lock()
+	newTrie, err := adb.recreateTrieAtRootHash(rootHash)
+	adb.mutOp.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return newTrie.IterateLeaves(ctx, filter, handler)
+}
+
+// GetProof returns the proof of the given address in the main trie that has the given root hash
+func (adb *AccountsDB) GetProof(rootHash []byte, address []byte) ([][]byte, error) {
 	adb.mutOp.Lock()
 	defer adb.mutOp.Unlock()
 
-	newTrie, err := adb.mainTrie.Recreate(rootHash)
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w in GetProof", ErrNilAddress)
+	}
+
+	mainTrie, err := adb.recreateTrieAtRootHash(rootHash)
 	if err != nil {
 		return nil, err
 	}
-	if newTrie == nil {
-		return nil, ErrNilTrie
+
+	return mainTrie.GetProof(address)
+}
+
+// GetDataTrieProof returns the proof of the given address in the main trie that has the given root hash, together
+// with the data trie root hash of that account and the proof of the given key in its data trie. An account without
+// data trie returns an empty data trie proof
+func (adb *AccountsDB) GetDataTrieProof(rootHash []byte, address []byte, key []byte) (*DataTrieProof, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w in GetDataTrieProof", ErrNilAddress)
 	}
 
-	allAccounts, err := newTrie.GetAllLeaves()
+	mainTrie, err := adb.recreateTrieAtRootHash(rootHash)
 	if err != nil {
 		return nil, err
 	}
 
-	return allAccounts, nil
+	val, err := mainTrie.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, ErrAccNotFound
+	}
+
+	accountProof, err := mainTrie.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	acnt, err := adb.accountFactory.CreateAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	err = adb.marshalizer.Unmarshal(acnt, val)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAcc, ok := acnt.(baseAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	proof := &DataTrieProof{
+		AccountProof:     accountProof,
+		DataTrieRootHash: baseAcc.GetRootHash(),
+		DataTrieProof:    make([][]byte, 0),
+	}
+	if len(proof.DataTrieRootHash) == 0 {
+		return proof, nil
+	}
+
+	dataTrie, err := adb.recreateTrieAtRootHash(proof.DataTrieRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.DataTrieProof, err = dataTrie.GetProof(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// RecreateReadOnlyAccounts returns a read only view over the accounts that were committed under the given root hash.
+// The view has its own trie, so the current state is not changed. An error is returned if the root hash is no longer
+// available, as it happens with the old states when pruning is enabled
+func (adb *AccountsDB) RecreateReadOnlyAccounts(rootHash []byte) (AccountsAdapter, error) {
+	adb.mutOp.Lock()
+	newTrie, err := adb.recreateTrieAtRootHash(rootHash)
+	adb.mutOp.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRootHashNotAvailable, err.Error())
+	}
+
+	accountsDB, err := NewAccountsDB(newTrie, adb.hasher, adb.marshalizer, adb.accountFactory)
+	if err != nil {
+		return nil, err
+	}
+	accountsDB.lastRootHash = rootHash
+
+	return &readOnlyAccountsDB{AccountsDB: accountsDB}, nil
+}
+
+func (adb *AccountsDB) recreateTrieAtRootHash(rootHash []byte) (data.Trie, error) {
+	newTrie, err := adb.mainTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(newTrie) {
+		return nil, ErrNilTrie
+	}
+
+	return newTrie, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -2,6 +2,7 @@ package state_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -921,22 +922,203 @@ func TestAccountsDB_GetAllLeaves(t *testing.T) {
 	t.Parallel()
 
 	recreateCalled := false
-	getAllLeavesCalled := false
 	trieStub := &mock.TrieStub{
 		RecreateCalled: func(root []byte) (d data.Trie, err error) {
 			recreateCalled = true
 			return &mock.TrieStub{
-				GetAllLeavesCalled: func() (m map[string][]byte, err error) {
-					getAllLeavesCalled = true
-					return nil, nil
+				IterateLeavesCalled: func(_ context.Context, _ data.LeavesFilter, handler data.LeafHandler) error {
+					_ = handler([]byte("key1"), []byte("value1"))
+					return handler([]byte("key2"), []byte("value2"))
 				},
 			}, nil
 		},
 	}
 
 	adb := generateAccountDBFromTrie(trieStub)
-	_, err := adb.GetAllLeaves([]byte("root hash"))
+	leaves, err := adb.GetAllLeaves([]byte("root hash"))
 	assert.Nil(t, err)
 	assert.True(t, recreateCalled)
-	assert.True(t, getAllLeavesCalled)
+	assert.Equal(t, map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")}, leaves)
+}
+
+func TestAccountsDB_IterateLeavesWrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			return nil, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	err := adb.IterateLeaves(context.Background(), []byte("root hash"), data.LeavesFilter{}, func(_ []byte, _ []byte) error {
+		return nil
+	})
+	assert.Equal(t, state.ErrNilTrie, err)
+}
+
+func TestAccountsDB_IterateLeavesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	filter := data.LeavesFilter{KeyPrefix: []byte("prefix")}
+	iterateLeavesCalled := false
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			assert.Equal(t, rootHash, root)
+			return &mock.TrieStub{
+				IterateLeavesCalled: func(_ context.Context, providedFilter data.LeavesFilter, handler data.LeafHandler) error {
+					iterateLeavesCalled = true
+					assert.Equal(t, filter, providedFilter)
+					return handler([]byte("prefix key"), []byte("value"))
+				},
+			}, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	leaves := make(map[string][]byte)
+	err := adb.IterateLeaves(context.Background(), rootHash, filter, func(key []byte, value []byte) error {
+		// the accounts DB must not be locked while the handler is called
+		assert.Equal(t, 0, adb.JournalLen())
+
+		leaves[string(key)] = value
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, iterateLeavesCalled)
+	assert.Equal(t, map[string][]byte{"prefix key": []byte("value")}, leaves)
+}
+
+func TestAccountsDB_RecreateAllTriesShouldRecreateDataTries(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	account, _ := state.NewUserAccount([]byte("address"))
+	account.SetRootHash([]byte("data trie root hash"))
+	serializedAccount, _ := marshalizer.Marshal(account)
+
+	recreatedRootHashes := make([][]byte, 0)
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			recreatedRootHashes = append(recreatedRootHashes, root)
+			return &mock.TrieStub{
+				IterateLeavesCalled: func(_ context.Context, _ data.LeavesFilter, handler data.LeafHandler) error {
+					errHandler := handler([]byte("code hash"), []byte("code"))
+					if errHandler != nil {
+						return errHandler
+					}
+					return handler(account.AddressBytes(), serializedAccount)
+				},
+			}, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	allTries, err := adb.RecreateAllTries([]byte("root hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(allTries))
+	assert.Equal(t, [][]byte{[]byte("root hash"), []byte("data trie root hash")}, recreatedRootHashes)
+}
+
+func TestAccountsDB_GetProofNilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := generateAccountDBFromTrie(&mock.TrieStub{})
+
+	proof, err := adb.GetProof([]byte("root hash"), nil)
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, state.ErrNilAddress))
+
+	dataTrieProof, err := adb.GetDataTrieProof([]byte("root hash"), nil, []byte("key"))
+	assert.Nil(t, dataTrieProof)
+	assert.True(t, errors.Is(err, state.ErrNilAddress))
+}
+
+func TestAccountsDB_GetProofWrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			return nil, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	proof, err := adb.GetProof([]byte("root hash"), []byte("address"))
+	assert.Equal(t, state.ErrNilTrie, err)
+	assert.Nil(t, proof)
+}
+
+func TestAccountsDB_GetProofAndGetDataTrieProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, accFactory)
+
+	address := make([]byte, 32)
+	key := []byte("key")
+	value := []byte("value")
+
+	acc, _ := adb.LoadAccount(address)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(key, value)
+	_ = adb.SaveAccount(acc)
+	otherAcc, _ := adb.LoadAccount(bytes.Repeat([]byte{1}, 32))
+	_ = adb.SaveAccount(otherAcc)
+	rootHash, _ := adb.Commit()
+
+	proof, err := adb.GetProof(rootHash, address)
+	assert.Nil(t, err)
+	accountBytes, err := trie.VerifyProof(rootHash, address, proof, marsh, hsh)
+	assert.Nil(t, err)
+
+	dataTrieProof, err := adb.GetDataTrieProof(rootHash, address, key)
+	assert.Nil(t, err)
+	assert.Equal(t, proof, dataTrieProof.AccountProof)
+
+	recoveredAcc, _ := accFactory.CreateAccount(address)
+	err = marsh.Unmarshal(recoveredAcc, accountBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, recoveredAcc.(state.UserAccountHandler).GetRootHash(), dataTrieProof.DataTrieRootHash)
+
+	storedValue, err := trie.VerifyProof(dataTrieProof.DataTrieRootHash, key, dataTrieProof.DataTrieProof, marsh, hsh)
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(storedValue, value))
+
+	dataTrieProof, err = adb.GetDataTrieProof(rootHash, address, []byte("missing key"))
+	assert.Nil(t, err)
+	storedValue, err = trie.VerifyProof(dataTrieProof.DataTrieRootHash, []byte("missing key"), dataTrieProof.DataTrieProof, marsh, hsh)
+	assert.Nil(t, err)
+	assert.Nil(t, storedValue)
+}
+
+func TestAccountsDB_GetDataTrieProofMissingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, accFactory)
+
+	acc, _ := adb.LoadAccount(make([]byte, 32))
+	_ = adb.SaveAccount(acc)
+	rootHash, _ := adb.Commit()
+
+	proof, err := adb.GetDataTrieProof(rootHash, bytes.Repeat([]byte{1}, 32), []byte("key"))
+	assert.Nil(t, proof)
+	assert.Equal(t, state.ErrAccNotFound, err)
+
+	proof, err = adb.GetDataTrieProof(rootHash, make([]byte, 32), []byte("key"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(proof.AccountProof))
+	assert.Equal(t, 0, len(proof.DataTrieRootHash))
+	assert.Equal(t, 0, len(proof.DataTrieProof))
 }
@@ -0,0 +1,14 @@
+package state
+
+// ApiKeyValuePair is the data transfer object which holds a hex encoded key of an account's data trie and its value
+type ApiKeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ApiKeyValuePairs is the data transfer object which will be returned for a page of the key-value pairs of an
+// account's data trie. The next page can be requested by providing NextCursor, which is empty on the last page
+type ApiKeyValuePairs struct {
+	Pairs      []*ApiKeyValuePair `json:"pairs"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
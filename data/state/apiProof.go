@@ -0,0 +1,21 @@
+package state
+
+// ApiProof is the data transfer object which will be returned for a Merkle proof. The proof nodes are hex encoded
+// and are ordered from the root of the trie to the node where the lookup of the key ends. The proof of the account
+// is always computed against the root hash of the main trie. For a data trie key, the data trie root hash found in
+// that account and the proof of the key in the data trie are also returned
+type ApiProof struct {
+	RootHash         string   `json:"rootHash"`
+	BlockNonce       uint64   `json:"blockNonce"`
+	Proof            []string `json:"proof"`
+	DataTrieRootHash string   `json:"dataTrieRootHash,omitempty"`
+	DataTrieProof    []string `json:"dataTrieProof,omitempty"`
+}
+
+// DataTrieProof holds everything needed to check a key of a data trie against the root hash of the main trie: the
+// proof of the account in the main trie, the root hash of the data trie and the proof of the key in the data trie
+type DataTrieProof struct {
+	AccountProof     [][]byte
+	DataTrieRootHash []byte
+	DataTrieProof    [][]byte
+}
@@ -115,3 +115,9 @@ var ErrInvalidPubkeyConverterType = errors.New("invalid pubkey converter type")
 
 // ErrInvalidHash signals that the given hash is invalid
 var ErrInvalidHash = errors.New("invalid hash provided")
+
+// ErrRootHashNotAvailable signals that the state having the requested root hash is no longer available
+var ErrRootHashNotAvailable = errors.New("root hash is not available")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
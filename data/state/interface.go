@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -134,7 +135,11 @@ type AccountsAdapter interface {
 	SetStateCheckpoint(rootHash []byte)
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte) (map[string][]byte, error)
+	IterateLeaves(ctx context.Context, rootHash []byte, filter data.LeavesFilter, handler data.LeafHandler) error
 	RecreateAllTries(rootHash []byte) (map[string]data.Trie, error)
+	GetProof(rootHash []byte, address []byte) ([][]byte, error)
+	GetDataTrieProof(rootHash []byte, address []byte, key []byte) (*DataTrieProof, error)
+	RecreateReadOnlyAccounts(rootHash []byte) (AccountsAdapter, error)
 	IsInterfaceNil() bool
 }
 
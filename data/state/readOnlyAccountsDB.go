@@ -0,0 +1,52 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// readOnlyAccountsDB is a view over the accounts found under a past root hash. All the operations that would change
+// the state are rejected
+type readOnlyAccountsDB struct {
+	*AccountsDB
+}
+
+// SaveAccount returns ErrOperationNotPermitted
+func (roadb *readOnlyAccountsDB) SaveAccount(_ AccountHandler) error {
+	return ErrOperationNotPermitted
+}
+
+// RemoveAccount returns ErrOperationNotPermitted
+func (roadb *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// Commit returns ErrOperationNotPermitted
+func (roadb *readOnlyAccountsDB) Commit() ([]byte, error) {
+	return nil, ErrOperationNotPermitted
+}
+
+// RecreateTrie returns ErrOperationNotPermitted
+func (roadb *readOnlyAccountsDB) RecreateTrie(_ []byte) error {
+	return ErrOperationNotPermitted
+}
+
+// PruneTrie does nothing
+func (roadb *readOnlyAccountsDB) PruneTrie(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// CancelPrune does nothing
+func (roadb *readOnlyAccountsDB) CancelPrune(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// SnapshotState does nothing
+func (roadb *readOnlyAccountsDB) SnapshotState(_ []byte) {
+}
+
+// SetStateCheckpoint does nothing
+func (roadb *readOnlyAccountsDB) SetStateCheckpoint(_ []byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (roadb *readOnlyAccountsDB) IsInterfaceNil() bool {
+	return roadb == nil || roadb.AccountsDB == nil
+}
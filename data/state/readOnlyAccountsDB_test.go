@@ -0,0 +1,78 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsDB_RecreateReadOnlyAccountsMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing root hash")
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (d data.Trie, err error) {
+			return nil, expectedErr
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	accounts, err := adb.RecreateReadOnlyAccounts([]byte("root hash"))
+	assert.True(t, check.IfNil(accounts))
+	assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+}
+
+func TestAccountsDB_RecreateReadOnlyAccountsShouldReadOldStateAndRejectChanges(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, factory.NewAccountCreator())
+
+	address := make([]byte, 32)
+	key := []byte("key")
+
+	acc, _ := adb.LoadAccount(address)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(key, []byte("old value"))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(address)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(key, []byte("new value"))
+	_ = adb.SaveAccount(acc)
+	newRootHash, _ := adb.Commit()
+	require.NotEqual(t, oldRootHash, newRootHash)
+
+	readOnlyAccounts, err := adb.RecreateReadOnlyAccounts(oldRootHash)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(readOnlyAccounts))
+
+	rootHash, _ := readOnlyAccounts.RootHash()
+	assert.Equal(t, oldRootHash, rootHash)
+
+	oldAcc, err := readOnlyAccounts.GetExistingAccount(address)
+	require.Nil(t, err)
+	value, err := oldAcc.(state.UserAccountHandler).DataTrieTracker().RetrieveValue(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old value"), value)
+
+	assert.Equal(t, state.ErrOperationNotPermitted, readOnlyAccounts.SaveAccount(oldAcc))
+	assert.Equal(t, state.ErrOperationNotPermitted, readOnlyAccounts.RemoveAccount(address))
+	assert.Equal(t, state.ErrOperationNotPermitted, readOnlyAccounts.RecreateTrie(newRootHash))
+	_, err = readOnlyAccounts.Commit()
+	assert.Equal(t, state.ErrOperationNotPermitted, err)
+
+	rootHash, _ = adb.RootHash()
+	assert.Equal(t, newRootHash, rootHash)
+}
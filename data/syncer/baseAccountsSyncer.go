@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -28,6 +29,9 @@ type baseAccountsSyncer struct {
 	cacher               storage.Cacher
 	rootHash             []byte
 	maxTrieLevelInMemory uint
+	trieSyncerVersion    uint32
+	maxInFlightNodes     int
+	appStatusHandler     core.AppStatusHandler
 }
 
 const minWaitTime = time.Second
@@ -41,6 +45,9 @@ type ArgsNewBaseAccountsSyncer struct {
 	WaitTime             time.Duration
 	Cacher               storage.Cacher
 	MaxTrieLevelInMemory uint
+	TrieSyncerVersion    uint32
+	MaxInFlightTrieNodes int
+	AppStatusHandler     core.AppStatusHandler
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -62,6 +69,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if check.IfNil(args.Cacher) {
 		return state.ErrNilCacher
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return state.ErrNilAppStatusHandler
+	}
 
 	return nil
 }
@@ -75,7 +85,7 @@ func (b *baseAccountsSyncer) syncMainTrie(rootHash []byte, trieTopic string, ctx
 	}
 
 	b.dataTries[string(rootHash)] = dataTrie
-	trieSyncer, err := trie.NewTrieSyncer(b.requestHandler, b.cacher, dataTrie, b.shardId, trieTopic)
+	trieSyncer, err := b.createTrieSyncer(dataTrie, trieTopic)
 	if err != nil {
 		return err
 	}
@@ -106,3 +116,17 @@ func (b *baseAccountsSyncer) GetSyncedTries() map[string]data.Trie {
 func (b *baseAccountsSyncer) IsInterfaceNil() bool {
 	return b == nil
 }
+
+func (b *baseAccountsSyncer) createTrieSyncer(dataTrie data.Trie, trieTopic string) (data.TrieSyncer, error) {
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:   b.requestHandler,
+		InterceptedNodes: b.cacher,
+		Trie:             dataTrie,
+		ShardId:          b.shardId,
+		Topic:            trieTopic,
+		MaxInFlightNodes: b.maxInFlightNodes,
+		AppStatusHandler: b.appStatusHandler,
+	}
+
+	return trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
+}
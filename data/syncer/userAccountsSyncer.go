@@ -44,6 +44,9 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		trieSyncerVersion:    args.TrieSyncerVersion,
+		maxInFlightNodes:     args.MaxInFlightTrieNodes,
+		appStatusHandler:     args.AppStatusHandler,
 	}
 
 	u := &userAccountsSyncer{
@@ -67,7 +70,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 	}
 
 	mainTrie := u.dataTries[string(rootHash)]
-	rootHashes, err := u.findAllAccountRootHashes(mainTrie)
+	rootHashes, err := u.findAllAccountRootHashes(mainTrie, ctx)
 	if err != nil {
 		return err
 	}
@@ -88,7 +91,7 @@ func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ctx conte
 		}
 
 		u.dataTries[string(rootHash)] = dataTrie
-		trieSyncer, err := trie.NewTrieSyncer(u.requestHandler, u.cacher, dataTrie, u.shardId, factory.AccountTrieNodesTopic)
+		trieSyncer, err := u.createTrieSyncer(dataTrie, factory.AccountTrieNodesTopic)
 		if err != nil {
 			return err
 		}
@@ -103,24 +106,24 @@ func (u *userAccountsSyncer) syncAccountDataTries(rootHashes [][]byte, ctx conte
 	return nil
 }
 
-func (u *userAccountsSyncer) findAllAccountRootHashes(mainTrie data.Trie) ([][]byte, error) {
-	leafs, err := mainTrie.GetAllLeaves()
-	if err != nil {
-		return nil, err
-	}
-
+func (u *userAccountsSyncer) findAllAccountRootHashes(mainTrie data.Trie, ctx context.Context) ([][]byte, error) {
 	rootHashes := make([][]byte, 0)
-	for _, leaf := range leafs {
+	err := mainTrie.IterateLeaves(ctx, data.LeavesFilter{}, func(_ []byte, value []byte) error {
 		account := state.NewEmptyUserAccount()
-		err = u.marshalizer.Unmarshal(account, leaf)
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
-			continue
+		errUnmarshal := u.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return nil
 		}
 
 		if len(account.RootHash) > 0 {
 			rootHashes = append(rootHashes, account.RootHash)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return rootHashes, nil
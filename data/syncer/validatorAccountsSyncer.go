@@ -39,6 +39,9 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		trieSyncerVersion:    args.TrieSyncerVersion,
+		maxInFlightNodes:     args.MaxInFlightTrieNodes,
+		appStatusHandler:     args.AppStatusHandler,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -0,0 +1,22 @@
+package transaction
+
+// ApiAddressHistory is the data transfer object which will be returned on the get transactions of an address endpoint
+type ApiAddressHistory struct {
+	Transactions []*ApiAddressHistoryEntry `json:"transactions"`
+	NextCursor   *ApiHistoryCursor         `json:"nextCursor,omitempty"`
+}
+
+// ApiAddressHistoryEntry is the data transfer object which will hold a transaction which touched an address
+type ApiAddressHistoryEntry struct {
+	Hash       string `json:"hash"`
+	Type       string `json:"type"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Epoch      uint32 `json:"epoch"`
+}
+
+// ApiHistoryCursor is the data transfer object which will hold the (epoch, block nonce) position from which the next
+// page of the transactions of an address can be requested
+type ApiHistoryCursor struct {
+	Epoch uint32 `json:"epoch"`
+	Nonce uint64 `json:"nonce"`
+}
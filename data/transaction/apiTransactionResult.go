@@ -3,6 +3,7 @@ package transaction
 // ApiTransactionResult is the data transfer object which will be returned on the get transaction by hash endpoint
 type ApiTransactionResult struct {
 	Type      string `json:"type"`
+	Hash      string `json:"hash,omitempty"`
 	Nonce     uint64 `json:"nonce,omitempty"`
 	Round     uint64 `json:"round,omitempty"`
 	Epoch     uint32 `json:"epoch,omitempty"`
@@ -14,4 +15,12 @@ type ApiTransactionResult struct {
 	Data      string `json:"data,omitempty"`
 	Code      string `json:"code,omitempty"`
 	Signature string `json:"signature,omitempty"`
+	ChainID   string `json:"chainID,omitempty"`
+	Version   uint32 `json:"version,omitempty"`
+
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	BlockNonce       uint64 `json:"blockNonce,omitempty"`
+	BlockHash        string `json:"blockHash,omitempty"`
+	MiniBlockHash    string `json:"miniblockHash,omitempty"`
 }
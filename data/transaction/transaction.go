@@ -66,6 +66,8 @@ type frontendTransaction struct {
 	GasPrice         uint64 `json:"gasPrice"`
 	GasLimit         uint64 `json:"gasLimit"`
 	Data             string `json:"data,omitempty"`
+	ChainID          string `json:"chainID"`
+	Version          uint32 `json:"version"`
 	Signature        string `json:"signature,omitempty"`
 }
 
@@ -88,6 +90,8 @@ func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalize
 		SenderUsername:   tx.SndUserName,
 		ReceiverUsername: tx.RcvUserName,
 		Data:             string(tx.Data),
+		ChainID:          string(tx.ChainID),
+		Version:          tx.Version,
 	}
 
 	return marshalizer.Marshal(ftx)
@@ -40,6 +40,8 @@ type Transaction struct {
 	GasLimit    uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
 	Data        []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
 	Signature   []byte        `protobuf:"bytes,10,opt,name=Signature,proto3" json:"signature,omitempty"`
+	ChainID     []byte        `protobuf:"bytes,11,opt,name=ChainID,proto3" json:"chainID"`
+	Version     uint32        `protobuf:"varint,12,opt,name=Version,proto3" json:"version"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -140,6 +142,20 @@ func (m *Transaction) GetSignature() []byte {
 	return nil
 }
 
+func (m *Transaction) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *Transaction) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
 }
@@ -147,36 +163,38 @@ func init() {
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xbd, 0x10, 0xe7, 0xcf, 0xa6, 0x42, 0x62, 0x11, 0x60, 0x38, 0xec, 0x56, 0x08, 0x55,
-	0x3d, 0xd0, 0x58, 0x02, 0x71, 0xea, 0xa9, 0x81, 0x0a, 0x55, 0x42, 0x11, 0x72, 0x80, 0x03, 0xb7,
-	0x8d, 0xbd, 0xb8, 0x2b, 0xea, 0xdd, 0x6a, 0xbd, 0x31, 0xe2, 0xc6, 0x23, 0xf0, 0x0e, 0x5c, 0x10,
-	0x4f, 0xc2, 0x31, 0xc7, 0x9c, 0x0c, 0x71, 0x2e, 0xc8, 0xa7, 0x3e, 0x02, 0xf2, 0x24, 0xad, 0x97,
-	0x9e, 0xec, 0xf9, 0xcd, 0xf7, 0xed, 0x37, 0x1a, 0x0d, 0xbe, 0x6d, 0x0d, 0x57, 0x39, 0x8f, 0xad,
-	0xd4, 0x6a, 0x74, 0x6e, 0xb4, 0xd5, 0xc4, 0x87, 0xcf, 0xc3, 0x83, 0x54, 0xda, 0xd3, 0xf9, 0x6c,
-	0x14, 0xeb, 0x2c, 0x4c, 0x75, 0xaa, 0x43, 0xc0, 0xb3, 0xf9, 0x47, 0xa8, 0xa0, 0x80, 0xbf, 0x8d,
-	0xeb, 0xd1, 0xf7, 0x0e, 0x1e, 0xbe, 0x6d, 0xdf, 0x22, 0x0c, 0xfb, 0x13, 0xad, 0x62, 0x11, 0xa0,
-	0x5d, 0xb4, 0xdf, 0x19, 0x0f, 0xea, 0x92, 0xf9, 0xaa, 0x01, 0xd1, 0x86, 0x93, 0x04, 0xfb, 0xef,
-	0xf9, 0xd9, 0x5c, 0x04, 0x37, 0x76, 0xd1, 0xfe, 0xce, 0x78, 0xd2, 0x08, 0x8a, 0x06, 0xfc, 0xfc,
-	0xcd, 0x8e, 0x32, 0x6e, 0x4f, 0xc3, 0x99, 0x4c, 0x47, 0x27, 0xca, 0x1e, 0x3a, 0x83, 0x1c, 0x9f,
-	0x19, 0xad, 0x92, 0x89, 0xb0, 0x9f, 0xb5, 0xf9, 0x14, 0x0a, 0xa8, 0x0e, 0x52, 0x1d, 0x26, 0xdc,
-	0xf2, 0xd1, 0x58, 0xa6, 0x27, 0xca, 0xbe, 0xe0, 0xb9, 0x15, 0x26, 0xda, 0x3c, 0x4e, 0xf6, 0x70,
-	0x2f, 0x8a, 0x8b, 0xa3, 0x24, 0x31, 0xc1, 0x4d, 0xc8, 0xd9, 0xa9, 0x4b, 0xd6, 0x37, 0x22, 0x16,
-	0xb2, 0x10, 0x26, 0xba, 0x6c, 0x92, 0x43, 0x3c, 0x8c, 0xe2, 0xe2, 0x5d, 0x2e, 0xcc, 0x84, 0x67,
-	0x22, 0xe8, 0x80, 0xf6, 0x41, 0x5d, 0xb2, 0xbb, 0xa6, 0xc5, 0x4f, 0x74, 0x26, 0xad, 0xc8, 0xce,
-	0xed, 0x97, 0xc8, 0x55, 0x93, 0xc7, 0xb8, 0x37, 0x55, 0x09, 0x84, 0xf8, 0x60, 0xc4, 0x75, 0xc9,
-	0xba, 0xb9, 0x50, 0x49, 0x13, 0xb1, 0x6d, 0x35, 0x11, 0x53, 0x95, 0x5c, 0x45, 0x74, 0xdb, 0x88,
-	0xbc, 0xc5, 0x6e, 0x84, 0xa3, 0x26, 0x4f, 0x71, 0xff, 0x15, 0xcf, 0xdf, 0x18, 0x19, 0x8b, 0xa0,
-	0x07, 0x1b, 0xbd, 0x57, 0x97, 0x8c, 0xa4, 0x5b, 0xe6, 0xd8, 0xae, 0x74, 0x5b, 0xcf, 0x6b, 0x99,
-	0x49, 0x1b, 0xf4, 0xff, 0xf3, 0x00, 0xbb, 0xe6, 0x01, 0x46, 0xf6, 0x70, 0xe7, 0x25, 0xb7, 0x3c,
-	0x18, 0xc0, 0x74, 0xa4, 0x2e, 0xd9, 0xad, 0x66, 0xb7, 0x8e, 0x16, 0xfa, 0xe4, 0x39, 0x1e, 0x4c,
-	0x65, 0xaa, 0xb8, 0x9d, 0x1b, 0x11, 0x60, 0x10, 0xdf, 0xaf, 0x4b, 0x76, 0x27, 0xbf, 0x84, 0x8e,
-	0xa3, 0x55, 0x8e, 0x8f, 0x17, 0x2b, 0xea, 0x2d, 0x57, 0xd4, 0xbb, 0x58, 0x51, 0xf4, 0xb5, 0xa2,
-	0xe8, 0x47, 0x45, 0xd1, 0xaf, 0x8a, 0xa2, 0x45, 0x45, 0xd1, 0xb2, 0xa2, 0xe8, 0x4f, 0x45, 0xd1,
-	0xdf, 0x8a, 0x7a, 0x17, 0x15, 0x45, 0xdf, 0xd6, 0xd4, 0x5b, 0xac, 0xa9, 0xb7, 0x5c, 0x53, 0xef,
-	0xc3, 0xd0, 0x39, 0xd4, 0x59, 0x17, 0x6e, 0xee, 0xd9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d,
-	0x35, 0x79, 0x01, 0xbe, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x8f, 0xd3, 0x3c,
+	0x1c, 0xc7, 0xe3, 0xe7, 0xe9, 0x5f, 0xa7, 0x20, 0x61, 0x04, 0x18, 0x06, 0xbb, 0x42, 0x70, 0xea,
+	0xc0, 0x35, 0x12, 0x88, 0xe9, 0xa6, 0xeb, 0xdd, 0x09, 0x55, 0x42, 0x15, 0x4a, 0xe1, 0x06, 0x36,
+	0x37, 0x31, 0xa9, 0xc5, 0xc5, 0x3e, 0x39, 0x6e, 0x10, 0x1b, 0x2f, 0x81, 0x97, 0x81, 0x78, 0x25,
+	0x8c, 0x1d, 0x3b, 0x05, 0x9a, 0x2e, 0x28, 0xd3, 0xf1, 0x0e, 0x50, 0x9c, 0xf6, 0x1a, 0x10, 0x53,
+	0xe2, 0xcf, 0xef, 0xf3, 0xf5, 0xd7, 0xb2, 0x0c, 0x6f, 0x19, 0xcd, 0x64, 0xc2, 0x02, 0x23, 0x94,
+	0x1c, 0x5e, 0x6a, 0x65, 0x14, 0x6a, 0xda, 0xcf, 0x83, 0xc3, 0x48, 0x98, 0xf9, 0x62, 0x36, 0x0c,
+	0x54, 0xec, 0x45, 0x2a, 0x52, 0x9e, 0xc5, 0xb3, 0xc5, 0x3b, 0xbb, 0xb2, 0x0b, 0xfb, 0x57, 0xa5,
+	0x1e, 0xfe, 0x6a, 0x40, 0xf7, 0xf5, 0x7e, 0x2f, 0x44, 0x61, 0x73, 0xa2, 0x64, 0xc0, 0x31, 0xe8,
+	0x83, 0x41, 0x63, 0xd4, 0x2d, 0x32, 0xda, 0x94, 0x25, 0xf0, 0x2b, 0x8e, 0x42, 0xd8, 0x3c, 0x67,
+	0x17, 0x0b, 0x8e, 0xff, 0xeb, 0x83, 0x41, 0x6f, 0x34, 0x29, 0x85, 0xb4, 0x04, 0x5f, 0xbf, 0xd3,
+	0xe3, 0x98, 0x99, 0xb9, 0x37, 0x13, 0xd1, 0x70, 0x2c, 0xcd, 0x51, 0xed, 0x20, 0x67, 0x17, 0x5a,
+	0xc9, 0x70, 0xc2, 0xcd, 0x07, 0xa5, 0xdf, 0x7b, 0xdc, 0xae, 0x0e, 0x23, 0xe5, 0x85, 0xcc, 0xb0,
+	0xe1, 0x48, 0x44, 0x63, 0x69, 0x4e, 0x58, 0x62, 0xb8, 0xf6, 0xab, 0xcd, 0xd1, 0x01, 0x6c, 0xfb,
+	0x41, 0x7a, 0x1c, 0x86, 0x1a, 0xff, 0x6f, 0x7b, 0x7a, 0x45, 0x46, 0x3b, 0x9a, 0x07, 0x5c, 0xa4,
+	0x5c, 0xfb, 0xbb, 0x21, 0x3a, 0x82, 0xae, 0x1f, 0xa4, 0x6f, 0x12, 0xae, 0x27, 0x2c, 0xe6, 0xb8,
+	0x61, 0xdd, 0xfb, 0x45, 0x46, 0xef, 0xe8, 0x3d, 0x7e, 0xa2, 0x62, 0x61, 0x78, 0x7c, 0x69, 0x3e,
+	0xfa, 0x75, 0x1b, 0x3d, 0x82, 0xed, 0xa9, 0x0c, 0x6d, 0x49, 0xd3, 0x06, 0x61, 0x91, 0xd1, 0x56,
+	0xc2, 0x65, 0x58, 0x56, 0x6c, 0x47, 0x65, 0xc5, 0x54, 0x86, 0xd7, 0x15, 0xad, 0x7d, 0x45, 0x22,
+	0xc3, 0x7f, 0x55, 0xd4, 0x6c, 0xf4, 0x14, 0x76, 0x5e, 0xb0, 0xe4, 0x95, 0x16, 0x01, 0xc7, 0x6d,
+	0x7b, 0xa3, 0x77, 0x8b, 0x8c, 0xa2, 0x68, 0xcb, 0x6a, 0xb1, 0x6b, 0x6f, 0x9b, 0x79, 0x29, 0x62,
+	0x61, 0x70, 0xe7, 0x8f, 0x8c, 0x65, 0x7f, 0x65, 0x2c, 0x43, 0x07, 0xb0, 0x71, 0xca, 0x0c, 0xc3,
+	0x5d, 0x7b, 0x3a, 0x54, 0x64, 0xf4, 0x66, 0x79, 0xb7, 0x35, 0xd7, 0xce, 0xd1, 0x73, 0xd8, 0x9d,
+	0x8a, 0x48, 0x32, 0xb3, 0xd0, 0x1c, 0x43, 0x2b, 0xdf, 0x2b, 0x32, 0x7a, 0x3b, 0xd9, 0xc1, 0x5a,
+	0x62, 0x6f, 0xa2, 0xc7, 0xb0, 0x7d, 0x32, 0x67, 0x42, 0x8e, 0x4f, 0xb1, 0x6b, 0x43, 0x6e, 0x91,
+	0xd1, 0x76, 0x50, 0x21, 0x7f, 0x37, 0x2b, 0xb5, 0x73, 0xae, 0x13, 0xa1, 0x24, 0xee, 0xf5, 0xc1,
+	0xe0, 0x46, 0xa5, 0xa5, 0x15, 0xf2, 0x77, 0xb3, 0xd1, 0xd9, 0x72, 0x4d, 0x9c, 0xd5, 0x9a, 0x38,
+	0x57, 0x6b, 0x02, 0x3e, 0xe5, 0x04, 0x7c, 0xc9, 0x09, 0xf8, 0x96, 0x13, 0xb0, 0xcc, 0x09, 0x58,
+	0xe5, 0x04, 0xfc, 0xc8, 0x09, 0xf8, 0x99, 0x13, 0xe7, 0x2a, 0x27, 0xe0, 0xf3, 0x86, 0x38, 0xcb,
+	0x0d, 0x71, 0x56, 0x1b, 0xe2, 0xbc, 0x75, 0x6b, 0xcf, 0x7e, 0xd6, 0xb2, 0x2f, 0xf8, 0xd9, 0xef,
+	0x01, 0x00, 0xb6, 0xcd, 0x3a, 0x05, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (this *Transaction) Equal(that interface{}) bool {
@@ -231,13 +249,19 @@ func (this *Transaction) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Signature, that1.Signature) {
 		return false
 	}
+	if !bytes.Equal(this.ChainID, that1.ChainID) {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
 	return true
 }
 func (this *Transaction) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 16)
 	s = append(s, "&transaction.Transaction{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
@@ -249,6 +273,8 @@ func (this *Transaction) GoString() string {
 	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "ChainID: "+fmt.Sprintf("%#v", this.ChainID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -280,6 +306,18 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -406,6 +444,13 @@ func (m *Transaction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTransaction(uint64(m.Version))
+	}
 	return n
 }
 
@@ -430,6 +475,8 @@ func (this *Transaction) String() string {
 		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
 		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`ChainID:` + fmt.Sprintf("%v", this.ChainID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -770,6 +817,59 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = append(m.ChainID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainID == nil {
+				m.ChainID = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -704,24 +705,41 @@ func (bn *branchNode) loadChildren(getNode func([]byte) (node, error)) ([][]byte
 	return missingChildren, existingChildren, nil
 }
 
-func (bn *branchNode) getAllLeaves(leaves map[string][]byte, key []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+func (bn *branchNode) iterateLeaves(
+	ctx context.Context,
+	key []byte,
+	startKey []byte,
+	db data.DBWriteCacher,
+	handler data.LeafHandler,
+) error {
 	err := bn.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
 	for i := range bn.children {
-		err = resolveIfCollapsed(bn, byte(i), db)
-		if err != nil {
-			return err
+		if isContextDone(ctx) {
+			return ErrContextClosing
 		}
 
-		if bn.children[i] == nil {
+		childStartKey, ok := seekStartKey([]byte{byte(i)}, startKey)
+		if !ok {
+			continue
+		}
+
+		child := bn.children[i]
+		if child == nil && len(bn.EncodedChildren[i]) != 0 {
+			child, err = getNodeFromDBAndDecode(bn.EncodedChildren[i], db, bn.marsh, bn.hasher)
+			if err != nil {
+				return err
+			}
+		}
+
+		if child == nil {
 			continue
 		}
 
-		childKey := append(key, byte(i))
-		err = bn.children[i].getAllLeaves(leaves, childKey, db, marshalizer)
+		err = child.iterateLeaves(ctx, concat(key, byte(i)), childStartKey, db, handler)
 		if err != nil {
 			return err
 		}
@@ -729,3 +747,19 @@ func (bn *branchNode) getAllLeaves(leaves map[string][]byte, key []byte, db data
 
 	return nil
 }
+
+func (bn *branchNode) getNextHashAndKey(key []byte) (bool, []byte, []byte) {
+	if len(key) == 0 || bn.isEmptyOrNil() != nil {
+		return true, nil, nil
+	}
+
+	childPos := key[firstByte]
+	if childPosOutOfRange(childPos) || int(childPos) >= len(bn.EncodedChildren) {
+		return true, nil, nil
+	}
+	if len(bn.EncodedChildren[childPos]) == 0 {
+		return true, nil, nil
+	}
+
+	return false, bn.EncodedChildren[childPos], key[1:]
+}
@@ -61,6 +61,12 @@ var ErrNilTrie = errors.New("the trie is nil")
 // ErrNilRequestHandler is raised when the given request handler is nil
 var ErrNilRequestHandler = errors.New("the request handler is nil")
 
+// ErrContextClosing signals that the context was closed while iterating the trie
+var ErrContextClosing = errors.New("context closing")
+
+// ErrNilLeafHandler signals that a nil leaf handler has been provided
+var ErrNilLeafHandler = errors.New("nil leaf handler")
+
 // ErrTimeIsOut signals that time is out
 var ErrTimeIsOut = errors.New("time is out")
 
@@ -85,5 +91,17 @@ var ErrNilContext = errors.New("nil context")
 // ErrInvalidIdentifier signals that the root hash has an  invalid identifier
 var ErrInvalidIdentifier = errors.New("invalid identifier")
 
+// ErrInvalidProof signals that the provided proof does not match the given root hash and key
+var ErrInvalidProof = errors.New("invalid proof")
+
 // ErrInvalidLevelValue signals that the given value for maxTrieLevelInMemory is invalid
 var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidTrieSyncerVersion signals that an unknown trie syncer version has been provided
+var ErrInvalidTrieSyncerVersion = errors.New("invalid trie syncer version")
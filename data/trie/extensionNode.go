@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -573,22 +574,47 @@ func (en *extensionNode) loadChildren(getNode func([]byte) (node, error)) ([][]b
 	return nil, []node{child}, nil
 }
 
-func (en *extensionNode) getAllLeaves(leaves map[string][]byte, key []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+func (en *extensionNode) iterateLeaves(
+	ctx context.Context,
+	key []byte,
+	startKey []byte,
+	db data.DBWriteCacher,
+	handler data.LeafHandler,
+) error {
 	err := en.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
-	err = resolveIfCollapsed(en, 0, db)
-	if err != nil {
-		return err
+	childStartKey, ok := seekStartKey(en.Key, startKey)
+	if !ok {
+		return nil
 	}
 
-	childKey := append(key, en.Key...)
-	err = en.child.getAllLeaves(leaves, childKey, db, marshalizer)
-	if err != nil {
-		return err
+	child := en.child
+	if child == nil {
+		child, err = getNodeFromDBAndDecode(en.EncodedChild, db, en.marsh, en.hasher)
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return child.iterateLeaves(ctx, concat(key, en.Key...), childStartKey, db, handler)
+}
+
+func (en *extensionNode) getNextHashAndKey(key []byte) (bool, []byte, []byte) {
+	if en.isEmptyOrNil() != nil {
+		return true, nil, nil
+	}
+
+	keyTooShort := len(key) < len(en.Key)
+	if keyTooShort {
+		return true, nil, nil
+	}
+	keysDontMatch := !bytes.Equal(en.Key, key[:len(en.Key)])
+	if keysDontMatch {
+		return true, nil, nil
+	}
+
+	return false, en.EncodedChild, key[len(en.Key):]
 }
@@ -93,6 +93,7 @@ func (tc *trieCreator) Create(
 		BatchDelaySeconds: tc.evictionWaitingListCfg.DB.BatchDelaySeconds,
 		MaxBatchSize:      tc.evictionWaitingListCfg.DB.MaxBatchSize,
 		MaxOpenFiles:      tc.evictionWaitingListCfg.DB.MaxOpenFiles,
+		Compression:       tc.evictionWaitingListCfg.DB.Compression,
 	}
 	evictionDb, err := storageUnit.NewDB(arg)
 	if err != nil {
@@ -110,6 +111,7 @@ func (tc *trieCreator) Create(
 		BatchDelaySeconds: tc.snapshotDbCfg.BatchDelaySeconds,
 		MaxBatchSize:      tc.snapshotDbCfg.MaxBatchSize,
 		MaxOpenFiles:      tc.snapshotDbCfg.MaxOpenFiles,
+		Compression:       tc.snapshotDbCfg.Compression,
 	}
 
 	trieStorage, err := trie.NewTrieStorageManager(
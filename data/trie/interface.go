@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"io"
 	"sync"
 	"time"
@@ -38,7 +39,8 @@ type node interface {
 	isValid() bool
 	setDirty(bool)
 	loadChildren(func([]byte) (node, error)) ([][]byte, []node, error)
-	getAllLeaves(map[string][]byte, []byte, data.DBWriteCacher, marshal.Marshalizer) error
+	iterateLeaves(ctx context.Context, key []byte, startKey []byte, db data.DBWriteCacher, handler data.LeafHandler) error
+	getNextHashAndKey([]byte) (bool, []byte, []byte)
 
 	getMarshalizer() marshal.Marshalizer
 	setMarshalizer(marshal.Marshalizer)
@@ -2,6 +2,7 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -339,18 +340,31 @@ func (ln *leafNode) loadChildren(_ func([]byte) (node, error)) ([][]byte, []node
 	return nil, nil, nil
 }
 
-func (ln *leafNode) getAllLeaves(leaves map[string][]byte, key []byte, _ data.DBWriteCacher, _ marshal.Marshalizer) error {
+func (ln *leafNode) iterateLeaves(
+	_ context.Context,
+	key []byte,
+	startKey []byte,
+	_ data.DBWriteCacher,
+	handler data.LeafHandler,
+) error {
 	err := ln.isEmptyOrNil()
 	if err != nil {
-		return fmt.Errorf("getAllLeaves error %w", err)
+		return fmt.Errorf("iterateLeaves error %w", err)
 	}
 
-	nodeKey := append(key, ln.Key...)
-	nodeKey, err = hexToKeyBytes(nodeKey)
+	leftStartKey, ok := seekStartKey(ln.Key, startKey)
+	if !ok || len(leftStartKey) != 0 {
+		return nil
+	}
+
+	nodeKey, err := hexToKeyBytes(concat(key, ln.Key...))
 	if err != nil {
 		return err
 	}
 
-	leaves[string(nodeKey)] = ln.Value
-	return nil
+	return handler(nodeKey, ln.Value)
+}
+
+func (ln *leafNode) getNextHashAndKey(_ []byte) (bool, []byte, []byte) {
+	return true, nil, nil
 }
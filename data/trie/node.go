@@ -2,6 +2,8 @@
 package trie
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -122,6 +124,15 @@ func resolveIfCollapsed(n node, pos byte, db data.DBWriteCacher) error {
 	return nil
 }
 
+func isContextDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
 func concat(s1 []byte, s2 ...byte) []byte {
 	r := make([]byte, len(s1)+len(s2))
 	copy(r, s1)
@@ -182,6 +193,30 @@ func getEmptyNodeOfType(t byte) (node, error) {
 	}
 }
 
+// seekStartKey checks the path step that leads to a child against the hex start key that is left to be matched. It
+// returns false if all the leaves below the step are before the start key, otherwise it returns the part of the start
+// key that is left to be matched below the step, which is empty once the step is after the start key
+func seekStartKey(step []byte, startKey []byte) ([]byte, bool) {
+	if len(startKey) == 0 {
+		return nil, true
+	}
+
+	commonLength := len(step)
+	if len(startKey) < commonLength {
+		commonLength = len(startKey)
+	}
+
+	comparison := bytes.Compare(step[:commonLength], startKey[:commonLength])
+	if comparison < 0 {
+		return nil, false
+	}
+	if comparison > 0 || len(startKey) <= len(step) {
+		return nil, true
+	}
+
+	return startKey[len(step):], true
+}
+
 func childPosOutOfRange(pos byte) bool {
 	return pos >= nrOfChildren
 }
@@ -0,0 +1,71 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const (
+	// BranchNodeType is the type of a trie branch node
+	BranchNodeType = "branch"
+	// ExtensionNodeType is the type of a trie extension node
+	ExtensionNodeType = "extension"
+	// LeafNodeType is the type of a trie leaf node
+	LeafNodeType = "leaf"
+)
+
+// NodeInfo holds the decoded content of an encoded trie node
+type NodeInfo struct {
+	Type           string
+	Hash           []byte
+	Key            []byte
+	Value          []byte
+	ChildrenHashes [][]byte
+}
+
+// DecodeNodeInfo decodes an encoded trie node, as it is saved in the trie storage, and computes its hash. The key of
+// the extension and leaf nodes is the one held by the node, in hex nibbles form
+func DecodeNodeInfo(encNode []byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*NodeInfo, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	n, err := decodeNode(encNode, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+	n.setDirty(true)
+
+	err = n.setHash()
+	if err != nil {
+		return nil, err
+	}
+
+	childrenHashes, err := getChildrenHashes(n)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &NodeInfo{
+		Hash:           n.getHash(),
+		ChildrenHashes: childrenHashes,
+	}
+
+	switch typedNode := n.(type) {
+	case *branchNode:
+		info.Type = BranchNodeType
+	case *extensionNode:
+		info.Type = ExtensionNodeType
+		info.Key = typedNode.Key
+	case *leafNode:
+		info.Type = LeafNodeType
+		info.Key = typedNode.Key
+		info.Value = typedNode.Value
+	}
+
+	return info, nil
+}
@@ -0,0 +1,66 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeNodeInfo_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	info, err := trie.DecodeNodeInfo([]byte("encoded node"), nil, &mock.KeccakMock{})
+
+	assert.Nil(t, info)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+}
+
+func TestDecodeNodeInfo_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	info, err := trie.DecodeNodeInfo([]byte("encoded node"), &mock.ProtobufMarshalizerMock{}, nil)
+
+	assert.Nil(t, info)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
+func TestDecodeNodeInfo_InvalidEncodingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	info, err := trie.DecodeNodeInfo(nil, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+
+	assert.Nil(t, info)
+	assert.Equal(t, trie.ErrInvalidEncoding, err)
+}
+
+func TestDecodeNodeInfo_ShouldDecodeAllTheTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	nodes, hashes := getEncodedTrieNodesAndHashes(tr)
+
+	numLeaves := 0
+	reachedHashes := make(map[string]struct{})
+	for i, encNode := range nodes {
+		info, err := trie.DecodeNodeInfo(encNode, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, hashes[i], info.Hash)
+
+		for _, childHash := range info.ChildrenHashes {
+			reachedHashes[string(childHash)] = struct{}{}
+		}
+		if info.Type == trie.LeafNodeType {
+			numLeaves++
+			assert.NotEmpty(t, info.Value)
+			assert.Empty(t, info.ChildrenHashes)
+		}
+	}
+
+	assert.Equal(t, 3, numLeaves)
+	for _, hash := range hashes[1:] {
+		_, found := reachedHashes[string(hash)]
+		assert.True(t, found)
+	}
+}
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -621,6 +622,29 @@ func TestPatriciaMerkleTrie_GetAllLeafsCollapsedTrie(t *testing.T) {
 	assert.Equal(t, []byte("cat"), leafs[string([]byte("ddog"))])
 }
 
+func TestPatriciaMerkleTrie_IterateLeavesCollapsedTrieShouldNotResolveNodes(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+
+	root, _ := tr.root.(*branchNode)
+	for i := 0; i < nrOfChildren; i++ {
+		root.children[i] = nil
+	}
+	tr.root = root
+
+	leaves := make(map[string][]byte)
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(key []byte, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(leaves))
+	assert.True(t, root.isCollapsed())
+}
+
 func TestPatriciaMerkleTrie_removeDuplicatedKeys(t *testing.T) {
 	map1 := map[string]struct{}{
 		"hash1": {},
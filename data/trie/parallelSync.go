@@ -0,0 +1,456 @@
+package trie
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ data.TrieSyncer = (*parallelTrieSyncer)(nil)
+
+const (
+	// LegacyTrieSyncerVersion is the version of the trie syncer which keeps the whole trie in memory and commits it
+	// at the end of the synchronization
+	LegacyTrieSyncerVersion = uint32(1)
+	// ParallelTrieSyncerVersion is the version of the trie syncer which requests the missing nodes in parallel and
+	// persists them as soon as they are received, being able to resume an interrupted synchronization
+	ParallelTrieSyncerVersion = uint32(2)
+)
+
+const (
+	trieSyncFrontierKeyPrefix = "trieSyncFrontier"
+	maxHashesPerRequest       = 100
+	frontierSaveInterval      = 5 * time.Second
+	timeBetweenChecks         = 100 * time.Millisecond
+	numNodesBetweenCtxChecks  = 1000
+)
+
+// ArgTrieSyncer is the DTO used to create a new trie syncer
+type ArgTrieSyncer struct {
+	RequestHandler   RequestHandler
+	InterceptedNodes storage.Cacher
+	Trie             data.Trie
+	ShardId          uint32
+	Topic            string
+	MaxInFlightNodes int
+	AppStatusHandler core.AppStatusHandler
+}
+
+// parallelTrieSyncer walks the trie in a depth first manner and keeps at most maxInFlightNodes requested nodes, split
+// in batches of maxHashesPerRequest hashes. Each batch is requested separately, so the batches are resolved in
+// parallel by different peers. Each received node is persisted right away and the hashes of the nodes that still
+// need to be processed (the frontier) are saved periodically, so that an interrupted synchronization can be resumed
+type parallelTrieSyncer struct {
+	requestHandler   RequestHandler
+	interceptedNodes storage.Cacher
+	trie             *patriciaMerkleTrie
+	db               data.DBWriteCacher
+	shardId          uint32
+	topic            string
+	maxInFlightNodes int
+	statusHandler    core.AppStatusHandler
+
+	mutOperation  sync.Mutex
+	mutInFlight   sync.Mutex
+	inFlight      map[string]time.Time
+	receivedNodes map[string]*InterceptedTrieNode
+	chReceived    chan struct{}
+
+	startTime    time.Time
+	numProcessed uint64
+}
+
+// NewParallelTrieSyncer creates a new instance of parallelTrieSyncer
+func NewParallelTrieSyncer(arg ArgTrieSyncer) (*parallelTrieSyncer, error) {
+	if check.IfNil(arg.RequestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+	if check.IfNil(arg.InterceptedNodes) {
+		return nil, data.ErrNilCacher
+	}
+	if check.IfNil(arg.Trie) {
+		return nil, ErrNilTrie
+	}
+	if len(arg.Topic) == 0 {
+		return nil, ErrInvalidTrieTopic
+	}
+	if arg.MaxInFlightNodes < 1 {
+		return nil, fmt.Errorf("%w for MaxInFlightNodes: %d", ErrInvalidValue, arg.MaxInFlightNodes)
+	}
+	if check.IfNil(arg.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	pmt, ok := arg.Trie.(*patriciaMerkleTrie)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+	db := pmt.Database()
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+
+	pts := &parallelTrieSyncer{
+		requestHandler:   arg.RequestHandler,
+		interceptedNodes: arg.InterceptedNodes,
+		trie:             pmt,
+		db:               db,
+		shardId:          arg.ShardId,
+		topic:            arg.Topic,
+		maxInFlightNodes: arg.MaxInFlightNodes,
+		statusHandler:    arg.AppStatusHandler,
+		inFlight:         make(map[string]time.Time),
+		receivedNodes:    make(map[string]*InterceptedTrieNode),
+		chReceived:       make(chan struct{}, 1),
+	}
+	pts.interceptedNodes.RegisterHandler(pts.trieNodeIntercepted)
+
+	return pts, nil
+}
+
+// CreateTrieSyncer creates the trie syncer having the given version
+func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion uint32) (data.TrieSyncer, error) {
+	switch trieSyncerVersion {
+	case LegacyTrieSyncerVersion:
+		return NewTrieSyncer(arg.RequestHandler, arg.InterceptedNodes, arg.Trie, arg.ShardId, arg.Topic)
+	case ParallelTrieSyncerVersion:
+		return NewParallelTrieSyncer(arg)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
+	}
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network. If a previous synchronization of
+// the same root hash was interrupted, it is resumed from the saved frontier
+func (pts *parallelTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if len(rootHash) == 0 {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	pts.mutOperation.Lock()
+	defer pts.mutOperation.Unlock()
+
+	pts.resetState()
+
+	pending := pts.loadFrontier(rootHash)
+	err := pts.syncPendingNodes(ctx, rootHash, pending)
+	if err != nil {
+		pts.saveFrontier(rootHash, pending)
+		return err
+	}
+
+	err = pts.db.Remove(frontierKey(rootHash))
+	if err != nil {
+		log.Debug("parallelTrieSyncer: could not remove the saved frontier", "root hash", rootHash, "error", err)
+	}
+
+	return pts.setTrieRoot(rootHash)
+}
+
+func (pts *parallelTrieSyncer) resetState() {
+	pts.mutInFlight.Lock()
+	pts.inFlight = make(map[string]time.Time)
+	pts.receivedNodes = make(map[string]*InterceptedTrieNode)
+	pts.mutInFlight.Unlock()
+
+	pts.startTime = time.Now()
+	pts.numProcessed = 0
+}
+
+func (pts *parallelTrieSyncer) syncPendingNodes(ctx context.Context, rootHash []byte, pending *pendingNodes) error {
+	lastFrontierSave := time.Now()
+	for {
+		err := pts.processAvailableNodes(ctx, pending)
+		if err != nil {
+			return err
+		}
+		if pending.len() == 0 {
+			pts.reportProgress(0)
+			return nil
+		}
+
+		pts.requestMissingNodes(pending)
+		pts.reportProgress(pending.len())
+
+		if time.Since(lastFrontierSave) > frontierSaveInterval {
+			pts.saveFrontier(rootHash, pending)
+			lastFrontierSave = time.Now()
+		}
+
+		select {
+		case <-pts.chReceived:
+		case <-time.After(timeBetweenChecks):
+		case <-ctx.Done():
+			return ErrTimeIsOut
+		}
+	}
+}
+
+// processAvailableNodes processes, in a depth first order, all the pending nodes that are available either from the
+// network or from the local storage. The missing nodes remain pending
+func (pts *parallelTrieSyncer) processAvailableNodes(ctx context.Context, pending *pendingNodes) error {
+	missing := make([][]byte, 0)
+	defer func() {
+		for i := len(missing) - 1; i >= 0; i-- {
+			pending.push(missing[i])
+		}
+	}()
+
+	numProcessedNow := 0
+	for pending.len() > 0 {
+		numProcessedNow++
+		if numProcessedNow%numNodesBetweenCtxChecks == 0 && isContextDone(ctx) {
+			return ErrTimeIsOut
+		}
+
+		hash := pending.pop()
+		n, err := pts.getNode(hash)
+		if err == ErrNodeNotFound {
+			missing = append(missing, hash)
+			continue
+		}
+		if err != nil {
+			pending.push(hash)
+			return err
+		}
+
+		childrenHashes, err := getChildrenHashes(n)
+		if err != nil {
+			pending.push(hash)
+			return err
+		}
+
+		pending.push(childrenHashes...)
+		pts.numProcessed++
+		pts.statusHandler.AddUint64(core.MetricTrieSyncNumProcessedNodes, 1)
+	}
+
+	return nil
+}
+
+// getNode returns the node with the given hash. A node received from the network is persisted before being returned
+func (pts *parallelTrieSyncer) getNode(hash []byte) (node, error) {
+	pts.mutInFlight.Lock()
+	_, isInFlight := pts.inFlight[string(hash)]
+	interceptedNode, isReceived := pts.receivedNodes[string(hash)]
+	pts.mutInFlight.Unlock()
+
+	if !isReceived {
+		interceptedNode, isReceived = pts.getInterceptedNode(hash)
+	}
+	if isReceived {
+		return pts.persistInterceptedNode(hash, interceptedNode)
+	}
+	if isInFlight {
+		return nil, ErrNodeNotFound
+	}
+
+	encodedNode, err := pts.db.Get(hash)
+	if err != nil {
+		return nil, ErrNodeNotFound
+	}
+
+	return decodeNode(encodedNode, pts.trie.marshalizer, pts.trie.hasher)
+}
+
+func (pts *parallelTrieSyncer) getInterceptedNode(hash []byte) (*InterceptedTrieNode, bool) {
+	value, ok := pts.interceptedNodes.Get(hash)
+	if !ok {
+		return nil, false
+	}
+
+	interceptedNode, ok := value.(*InterceptedTrieNode)
+	return interceptedNode, ok
+}
+
+func (pts *parallelTrieSyncer) persistInterceptedNode(hash []byte, interceptedNode *InterceptedTrieNode) (node, error) {
+	encodedNode := interceptedNode.EncodedNode()
+	err := pts.db.Put(hash, encodedNode)
+	if err != nil {
+		return nil, err
+	}
+
+	pts.mutInFlight.Lock()
+	delete(pts.inFlight, string(hash))
+	delete(pts.receivedNodes, string(hash))
+	pts.mutInFlight.Unlock()
+
+	pts.statusHandler.AddUint64(core.MetricTrieSyncNumReceivedBytes, uint64(len(encodedNode)))
+
+	return interceptedNode.node, nil
+}
+
+// requestMissingNodes requests the missing nodes found at the top of the pending stack, keeping at most
+// maxInFlightNodes requested nodes. The nodes which were requested, but were not received in the request interval,
+// are requested again
+func (pts *parallelTrieSyncer) requestMissingNodes(pending *pendingNodes) {
+	requestInterval := pts.requestHandler.RequestInterval()
+	hashesToRequest := make([][]byte, 0)
+
+	pts.mutInFlight.Lock()
+	for i := pending.len() - 1; i >= 0; i-- {
+		hash := pending.hashes[i]
+		requestTime, isInFlight := pts.inFlight[string(hash)]
+		if isInFlight && time.Since(requestTime) < requestInterval {
+			continue
+		}
+		if !isInFlight && len(pts.inFlight) >= pts.maxInFlightNodes {
+			break
+		}
+
+		pts.inFlight[string(hash)] = time.Now()
+		hashesToRequest = append(hashesToRequest, hash)
+	}
+	pts.mutInFlight.Unlock()
+
+	for len(hashesToRequest) > 0 {
+		batchSize := core.MinInt(len(hashesToRequest), maxHashesPerRequest)
+		pts.requestHandler.RequestTrieNodes(pts.shardId, hashesToRequest[:batchSize], pts.topic)
+		hashesToRequest = hashesToRequest[batchSize:]
+	}
+}
+
+func (pts *parallelTrieSyncer) reportProgress(numPending int) {
+	pts.statusHandler.SetUInt64Value(core.MetricTrieSyncNumPendingNodes, uint64(numPending))
+
+	elapsedSeconds := time.Since(pts.startTime).Seconds()
+	if pts.numProcessed == 0 || elapsedSeconds == 0 {
+		return
+	}
+
+	// the total number of nodes is not known in advance, so the estimation only takes into account the pending nodes
+	nodesPerSecond := float64(pts.numProcessed) / elapsedSeconds
+	estimatedSecondsLeft := uint64(float64(numPending) / nodesPerSecond)
+	pts.statusHandler.SetUInt64Value(core.MetricTrieSyncEstimatedTimeLeftInSec, estimatedSecondsLeft)
+}
+
+func (pts *parallelTrieSyncer) trieNodeIntercepted(hash []byte, val interface{}) {
+	interceptedNode, ok := val.(*InterceptedTrieNode)
+	if !ok {
+		return
+	}
+
+	pts.mutInFlight.Lock()
+	_, isInFlight := pts.inFlight[string(hash)]
+	if isInFlight {
+		pts.receivedNodes[string(hash)] = interceptedNode
+	}
+	pts.mutInFlight.Unlock()
+
+	if !isInFlight {
+		return
+	}
+
+	select {
+	case pts.chReceived <- struct{}{}:
+	default:
+	}
+}
+
+func (pts *parallelTrieSyncer) loadFrontier(rootHash []byte) *pendingNodes {
+	pending := &pendingNodes{}
+
+	buff, err := pts.db.Get(frontierKey(rootHash))
+	if err != nil || len(buff) == 0 {
+		pending.push(rootHash)
+		return pending
+	}
+
+	hashSize := len(rootHash)
+	if len(buff)%hashSize != 0 {
+		log.Warn("parallelTrieSyncer: invalid saved frontier, starting from the root", "root hash", rootHash)
+		pending.push(rootHash)
+		return pending
+	}
+
+	for i := 0; i < len(buff); i += hashSize {
+		pending.push(buff[i : i+hashSize])
+	}
+	log.Debug("parallelTrieSyncer: resuming the trie synchronization",
+		"root hash", rootHash, "num pending nodes", pending.len())
+
+	return pending
+}
+
+func (pts *parallelTrieSyncer) saveFrontier(rootHash []byte, pending *pendingNodes) {
+	buff := make([]byte, 0, pending.len()*len(rootHash))
+	for _, hash := range pending.hashes {
+		if len(hash) != len(rootHash) {
+			log.Debug("parallelTrieSyncer: frontier not saved, hashes with different lengths found")
+			return
+		}
+		buff = append(buff, hash...)
+	}
+
+	err := pts.db.Put(frontierKey(rootHash), buff)
+	if err != nil {
+		log.Debug("parallelTrieSyncer: could not save the frontier", "root hash", rootHash, "error", err)
+	}
+}
+
+func (pts *parallelTrieSyncer) setTrieRoot(rootHash []byte) error {
+	rootNode, err := getNodeFromDBAndDecode(rootHash, pts.db, pts.trie.marshalizer, pts.trie.hasher)
+	if err != nil {
+		return err
+	}
+	rootNode.setGivenHash(rootHash)
+
+	pts.trie.mutOperation.Lock()
+	pts.trie.root = rootNode
+	pts.trie.mutOperation.Unlock()
+
+	return nil
+}
+
+// Trie returns the synced trie
+func (pts *parallelTrieSyncer) Trie() data.Trie {
+	return pts.trie
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pts *parallelTrieSyncer) IsInterfaceNil() bool {
+	return pts == nil
+}
+
+func frontierKey(rootHash []byte) []byte {
+	return append([]byte(trieSyncFrontierKeyPrefix), rootHash...)
+}
+
+// getChildrenHashes returns the hashes of all the children of the given collapsed node
+func getChildrenHashes(n node) ([][]byte, error) {
+	childrenHashes, _, err := n.loadChildren(func(_ []byte) (node, error) {
+		return nil, ErrNodeNotFound
+	})
+
+	return childrenHashes, err
+}
+
+// pendingNodes is the stack of the hashes of the nodes that still need to be processed
+type pendingNodes struct {
+	hashes [][]byte
+}
+
+func (pn *pendingNodes) push(hashes ...[]byte) {
+	pn.hashes = append(pn.hashes, hashes...)
+}
+
+func (pn *pendingNodes) pop() []byte {
+	lastIndex := len(pn.hashes) - 1
+	hash := pn.hashes[lastIndex]
+	pn.hashes = pn.hashes[:lastIndex]
+
+	return hash
+}
+
+func (pn *pendingNodes) len() int {
+	return len(pn.hashes)
+}
@@ -0,0 +1,285 @@
+package trie
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemoryTrie() *patriciaMerkleTrie {
+	marsh, hsh := getTestMarshAndHasher()
+	trieStorage, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := NewTrie(trieStorage, marsh, hsh, 5)
+
+	return tr
+}
+
+func createSourceTrie(numLeaves int) (*patriciaMerkleTrie, []byte) {
+	tr := newMemoryTrie()
+	for i := 0; i < numLeaves; i++ {
+		_ = tr.Update([]byte("key"+strconv.Itoa(i)), []byte("value"+strconv.Itoa(i)))
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	return tr, rootHash
+}
+
+func createNilAppStatusHandler() *mock.AppStatusHandlerStub {
+	return &mock.AppStatusHandlerStub{
+		AddUint64Handler:      func(_ string, _ uint64) {},
+		SetUInt64ValueHandler: func(_ string, _ uint64) {},
+	}
+}
+
+func createMockArgTrieSyncer() ArgTrieSyncer {
+	cacher, _ := lrucache.NewCache(100000)
+
+	return ArgTrieSyncer{
+		RequestHandler:   &mock.RequestHandlerStub{},
+		InterceptedNodes: cacher,
+		Trie:             newMemoryTrie(),
+		ShardId:          0,
+		Topic:            "trieNodes",
+		MaxInFlightNodes: 100,
+		AppStatusHandler: createNilAppStatusHandler(),
+	}
+}
+
+// createResolvingRequestHandler returns a request handler which answers the requests from the source trie's storage,
+// as long as the provided filter allows it
+func createResolvingRequestHandler(
+	source *patriciaMerkleTrie,
+	cacher storage.Cacher,
+	shouldResolve func(hash []byte) bool,
+) *mock.RequestHandlerStub {
+	marsh, hsh := getTestMarshAndHasher()
+
+	return &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(_ uint32, hashes [][]byte, _ string) {
+			for _, hash := range hashes {
+				if !shouldResolve(hash) {
+					continue
+				}
+
+				encodedNode, err := source.Database().Get(hash)
+				if err != nil {
+					continue
+				}
+
+				interceptedNode, _ := NewInterceptedTrieNode(encodedNode, marsh, hsh)
+				cacher.Put(hash, interceptedNode, 0)
+			}
+		},
+	}
+}
+
+func resolveAll(_ []byte) bool {
+	return true
+}
+
+func TestNewParallelTrieSyncer_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.RequestHandler = nil
+	pts, err := NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, ErrNilRequestHandler, err)
+
+	arg = createMockArgTrieSyncer()
+	arg.InterceptedNodes = nil
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, data.ErrNilCacher, err)
+
+	arg = createMockArgTrieSyncer()
+	arg.Trie = nil
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, ErrNilTrie, err)
+
+	arg = createMockArgTrieSyncer()
+	arg.Topic = ""
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, ErrInvalidTrieTopic, err)
+
+	arg = createMockArgTrieSyncer()
+	arg.MaxInFlightNodes = 0
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	arg = createMockArgTrieSyncer()
+	arg.AppStatusHandler = nil
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, ErrNilAppStatusHandler, err)
+
+	arg = createMockArgTrieSyncer()
+	arg.Trie = &mock.TrieStub{}
+	pts, err = NewParallelTrieSyncer(arg)
+	assert.Nil(t, pts)
+	assert.Equal(t, ErrWrongTypeAssertion, err)
+}
+
+func TestCreateTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	ts, err := CreateTrieSyncer(createMockArgTrieSyncer(), LegacyTrieSyncerVersion)
+	assert.Nil(t, err)
+	_, ok := ts.(*trieSyncer)
+	assert.True(t, ok)
+
+	ts, err = CreateTrieSyncer(createMockArgTrieSyncer(), ParallelTrieSyncerVersion)
+	assert.Nil(t, err)
+	_, ok = ts.(*parallelTrieSyncer)
+	assert.True(t, ok)
+
+	ts, err = CreateTrieSyncer(createMockArgTrieSyncer(), 0)
+	assert.Nil(t, ts)
+	assert.True(t, errors.Is(err, ErrInvalidTrieSyncerVersion))
+}
+
+func TestParallelTrieSyncer_StartSyncingNilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pts, _ := NewParallelTrieSyncer(createMockArgTrieSyncer())
+	err := pts.StartSyncing([]byte("root hash"), nil) //nolint
+	assert.Equal(t, ErrNilContext, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingShouldSyncTheWholeTrie(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 1000
+	source, rootHash := createSourceTrie(numLeaves)
+
+	arg := createMockArgTrieSyncer()
+	arg.MaxInFlightNodes = 50
+	numProcessedNodes := uint64(0)
+	numReceivedBytes := uint64(0)
+	arg.AppStatusHandler = &mock.AppStatusHandlerStub{
+		AddUint64Handler: func(key string, value uint64) {
+			switch key {
+			case core.MetricTrieSyncNumProcessedNodes:
+				atomic.AddUint64(&numProcessedNodes, value)
+			case core.MetricTrieSyncNumReceivedBytes:
+				atomic.AddUint64(&numReceivedBytes, value)
+			}
+		},
+		SetUInt64ValueHandler: func(_ string, _ uint64) {},
+	}
+	requestHandler := createResolvingRequestHandler(source, arg.InterceptedNodes, resolveAll)
+	resolve := requestHandler.RequestTrieNodesCalled
+	maxRequestedAtOnce := 0
+	requestHandler.RequestTrieNodesCalled = func(destShardID uint32, hashes [][]byte, topic string) {
+		assert.True(t, len(hashes) <= maxHashesPerRequest)
+		maxRequestedAtOnce = core.MaxInt(maxRequestedAtOnce, len(hashes))
+		resolve(destShardID, hashes, topic)
+	}
+	arg.RequestHandler = requestHandler
+
+	pts, _ := NewParallelTrieSyncer(arg)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := pts.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	syncedRootHash, _ := pts.Trie().Root()
+	assert.Equal(t, rootHash, syncedRootHash)
+	leaves, err := pts.Trie().GetAllLeaves()
+	require.Nil(t, err)
+	assert.Equal(t, numLeaves, len(leaves))
+	assert.Equal(t, []byte("value7"), leaves["key7"])
+
+	numNodes := len(getTrieHashes(t, source))
+	assert.Equal(t, uint64(numNodes), atomic.LoadUint64(&numProcessedNodes))
+	assert.True(t, atomic.LoadUint64(&numReceivedBytes) > 0)
+	assert.True(t, maxRequestedAtOnce <= arg.MaxInFlightNodes)
+
+	_, err = pts.db.Get(frontierKey(rootHash))
+	assert.NotNil(t, err)
+}
+
+func TestParallelTrieSyncer_StartSyncingShouldResumeInterruptedSync(t *testing.T) {
+	t.Parallel()
+
+	source, rootHash := createSourceTrie(1000)
+	numNodes := len(getTrieHashes(t, source))
+	target := newMemoryTrie()
+
+	arg := createMockArgTrieSyncer()
+	arg.Trie = target
+	numResolved := int32(0)
+	maxResolvedBeforeStop := int32(numNodes / 2)
+	arg.RequestHandler = createResolvingRequestHandler(source, arg.InterceptedNodes, func(_ []byte) bool {
+		return atomic.AddInt32(&numResolved, 1) <= maxResolvedBeforeStop
+	})
+
+	pts, _ := NewParallelTrieSyncer(arg)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := pts.StartSyncing(rootHash, ctx)
+	cancel()
+	require.Equal(t, ErrTimeIsOut, err)
+
+	frontier, err := target.Database().Get(frontierKey(rootHash))
+	require.Nil(t, err)
+	assert.True(t, len(frontier) > 0)
+
+	secondArg := createMockArgTrieSyncer()
+	secondArg.Trie = target
+	mutRequested := sync.Mutex{}
+	requested := make(map[string]struct{})
+	resolver := createResolvingRequestHandler(source, secondArg.InterceptedNodes, resolveAll)
+	resolve := resolver.RequestTrieNodesCalled
+	resolver.RequestTrieNodesCalled = func(destShardID uint32, hashes [][]byte, topic string) {
+		mutRequested.Lock()
+		for _, hash := range hashes {
+			requested[string(hash)] = struct{}{}
+		}
+		mutRequested.Unlock()
+		resolve(destShardID, hashes, topic)
+	}
+	secondArg.RequestHandler = resolver
+
+	resumedSyncer, _ := NewParallelTrieSyncer(secondArg)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = resumedSyncer.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	mutRequested.Lock()
+	numRequestedAfterResume := len(requested)
+	mutRequested.Unlock()
+	assert.True(t, numRequestedAfterResume < numNodes)
+	_, alreadySyncedRootWasRequested := requested[string(rootHash)]
+	assert.False(t, alreadySyncedRootWasRequested)
+
+	leaves, err := resumedSyncer.Trie().GetAllLeaves()
+	require.Nil(t, err)
+	assert.Equal(t, 1000, len(leaves))
+	_, err = target.Database().Get(frontierKey(rootHash))
+	assert.NotNil(t, err)
+}
+
+func getTrieHashes(t *testing.T, tr *patriciaMerkleTrie) [][]byte {
+	_, hashes := getEncodedTrieNodesAndHashes(tr)
+	require.True(t, len(hashes) > 0)
+
+	return hashes
+}
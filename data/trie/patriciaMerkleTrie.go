@@ -2,7 +2,9 @@ package trie
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -18,6 +20,8 @@ var log = logger.GetOrCreate("trie")
 
 var _ dataRetriever.TrieDataGetter = (*patriciaMerkleTrie)(nil)
 
+var errLeavesIterationEnded = errors.New("leaves iteration ended")
+
 const (
 	extension = iota
 	leaf
@@ -534,20 +538,154 @@ func (tr *patriciaMerkleTrie) GetSerializedNodes(rootHash []byte, maxBuffToSend
 
 // GetAllLeaves iterates the trie and returns a map that contains all leafNodes information
 func (tr *patriciaMerkleTrie) GetAllLeaves() (map[string][]byte, error) {
+	leaves := make(map[string][]byte)
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(key []byte, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leaves, nil
+}
+
+// IterateLeaves walks the trie and calls the handler for every leaf accepted by the filter. The collapsed nodes are
+// loaded from the database only for the duration of the walk, so the memory used does not grow with the trie size.
+// The leaves are visited in the trie order, the one used by data.CompareKeysInTrieOrder: the walk descends directly
+// to the filter's start key and stops at the first leaf that is not before the end key, while the key prefix is only
+// checked on the visited leaves. The handler must not modify the trie
+func (tr *patriciaMerkleTrie) IterateLeaves(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if handler == nil {
+		return ErrNilLeafHandler
+	}
+
 	tr.mutOperation.RLock()
 	defer tr.mutOperation.RUnlock()
 
 	if tr.root == nil {
-		return map[string][]byte{}, nil
+		return nil
 	}
 
-	leaves := make(map[string][]byte)
-	err := tr.root.getAllLeaves(leaves, []byte{}, tr.Database(), tr.marshalizer)
-	if err != nil {
-		return nil, err
+	var startKey []byte
+	if len(filter.StartKey) > 0 {
+		startKey = keyBytesToHex(filter.StartKey)
 	}
 
-	return leaves, nil
+	err := tr.root.iterateLeaves(ctx, []byte{}, startKey, tr.Database(), func(key []byte, value []byte) error {
+		if filter.IsAfterEnd(key) {
+			return errLeavesIterationEnded
+		}
+		if !filter.IsKeyAccepted(key) {
+			return nil
+		}
+
+		return handler(key, value)
+	})
+	if err == errLeavesIterationEnded {
+		return nil
+	}
+
+	return err
+}
+
+// GetProof returns the encoded nodes found on the path from the root to the given key. If the key is present
+// in the trie, the last node is the leaf that holds its value, otherwise the proof shows where the path ends
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	if tr.root == nil {
+		return proof, nil
+	}
+
+	if tr.root.getHash() == nil {
+		err := tr.root.setRootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		encNode, err := currentNode.getEncodedNode()
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encNode)
+
+		finished, _, _ := currentNode.getNextHashAndKey(hexKey)
+		if finished {
+			return proof, nil
+		}
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// VerifyProof checks the given proof against the root hash and returns the value stored under the key. A nil value
+// and no error means that the proof is valid and shows that the key is not present in the trie
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if emptyTrie(rootHash) {
+		if len(proof) != 0 {
+			return nil, ErrInvalidProof
+		}
+		return nil, nil
+	}
+
+	expectedHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for i, encNode := range proof {
+		if !bytes.Equal(expectedHash, hasher.Compute(string(encNode))) {
+			return nil, ErrInvalidProof
+		}
+
+		n, err := decodeNode(encNode, marshalizer, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+		}
+
+		finished, nextHash, nextKey := n.getNextHashAndKey(hexKey)
+		if !finished {
+			expectedHash = nextHash
+			hexKey = nextKey
+			continue
+		}
+
+		isLastNode := i == len(proof)-1
+		if !isLastNode {
+			return nil, ErrInvalidProof
+		}
+
+		ln, ok := n.(*leafNode)
+		if ok && bytes.Equal(ln.Key, hexKey) {
+			return ln.Value, nil
+		}
+
+		return nil, nil
+	}
+
+	return nil, ErrInvalidProof
 }
 
 // IsPruningEnabled returns true if state pruning is enabled
@@ -1,10 +1,13 @@
 package trie_test
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 	"testing"
@@ -488,6 +491,174 @@ func TestPatriciaMerkleTrie_GetAllLeaves(t *testing.T) {
 	assert.Equal(t, []byte("cat"), leaves["ddog"])
 }
 
+func TestPatriciaMerkleTrie_IterateLeavesNilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	err := tr.IterateLeaves(nil, data.LeavesFilter{}, func(_ []byte, _ []byte) error {
+		return nil
+	})
+
+	assert.Equal(t, trie.ErrNilContext, err)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, nil)
+
+	assert.Equal(t, trie.ErrNilLeafHandler, err)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	numCalls := 0
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(_ []byte, _ []byte) error {
+		numCalls++
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numCalls)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesWithFilter(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	leaves := make(map[string][]byte)
+	handler := func(key []byte, value []byte) error {
+		leaves[string(key)] = value
+		return nil
+	}
+
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{KeyPrefix: []byte("do")}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(leaves))
+	assert.Equal(t, []byte("reindeer"), leaves["doe"])
+	assert.Equal(t, []byte("puppy"), leaves["dog"])
+
+	leaves = make(map[string][]byte)
+	err = tr.IterateLeaves(context.Background(), data.LeavesFilter{StartKey: []byte("ddog"), EndKey: []byte("dog")}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(leaves))
+	assert.Equal(t, []byte("cat"), leaves["ddog"])
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesShouldVisitKeysInTrieOrder(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+
+	keys := make([][]byte, 0)
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(key []byte, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	sort.Slice(values, func(i, j int) bool {
+		return data.CompareKeysInTrieOrder(values[i], values[j]) < 0
+	})
+	assert.Equal(t, values, keys)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesShouldSeekStartKeyAndStopAtEndKey(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+	sort.Slice(values, func(i, j int) bool {
+		return data.CompareKeysInTrieOrder(values[i], values[j]) < 0
+	})
+
+	filter := data.LeavesFilter{
+		StartKey: values[20],
+		EndKey:   values[30],
+	}
+	keys := make([][]byte, 0)
+	err := tr.IterateLeaves(context.Background(), filter, func(key []byte, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, values[20:30], keys)
+
+	missingStartKey := append([]byte{}, values[50]...)
+	missingStartKey[len(missingStartKey)-1]++
+	keys = make([][]byte, 0)
+	err = tr.IterateLeaves(context.Background(), data.LeavesFilter{StartKey: missingStartKey}, func(key []byte, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+	assert.Nil(t, err)
+	for _, key := range keys {
+		assert.True(t, data.CompareKeysInTrieOrder(key, missingStartKey) > 0)
+	}
+	for _, key := range values {
+		if data.CompareKeysInTrieOrder(key, missingStartKey) > 0 {
+			assert.Contains(t, keys, key)
+		}
+	}
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	getKeys := func() [][]byte {
+		keys := make([][]byte, 0)
+		_ = tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(key []byte, _ []byte) error {
+			keys = append(keys, key)
+			return nil
+		})
+		return keys
+	}
+
+	firstKeys := getKeys()
+	assert.Equal(t, 100, len(firstKeys))
+	assert.Equal(t, firstKeys, getKeys())
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	err := tr.IterateLeaves(context.Background(), data.LeavesFilter{}, func(_ []byte, _ []byte) error {
+		numCalls++
+		return expectedErr
+	})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesContextClosingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	_ = tr.Commit()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := 0
+	err := tr.IterateLeaves(ctx, data.LeavesFilter{}, func(_ []byte, _ []byte) error {
+		numCalls++
+		if numCalls == 10 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.Equal(t, trie.ErrContextClosing, err)
+	assert.Equal(t, 10, numCalls)
+}
+
 func TestPatriciaMerkleTrie_String(t *testing.T) {
 	t.Parallel()
 
@@ -556,6 +727,148 @@ func TestPatriciaMerkleTree_reduceBranchNodeReturnsOldHashesCorrectly(t *testing
 	assert.Equal(t, len(oldHashes), len(newHashes))
 }
 
+func TestPatriciaMerkleTrie_GetProofAndVerifyProofExistingKeys(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	keys := map[string]string{
+		"doe":  "reindeer",
+		"dog":  "puppy",
+		"ddog": "cat",
+	}
+	for key, value := range keys {
+		proof, err := tr.GetProof([]byte(key))
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(proof))
+
+		val, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(value), val)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProofMissingKeys(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	for _, key := range []string{"dogs", "do", "cat", "doc"} {
+		proof, err := tr.GetProof([]byte(key))
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(proof))
+
+		val, err := trie.VerifyProof(rootHash, []byte(key), proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Nil(t, val)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofOfCollapsedTrie(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	collapsedTrie, _ := tr.Recreate(rootHash)
+	for _, value := range values {
+		proof, err := collapsedTrie.GetProof(value)
+		assert.Nil(t, err)
+
+		val, err := trie.VerifyProof(rootHash, value, proof, marshalizer, hasher)
+		assert.Nil(t, err)
+		assert.Equal(t, value, val)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof))
+
+	val, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.Nil(t, val)
+}
+
+func TestVerifyProof_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+	val, err := trie.VerifyProof([]byte("wrong root hash"), []byte("dog"), proof, marshalizer, hasher)
+
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProof_TamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+	lastNode := proof[len(proof)-1]
+	tamperedNode := make([]byte, len(lastNode))
+	copy(tamperedNode, lastNode)
+	tamperedNode[0]++
+	proof[len(proof)-1] = tamperedNode
+
+	val, err := trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProof_IncompleteOrExtraNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	val, err := trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], marshalizer, hasher)
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	proof = append(proof, proof[len(proof)-1])
+	val, err = trie.VerifyProof(rootHash, []byte("dog"), proof, marshalizer, hasher)
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProof_NilMarshalizerOrHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	val, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, nil, hasher)
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	val, err = trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, marshalizer, nil)
+	assert.Nil(t, val)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
@@ -26,7 +26,8 @@ const (
 
 // trieStorageManager manages all the storage operations of the trie (commit, snapshot, checkpoint, pruning)
 type trieStorageManager struct {
-	db data.DBWriteCacher
+	db          data.DBWriteCacher
+	mutDatabase sync.RWMutex
 
 	snapshots          []storage.Persister
 	snapshotId         int
@@ -128,6 +129,7 @@ func getSnapshotsAndSnapshotId(snapshotDbCfg config.DBConfig) ([]storage.Persist
 			BatchDelaySeconds: snapshotDbCfg.BatchDelaySeconds,
 			MaxBatchSize:      snapshotDbCfg.MaxBatchSize,
 			MaxOpenFiles:      snapshotDbCfg.MaxOpenFiles,
+			Compression:       snapshotDbCfg.Compression,
 		}
 		db, err = storageUnit.NewDB(arg)
 		if err != nil {
@@ -150,9 +152,25 @@ func getSnapshotsAndSnapshotId(snapshotDbCfg config.DBConfig) ([]storage.Persist
 
 // Database returns the main database
 func (tsm *trieStorageManager) Database() data.DBWriteCacher {
+	tsm.mutDatabase.RLock()
+	defer tsm.mutDatabase.RUnlock()
+
 	return tsm.db
 }
 
+// SetDatabase replaces the main database, e.g. with one which journals the writes done while committing a block
+func (tsm *trieStorageManager) SetDatabase(db data.DBWriteCacher) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+
+	tsm.mutDatabase.Lock()
+	tsm.db = db
+	tsm.mutDatabase.Unlock()
+
+	return nil
+}
+
 // EnterSnapshotMode sets the snapshot mode on
 func (tsm *trieStorageManager) EnterSnapshotMode() {
 	tsm.storageOperationMutex.Lock()
@@ -286,7 +304,7 @@ func (tsm *trieStorageManager) removeFromDb(rootHash []byte) error {
 		}
 
 		log.Trace("remove hash from trie db", "hash", hex.EncodeToString(hash))
-		err = tsm.db.Remove(hash)
+		err = tsm.Database().Remove(hash)
 		if err != nil {
 			return err
 		}
@@ -304,11 +322,12 @@ func (tsm *trieStorageManager) MarkForEviction(root []byte, hashes data.Modified
 
 // GetDbThatContainsHash returns the database that contains the given hash
 func (tsm *trieStorageManager) GetDbThatContainsHash(rootHash []byte) data.DBWriteCacher {
-	_, err := tsm.db.Get(rootHash)
+	db := tsm.Database()
+	_, err := db.Get(rootHash)
 
 	hashPresent := err == nil
 	if hashPresent {
-		return tsm.db
+		return db
 	}
 
 	return tsm.getSnapshotDbThatContainsHash(rootHash)
@@ -366,7 +385,8 @@ func (tsm *trieStorageManager) takeSnapshot(snapshot *snapshotsQueueEntry, msh m
 
 	log.Debug("trie snapshot started", "rootHash", snapshot.rootHash)
 
-	newRoot, err := newSnapshotNode(tsm.db, msh, hsh, snapshot.rootHash)
+	mainDb := tsm.Database()
+	newRoot, err := newSnapshotNode(mainDb, msh, hsh, snapshot.rootHash)
 	if err != nil {
 		log.Error("trie storage manager: newSnapshotTrie", "error", err.Error())
 		return
@@ -377,7 +397,7 @@ func (tsm *trieStorageManager) takeSnapshot(snapshot *snapshotsQueueEntry, msh m
 	}
 
 	maxTrieLevelInMemory := uint(5)
-	err = newRoot.commit(true, 0, maxTrieLevelInMemory, tsm.db, db)
+	err = newRoot.commit(true, 0, maxTrieLevelInMemory, mainDb, db)
 	if err != nil {
 		log.Error("trie storage manager: commit", "error", err.Error())
 		return
@@ -467,6 +487,7 @@ func (tsm *trieStorageManager) newSnapshotDb() (storage.Persister, error) {
 		BatchDelaySeconds: tsm.snapshotDbCfg.BatchDelaySeconds,
 		MaxBatchSize:      tsm.snapshotDbCfg.MaxBatchSize,
 		MaxOpenFiles:      tsm.snapshotDbCfg.MaxOpenFiles,
+		Compression:       tsm.snapshotDbCfg.Compression,
 	}
 	db, err := storageUnit.NewDB(arg)
 	if err != nil {
@@ -60,6 +60,20 @@ func TestNewTrieStorageManagerOkVals(t *testing.T) {
 	assert.NotNil(t, ts)
 }
 
+func TestTrieStorageManager_SetDatabase(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieStorageManager(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, &mock.EvictionWaitingList{}, config.TrieStorageManagerConfig{})
+
+	err := ts.SetDatabase(nil)
+	assert.Equal(t, ErrNilDatabase, err)
+
+	db := mock.NewMemDbMock()
+	err = ts.SetDatabase(db)
+	assert.Nil(t, err)
+	assert.True(t, ts.Database() == db)
+}
+
 func TestNewTrieStorageManagerWithExistingSnapshot(t *testing.T) {
 	t.Parallel()
 
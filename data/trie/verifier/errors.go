@@ -0,0 +1,49 @@
+package verifier
+
+import "errors"
+
+// ErrNilChainHandler signals that a nil chain handler has been provided
+var ErrNilChainHandler = errors.New("nil chain handler")
+
+// ErrNilAccountsStorageManager signals that a nil accounts trie storage manager has been provided
+var ErrNilAccountsStorageManager = errors.New("nil accounts trie storage manager")
+
+// ErrNilPeerStorageManager signals that a nil peer accounts trie storage manager has been provided
+var ErrNilPeerStorageManager = errors.New("nil peer accounts trie storage manager")
+
+// ErrNilTrieNodesCacher signals that a nil trie nodes cacher has been provided
+var ErrNilTrieNodesCacher = errors.New("nil trie nodes cacher")
+
+// ErrNilRequestHandler signals that a nil request handler has been provided
+var ErrNilRequestHandler = errors.New("nil request handler")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidBackgroundInterval signals that an invalid background verification interval has been provided
+var ErrInvalidBackgroundInterval = errors.New("invalid background verification interval")
+
+// ErrNilForkDetector signals that a nil fork detector has been provided
+var ErrNilForkDetector = errors.New("nil fork detector")
+
+// ErrNilStore signals that a nil storage service has been provided
+var ErrNilStore = errors.New("nil storage service")
+
+// ErrDatabaseNotReplaceable signals that the garbage collection is enabled for a trie storage manager which does not
+// allow its database to be wrapped
+var ErrDatabaseNotReplaceable = errors.New("the trie storage manager does not allow its database to be replaced")
+
+// ErrPruningEnabled signals that a garbage collection was requested on a trie storage which is pruned
+var ErrPruningEnabled = errors.New("trie storage pruning is enabled")
+
+// ErrIncompleteTrie signals that the garbage collection can not run as the trie has missing or corrupted nodes
+var ErrIncompleteTrie = errors.New("the trie has missing or corrupted nodes")
+
+// ErrHashMismatch signals that the hash of a trie node does not match the key it is saved under
+var ErrHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrNilHeader signals that neither the current nor the genesis header is available
+var ErrNilHeader = errors.New("nil header")
@@ -0,0 +1,108 @@
+package verifier
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ data.DBWriteCacher = (*guardedDatabase)(nil)
+
+// guardedDatabase wraps the main database of a trie when the garbage collection is enabled. While a collection is in
+// progress it keeps the keys written in the meantime, so the sweep never removes a trie node saved again by a block
+// committed during the collection
+type guardedDatabase struct {
+	db         data.DBWriteCacher
+	mutWritten sync.Mutex
+	written    map[string]struct{}
+}
+
+func newGuardedDatabase(db data.DBWriteCacher) *guardedDatabase {
+	return &guardedDatabase{
+		db: db,
+	}
+}
+
+// Put saves the trie node in the wrapped database, keeping its key if a collection is in progress
+func (gd *guardedDatabase) Put(key, val []byte) error {
+	gd.mutWritten.Lock()
+	defer gd.mutWritten.Unlock()
+
+	if gd.written != nil {
+		gd.written[string(key)] = struct{}{}
+	}
+
+	return gd.db.Put(key, val)
+}
+
+// Get returns the trie node from the wrapped database
+func (gd *guardedDatabase) Get(key []byte) ([]byte, error) {
+	return gd.db.Get(key)
+}
+
+// Remove removes the trie node from the wrapped database
+func (gd *guardedDatabase) Remove(key []byte) error {
+	return gd.db.Remove(key)
+}
+
+// Close closes the wrapped database
+func (gd *guardedDatabase) Close() error {
+	return gd.db.Close()
+}
+
+// RangeKeys iterates the wrapped database, if it is able to range its keys
+func (gd *guardedDatabase) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	ranger, ok := gd.db.(storage.SortedKeysRanger)
+	if !ok {
+		return storage.ErrRangeKeysNotSupported
+	}
+
+	return ranger.RangeKeys(handler)
+}
+
+// PersisterStatistics returns the statistics of the wrapped database, if it is able to report them
+func (gd *guardedDatabase) PersisterStatistics() storage.PersisterStatistics {
+	provider, ok := gd.db.(storage.PersisterStatisticsProvider)
+	if !ok {
+		return storage.PersisterStatistics{}
+	}
+
+	return provider.PersisterStatistics()
+}
+
+func (gd *guardedDatabase) startKeepingWrittenKeys() {
+	gd.mutWritten.Lock()
+	gd.written = make(map[string]struct{})
+	gd.mutWritten.Unlock()
+}
+
+func (gd *guardedDatabase) stopKeepingWrittenKeys() {
+	gd.mutWritten.Lock()
+	gd.written = nil
+	gd.mutWritten.Unlock()
+}
+
+// removeIfNotWritten removes the key unless it was written since the collection started. The check and the removal
+// are done under the lock taken by the writes, so a trie node can not be saved again between them
+func (gd *guardedDatabase) removeIfNotWritten(key []byte) (bool, error) {
+	gd.mutWritten.Lock()
+	defer gd.mutWritten.Unlock()
+
+	_, isWritten := gd.written[string(key)]
+	if isWritten {
+		return false, nil
+	}
+
+	err := gd.db.Remove(key)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gd *guardedDatabase) IsInterfaceNil() bool {
+	return gd == nil
+}
@@ -0,0 +1,15 @@
+package verifier
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// ForkDetector defines the fork detector functionality needed by the trie verifier
+type ForkDetector interface {
+	GetHighestFinalBlockNonce() uint64
+	IsInterfaceNil() bool
+}
+
+// databaseHolder is implemented by the trie storage managers which allow their main database to be wrapped
+type databaseHolder interface {
+	Database() data.DBWriteCacher
+	SetDatabase(db data.DBWriteCacher) error
+}
@@ -0,0 +1,702 @@
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	accountsTrieName = "accounts"
+	peerTrieName     = "peer"
+
+	verifyOperation         = "verification"
+	garbageCollectOperation = "garbage collection"
+
+	maxReportedHashes   = 100
+	logProgressInterval = 100000
+)
+
+var log = logger.GetOrCreate("data/trie/verifier")
+
+// ArgsTrieVerifier is the argument structure used to create a new trie verifier
+type ArgsTrieVerifier struct {
+	ChainHandler             data.ChainHandler
+	ForkDetector             ForkDetector
+	Store                    dataRetriever.StorageService
+	AccountsStorageManager   data.StorageManager
+	PeerStorageManager       data.StorageManager
+	TrieNodesCacher          storage.Cacher
+	RequestHandler           trie.RequestHandler
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	ShardID                  uint32
+	GarbageCollectionEnabled bool
+}
+
+type verifiedTrie struct {
+	name           string
+	storageManager data.StorageManager
+	hasDataTries   bool
+	requestShardID uint32
+	requestTopic   string
+	getRootHash    func(header data.HeaderHandler) []byte
+	guardedDb      *guardedDatabase
+}
+
+type pendingNode struct {
+	hash       []byte
+	isDataTrie bool
+}
+
+type trieReport struct {
+	name         string
+	rootHash     []byte
+	numNodes     int
+	numLeaves    int
+	numDataTries int
+	numRepaired  int
+	missing      [][]byte
+	corrupted    [][]byte
+}
+
+// trieVerifier walks the state tries from the root hashes of the current block, verifying the hash of each node.
+// The missing and corrupted nodes are requested from the network and are saved in the trie storage once received,
+// on the next walk. When the trie storage is not pruned, the nodes which are no longer reachable from the root hashes
+// of the blocks since the last final one can be deleted with a mark and sweep garbage collection
+type trieVerifier struct {
+	chainHandler             data.ChainHandler
+	forkDetector             ForkDetector
+	store                    dataRetriever.StorageService
+	shardID                  uint32
+	tries                    []*verifiedTrie
+	trieNodesCacher          storage.Cacher
+	requestHandler           trie.RequestHandler
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	garbageCollectionEnabled bool
+
+	ctx             context.Context
+	cancel          func()
+	wgOperations    sync.WaitGroup
+	isRunning       uint32
+	numVisitedNodes uint64
+
+	mutStatus        sync.RWMutex
+	currentOperation string
+	lastReport       []string
+}
+
+// NewTrieVerifier creates a new trie verifier
+func NewTrieVerifier(args ArgsTrieVerifier) (*trieVerifier, error) {
+	if check.IfNil(args.ChainHandler) {
+		return nil, ErrNilChainHandler
+	}
+	if check.IfNil(args.ForkDetector) {
+		return nil, ErrNilForkDetector
+	}
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStore
+	}
+	if check.IfNil(args.AccountsStorageManager) {
+		return nil, ErrNilAccountsStorageManager
+	}
+	if check.IfNil(args.PeerStorageManager) {
+		return nil, ErrNilPeerStorageManager
+	}
+	if check.IfNil(args.TrieNodesCacher) {
+		return nil, ErrNilTrieNodesCacher
+	}
+	if check.IfNil(args.RequestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tv := &trieVerifier{
+		chainHandler: args.ChainHandler,
+		forkDetector: args.ForkDetector,
+		store:        args.Store,
+		shardID:      args.ShardID,
+		tries: []*verifiedTrie{
+			{
+				name:           accountsTrieName,
+				storageManager: args.AccountsStorageManager,
+				hasDataTries:   true,
+				requestShardID: args.ShardID,
+				requestTopic:   factory.AccountTrieNodesTopic,
+				getRootHash:    data.HeaderHandler.GetRootHash,
+			},
+			{
+				name:           peerTrieName,
+				storageManager: args.PeerStorageManager,
+				hasDataTries:   false,
+				requestShardID: core.MetachainShardId,
+				requestTopic:   factory.ValidatorTrieNodesTopic,
+				getRootHash:    data.HeaderHandler.GetValidatorStatsRootHash,
+			},
+		},
+		trieNodesCacher:          args.TrieNodesCacher,
+		requestHandler:           args.RequestHandler,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		garbageCollectionEnabled: args.GarbageCollectionEnabled,
+		ctx:                      ctx,
+		cancel:                   cancel,
+		lastReport:               make([]string, 0),
+	}
+
+	if tv.garbageCollectionEnabled {
+		err := tv.guardTrieDatabases()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tv, nil
+}
+
+// guardTrieDatabases wraps the main databases of the tries which can be garbage collected, so the writes done during
+// a collection are known by its sweep. The databases are wrapped from the start, as a commit in progress might have
+// already taken the database it writes to
+func (tv *trieVerifier) guardTrieDatabases() error {
+	for _, vt := range tv.tries {
+		if vt.storageManager.IsPruningEnabled() {
+			continue
+		}
+
+		holder, ok := vt.storageManager.(databaseHolder)
+		if !ok {
+			return fmt.Errorf("%w for the %s trie", ErrDatabaseNotReplaceable, vt.name)
+		}
+
+		guardedDb, isGuarded := holder.Database().(*guardedDatabase)
+		if !isGuarded {
+			guardedDb = newGuardedDatabase(holder.Database())
+			err := holder.SetDatabase(guardedDb)
+			if err != nil {
+				return err
+			}
+		}
+
+		vt.guardedDb = guardedDb
+	}
+
+	return nil
+}
+
+// StartBackgroundVerification starts, each time the provided interval elapses, a verification of the state tries or,
+// if it is enabled, a garbage collection, which also verifies the tries while marking their reachable nodes
+func (tv *trieVerifier) StartBackgroundVerification(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidBackgroundInterval, interval)
+	}
+
+	go tv.backgroundVerificationLoop(interval)
+
+	return nil
+}
+
+func (tv *trieVerifier) backgroundVerificationLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-tv.ctx.Done():
+			return
+		case <-ticker.C:
+			result := tv.startBackgroundOperation()
+			log.Debug("background trie verification", "result", result)
+		}
+	}
+}
+
+func (tv *trieVerifier) startBackgroundOperation() string {
+	if tv.garbageCollectionEnabled {
+		return tv.startOperation(garbageCollectOperation, tv.collectGarbage)
+	}
+
+	return tv.startOperation(verifyOperation, tv.verify)
+}
+
+// Query returns the progress of the operation in progress, followed by the report of the last finished operation.
+// The operations are only started in the background, as the query is served on an unauthenticated route
+func (tv *trieVerifier) Query(_ string) []string {
+	return tv.status()
+}
+
+func (tv *trieVerifier) startOperation(name string, operation func(ctx context.Context) ([]string, error)) string {
+	if !atomic.CompareAndSwapUint32(&tv.isRunning, 0, 1) {
+		return fmt.Sprintf("%s not started, another operation is in progress", name)
+	}
+
+	tv.mutStatus.Lock()
+	defer tv.mutStatus.Unlock()
+
+	if tv.ctx.Err() != nil {
+		atomic.StoreUint32(&tv.isRunning, 0)
+		return fmt.Sprintf("%s not started, the trie verifier is closed", name)
+	}
+
+	tv.currentOperation = name
+	atomic.StoreUint64(&tv.numVisitedNodes, 0)
+	tv.wgOperations.Add(1)
+	go tv.runOperation(name, operation)
+
+	return fmt.Sprintf("%s started", name)
+}
+
+func (tv *trieVerifier) runOperation(name string, operation func(ctx context.Context) ([]string, error)) {
+	defer tv.wgOperations.Done()
+
+	startTime := time.Now()
+	lines, err := operation(tv.ctx)
+	if err != nil {
+		log.Warn("trie verifier operation failed", "operation", name, "error", err.Error())
+		lines = append(lines, fmt.Sprintf("%s failed: %s", name, err.Error()))
+	}
+
+	report := append([]string{
+		fmt.Sprintf("last %s started at %s and took %v", name, startTime.Format(time.RFC3339), time.Since(startTime)),
+	}, lines...)
+
+	tv.mutStatus.Lock()
+	tv.currentOperation = ""
+	tv.lastReport = report
+	tv.mutStatus.Unlock()
+
+	atomic.StoreUint32(&tv.isRunning, 0)
+}
+
+func (tv *trieVerifier) status() []string {
+	tv.mutStatus.RLock()
+	defer tv.mutStatus.RUnlock()
+
+	status := "no operation in progress"
+	if len(tv.currentOperation) > 0 {
+		status = fmt.Sprintf("%s in progress, %d trie nodes visited",
+			tv.currentOperation, atomic.LoadUint64(&tv.numVisitedNodes))
+	}
+
+	return append([]string{status}, tv.lastReport...)
+}
+
+func (tv *trieVerifier) verify(ctx context.Context) ([]string, error) {
+	header := tv.getCurrentHeader()
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	lines := make([]string, 0)
+	for _, vt := range tv.tries {
+		rootHash := vt.getRootHash(header)
+		if !isRootHashSet(rootHash) {
+			lines = append(lines, fmt.Sprintf("%s trie skipped, the current header holds no root hash for it", vt.name))
+			continue
+		}
+
+		report, err := tv.verifyTrie(ctx, vt, rootHash, make(map[string]struct{}))
+		if err != nil {
+			return lines, err
+		}
+
+		tv.requestBrokenNodes(vt, report)
+		lines = append(lines, report.lines()...)
+	}
+
+	return lines, nil
+}
+
+// collectGarbage deletes from the storage of each trie the nodes which are not reachable from the root hashes of the
+// blocks since the last final one. The root hashes older than the last final block can not be recreated afterwards
+func (tv *trieVerifier) collectGarbage(ctx context.Context) ([]string, error) {
+	lines := make([]string, 0)
+	for _, vt := range tv.tries {
+		trieLines, err := tv.collectTrieGarbage(ctx, vt)
+		lines = append(lines, trieLines...)
+		if err != nil {
+			return lines, err
+		}
+	}
+
+	return lines, nil
+}
+
+func (tv *trieVerifier) collectTrieGarbage(ctx context.Context, vt *verifiedTrie) ([]string, error) {
+	if vt.storageManager.IsPruningEnabled() || vt.guardedDb == nil {
+		return nil, fmt.Errorf("%w for the %s trie", ErrPruningEnabled, vt.name)
+	}
+
+	// the written keys are kept before collecting the candidates, so the nodes saved again in the meantime are never
+	// swept, while the nodes first saved in the meantime are not candidates at all
+	vt.guardedDb.startKeepingWrittenKeys()
+	defer vt.guardedDb.stopKeepingWrittenKeys()
+
+	candidates := make(map[string]struct{})
+	err := vt.guardedDb.RangeKeys(func(key []byte, _ []byte) bool {
+		candidates[string(key)] = struct{}{}
+		return ctx.Err() == nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s trie", err, vt.name)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	lines, marked, err := tv.markReachableNodes(ctx, vt)
+	if err != nil || marked == nil {
+		return lines, err
+	}
+
+	numDeleted := 0
+	for key := range candidates {
+		if ctx.Err() != nil {
+			return lines, ctx.Err()
+		}
+
+		_, isMarked := marked[key]
+		if isMarked {
+			continue
+		}
+
+		isRemoved, errRemove := vt.guardedDb.removeIfNotWritten([]byte(key))
+		if errRemove != nil {
+			return lines, errRemove
+		}
+		if isRemoved {
+			numDeleted++
+		}
+	}
+
+	lines = append(lines, fmt.Sprintf("%s trie storage: %d keys, %d unreachable keys deleted",
+		vt.name, len(candidates), numDeleted))
+
+	return lines, nil
+}
+
+// markReachableNodes marks the nodes reachable from the root hashes of the blocks since the last final one, as the
+// accounts can still be reverted to any of them. It marks again from the root hashes of the blocks committed in the
+// meantime, until no new root hash is found. A nil marked set is returned if the trie has no root hash to mark from
+func (tv *trieVerifier) markReachableNodes(ctx context.Context, vt *verifiedTrie) ([]string, map[string]struct{}, error) {
+	lines := make([]string, 0)
+	marked := make(map[string]struct{})
+	markedRootHashes := make(map[string]struct{})
+	for {
+		headers, err := tv.getHeadersSinceFinalBlock()
+		if err != nil {
+			return lines, nil, err
+		}
+		if !isRootHashSet(vt.getRootHash(headers[0])) {
+			lines = append(lines, fmt.Sprintf("%s trie storage skipped, the current header holds no root hash for it", vt.name))
+			return lines, nil, nil
+		}
+
+		numMarkedRootHashes := len(markedRootHashes)
+		for _, header := range headers {
+			rootHash := vt.getRootHash(header)
+			_, isMarked := markedRootHashes[string(rootHash)]
+			if isMarked || !isRootHashSet(rootHash) {
+				continue
+			}
+			markedRootHashes[string(rootHash)] = struct{}{}
+
+			report, errVerify := tv.verifyTrie(ctx, vt, rootHash, marked)
+			if errVerify != nil {
+				return lines, nil, errVerify
+			}
+
+			lines = append(lines, report.lines()...)
+			if len(report.missing) > 0 || len(report.corrupted) > 0 {
+				tv.requestBrokenNodes(vt, report)
+				return lines, nil, fmt.Errorf("%w: %s trie", ErrIncompleteTrie, vt.name)
+			}
+		}
+
+		if len(markedRootHashes) == numMarkedRootHashes {
+			return lines, marked, nil
+		}
+	}
+}
+
+// getHeadersSinceFinalBlock returns the current header followed by its ancestors, down to the highest final block
+func (tv *trieVerifier) getHeadersSinceFinalBlock() ([]data.HeaderHandler, error) {
+	header := tv.getCurrentHeader()
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	finalNonce := tv.forkDetector.GetHighestFinalBlockNonce()
+	headers := []data.HeaderHandler{header}
+	for header.GetNonce() > finalNonce {
+		prevHeader, err := tv.getHeader(header.GetPrevHash())
+		if err != nil {
+			return nil, fmt.Errorf("%w: previous header of nonce %d", err, header.GetNonce())
+		}
+
+		header = prevHeader
+		headers = append(headers, header)
+	}
+
+	return headers, nil
+}
+
+func (tv *trieVerifier) getHeader(hash []byte) (data.HeaderHandler, error) {
+	if bytes.Equal(hash, tv.chainHandler.GetGenesisHeaderHash()) && !check.IfNil(tv.chainHandler.GetGenesisHeader()) {
+		return tv.chainHandler.GetGenesisHeader(), nil
+	}
+
+	if tv.shardID == core.MetachainShardId {
+		metaHeader, err := process.GetMetaHeaderFromStorage(hash, tv.marshalizer, tv.store)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaHeader, nil
+	}
+
+	shardHeader, err := process.GetShardHeaderFromStorage(hash, tv.marshalizer, tv.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return shardHeader, nil
+}
+
+// verifyTrie walks the trie, and the account data tries if it holds accounts, from the provided root hash. The
+// nodes found in the visited set are skipped and each walked node is added to it
+func (tv *trieVerifier) verifyTrie(
+	ctx context.Context,
+	vt *verifiedTrie,
+	rootHash []byte,
+	visited map[string]struct{},
+) (*trieReport, error) {
+	vt.storageManager.EnterSnapshotMode()
+	defer vt.storageManager.ExitSnapshotMode()
+
+	db := vt.storageManager.Database()
+	report := &trieReport{
+		name:      vt.name,
+		rootHash:  rootHash,
+		missing:   make([][]byte, 0),
+		corrupted: make([][]byte, 0),
+	}
+
+	pending := []pendingNode{{hash: rootHash}}
+	for len(pending) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		_, isVisited := visited[string(current.hash)]
+		if isVisited {
+			continue
+		}
+		visited[string(current.hash)] = struct{}{}
+
+		nodeInfo, err := tv.getNodeInfo(db, current.hash)
+		if err != nil {
+			nodeInfo = tv.repairFromReceivedNodes(db, current.hash)
+			if nodeInfo == nil {
+				report.addBrokenNode(current.hash, err)
+				continue
+			}
+			report.numRepaired++
+		}
+
+		report.numNodes++
+		numVisitedNodes := atomic.AddUint64(&tv.numVisitedNodes, 1)
+		if numVisitedNodes%logProgressInterval == 0 {
+			log.Debug("verifying trie", "trie", vt.name, "num visited nodes", numVisitedNodes)
+		}
+
+		for _, childHash := range nodeInfo.ChildrenHashes {
+			pending = append(pending, pendingNode{hash: childHash, isDataTrie: current.isDataTrie})
+		}
+
+		if nodeInfo.Type != trie.LeafNodeType {
+			continue
+		}
+		report.numLeaves++
+
+		if !vt.hasDataTries || current.isDataTrie {
+			continue
+		}
+
+		dataTrieRootHash := tv.getDataTrieRootHash(nodeInfo.Value)
+		if isRootHashSet(dataTrieRootHash) {
+			report.numDataTries++
+			pending = append(pending, pendingNode{hash: dataTrieRootHash, isDataTrie: true})
+		}
+	}
+
+	return report, nil
+}
+
+func (tv *trieVerifier) getNodeInfo(db data.DBWriteCacher, hash []byte) (*trie.NodeInfo, error) {
+	encNode, err := db.Get(hash)
+	if err != nil {
+		return nil, trie.ErrNodeNotFound
+	}
+
+	return tv.decodeNode(encNode, hash)
+}
+
+func (tv *trieVerifier) decodeNode(encNode []byte, hash []byte) (*trie.NodeInfo, error) {
+	nodeInfo, err := trie.DecodeNodeInfo(encNode, tv.marshalizer, tv.hasher)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(nodeInfo.Hash, hash) {
+		return nil, ErrHashMismatch
+	}
+
+	return nodeInfo, nil
+}
+
+// repairFromReceivedNodes saves the node in the trie storage if it was received from the network, after a previous
+// request, returning nil if it was not received
+func (tv *trieVerifier) repairFromReceivedNodes(db data.DBWriteCacher, hash []byte) *trie.NodeInfo {
+	value, ok := tv.trieNodesCacher.Get(hash)
+	if !ok {
+		return nil
+	}
+
+	interceptedNode, ok := value.(*trie.InterceptedTrieNode)
+	if !ok {
+		return nil
+	}
+
+	nodeInfo, err := tv.decodeNode(interceptedNode.EncodedNode(), hash)
+	if err != nil {
+		return nil
+	}
+
+	err = db.Put(hash, interceptedNode.EncodedNode())
+	if err != nil {
+		log.Warn("could not save the received trie node", "hash", hash, "error", err.Error())
+		return nil
+	}
+
+	log.Debug("trie node repaired from the received nodes", "hash", hash)
+
+	return nodeInfo
+}
+
+func (tv *trieVerifier) getDataTrieRootHash(leafValue []byte) []byte {
+	account := state.NewEmptyUserAccount()
+	err := tv.marshalizer.Unmarshal(account, leafValue)
+	if err != nil {
+		log.Trace("this must be a leaf with code", "error", err.Error())
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func (tv *trieVerifier) requestBrokenNodes(vt *verifiedTrie, report *trieReport) {
+	hashes := make([][]byte, 0, len(report.missing)+len(report.corrupted))
+	hashes = append(hashes, report.missing...)
+	hashes = append(hashes, report.corrupted...)
+	if len(hashes) == 0 {
+		return
+	}
+
+	log.Info("requesting the missing and corrupted trie nodes", "trie", vt.name, "num nodes", len(hashes))
+	tv.requestHandler.RequestTrieNodes(vt.requestShardID, hashes, vt.requestTopic)
+}
+
+func (tv *trieVerifier) getCurrentHeader() data.HeaderHandler {
+	header := tv.chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(header) {
+		return header
+	}
+
+	return tv.chainHandler.GetGenesisHeader()
+}
+
+// Close stops the background verification and waits for the operation in progress to be canceled
+func (tv *trieVerifier) Close() error {
+	tv.mutStatus.Lock()
+	tv.cancel()
+	tv.mutStatus.Unlock()
+
+	tv.wgOperations.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *trieVerifier) IsInterfaceNil() bool {
+	return tv == nil
+}
+
+func (tr *trieReport) addBrokenNode(hash []byte, err error) {
+	if errors.Is(err, trie.ErrNodeNotFound) {
+		tr.missing = append(tr.missing, hash)
+		return
+	}
+
+	tr.corrupted = append(tr.corrupted, hash)
+}
+
+func (tr *trieReport) lines() []string {
+	lines := []string{fmt.Sprintf(
+		"%s trie, root hash %s: %d nodes, %d leaves, %d data tries, %d missing, %d corrupted, %d repaired",
+		tr.name,
+		hex.EncodeToString(tr.rootHash),
+		tr.numNodes,
+		tr.numLeaves,
+		tr.numDataTries,
+		len(tr.missing),
+		len(tr.corrupted),
+		tr.numRepaired,
+	)}
+	lines = appendHashes(lines, "missing", tr.missing)
+	lines = appendHashes(lines, "corrupted", tr.corrupted)
+
+	return lines
+}
+
+func appendHashes(lines []string, title string, hashes [][]byte) []string {
+	for i, hash := range hashes {
+		if i == maxReportedHashes {
+			return append(lines, fmt.Sprintf("... and %d more %s nodes", len(hashes)-maxReportedHashes, title))
+		}
+
+		lines = append(lines, fmt.Sprintf("%s node %s", title, hex.EncodeToString(hash)))
+	}
+
+	return lines
+}
+
+func isRootHashSet(rootHash []byte) bool {
+	return len(rootHash) > 0 && !bytes.Equal(rootHash, trie.EmptyTrieHash)
+}
@@ -0,0 +1,635 @@
+package verifier
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxTrieLevelInMemory = 5
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = &mock.HasherMock{}
+
+type testTrieStorage struct {
+	db             *memorydb.DB
+	storageManager data.StorageManager
+}
+
+func createTestTrieStorage(t *testing.T) *testTrieStorage {
+	db := memorydb.New()
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+
+	return &testTrieStorage{
+		db:             db,
+		storageManager: storageManager,
+	}
+}
+
+// commitAccountsTrie commits a trie holding two accounts, the first one with a data trie, returning its root hash
+func (tts *testTrieStorage) commitAccountsTrie(t *testing.T, balance int64) []byte {
+	dataTrie, err := trie.NewTrie(tts.storageManager, testMarshalizer, testHasher, maxTrieLevelInMemory)
+	require.Nil(t, err)
+	_ = dataTrie.Update([]byte("key1"), []byte("value1"))
+	_ = dataTrie.Update([]byte("key2"), []byte("value2"))
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, _ := dataTrie.Root()
+
+	mainTrie, err := trie.NewTrie(tts.storageManager, testMarshalizer, testHasher, maxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	account, _ := state.NewUserAccount([]byte("address1"))
+	account.RootHash = dataTrieRootHash
+	buff, _ := testMarshalizer.Marshal(account)
+	_ = mainTrie.Update(account.Address, buff)
+
+	account, _ = state.NewUserAccount([]byte("address2"))
+	_ = account.AddToBalance(big.NewInt(balance))
+	buff, _ = testMarshalizer.Marshal(account)
+	_ = mainTrie.Update(account.Address, buff)
+
+	require.Nil(t, mainTrie.Commit())
+	rootHash, _ := mainTrie.Root()
+
+	return rootHash
+}
+
+func (tts *testTrieStorage) keys() [][]byte {
+	keys := make([][]byte, 0)
+	_ = tts.db.RangeKeys(func(key []byte, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+func createTestStore() dataRetriever.StorageService {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 10, 1, 0)
+	headersUnit, _ := storageUnit.NewStorageUnit(cacher, memorydb.New())
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, headersUnit)
+
+	return store
+}
+
+func createMockArgs(t *testing.T) ArgsTrieVerifier {
+	cacher, _ := lrucache.NewCache(100)
+
+	return ArgsTrieVerifier{
+		ChainHandler:           blockchain.NewBlockChain(),
+		ForkDetector:           &mock.ForkDetectorStub{},
+		Store:                  createTestStore(),
+		AccountsStorageManager: createTestTrieStorage(t).storageManager,
+		PeerStorageManager:     createTestTrieStorage(t).storageManager,
+		TrieNodesCacher:        cacher,
+		RequestHandler:         &mock.RequestHandlerStub{},
+		Marshalizer:            testMarshalizer,
+		Hasher:                 testHasher,
+		ShardID:                0,
+	}
+}
+
+func setCurrentRootHash(args ArgsTrieVerifier, rootHash []byte) {
+	_ = args.ChainHandler.SetCurrentBlockHeader(&block.Header{RootHash: rootHash})
+}
+
+// saveHeader saves a shard header holding the provided root hash, returning its hash
+func saveHeader(t *testing.T, args ArgsTrieVerifier, header *block.Header) []byte {
+	buff, err := testMarshalizer.Marshal(header)
+	require.Nil(t, err)
+	hash := testHasher.Compute(string(buff))
+	err = args.Store.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+	require.Nil(t, err)
+
+	return hash
+}
+
+func TestNewTrieVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsTrieVerifier)
+		expectedErr error
+	}{
+		{"chain handler", func(args *ArgsTrieVerifier) { args.ChainHandler = nil }, ErrNilChainHandler},
+		{"fork detector", func(args *ArgsTrieVerifier) { args.ForkDetector = nil }, ErrNilForkDetector},
+		{"store", func(args *ArgsTrieVerifier) { args.Store = nil }, ErrNilStore},
+		{"accounts storage", func(args *ArgsTrieVerifier) { args.AccountsStorageManager = nil }, ErrNilAccountsStorageManager},
+		{"peer storage", func(args *ArgsTrieVerifier) { args.PeerStorageManager = nil }, ErrNilPeerStorageManager},
+		{"trie nodes cacher", func(args *ArgsTrieVerifier) { args.TrieNodesCacher = nil }, ErrNilTrieNodesCacher},
+		{"request handler", func(args *ArgsTrieVerifier) { args.RequestHandler = nil }, ErrNilRequestHandler},
+		{"marshalizer", func(args *ArgsTrieVerifier) { args.Marshalizer = nil }, ErrNilMarshalizer},
+		{"hasher", func(args *ArgsTrieVerifier) { args.Hasher = nil }, ErrNilHasher},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgs(t)
+		tt.modify(&args)
+
+		tv, err := NewTrieVerifier(args)
+		assert.True(t, check.IfNil(tv), tt.name)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestNewTrieVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tv, err := NewTrieVerifier(createMockArgs(t))
+
+	assert.Nil(t, err)
+	assert.False(t, tv.IsInterfaceNil())
+}
+
+func TestTrieVerifier_VerifyCompleteTrie(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	rootHash := accountsStorage.commitAccountsTrie(t, 10)
+	setCurrentRootHash(args, rootHash)
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(_ uint32, _ [][]byte, _ string) {
+			assert.Fail(t, "should not request trie nodes")
+		},
+	}
+	tv, _ := NewTrieVerifier(args)
+
+	report, err := tv.verifyTrie(context.Background(), tv.tries[0], rootHash, make(map[string]struct{}))
+	require.Nil(t, err)
+	assert.Equal(t, len(accountsStorage.keys()), report.numNodes)
+	assert.Equal(t, 4, report.numLeaves)
+	assert.Equal(t, 1, report.numDataTries)
+	assert.Equal(t, 0, len(report.missing))
+	assert.Equal(t, 0, len(report.corrupted))
+
+	lines, err := tv.verify(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(lines))
+	assert.True(t, strings.Contains(lines[0], "0 missing, 0 corrupted"))
+	assert.True(t, strings.HasPrefix(lines[1], "peer trie skipped"))
+}
+
+func TestTrieVerifier_VerifyShouldReportAndRequestMissingNode(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.ShardID = 1
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	rootHash := accountsStorage.commitAccountsTrie(t, 10)
+	setCurrentRootHash(args, rootHash)
+
+	var requestedHashes [][]byte
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Equal(t, uint32(1), destShardID)
+			assert.Equal(t, factory.AccountTrieNodesTopic, topic)
+			requestedHashes = hashes
+		},
+	}
+	tv, _ := NewTrieVerifier(args)
+
+	missingHash := getNonRootKey(accountsStorage, rootHash)
+	_ = accountsStorage.db.Remove(missingHash)
+
+	lines, err := tv.verify(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{missingHash}, requestedHashes)
+	assert.True(t, strings.Contains(lines[0], "1 missing, 0 corrupted"))
+}
+
+func TestTrieVerifier_VerifyShouldReportCorruptedNode(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	rootHash := accountsStorage.commitAccountsTrie(t, 10)
+	tv, _ := NewTrieVerifier(args)
+
+	corruptedHash := getNonRootKey(accountsStorage, rootHash)
+	_ = accountsStorage.db.Put(corruptedHash, []byte("corrupted"))
+
+	report, err := tv.verifyTrie(context.Background(), tv.tries[0], rootHash, make(map[string]struct{}))
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.missing))
+	assert.Equal(t, [][]byte{corruptedHash}, report.corrupted)
+}
+
+func TestTrieVerifier_VerifyShouldRepairFromReceivedNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	rootHash := accountsStorage.commitAccountsTrie(t, 10)
+	tv, _ := NewTrieVerifier(args)
+
+	missingHash := getNonRootKey(accountsStorage, rootHash)
+	encNode, _ := accountsStorage.db.Get(missingHash)
+	_ = accountsStorage.db.Remove(missingHash)
+	interceptedNode, err := trie.NewInterceptedTrieNode(encNode, testMarshalizer, testHasher)
+	require.Nil(t, err)
+	args.TrieNodesCacher.Put(missingHash, interceptedNode, len(encNode))
+
+	report, err := tv.verifyTrie(context.Background(), tv.tries[0], rootHash, make(map[string]struct{}))
+	require.Nil(t, err)
+	assert.Equal(t, 1, report.numRepaired)
+	assert.Equal(t, 0, len(report.missing))
+
+	savedNode, err := accountsStorage.db.Get(missingHash)
+	assert.Nil(t, err)
+	assert.Equal(t, encNode, savedNode)
+}
+
+func TestTrieVerifier_VerifyCanceledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	rootHash := accountsStorage.commitAccountsTrie(t, 10)
+	tv, _ := NewTrieVerifier(args)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := tv.verifyTrie(ctx, tv.tries[0], rootHash, make(map[string]struct{}))
+	assert.Nil(t, report)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestTrieVerifier_CollectGarbageShouldDeleteUnreachableNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	oldRootHash := accountsStorage.commitAccountsTrie(t, 10)
+	numKeysOfOneTrie := len(accountsStorage.keys())
+	rootHash := accountsStorage.commitAccountsTrie(t, 20)
+	setCurrentRootHash(args, rootHash)
+	require.True(t, len(accountsStorage.keys()) > numKeysOfOneTrie)
+	tv, _ := NewTrieVerifier(args)
+
+	lines, err := tv.collectGarbage(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, numKeysOfOneTrie, len(accountsStorage.keys()))
+	assert.True(t, strings.HasPrefix(lines[len(lines)-1], "peer trie storage skipped"))
+
+	_, err = accountsStorage.db.Get(oldRootHash)
+	assert.NotNil(t, err)
+	report, err := tv.verifyTrie(context.Background(), tv.tries[0], rootHash, make(map[string]struct{}))
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.missing))
+}
+
+func TestTrieVerifier_CollectGarbageIncompleteTrieShouldNotDelete(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	_ = accountsStorage.commitAccountsTrie(t, 10)
+	rootHash := accountsStorage.commitAccountsTrie(t, 20)
+	setCurrentRootHash(args, rootHash)
+	_ = accountsStorage.db.Remove(getNonRootKey(accountsStorage, rootHash))
+	numKeys := len(accountsStorage.keys())
+	tv, _ := NewTrieVerifier(args)
+
+	_, err := tv.collectGarbage(context.Background())
+	assert.True(t, errors.Is(err, ErrIncompleteTrie))
+	assert.Equal(t, numKeys, len(accountsStorage.keys()))
+}
+
+func TestTrieVerifier_CollectGarbageWithPruningShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	args.AccountsStorageManager = &mock.StorageManagerStub{
+		IsPruningEnabledCalled: func() bool {
+			return true
+		},
+	}
+	tv, _ := NewTrieVerifier(args)
+
+	_, err := tv.collectGarbage(context.Background())
+	assert.True(t, errors.Is(err, ErrPruningEnabled))
+}
+
+func TestTrieVerifier_CollectGarbageNotIterableDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	args.AccountsStorageManager, _ = trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tv, _ := NewTrieVerifier(args)
+
+	_, err := tv.collectGarbage(context.Background())
+	assert.True(t, errors.Is(err, storage.ErrRangeKeysNotSupported))
+}
+
+func TestNewTrieVerifier_GarbageCollectionOnNotReplaceableDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	args.AccountsStorageManager = &mock.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return mock.NewMemDbMock()
+		},
+	}
+
+	tv, err := NewTrieVerifier(args)
+	assert.True(t, check.IfNil(tv))
+	assert.True(t, errors.Is(err, ErrDatabaseNotReplaceable))
+}
+
+func TestNewTrieVerifier_GarbageCollectionShouldGuardTheTrieDatabases(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	args.PeerStorageManager = accountsStorage.storageManager
+
+	tv, err := NewTrieVerifier(args)
+	require.Nil(t, err)
+
+	guardedDb, ok := accountsStorage.storageManager.Database().(*guardedDatabase)
+	require.True(t, ok)
+	assert.True(t, guardedDb.db == accountsStorage.db)
+	assert.True(t, tv.tries[0].guardedDb == guardedDb)
+	assert.True(t, tv.tries[1].guardedDb == guardedDb)
+}
+
+func TestTrieVerifier_CollectGarbageShouldKeepTheTriesSinceTheFinalBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	args.ForkDetector = &mock.ForkDetectorStub{
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 1
+		},
+	}
+	unreachableRootHash := accountsStorage.commitAccountsTrie(t, 5)
+	finalRootHash := accountsStorage.commitAccountsTrie(t, 10)
+	notFinalRootHash := accountsStorage.commitAccountsTrie(t, 15)
+	rootHash := accountsStorage.commitAccountsTrie(t, 20)
+
+	finalHash := saveHeader(t, args, &block.Header{Nonce: 1, RootHash: finalRootHash})
+	notFinalHash := saveHeader(t, args, &block.Header{Nonce: 2, PrevHash: finalHash, RootHash: notFinalRootHash})
+	_ = args.ChainHandler.SetCurrentBlockHeader(&block.Header{Nonce: 3, PrevHash: notFinalHash, RootHash: rootHash})
+	tv, _ := NewTrieVerifier(args)
+
+	_, err := tv.collectGarbage(context.Background())
+	require.Nil(t, err)
+
+	_, err = accountsStorage.db.Get(unreachableRootHash)
+	assert.NotNil(t, err)
+	for _, keptRootHash := range [][]byte{finalRootHash, notFinalRootHash, rootHash} {
+		report, errVerify := tv.verifyTrie(context.Background(), tv.tries[0], keptRootHash, make(map[string]struct{}))
+		require.Nil(t, errVerify)
+		assert.Equal(t, 0, len(report.missing))
+	}
+}
+
+func TestTrieVerifier_CollectGarbageMissingHeaderSinceTheFinalBlockShouldNotDelete(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	_ = accountsStorage.commitAccountsTrie(t, 10)
+	rootHash := accountsStorage.commitAccountsTrie(t, 20)
+	_ = args.ChainHandler.SetCurrentBlockHeader(&block.Header{Nonce: 2, PrevHash: []byte("missing"), RootHash: rootHash})
+	numKeys := len(accountsStorage.keys())
+	tv, _ := NewTrieVerifier(args)
+
+	_, err := tv.collectGarbage(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, numKeys, len(accountsStorage.keys()))
+}
+
+func TestGuardedDatabase_ShouldNotRemoveTheKeysWrittenDuringTheCollection(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_ = db.Put([]byte("written"), []byte("old value"))
+	_ = db.Put([]byte("not written"), []byte("value"))
+	guardedDb := newGuardedDatabase(db)
+
+	guardedDb.startKeepingWrittenKeys()
+	_ = guardedDb.Put([]byte("written"), []byte("new value"))
+
+	isRemoved, err := guardedDb.removeIfNotWritten([]byte("written"))
+	assert.Nil(t, err)
+	assert.False(t, isRemoved)
+	isRemoved, err = guardedDb.removeIfNotWritten([]byte("not written"))
+	assert.Nil(t, err)
+	assert.True(t, isRemoved)
+
+	value, err := db.Get([]byte("written"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+	_, err = db.Get([]byte("not written"))
+	assert.NotNil(t, err)
+
+	guardedDb.stopKeepingWrittenKeys()
+	_ = guardedDb.Put([]byte("written"), []byte("new value"))
+	isRemoved, err = guardedDb.removeIfNotWritten([]byte("written"))
+	assert.Nil(t, err)
+	assert.True(t, isRemoved)
+}
+
+func TestTrieVerifier_VerifyPeerTrieShouldRequestFromMetachain(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	peerStorage := createTestTrieStorage(t)
+	args.PeerStorageManager = peerStorage.storageManager
+	peerTrie, _ := trie.NewTrie(peerStorage.storageManager, testMarshalizer, testHasher, maxTrieLevelInMemory)
+	_ = peerTrie.Update([]byte("validator1"), []byte("peer account 1"))
+	_ = peerTrie.Update([]byte("validator2"), []byte("peer account 2"))
+	_ = peerTrie.Commit()
+	peerRootHash, _ := peerTrie.Root()
+	args.ChainHandler = blockchain.NewMetaChain()
+	_ = args.ChainHandler.SetCurrentBlockHeader(&block.MetaBlock{ValidatorStatsRootHash: peerRootHash})
+	_ = peerStorage.db.Remove(getNonRootKey(peerStorage, peerRootHash))
+
+	numRequested := 0
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Equal(t, core.MetachainShardId, destShardID)
+			assert.Equal(t, factory.ValidatorTrieNodesTopic, topic)
+			numRequested += len(hashes)
+		},
+	}
+	tv, _ := NewTrieVerifier(args)
+
+	lines, err := tv.verify(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, 1, numRequested)
+	assert.True(t, strings.HasPrefix(lines[0], "accounts trie skipped"))
+	assert.True(t, strings.Contains(lines[1], "0 data tries, 1 missing"))
+}
+
+func TestTrieVerifier_QueryShouldOnlyReportStatus(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.GarbageCollectionEnabled = true
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	_ = accountsStorage.commitAccountsTrie(t, 5)
+	setCurrentRootHash(args, accountsStorage.commitAccountsTrie(t, 10))
+	numKeys := len(accountsStorage.keys())
+	tv, _ := NewTrieVerifier(args)
+	defer func() {
+		_ = tv.Close()
+	}()
+
+	assert.Equal(t, []string{"no operation in progress"}, tv.Query(""))
+	assert.Equal(t, []string{"no operation in progress"}, tv.Query("verify"))
+	assert.Equal(t, []string{"no operation in progress"}, tv.Query("gc"))
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, []string{"no operation in progress"}, tv.Query(""))
+	assert.Equal(t, numKeys, len(accountsStorage.keys()))
+
+	assert.Equal(t, "garbage collection started", tv.startBackgroundOperation())
+	status := waitOperationFinished(tv)
+	require.True(t, len(status) > 1)
+	assert.True(t, strings.HasPrefix(status[1], "last garbage collection started at"))
+	assert.True(t, numKeys > len(accountsStorage.keys()))
+}
+
+func TestTrieVerifier_BackgroundVerificationShouldReportStatus(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	setCurrentRootHash(args, accountsStorage.commitAccountsTrie(t, 10))
+	tv, _ := NewTrieVerifier(args)
+	defer func() {
+		_ = tv.Close()
+	}()
+
+	assert.Equal(t, "verification started", tv.startBackgroundOperation())
+
+	status := waitOperationFinished(tv)
+	require.Equal(t, 4, len(status))
+	assert.True(t, strings.HasPrefix(status[1], "last verification started at"))
+	assert.True(t, strings.HasPrefix(status[2], "accounts trie, root hash"))
+}
+
+func TestTrieVerifier_CloseShouldNotStartOperations(t *testing.T) {
+	t.Parallel()
+
+	tv, _ := NewTrieVerifier(createMockArgs(t))
+
+	err := tv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, "verification not started, the trie verifier is closed", tv.startBackgroundOperation())
+	assert.Equal(t, []string{"no operation in progress"}, tv.Query(""))
+}
+
+func TestTrieVerifier_StartBackgroundVerification(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	accountsStorage := createTestTrieStorage(t)
+	args.AccountsStorageManager = accountsStorage.storageManager
+	setCurrentRootHash(args, accountsStorage.commitAccountsTrie(t, 10))
+	tv, _ := NewTrieVerifier(args)
+	defer func() {
+		_ = tv.Close()
+	}()
+
+	err := tv.StartBackgroundVerification(0)
+	assert.True(t, errors.Is(err, ErrInvalidBackgroundInterval))
+
+	err = tv.StartBackgroundVerification(time.Millisecond)
+	require.Nil(t, err)
+
+	for i := 0; i < 100; i++ {
+		status := tv.Query("")
+		if len(status) > 1 {
+			assert.True(t, strings.HasPrefix(status[1], "last verification started at"))
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Fail(t, "the background verification did not run")
+}
+
+func waitOperationFinished(tv *trieVerifier) []string {
+	for i := 0; i < 100; i++ {
+		status := tv.Query("")
+		if status[0] == "no operation in progress" && len(status) > 1 {
+			return status
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return tv.Query("")
+}
+
+// getNonRootKey returns the key of a node reachable from the provided root hash, other than the root
+func getNonRootKey(tts *testTrieStorage, rootHash []byte) []byte {
+	cacher, _ := lrucache.NewCache(10)
+	tv := &trieVerifier{
+		trieNodesCacher: cacher,
+		marshalizer:     testMarshalizer,
+		hasher:          testHasher,
+	}
+	vt := &verifiedTrie{
+		storageManager: tts.storageManager,
+		hasDataTries:   true,
+	}
+
+	reachable := make(map[string]struct{})
+	_, _ = tv.verifyTrie(context.Background(), vt, rootHash, reachable)
+	for _, key := range tts.keys() {
+		_, isReachable := reachable[string(key)]
+		if isReachable && string(key) != string(rootHash) {
+			return key
+		}
+	}
+
+	return nil
+}
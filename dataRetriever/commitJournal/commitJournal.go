@@ -0,0 +1,450 @@
+package commitJournal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	goLevelDB "github.com/syndtr/goleveldb/leveldb"
+)
+
+var log = logger.GetOrCreate("dataretriever/commitjournal")
+
+const epochSize = 4
+
+// journalRecordKey is the key under which the last block commit journal record is saved in the journal unit
+var journalRecordKey = []byte("blockCommitJournalRecord")
+
+type journalBatch interface {
+	storage.Batcher
+	IsRemoved(key []byte) bool
+	Dump() []byte
+}
+
+// journaledUnit is a storage medium on which the recorded writes are applied
+type journaledUnit interface {
+	Put(key, data []byte) error
+	Remove(key []byte) error
+}
+
+// databaseHolder is implemented by the trie storage managers which allow their main database to be journaled
+type databaseHolder interface {
+	Database() data.DBWriteCacher
+	SetDatabase(db data.DBWriteCacher) error
+}
+
+// ArgsCommitJournal is the argument DTO used to create a new commit journal instance
+type ArgsCommitJournal struct {
+	Store               dataRetriever.StorageService
+	JournalUnit         dataRetriever.UnitType
+	JournaledUnits      []dataRetriever.UnitType
+	TrieStorageManagers map[dataRetriever.UnitType]data.StorageManager
+}
+
+// commitJournal is a redo write-ahead journal which makes the writes done while committing a block atomic: between
+// Begin and Commit the writes done through the journaled store and on the journaled trie databases are kept in a
+// leveldb batch, then the batch is persisted as a single record in the journal unit before being applied. A crash
+// before the record is persisted leaves the units untouched, while a crash after it is completed by Recover on the
+// next start. The record is kept after being applied, so the writes still buffered by the units can be replayed after
+// a crash, and is dropped as soon as a journaled unit is written outside a commit.
+//
+// Only the components committing the block write through the journaled store, the other ones use the original store,
+// whose journaled units are wrapped just to drop the record, so a rollback never discards their writes. The trie
+// nodes are content addressed, so they are written even if the commit is rolled back and writing them outside a
+// commit never invalidates the record.
+type commitJournal struct {
+	mutJournal         sync.RWMutex
+	journalStorer      storage.Storer
+	units              map[dataRetriever.UnitType]journaledUnit
+	trieUnits          map[dataRetriever.UnitType]struct{}
+	journaledStore     *journaledStore
+	batch              journalBatch
+	epoch              uint32
+	isRecording        bool
+	hasPersistedRecord bool
+}
+
+// NewCommitJournal creates a new commit journal instance. The journaled units from the provided store are wrapped so
+// that writing them outside a commit drops the persisted record, while the journaled store returned by
+// JournaledStore records the writes done during a commit. The main databases of the provided trie storage managers
+// are replaced with journaled ones
+func NewCommitJournal(args ArgsCommitJournal) (*commitJournal, error) {
+	if check.IfNil(args.Store) {
+		return nil, dataRetriever.ErrNilStore
+	}
+	if len(args.JournaledUnits) == 0 {
+		return nil, dataRetriever.ErrEmptyJournaledUnits
+	}
+
+	journalStorer := args.Store.GetStorer(args.JournalUnit)
+	if check.IfNil(journalStorer) {
+		return nil, fmt.Errorf("%w for journal unit %s", dataRetriever.ErrNoSuchStorageUnit, args.JournalUnit.String())
+	}
+
+	cj := &commitJournal{
+		journalStorer: journalStorer,
+		units:         make(map[dataRetriever.UnitType]journaledUnit),
+		trieUnits:     make(map[dataRetriever.UnitType]struct{}),
+		batch:         leveldb.NewBatch(),
+	}
+	cj.journaledStore = newJournaledStore(args.Store)
+
+	for _, unit := range args.JournaledUnits {
+		storer := args.Store.GetStorer(unit)
+		if check.IfNil(storer) {
+			log.Debug("commit journal: storage unit not found, it will not be journaled", "unit", unit.String())
+			continue
+		}
+
+		cj.units[unit] = storer
+		cj.journaledStore.addStorer(unit, newJournaledStorer(unit, storer, cj, true))
+		args.Store.AddStorer(unit, newJournaledStorer(unit, storer, cj, false))
+	}
+
+	for unit, trieStorageManager := range args.TrieStorageManagers {
+		err := cj.journalTrieDatabase(unit, trieStorageManager)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cj, nil
+}
+
+func (cj *commitJournal) journalTrieDatabase(unit dataRetriever.UnitType, trieStorageManager data.StorageManager) error {
+	if check.IfNil(trieStorageManager) {
+		return fmt.Errorf("%w for unit %s", dataRetriever.ErrInvalidTrieStorageManager, unit.String())
+	}
+	holder, ok := trieStorageManager.(databaseHolder)
+	if !ok {
+		return fmt.Errorf("%w for unit %s", dataRetriever.ErrInvalidTrieStorageManager, unit.String())
+	}
+
+	db := holder.Database()
+	err := holder.SetDatabase(newJournaledDatabase(unit, db, cj))
+	if err != nil {
+		return err
+	}
+
+	cj.units[unit] = db
+	cj.trieUnits[unit] = struct{}{}
+
+	return nil
+}
+
+// JournaledStore returns the storage service whose writes are recorded while a commit is in progress. It must be used
+// only by the components committing the block
+func (cj *commitJournal) JournaledStore() dataRetriever.StorageService {
+	return cj.journaledStore
+}
+
+// Begin starts recording the writes done through the journaled store and on the journaled trie databases. The epoch
+// is the one used for put operations
+func (cj *commitJournal) Begin(epoch uint32) {
+	cj.mutJournal.Lock()
+	cj.batch.Reset()
+	cj.epoch = epoch
+	cj.isRecording = true
+	cj.mutJournal.Unlock()
+}
+
+// Commit persists the recorded writes as a single journal record and then applies them. If the commit fails, the
+// persisted record is dropped, so it is not replayed on restart, and only the recorded trie nodes are written
+func (cj *commitJournal) Commit() error {
+	cj.mutJournal.Lock()
+	defer cj.mutJournal.Unlock()
+
+	if !cj.isRecording {
+		return nil
+	}
+
+	cj.isRecording = false
+	batchData := cj.batch.Dump()
+	cj.batch.Reset()
+	if len(batchData) == 0 {
+		return nil
+	}
+
+	record := cj.newRecord(batchData)
+	err := cj.journalStorer.Put(journalRecordKey, record)
+	if err != nil {
+		cj.writeTrieNodes(record)
+		return err
+	}
+	cj.hasPersistedRecord = true
+
+	err = cj.applyRecord(record, cj.isAnyUnit)
+	if err != nil {
+		cj.dropPersistedRecord()
+		cj.writeTrieNodes(record)
+		return fmt.Errorf("%w while applying the block commit journal", err)
+	}
+
+	return nil
+}
+
+// Rollback discards the writes recorded since Begin, except for the trie nodes which are written
+func (cj *commitJournal) Rollback() {
+	cj.mutJournal.Lock()
+	defer cj.mutJournal.Unlock()
+
+	if !cj.isRecording {
+		return
+	}
+
+	cj.isRecording = false
+	batchData := cj.batch.Dump()
+	cj.batch.Reset()
+	if len(batchData) == 0 {
+		return
+	}
+
+	cj.writeTrieNodes(cj.newRecord(batchData))
+}
+
+// Recover replays the last persisted journal record, if any, completing a commit interrupted by a crash
+func (cj *commitJournal) Recover() error {
+	cj.mutJournal.Lock()
+	defer cj.mutJournal.Unlock()
+
+	record, err := cj.journalStorer.Get(journalRecordKey)
+	if err != nil {
+		log.Debug("commit journal: no record to recover")
+		return nil
+	}
+
+	log.Info("commit journal: replaying the last block commit", "num bytes", len(record))
+	err = cj.applyRecord(record, cj.isAnyUnit)
+	if err != nil {
+		return err
+	}
+	cj.hasPersistedRecord = true
+
+	return nil
+}
+
+func (cj *commitJournal) newRecord(batchData []byte) []byte {
+	record := make([]byte, epochSize+len(batchData))
+	binary.BigEndian.PutUint32(record, cj.epoch)
+	copy(record[epochSize:], batchData)
+
+	return record
+}
+
+// writeTrieNodes writes the trie nodes from a record which was not applied, as the trie databases might have been
+// written by other components while the commit was in progress
+func (cj *commitJournal) writeTrieNodes(record []byte) {
+	err := cj.applyRecord(record, cj.isTrieUnit)
+	if err != nil {
+		log.Warn("commit journal: cannot write the recorded trie nodes", "error", err.Error())
+	}
+}
+
+func (cj *commitJournal) isAnyUnit(_ dataRetriever.UnitType) bool {
+	return true
+}
+
+func (cj *commitJournal) isTrieUnit(unit dataRetriever.UnitType) bool {
+	_, ok := cj.trieUnits[unit]
+	return ok
+}
+
+func (cj *commitJournal) applyRecord(record []byte, shouldApply func(unit dataRetriever.UnitType) bool) error {
+	if len(record) < epochSize {
+		return dataRetriever.ErrInvalidJournalRecord
+	}
+
+	epoch := binary.BigEndian.Uint32(record[:epochSize])
+	batch := &goLevelDB.Batch{}
+	err := batch.Load(record[epochSize:])
+	if err != nil {
+		return fmt.Errorf("%w: %s", dataRetriever.ErrInvalidJournalRecord, err.Error())
+	}
+
+	for unitType, unit := range cj.units {
+		if !shouldApply(unitType) {
+			continue
+		}
+
+		storerWithEpoch, ok := unit.(storage.StorerWithPutInEpoch)
+		if ok {
+			storerWithEpoch.SetEpochForPutOperation(epoch)
+		}
+	}
+
+	replayer := &recordReplayer{
+		units:       cj.units,
+		shouldApply: shouldApply,
+	}
+	err = batch.Replay(replayer)
+	if err != nil {
+		return err
+	}
+
+	return replayer.err
+}
+
+// record adds the write to the batch, if a commit is in progress
+func (cj *commitJournal) record(unit dataRetriever.UnitType, key []byte, value []byte) bool {
+	cj.mutJournal.Lock()
+	defer cj.mutJournal.Unlock()
+
+	if !cj.isRecording {
+		return false
+	}
+
+	_ = cj.batch.Put(recordKey(unit, key), value)
+
+	return true
+}
+
+// recordRemove adds the removal to the batch, if a commit is in progress
+func (cj *commitJournal) recordRemove(unit dataRetriever.UnitType, key []byte) bool {
+	cj.mutJournal.Lock()
+	defer cj.mutJournal.Unlock()
+
+	if !cj.isRecording {
+		return false
+	}
+
+	_ = cj.batch.Delete(recordKey(unit, key))
+
+	return true
+}
+
+// get returns the value recorded for the provided key, if any, and whether the key was removed while recording
+func (cj *commitJournal) get(unit dataRetriever.UnitType, key []byte) ([]byte, bool) {
+	cj.mutJournal.RLock()
+	defer cj.mutJournal.RUnlock()
+
+	if !cj.isRecording {
+		return nil, false
+	}
+
+	journalKey := recordKey(unit, key)
+	if cj.batch.IsRemoved(journalKey) {
+		return nil, true
+	}
+
+	return cj.batch.Get(journalKey), false
+}
+
+// writeOutsideCommit is called before a journaled unit is written outside a commit. The persisted record is removed as
+// it must not be replayed over such a write
+func (cj *commitJournal) writeOutsideCommit() {
+	cj.mutJournal.Lock()
+	cj.dropPersistedRecord()
+	cj.mutJournal.Unlock()
+}
+
+func (cj *commitJournal) dropPersistedRecord() {
+	if !cj.hasPersistedRecord {
+		return
+	}
+
+	err := cj.journalStorer.Remove(journalRecordKey)
+	if err != nil {
+		log.Warn("commit journal: cannot remove the persisted record", "error", err.Error())
+		return
+	}
+
+	cj.hasPersistedRecord = false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cj *commitJournal) IsInterfaceNil() bool {
+	return cj == nil
+}
+
+func recordKey(unit dataRetriever.UnitType, key []byte) []byte {
+	journalKey := make([]byte, 1+len(key))
+	journalKey[0] = byte(unit)
+	copy(journalKey[1:], key)
+
+	return journalKey
+}
+
+type recordReplayer struct {
+	units       map[dataRetriever.UnitType]journaledUnit
+	shouldApply func(unit dataRetriever.UnitType) bool
+	err         error
+}
+
+// Put applies a recorded put operation on the corresponding unit
+func (rr *recordReplayer) Put(key, value []byte) {
+	unit, ok := rr.getUnit(key)
+	if !ok {
+		return
+	}
+
+	err := unit.Put(key[1:], value)
+	if err != nil && rr.err == nil {
+		rr.err = err
+	}
+}
+
+// Delete applies a recorded remove operation on the corresponding unit
+func (rr *recordReplayer) Delete(key []byte) {
+	unit, ok := rr.getUnit(key)
+	if !ok {
+		return
+	}
+
+	err := unit.Remove(key[1:])
+	if err != nil && rr.err == nil {
+		rr.err = err
+	}
+}
+
+func (rr *recordReplayer) getUnit(key []byte) (journaledUnit, bool) {
+	if rr.err != nil {
+		return nil, false
+	}
+	if len(key) == 0 {
+		rr.err = dataRetriever.ErrInvalidJournalRecord
+		return nil, false
+	}
+
+	unitType := dataRetriever.UnitType(key[0])
+	if !rr.shouldApply(unitType) {
+		return nil, false
+	}
+
+	unit, ok := rr.units[unitType]
+	if !ok {
+		rr.err = fmt.Errorf("%w for unit %d", dataRetriever.ErrNoSuchStorageUnit, key[0])
+		return nil, false
+	}
+
+	return unit, true
+}
+
+// BlockCommitUnits returns the storage units written while committing a block, for the provided number of shards. The
+// bootstrap unit is not journaled: the bootstrap data is saved after the commit and points to the last committed block
+func BlockCommitUnits(numShards uint32) []dataRetriever.UnitType {
+	units := []dataRetriever.UnitType{
+		dataRetriever.TransactionUnit,
+		dataRetriever.MiniBlockUnit,
+		dataRetriever.PeerChangesUnit,
+		dataRetriever.BlockHeaderUnit,
+		dataRetriever.MetaBlockUnit,
+		dataRetriever.UnsignedTransactionUnit,
+		dataRetriever.RewardTransactionUnit,
+		dataRetriever.MetaHdrNonceHashDataUnit,
+		dataRetriever.TransactionsMetadataUnit,
+		dataRetriever.MiniblocksMetadataUnit,
+		dataRetriever.TransactionsHistoryUnit,
+	}
+
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		units = append(units, dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardID))
+	}
+
+	return units
+}
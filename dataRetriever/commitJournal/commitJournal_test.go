@@ -0,0 +1,714 @@
+package commitJournal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var journaledUnits = []dataRetriever.UnitType{
+	dataRetriever.TransactionUnit,
+	dataRetriever.BlockHeaderUnit,
+	dataRetriever.BootstrapUnit,
+}
+
+var errKilled = errors.New("killed")
+
+// killSwitch panics on the write operation with the given index, simulating a node killed in the middle of it
+type killSwitch struct {
+	numOperations int
+	killAt        int
+}
+
+func (ks *killSwitch) step() {
+	if ks == nil {
+		return
+	}
+
+	ks.numOperations++
+	if ks.numOperations == ks.killAt {
+		panic(errKilled)
+	}
+}
+
+type killableStorer struct {
+	storage.Storer
+	killSwitch *killSwitch
+}
+
+func (ks *killableStorer) Put(key, data []byte) error {
+	ks.killSwitch.step()
+	return ks.Storer.Put(key, data)
+}
+
+func (ks *killableStorer) Remove(key []byte) error {
+	ks.killSwitch.step()
+	return ks.Storer.Remove(key)
+}
+
+// persisters holds the storage media surviving a node restart
+type persisters map[dataRetriever.UnitType]storage.Persister
+
+func newPersisters() persisters {
+	p := make(persisters)
+	for _, unit := range journaledUnits {
+		p[unit] = memorydb.New()
+	}
+	p[dataRetriever.BlockCommitJournalUnit] = memorydb.New()
+	p[dataRetriever.UserAccountsUnit] = memorydb.New()
+
+	return p
+}
+
+func createKillableStorer(persister storage.Persister, ks *killSwitch) *killableStorer {
+	cacher, _ := lrucache.NewCache(100)
+	storer, _ := storageUnit.NewStorageUnit(cacher, persister)
+
+	return &killableStorer{Storer: storer, killSwitch: ks}
+}
+
+// createStore creates a store over the provided persisters, with empty caches, as after a node restart
+func createStore(p persisters, ks *killSwitch) dataRetriever.StorageService {
+	store := dataRetriever.NewChainStorer()
+	for unit, persister := range p {
+		if unit == dataRetriever.UserAccountsUnit {
+			continue
+		}
+
+		store.AddStorer(unit, createKillableStorer(persister, ks))
+	}
+
+	return store
+}
+
+// createTrieStorageManager creates the storage manager of the accounts trie over the provided persisters
+func createTrieStorageManager(p persisters, ks *killSwitch) data.StorageManager {
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(createKillableStorer(p[dataRetriever.UserAccountsUnit], ks))
+
+	return tsm
+}
+
+func createMockArgs(store dataRetriever.StorageService) ArgsCommitJournal {
+	return ArgsCommitJournal{
+		Store:          store,
+		JournalUnit:    dataRetriever.BlockCommitJournalUnit,
+		JournaledUnits: journaledUnits,
+	}
+}
+
+func newCommitJournalOverStore(t *testing.T, store dataRetriever.StorageService) *commitJournal {
+	cj, err := NewCommitJournal(createMockArgs(store))
+	require.Nil(t, err)
+
+	return cj
+}
+
+func newCommitJournalOverStoreAndTrie(
+	t *testing.T,
+	store dataRetriever.StorageService,
+	tsm data.StorageManager,
+) *commitJournal {
+	args := createMockArgs(store)
+	args.TrieStorageManagers = map[dataRetriever.UnitType]data.StorageManager{
+		dataRetriever.UserAccountsUnit: tsm,
+	}
+	cj, err := NewCommitJournal(args)
+	require.Nil(t, err)
+
+	return cj
+}
+
+// trieState returns the trie nodes which are relevant for the tests
+func trieState(p persisters) map[string]string {
+	state := make(map[string]string)
+	for _, key := range []string{"node0", "node1", "node2"} {
+		value, err := p[dataRetriever.UserAccountsUnit].Get([]byte(key))
+		if err == nil {
+			state[key] = string(value)
+		}
+	}
+
+	return state
+}
+
+// unitsState returns the content of the journaled units which is relevant for the tests
+func unitsState(store dataRetriever.StorageService) map[string]string {
+	keys := map[dataRetriever.UnitType][]string{
+		dataRetriever.TransactionUnit: {"tx0", "tx1", "tx2"},
+		dataRetriever.BlockHeaderUnit: {"hdr1", "hdr2"},
+		dataRetriever.BootstrapUnit:   {"round1", "round2", "highestRound"},
+	}
+
+	state := make(map[string]string)
+	for unit, unitKeys := range keys {
+		for _, key := range unitKeys {
+			value, err := store.Get(unit, []byte(key))
+			if err == nil {
+				state[key] = string(value)
+			}
+		}
+	}
+
+	return state
+}
+
+func previousBlockState() map[string]string {
+	return map[string]string{
+		"tx0":          "tx0 data",
+		"hdr1":         "header 1",
+		"round1":       "boot data 1",
+		"highestRound": "1",
+	}
+}
+
+func committedBlockState() map[string]string {
+	return map[string]string{
+		"tx1":          "tx1 data",
+		"tx2":          "tx2 data",
+		"hdr1":         "header 1",
+		"hdr2":         "header 2",
+		"round1":       "boot data 1",
+		"round2":       "boot data 2",
+		"highestRound": "2",
+	}
+}
+
+func writePreviousBlock(p persisters) {
+	store := createStore(p, nil)
+	for key, value := range previousBlockState() {
+		unit := dataRetriever.BootstrapUnit
+		switch key {
+		case "tx0":
+			unit = dataRetriever.TransactionUnit
+		case "hdr1":
+			unit = dataRetriever.BlockHeaderUnit
+		}
+		_ = store.Put(unit, []byte(key), []byte(value))
+	}
+}
+
+// commitBlock writes the second block through the journaled store, the same way a block processor commits it
+func commitBlock(cj *commitJournal) error {
+	cj.Begin(0)
+
+	store := cj.JournaledStore()
+	_ = store.Put(dataRetriever.TransactionUnit, []byte("tx1"), []byte("tx1 data"))
+	_ = store.Put(dataRetriever.TransactionUnit, []byte("tx2"), []byte("tx2 data"))
+	_ = store.GetStorer(dataRetriever.TransactionUnit).Remove([]byte("tx0"))
+	_ = store.Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	_ = store.Put(dataRetriever.BootstrapUnit, []byte("round2"), []byte("boot data 2"))
+	_ = store.Put(dataRetriever.BootstrapUnit, []byte("highestRound"), []byte("2"))
+
+	return cj.Commit()
+}
+
+// commitBlockWithTrieNodes writes the second block and the trie nodes of its state through the journal
+func commitBlockWithTrieNodes(cj *commitJournal, tsm data.StorageManager) error {
+	cj.Begin(0)
+
+	_ = tsm.Database().Put([]byte("node1"), []byte("node 1"))
+	_ = tsm.Database().Put([]byte("node2"), []byte("node 2"))
+
+	store := cj.JournaledStore()
+	_ = store.Put(dataRetriever.TransactionUnit, []byte("tx1"), []byte("tx1 data"))
+	_ = store.Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+
+	return cj.Commit()
+}
+
+func runUntilKilled(handler func()) (wasKilled bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if r != errKilled {
+			panic(r)
+		}
+		wasKilled = true
+	}()
+
+	handler()
+
+	return false
+}
+
+// restart recreates the store and the journal over the same persisters and recovers the journal
+func restart(t *testing.T, p persisters, ks *killSwitch) (dataRetriever.StorageService, bool) {
+	store := createStore(p, ks)
+	cj := newCommitJournalOverStore(t, store)
+
+	wasKilled := runUntilKilled(func() {
+		err := cj.Recover()
+		require.Nil(t, err)
+	})
+
+	return store, wasKilled
+}
+
+// restartWithTrie recreates the store, the trie storage manager and the journal and recovers the journal
+func restartWithTrie(t *testing.T, p persisters, ks *killSwitch) bool {
+	store := createStore(p, ks)
+	cj := newCommitJournalOverStoreAndTrie(t, store, createTrieStorageManager(p, ks))
+
+	return runUntilKilled(func() {
+		err := cj.Recover()
+		require.Nil(t, err)
+	})
+}
+
+func TestNewCommitJournal_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil)
+	cj, err := NewCommitJournal(args)
+	assert.Nil(t, cj)
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+
+	args = createMockArgs(createStore(newPersisters(), nil))
+	args.JournaledUnits = nil
+	cj, err = NewCommitJournal(args)
+	assert.Nil(t, cj)
+	assert.Equal(t, dataRetriever.ErrEmptyJournaledUnits, err)
+
+	args = createMockArgs(createStore(newPersisters(), nil))
+	args.JournalUnit = dataRetriever.StatusMetricsUnit
+	cj, err = NewCommitJournal(args)
+	assert.Nil(t, cj)
+	assert.True(t, errors.Is(err, dataRetriever.ErrNoSuchStorageUnit))
+}
+
+func TestNewCommitJournal_ShouldWrapTheJournaledUnits(t *testing.T) {
+	t.Parallel()
+
+	store := createStore(newPersisters(), nil)
+	args := createMockArgs(store)
+	args.JournaledUnits = append(args.JournaledUnits, dataRetriever.MiniBlockUnit)
+	cj, err := NewCommitJournal(args)
+	require.Nil(t, err)
+	assert.False(t, cj.IsInterfaceNil())
+
+	for _, unit := range journaledUnits {
+		storer, ok := store.GetStorer(unit).(*journaledStorer)
+		require.True(t, ok)
+		assert.False(t, storer.recordsWrites)
+
+		storer, ok = cj.JournaledStore().GetStorer(unit).(*journaledStorer)
+		require.True(t, ok)
+		assert.True(t, storer.recordsWrites)
+	}
+	_, ok := store.GetStorer(dataRetriever.BlockCommitJournalUnit).(*journaledStorer)
+	assert.False(t, ok)
+	_, ok = cj.JournaledStore().GetStorer(dataRetriever.BlockCommitJournalUnit).(*journaledStorer)
+	assert.False(t, ok)
+	assert.Nil(t, store.GetStorer(dataRetriever.MiniBlockUnit))
+}
+
+func TestNewCommitJournal_ShouldJournalTheTrieDatabases(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	tsm := createTrieStorageManager(p, nil)
+	cj := newCommitJournalOverStoreAndTrie(t, createStore(p, nil), tsm)
+	assert.False(t, cj.IsInterfaceNil())
+
+	_, ok := tsm.Database().(*journaledDatabase)
+	assert.True(t, ok)
+}
+
+func TestNewCommitJournal_InvalidTrieStorageManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(createStore(newPersisters(), nil))
+	args.TrieStorageManagers = map[dataRetriever.UnitType]data.StorageManager{
+		dataRetriever.UserAccountsUnit: nil,
+	}
+	cj, err := NewCommitJournal(args)
+	assert.Nil(t, cj)
+	assert.True(t, errors.Is(err, dataRetriever.ErrInvalidTrieStorageManager))
+}
+
+func TestCommitJournal_RecordedWritesShouldBeReadableBeforeCommit(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	cj.Begin(0)
+	storer := cj.JournaledStore().GetStorer(dataRetriever.TransactionUnit)
+	_ = storer.Put([]byte("tx1"), []byte("tx1 data"))
+	_ = storer.Remove([]byte("tx0"))
+
+	value, err := storer.Get([]byte("tx1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("tx1 data"), value)
+	assert.Nil(t, storer.Has([]byte("tx1")))
+	value, err = storer.SearchFirst([]byte("tx1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("tx1 data"), value)
+	value, err = storer.GetFromEpoch([]byte("tx1"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("tx1 data"), value)
+	assert.Nil(t, storer.HasInEpoch([]byte("tx1"), 0))
+
+	_, err = storer.Get([]byte("tx0"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, storer.Has([]byte("tx0")))
+
+	assert.Equal(t, previousBlockState(), unitsState(store))
+	assert.Equal(t, previousBlockState(), unitsState(createStore(p, nil)))
+}
+
+func TestCommitJournal_RollbackShouldDiscardTheRecordedWrites(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	cj.Begin(0)
+	_ = cj.JournaledStore().Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	cj.Rollback()
+
+	err := cj.Commit()
+	assert.Nil(t, err)
+	assert.Equal(t, previousBlockState(), unitsState(store))
+
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.NotNil(t, err)
+}
+
+func TestCommitJournal_CommitShouldApplyTheRecordedWritesAndKeepTheRecord(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	err := commitBlock(cj)
+	require.Nil(t, err)
+	assert.Equal(t, committedBlockState(), unitsState(store))
+	assert.Equal(t, committedBlockState(), unitsState(createStore(p, nil)))
+
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.Nil(t, err)
+}
+
+func TestCommitJournal_WriteOutsideCommitShouldDropTheRecord(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	err := commitBlock(cj)
+	require.Nil(t, err)
+
+	// e.g. the bootstrapper rolls back the committed block
+	err = store.GetStorer(dataRetriever.BlockHeaderUnit).Remove([]byte("hdr2"))
+	require.Nil(t, err)
+
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.NotNil(t, err)
+
+	restartedStore, _ := restart(t, p, nil)
+	_, err = restartedStore.Get(dataRetriever.BlockHeaderUnit, []byte("hdr2"))
+	assert.NotNil(t, err)
+}
+
+func TestCommitJournal_CommitWithFailingJournalUnitShouldNotTouchTheUnits(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	expectedErr := errors.New("expected error")
+	journalStorer := store.GetStorer(dataRetriever.BlockCommitJournalUnit).(*killableStorer)
+	store.AddStorer(dataRetriever.BlockCommitJournalUnit, &failingPutStorer{Storer: journalStorer, err: expectedErr})
+	cj := newCommitJournalOverStore(t, store)
+
+	err := commitBlock(cj)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, previousBlockState(), unitsState(createStore(p, nil)))
+}
+
+func TestCommitJournal_RecoverShouldSetTheRecordedEpochForPutOperations(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+	cj.Begin(7)
+	_ = cj.JournaledStore().Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	err := cj.Commit()
+	require.Nil(t, err)
+
+	restartedStore := createStore(p, nil)
+	headerStorer := &epochRecorderStorer{Storer: restartedStore.GetStorer(dataRetriever.BlockHeaderUnit)}
+	restartedStore.AddStorer(dataRetriever.BlockHeaderUnit, headerStorer)
+	err = newCommitJournalOverStore(t, restartedStore).Recover()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(7), headerStorer.epoch)
+}
+
+func TestCommitJournal_RecoverInvalidRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	_ = p[dataRetriever.BlockCommitJournalUnit].Put(journalRecordKey, []byte("invalid record"))
+
+	cj := newCommitJournalOverStore(t, createStore(p, nil))
+	err := cj.Recover()
+	assert.True(t, errors.Is(err, dataRetriever.ErrInvalidJournalRecord))
+}
+
+func TestCommitJournal_KilledBeforeCommitShouldRollbackTheBlock(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	cj.Begin(0)
+	_ = cj.JournaledStore().Put(dataRetriever.TransactionUnit, []byte("tx1"), []byte("tx1 data"))
+	_ = cj.JournaledStore().Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	// the node is killed here, before the commit
+
+	restartedStore, _ := restart(t, p, nil)
+	assert.Equal(t, previousBlockState(), unitsState(restartedStore))
+}
+
+func TestCommitJournal_KilledAtEveryCommitStepShouldCompleteOrRollbackTheBlock(t *testing.T) {
+	t.Parallel()
+
+	numCommitOperations := countCommitOperations(t)
+	require.True(t, numCommitOperations > 1)
+
+	for killAt := 1; killAt <= numCommitOperations; killAt++ {
+		t.Run(fmt.Sprintf("killed at operation %d", killAt), func(t *testing.T) {
+			p := newPersisters()
+			writePreviousBlock(p)
+			ks := &killSwitch{killAt: killAt}
+			store := createStore(p, ks)
+			cj := newCommitJournalOverStore(t, store)
+
+			wasKilled := runUntilKilled(func() {
+				_ = commitBlock(cj)
+			})
+			require.True(t, wasKilled)
+
+			expectedState := committedBlockState()
+			isJournalRecordWrite := killAt == 1
+			if isJournalRecordWrite {
+				expectedState = previousBlockState()
+			}
+
+			restartedStore, _ := restart(t, p, nil)
+			assert.Equal(t, expectedState, unitsState(restartedStore))
+		})
+	}
+}
+
+func TestCommitJournal_KilledAgainWhileRecoveringShouldCompleteTheBlock(t *testing.T) {
+	t.Parallel()
+
+	numCommitOperations := countCommitOperations(t)
+	for killAt := 2; killAt <= numCommitOperations; killAt++ {
+		for killRecoveryAt := 1; killRecoveryAt < numCommitOperations; killRecoveryAt++ {
+			p := newPersisters()
+			writePreviousBlock(p)
+			store := createStore(p, &killSwitch{killAt: killAt})
+			cj := newCommitJournalOverStore(t, store)
+			wasKilled := runUntilKilled(func() {
+				_ = commitBlock(cj)
+			})
+			require.True(t, wasKilled)
+
+			_, wasKilled = restart(t, p, &killSwitch{killAt: killRecoveryAt})
+			require.True(t, wasKilled)
+
+			restartedStore, _ := restart(t, p, nil)
+			assert.Equal(t, committedBlockState(), unitsState(restartedStore),
+				fmt.Sprintf("killed at operation %d, then at recovery operation %d", killAt, killRecoveryAt))
+		}
+	}
+}
+
+func TestCommitJournal_WritesThroughTheOriginalStoreShouldNotBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	cj := newCommitJournalOverStore(t, store)
+
+	cj.Begin(0)
+	_ = cj.JournaledStore().Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	// e.g. a component not involved in the block commit, running on another goroutine
+	err := store.Put(dataRetriever.TransactionUnit, []byte("tx1"), []byte("tx1 data"))
+	require.Nil(t, err)
+
+	_, err = cj.JournaledStore().Get(dataRetriever.TransactionUnit, []byte("tx1"))
+	assert.Nil(t, err)
+	_, err = store.Get(dataRetriever.BlockHeaderUnit, []byte("hdr2"))
+	assert.NotNil(t, err)
+
+	cj.Rollback()
+
+	expectedState := previousBlockState()
+	expectedState["tx1"] = "tx1 data"
+	assert.Equal(t, expectedState, unitsState(createStore(p, nil)))
+}
+
+func TestCommitJournal_CommitShouldApplyTheRecordedTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	tsm := createTrieStorageManager(p, nil)
+	cj := newCommitJournalOverStoreAndTrie(t, createStore(p, nil), tsm)
+
+	cj.Begin(0)
+	_ = tsm.Database().Put([]byte("node1"), []byte("node 1"))
+	value, err := tsm.Database().Get([]byte("node1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("node 1"), value)
+	assert.Equal(t, map[string]string{}, trieState(p))
+
+	err = cj.Commit()
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"node1": "node 1"}, trieState(p))
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.Nil(t, err)
+
+	// the pruning, done outside the commit, does not drop the record
+	_ = tsm.Database().Put([]byte("node0"), []byte("node 0"))
+	_ = tsm.Database().Remove([]byte("node0"))
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.Nil(t, err)
+}
+
+func TestCommitJournal_RollbackShouldWriteTheRecordedTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	tsm := createTrieStorageManager(p, nil)
+	cj := newCommitJournalOverStoreAndTrie(t, createStore(p, nil), tsm)
+
+	cj.Begin(0)
+	_ = tsm.Database().Put([]byte("node1"), []byte("node 1"))
+	_ = cj.JournaledStore().Put(dataRetriever.BlockHeaderUnit, []byte("hdr2"), []byte("header 2"))
+	cj.Rollback()
+
+	assert.Equal(t, previousBlockState(), unitsState(createStore(p, nil)))
+	assert.Equal(t, map[string]string{"node1": "node 1"}, trieState(p))
+	_, err := p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.NotNil(t, err)
+}
+
+func TestCommitJournal_CommitFailingToApplyShouldDropTheRecord(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	writePreviousBlock(p)
+	store := createStore(p, nil)
+	expectedErr := errors.New("expected error")
+	headerStorer := store.GetStorer(dataRetriever.BlockHeaderUnit).(*killableStorer)
+	store.AddStorer(dataRetriever.BlockHeaderUnit, &failingPutStorer{Storer: headerStorer, err: expectedErr})
+	tsm := createTrieStorageManager(p, nil)
+	cj := newCommitJournalOverStoreAndTrie(t, store, tsm)
+
+	err := commitBlockWithTrieNodes(cj, tsm)
+	assert.True(t, errors.Is(err, expectedErr))
+	_, err = p[dataRetriever.BlockCommitJournalUnit].Get(journalRecordKey)
+	assert.NotNil(t, err)
+	assert.Equal(t, map[string]string{"node1": "node 1", "node2": "node 2"}, trieState(p))
+
+	restartWithTrie(t, p, nil)
+	_, err = createStore(p, nil).Get(dataRetriever.BlockHeaderUnit, []byte("hdr2"))
+	assert.NotNil(t, err)
+}
+
+func TestCommitJournal_KilledAtEveryCommitStepShouldCompleteOrRollbackTheTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	p := newPersisters()
+	ks := &killSwitch{}
+	tsm := createTrieStorageManager(p, ks)
+	err := commitBlockWithTrieNodes(newCommitJournalOverStoreAndTrie(t, createStore(p, ks), tsm), tsm)
+	require.Nil(t, err)
+	numCommitOperations := ks.numOperations
+
+	expectedCommittedState := map[string]string{"node1": "node 1", "node2": "node 2", "tx1": "tx1 data", "hdr2": "header 2"}
+	for killAt := 1; killAt <= numCommitOperations; killAt++ {
+		p = newPersisters()
+		ks = &killSwitch{killAt: killAt}
+		tsm = createTrieStorageManager(p, ks)
+		cj := newCommitJournalOverStoreAndTrie(t, createStore(p, ks), tsm)
+		wasKilled := runUntilKilled(func() {
+			_ = commitBlockWithTrieNodes(cj, tsm)
+		})
+		require.True(t, wasKilled)
+
+		wasKilled = restartWithTrie(t, p, nil)
+		require.False(t, wasKilled)
+
+		state := trieState(p)
+		for key, value := range unitsState(createStore(p, nil)) {
+			state[key] = value
+		}
+		expectedState := expectedCommittedState
+		isJournalRecordWrite := killAt == 1
+		if isJournalRecordWrite {
+			expectedState = map[string]string{}
+		}
+		assert.Equal(t, expectedState, state, fmt.Sprintf("killed at operation %d", killAt))
+	}
+}
+
+// countCommitOperations returns the number of write operations done on the storage media by a block commit
+func countCommitOperations(t *testing.T) int {
+	p := newPersisters()
+	writePreviousBlock(p)
+	ks := &killSwitch{}
+	store := createStore(p, ks)
+	cj := newCommitJournalOverStore(t, store)
+
+	err := commitBlock(cj)
+	require.Nil(t, err)
+
+	return ks.numOperations
+}
+
+type failingPutStorer struct {
+	storage.Storer
+	err error
+}
+
+func (fps *failingPutStorer) Put(_, _ []byte) error {
+	return fps.err
+}
+
+type epochRecorderStorer struct {
+	storage.Storer
+	epoch uint32
+}
+
+func (ers *epochRecorderStorer) SetEpochForPutOperation(epoch uint32) {
+	ers.epoch = epoch
+}
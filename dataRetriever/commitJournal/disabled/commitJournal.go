@@ -0,0 +1,33 @@
+package disabled
+
+type commitJournal struct {
+}
+
+// NewCommitJournal returns a block commit journal which does not record anything, the writes going directly to the
+// storage units
+func NewCommitJournal() *commitJournal {
+	return &commitJournal{}
+}
+
+// Begin does nothing
+func (cj *commitJournal) Begin(_ uint32) {
+}
+
+// Commit does nothing
+func (cj *commitJournal) Commit() error {
+	return nil
+}
+
+// Rollback does nothing
+func (cj *commitJournal) Rollback() {
+}
+
+// Recover does nothing
+func (cj *commitJournal) Recover() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cj *commitJournal) IsInterfaceNil() bool {
+	return cj == nil
+}
@@ -0,0 +1,80 @@
+package commitJournal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ data.DBWriteCacher = (*journaledDatabase)(nil)
+
+// journaledDatabase wraps the main database of a trie so the trie nodes committed while a commit is in progress are
+// recorded by the commit journal. As the trie nodes are content addressed, the removals done by the pruning are never
+// recorded and the writes done outside a commit do not drop the persisted record
+type journaledDatabase struct {
+	unit    dataRetriever.UnitType
+	db      data.DBWriteCacher
+	journal *commitJournal
+}
+
+func newJournaledDatabase(unit dataRetriever.UnitType, db data.DBWriteCacher, journal *commitJournal) *journaledDatabase {
+	return &journaledDatabase{
+		unit:    unit,
+		db:      db,
+		journal: journal,
+	}
+}
+
+// Put records the trie node in the journal if a commit is in progress or saves it in the wrapped database otherwise
+func (jd *journaledDatabase) Put(key, val []byte) error {
+	if jd.journal.record(jd.unit, key, val) {
+		return nil
+	}
+
+	return jd.db.Put(key, val)
+}
+
+// Get returns the trie node recorded in the journal, if any, or the one from the wrapped database otherwise
+func (jd *journaledDatabase) Get(key []byte) ([]byte, error) {
+	value, _ := jd.journal.get(jd.unit, key)
+	if value != nil {
+		return value, nil
+	}
+
+	return jd.db.Get(key)
+}
+
+// Remove removes the trie node from the wrapped database
+func (jd *journaledDatabase) Remove(key []byte) error {
+	return jd.db.Remove(key)
+}
+
+// Close closes the wrapped database
+func (jd *journaledDatabase) Close() error {
+	return jd.db.Close()
+}
+
+// RangeKeys iterates the wrapped database, if it is able to range its keys
+func (jd *journaledDatabase) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	ranger, ok := jd.db.(storage.SortedKeysRanger)
+	if !ok {
+		return storage.ErrRangeKeysNotSupported
+	}
+
+	return ranger.RangeKeys(handler)
+}
+
+// PersisterStatistics returns the statistics of the wrapped database, if it is able to report them
+func (jd *journaledDatabase) PersisterStatistics() storage.PersisterStatistics {
+	provider, ok := jd.db.(storage.PersisterStatisticsProvider)
+	if !ok {
+		return storage.PersisterStatistics{}
+	}
+
+	return provider.PersisterStatistics()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jd *journaledDatabase) IsInterfaceNil() bool {
+	return jd == nil
+}
@@ -0,0 +1,98 @@
+package commitJournal
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ dataRetriever.StorageService = (*journaledStore)(nil)
+
+// journaledStore is the storage service used by the components committing a block. It returns the recording storers
+// for the journaled units and delegates to the original store for all the other units
+type journaledStore struct {
+	dataRetriever.StorageService
+	mutStorers sync.RWMutex
+	storers    map[dataRetriever.UnitType]storage.Storer
+}
+
+func newJournaledStore(store dataRetriever.StorageService) *journaledStore {
+	return &journaledStore{
+		StorageService: store,
+		storers:        make(map[dataRetriever.UnitType]storage.Storer),
+	}
+}
+
+func (js *journaledStore) addStorer(unitType dataRetriever.UnitType, storer storage.Storer) {
+	js.mutStorers.Lock()
+	js.storers[unitType] = storer
+	js.mutStorers.Unlock()
+}
+
+// GetStorer returns the recording storer of a journaled unit or the storer from the original store otherwise
+func (js *journaledStore) GetStorer(unitType dataRetriever.UnitType) storage.Storer {
+	js.mutStorers.RLock()
+	storer, ok := js.storers[unitType]
+	js.mutStorers.RUnlock()
+	if ok {
+		return storer
+	}
+
+	return js.StorageService.GetStorer(unitType)
+}
+
+// Has returns nil if the key is found in the selected unit
+func (js *journaledStore) Has(unitType dataRetriever.UnitType, key []byte) error {
+	storer := js.GetStorer(unitType)
+	if storer == nil {
+		return dataRetriever.ErrNoSuchStorageUnit
+	}
+
+	return storer.Has(key)
+}
+
+// Get returns the value for the given key if found in the selected unit
+func (js *journaledStore) Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	storer := js.GetStorer(unitType)
+	if storer == nil {
+		return nil, dataRetriever.ErrNoSuchStorageUnit
+	}
+
+	return storer.Get(key)
+}
+
+// Put stores the key, value pair in the selected unit
+func (js *journaledStore) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	storer := js.GetStorer(unitType)
+	if storer == nil {
+		return dataRetriever.ErrNoSuchStorageUnit
+	}
+
+	return storer.Put(key, value)
+}
+
+// GetAll gets all the elements with keys in the keys array, from the selected unit
+func (js *journaledStore) GetAll(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+	storer := js.GetStorer(unitType)
+	if storer == nil {
+		return nil, dataRetriever.ErrNoSuchStorageUnit
+	}
+
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, err := storer.Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		values[string(key)] = value
+	}
+
+	return values, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (js *journaledStore) IsInterfaceNil() bool {
+	return js == nil
+}
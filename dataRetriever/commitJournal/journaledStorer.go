@@ -0,0 +1,165 @@
+package commitJournal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.StorerWithPutInEpoch = (*journaledStorer)(nil)
+
+// journaledStorer wraps a storage unit of the block commit. A recording storer, used by the components committing the
+// block, records its writes in the commit journal while a commit is in progress, and the recorded writes are visible to
+// the reads done through it before being applied on the wrapped unit. Any other storer writes the wrapped unit directly
+type journaledStorer struct {
+	unit          dataRetriever.UnitType
+	storer        storage.Storer
+	journal       *commitJournal
+	recordsWrites bool
+}
+
+func newJournaledStorer(
+	unit dataRetriever.UnitType,
+	storer storage.Storer,
+	journal *commitJournal,
+	recordsWrites bool,
+) *journaledStorer {
+	return &journaledStorer{
+		unit:          unit,
+		storer:        storer,
+		journal:       journal,
+		recordsWrites: recordsWrites,
+	}
+}
+
+// Put records the key, value pair in the journal if a commit is in progress or saves it in the wrapped unit otherwise
+func (js *journaledStorer) Put(key, data []byte) error {
+	if js.recordsWrites && js.journal.record(js.unit, key, data) {
+		return nil
+	}
+
+	js.journal.writeOutsideCommit()
+
+	return js.storer.Put(key, data)
+}
+
+// Get returns the value recorded in the journal, if any, or the one from the wrapped unit otherwise
+func (js *journaledStorer) Get(key []byte) ([]byte, error) {
+	value, isRemoved := js.getRecorded(key)
+	if isRemoved {
+		return nil, storage.ErrKeyNotFound
+	}
+	if value != nil {
+		return value, nil
+	}
+
+	return js.storer.Get(key)
+}
+
+// Has returns nil if the key was recorded in the journal or if the wrapped unit has it
+func (js *journaledStorer) Has(key []byte) error {
+	value, isRemoved := js.getRecorded(key)
+	if isRemoved {
+		return storage.ErrKeyNotFound
+	}
+	if value != nil {
+		return nil
+	}
+
+	return js.storer.Has(key)
+}
+
+// SearchFirst returns the value recorded in the journal, if any, or searches the wrapped unit otherwise
+func (js *journaledStorer) SearchFirst(key []byte) ([]byte, error) {
+	value, isRemoved := js.getRecorded(key)
+	if isRemoved {
+		return nil, storage.ErrKeyNotFound
+	}
+	if value != nil {
+		return value, nil
+	}
+
+	return js.storer.SearchFirst(key)
+}
+
+// Remove records the removal in the journal if a commit is in progress or removes the key from the wrapped unit
+func (js *journaledStorer) Remove(key []byte) error {
+	if js.recordsWrites && js.journal.recordRemove(js.unit, key) {
+		return nil
+	}
+
+	js.journal.writeOutsideCommit()
+
+	return js.storer.Remove(key)
+}
+
+func (js *journaledStorer) getRecorded(key []byte) ([]byte, bool) {
+	if !js.recordsWrites {
+		return nil, false
+	}
+
+	return js.journal.get(js.unit, key)
+}
+
+// ClearCache cleans up the cache of the wrapped unit
+func (js *journaledStorer) ClearCache() {
+	js.storer.ClearCache()
+}
+
+// DestroyUnit destroys the wrapped unit
+func (js *journaledStorer) DestroyUnit() error {
+	return js.storer.DestroyUnit()
+}
+
+// GetFromEpoch returns the value recorded in the journal, if any, or the one from the given epoch of the wrapped unit
+func (js *journaledStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	value, isRemoved := js.getRecorded(key)
+	if isRemoved {
+		return nil, storage.ErrKeyNotFound
+	}
+	if value != nil {
+		return value, nil
+	}
+
+	return js.storer.GetFromEpoch(key, epoch)
+}
+
+// HasInEpoch returns nil if the key was recorded in the journal or if the given epoch of the wrapped unit has it
+func (js *journaledStorer) HasInEpoch(key []byte, epoch uint32) error {
+	value, isRemoved := js.getRecorded(key)
+	if isRemoved {
+		return storage.ErrKeyNotFound
+	}
+	if value != nil {
+		return nil
+	}
+
+	return js.storer.HasInEpoch(key, epoch)
+}
+
+// SetEpochForPutOperation forwards the epoch used for put operations to the wrapped unit, if it supports it
+func (js *journaledStorer) SetEpochForPutOperation(epoch uint32) {
+	storerWithEpoch, ok := js.storer.(storage.StorerWithPutInEpoch)
+	if ok {
+		storerWithEpoch.SetEpochForPutOperation(epoch)
+	}
+}
+
+// Close closes the wrapped unit
+func (js *journaledStorer) Close() error {
+	return js.storer.Close()
+}
+
+// PersisterStatistics returns the statistics of the wrapped storer, if it is able to report them
+func (js *journaledStorer) PersisterStatistics() storage.PersisterStatistics {
+	provider, ok := js.storer.(storage.PersisterStatisticsProvider)
+	if !ok {
+		return storage.PersisterStatistics{}
+	}
+
+	return provider.PersisterStatistics()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (js *journaledStorer) IsInterfaceNil() bool {
+	return js == nil
+}
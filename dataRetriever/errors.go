@@ -178,3 +178,12 @@ var ErrNilResolverDebugHandler = errors.New("nil resolver debug handler")
 
 // ErrMissingData signals that the required data is missing
 var ErrMissingData = errors.New("missing data")
+
+// ErrInvalidJournalRecord signals that the block commit journal record can not be decoded
+var ErrInvalidJournalRecord = errors.New("invalid block commit journal record")
+
+// ErrEmptyJournaledUnits signals that no storage unit was provided to be journaled
+var ErrEmptyJournaledUnits = errors.New("empty journaled units")
+
+// ErrInvalidTrieStorageManager signals that the provided trie storage manager does not allow its database to be journaled
+var ErrInvalidTrieStorageManager = errors.New("invalid trie storage manager")
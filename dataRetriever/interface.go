@@ -37,6 +37,20 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case TxLogsUnit:
+		return "TxLogsUnit"
+	case TransactionsMetadataUnit:
+		return "TransactionsMetadataUnit"
+	case MiniblocksMetadataUnit:
+		return "MiniblocksMetadataUnit"
+	case TransactionsHistoryUnit:
+		return "TransactionsHistoryUnit"
+	case BlockCommitJournalUnit:
+		return "BlockCommitJournalUnit"
+	case UserAccountsUnit:
+		return "UserAccountsUnit"
+	case PeerAccountsUnit:
+		return "PeerAccountsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -71,6 +85,18 @@ const (
 	StatusMetricsUnit UnitType = 10
 	// TxLogsUnit is the status metrics storage unit identifier
 	TxLogsUnit UnitType = 11
+	// TransactionsMetadataUnit is the transactions metadata storage unit identifier, used to compute transactions status
+	TransactionsMetadataUnit UnitType = 12
+	// MiniblocksMetadataUnit is the miniblocks metadata storage unit identifier, used to locate the block of a miniblock
+	MiniblocksMetadataUnit UnitType = 13
+	// TransactionsHistoryUnit is the per address transactions history storage unit identifier
+	TransactionsHistoryUnit UnitType = 14
+	// BlockCommitJournalUnit is the storage unit identifier holding the write-ahead journal of the block commit
+	BlockCommitJournalUnit UnitType = 15
+	// UserAccountsUnit is the user accounts trie storage unit identifier
+	UserAccountsUnit UnitType = 16
+	// PeerAccountsUnit is the peer accounts trie storage unit identifier
+	PeerAccountsUnit UnitType = 17
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -18,6 +19,7 @@ type TrieStub struct {
 	AppendToOldHashesCalled  func([][]byte)
 	GetSerializedNodesCalled func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled           func() data.DBWriteCacher
+	IterateLeavesCalled      func(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error
 }
 
 // EnterSnapshotMode -
@@ -46,6 +48,20 @@ func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
 	return make(map[string][]byte), nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(_ []byte) ([][]byte, error) {
+	return nil, nil
+}
+
+// IterateLeaves -
+func (ts *TrieStub) IterateLeaves(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error {
+	if ts.IterateLeavesCalled != nil {
+		return ts.IterateLeavesCalled(ctx, filter, handler)
+	}
+
+	return nil
+}
+
 // IsPruningEnabled -
 func (ts *TrieStub) IsPruningEnabled() bool {
 	return false
@@ -82,6 +82,9 @@ func checkArguments(args ArgsEpochStartBootstrap) error {
 	if check.IfNil(args.NodeShuffler) {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilShuffler)
 	}
+	if check.IfNil(args.StatusHandler) {
+		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNilStatusHandler)
+	}
 	if args.GeneralConfig.EpochStartConfig.MinNumOfPeersToConsiderBlockValid < minNumPeersToConsiderMetaBlockValid {
 		return fmt.Errorf("%s: %w", baseErrorMessage, epochStart.ErrNotEnoughNumOfPeersToConsiderBlockValid)
 	}
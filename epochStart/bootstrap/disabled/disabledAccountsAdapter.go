@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 )
@@ -94,6 +96,26 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte) (map[string]data.Trie, erro
 	return nil, nil
 }
 
+// GetProof -
+func (a *accountsAdapter) GetProof(_ []byte, _ []byte) ([][]byte, error) {
+	return nil, nil
+}
+
+// GetDataTrieProof -
+func (a *accountsAdapter) GetDataTrieProof(_ []byte, _ []byte, _ []byte) (*state.DataTrieProof, error) {
+	return nil, nil
+}
+
+// IterateLeaves -
+func (a *accountsAdapter) IterateLeaves(_ context.Context, _ []byte, _ data.LeavesFilter, _ data.LeafHandler) error {
+	return nil
+}
+
+// RecreateReadOnlyAccounts -
+func (a *accountsAdapter) RecreateReadOnlyAccounts(_ []byte) (state.AccountsAdapter, error) {
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (a *accountsAdapter) IsInterfaceNil() bool {
 	return a == nil
@@ -263,6 +263,20 @@ func getGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		BlockCommitJournalStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Capacity: 10,
+				Type:     "LRU",
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "BlockCommitJournal",
+				Type:              "MemoryDB",
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
 		PeerBlockBodyStorage: config.StorageConfig{
 			Cache: config.CacheConfig{
 				Capacity: 10000,
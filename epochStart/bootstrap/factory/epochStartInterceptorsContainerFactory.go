@@ -95,6 +95,8 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
 		AntifloodHandler:        antiFloodHandler,
 		NonceConverter:          args.NonceConverter,
+		ChainID:                 args.ChainID,
+		MinTransactionVersion:   args.Config.GeneralSettings.MinTransactionVersion,
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
@@ -98,6 +98,7 @@ type epochStartBootstrap struct {
 	nodeShuffler               sharding.NodesShuffler
 	rounder                    epochStart.Rounder
 	addressPubkeyConverter     core.PubkeyConverter
+	statusHandler              core.AppStatusHandler
 
 	// created components
 	requestHandler            process.RequestHandler
@@ -160,6 +161,7 @@ type ArgsEpochStartBootstrap struct {
 	NodeShuffler               sharding.NodesShuffler
 	Rounder                    epochStart.Rounder
 	AddressPubkeyConverter     core.PubkeyConverter
+	StatusHandler              core.AppStatusHandler
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -196,6 +198,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		storageOpenerHandler:       args.StorageUnitOpener,
 		latestStorageDataProvider:  args.LatestStorageDataProvider,
 		addressPubkeyConverter:     args.AddressPubkeyConverter,
+		statusHandler:              args.StatusHandler,
 		shuffledOut:                false,
 	}
 
@@ -743,6 +746,9 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			TrieSyncerVersion:    e.generalConfig.TrieSync.TrieSyncerVersion,
+			MaxInFlightTrieNodes: e.generalConfig.TrieSync.MaxInFlightNodes,
+			AppStatusHandler:     e.statusHandler,
 		},
 		ShardId: e.shardCoordinator.SelfId(),
 	}
@@ -777,7 +783,7 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 	userStorageManager, userAccountTrie, err := trieFactory.Create(
 		e.generalConfig.AccountsTrieStorage,
 		core.GetShardIdString(shardId),
-		e.generalConfig.StateTriesConfig.AccountsStatePruningEnabled,
+		e.generalConfig.StateTriesConfig.AccountsStatePruningEnabled && !e.generalConfig.StateTriesConfig.ArchiveMode,
 		e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 	)
 	if err != nil {
@@ -813,6 +819,9 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+			TrieSyncerVersion:    e.generalConfig.TrieSync.TrieSyncerVersion,
+			MaxInFlightTrieNodes: e.generalConfig.TrieSync.MaxInFlightNodes,
+			AppStatusHandler:     e.statusHandler,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
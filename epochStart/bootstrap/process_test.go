@@ -2,6 +2,7 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,9 +13,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -42,6 +45,9 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 		Hasher:            &mock.HasherMock{},
 		Messenger:         &mock.MessengerStub{},
 		GeneralConfig: config.Config{
+			GeneralSettings: config.GeneralSettingsConfig{
+				MinTransactionVersion: 1,
+			},
 			WhiteListPool: config.CacheConfig{
 				Type:     "LRU",
 				Capacity: 10,
@@ -108,6 +114,10 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 				SnapshotsBufferLen: 10,
 				MaxSnapshots:       2,
 			},
+			TrieSync: config.TrieSyncConfig{
+				TrieSyncerVersion: 2,
+				MaxInFlightNodes:  1000,
+			},
 		},
 		EconomicsData:              &economics.EconomicsData{},
 		SingleSigner:               &mock.SignerStub{},
@@ -129,6 +139,7 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 		AddressPubkeyConverter:     &mock.PubkeyConverterMock{},
 		LatestStorageDataProvider:  &mock.LatestStorageDataProviderStub{},
 		StorageUnitOpener:          &mock.UnitOpenerStub{},
+		StatusHandler:              statusHandler.NewNilStatusHandler(),
 	}
 }
 
@@ -142,6 +153,17 @@ func TestNewEpochStartBootstrap(t *testing.T) {
 	assert.False(t, check.IfNil(epochStartProvider))
 }
 
+func TestNewEpochStartBootstrap_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEpochStartBootstrapArgs()
+	args.StatusHandler = nil
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	assert.Nil(t, epochStartProvider)
+	assert.True(t, errors.Is(err, epochStart.ErrNilStatusHandler))
+}
+
 func TestIsStartInEpochZero(t *testing.T) {
 	t.Parallel()
 
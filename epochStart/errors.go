@@ -110,6 +110,9 @@ var ErrRewardMiniBlocksNumDoesNotMatch = errors.New("number of created and recei
 // ErrNilRewardsHandler signals that rewards handler is nil
 var ErrNilRewardsHandler = errors.New("rewards handler is nil")
 
+// ErrNilScQuery signals that a nil smart contract query service has been provided
+var ErrNilScQuery = errors.New("nil smart contract query service")
+
 // ErrNilTotalAccumulatedFeesInEpoch signals that total accumulated fees in epoch is nil
 var ErrNilTotalAccumulatedFeesInEpoch = errors.New("total accumulated fees in epoch is nil")
 
@@ -158,6 +161,9 @@ var ErrNilPathManager = errors.New("nil path manager")
 // ErrNilMessenger signals that a nil messenger has been provided
 var ErrNilMessenger = errors.New("nil messenger")
 
+// ErrNilValidatorSettings signals that a nil validator settings handler has been provided
+var ErrNilValidatorSettings = errors.New("nil validator settings")
+
 // ErrNilEconomicsData signals that a nil economics data handler has been provided
 var ErrNilEconomicsData = errors.New("nil economics data")
 
@@ -2,6 +2,8 @@ package metachain
 
 import (
 	"bytes"
+	"encoding/json"
+	"math"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -13,12 +15,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
 
 var _ process.EndOfEpochEconomics = (*economics)(nil)
 
-const numberOfDaysInYear = 365.0
+const numberOfDaysInYear = 365
 const numberOfSecondsInDay = 86400
+const auctionGetFunctionName = "get"
 
 type economics struct {
 	marshalizer         marshal.Marshalizer
@@ -28,6 +33,8 @@ type economics struct {
 	nodesConfigProvider epochStart.NodesConfigProvider
 	rewardsHandler      process.RewardsHandler
 	roundTime           process.RoundTimeDurationHandler
+	scQuery             process.SCQueryService
+	validatorSettings   process.ValidatorSettingsHandler
 }
 
 // ArgsNewEpochEconomics is the argument for the economics constructor
@@ -39,6 +46,8 @@ type ArgsNewEpochEconomics struct {
 	NodesConfigProvider epochStart.NodesConfigProvider
 	RewardsHandler      process.RewardsHandler
 	RoundTime           process.RoundTimeDurationHandler
+	ScQuery             process.SCQueryService
+	ValidatorSettings   process.ValidatorSettingsHandler
 }
 
 // NewEndOfEpochEconomicsDataCreator creates a new end of epoch economics data creator object
@@ -64,6 +73,12 @@ func NewEndOfEpochEconomicsDataCreator(args ArgsNewEpochEconomics) (*economics,
 	if check.IfNil(args.RoundTime) {
 		return nil, process.ErrNilRounder
 	}
+	if check.IfNil(args.ScQuery) {
+		return nil, epochStart.ErrNilScQuery
+	}
+	if check.IfNil(args.ValidatorSettings) {
+		return nil, epochStart.ErrNilValidatorSettings
+	}
 
 	e := &economics{
 		marshalizer:         args.Marshalizer,
@@ -73,6 +88,8 @@ func NewEndOfEpochEconomicsDataCreator(args ArgsNewEpochEconomics) (*economics,
 		nodesConfigProvider: args.NodesConfigProvider,
 		rewardsHandler:      args.RewardsHandler,
 		roundTime:           args.RoundTime,
+		scQuery:             args.ScQuery,
+		validatorSettings:   args.ValidatorSettings,
 	}
 	return e, nil
 }
@@ -109,11 +126,18 @@ func (e *economics) ComputeEndOfEpochEconomics(
 	maxBlocksInEpoch := core.MaxUint64(1, roundsPassedInEpoch*uint64(e.shardCoordinator.NumberOfShards()+1))
 	totalNumBlocksInEpoch := e.computeNumOfTotalCreatedBlocks(noncesPerShardPrevEpoch, noncesPerShardCurrEpoch)
 
-	inflationRate, err := e.computeInflationRate(prevEpochEconomics.TotalSupply, prevEpochEconomics.NodePrice)
+	nodePrice, err := e.computeNodePrice(metaBlock.Epoch - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	totalStaked, err := e.computeTotalStaked(metaBlock.Epoch-1, nodePrice)
 	if err != nil {
 		return nil, err
 	}
 
+	inflationRate := e.computeInflationRate(prevEpochEconomics.TotalSupply, totalStaked)
+
 	rwdPerBlock := e.computeRewardsPerBlock(prevEpochEconomics.TotalSupply, maxBlocksInEpoch, inflationRate)
 	totalRewardsToBeDistributed := big.NewInt(0).Mul(rwdPerBlock, big.NewInt(0).SetUint64(totalNumBlocksInEpoch))
 
@@ -141,10 +165,11 @@ func (e *economics) ComputeEndOfEpochEconomics(
 		TotalNewlyMinted:       big.NewInt(0).Set(newTokens),
 		RewardsPerBlockPerNode: e.computeRewardsPerValidatorPerBlock(rwdPerBlock),
 		RewardsForCommunity:    rewardsForCommunity,
-		// TODO: get actual nodePrice from auction smart contract (currently on another feature branch, and not all features enabled)
-		NodePrice:           big.NewInt(0).Set(prevEpochEconomics.NodePrice),
-		PrevEpochStartRound: prevEpochStart.GetRound(),
-		PrevEpochStartHash:  prevEpochStartHash,
+		NodePrice:              nodePrice,
+		PrevEpochStartRound:    prevEpochStart.GetRound(),
+		PrevEpochStartHash:     prevEpochStartHash,
+		TotalStaked:            totalStaked,
+		InflationRate:          inflationRate,
 	}
 
 	return &computedEconomics, nil
@@ -192,28 +217,133 @@ func (e *economics) computeRewardsPerValidatorPerBlock(rwdPerBlock *big.Int) *bi
 	return big.NewInt(0).Div(rwdPerBlock, big.NewInt(0).SetUint64(numOfNodes))
 }
 
-// compute inflation rate from totalSupply and totalStaked
-func (e *economics) computeInflationRate(_ *big.Int, _ *big.Int) (float64, error) {
-	//TODO: use prevTotalSupply and nodePrice (number of eligible + number of waiting)
-	// for epoch which ends now to compute inflation rate according to formula provided by L.
-	return e.rewardsHandler.MaxInflationRate(), nil
+// computeNodePrice returns the node price set in the auction smart contract for the epoch which ends now. If the
+// auction smart contract holds no valid configuration for that epoch, the genesis node price is used, the same way
+// the auction smart contract falls back to its base configuration
+func (e *economics) computeNodePrice(epoch uint32) (*big.Int, error) {
+	query := &process.SCQuery{
+		ScAddress: vmFactory.AuctionSCAddress,
+		FuncName:  auctionGetFunctionName,
+		Arguments: [][]byte{big.NewInt(int64(epoch)).Bytes()},
+	}
+	vmOutput, err := e.scQuery.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	baseNodePrice := big.NewInt(0).Set(e.validatorSettings.GenesisNodePrice())
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		return baseNodePrice, nil
+	}
+
+	auctionConfig := &systemSmartContracts.AuctionConfig{}
+	err = json.Unmarshal(vmOutput.ReturnData[0], auctionConfig)
+	if err != nil {
+		log.Debug("computeNodePrice: invalid auction config, using the genesis node price",
+			"epoch", epoch,
+			"error", err.Error(),
+		)
+		return baseNodePrice, nil
+	}
+	if !isAuctionConfigCorrect(auctionConfig) {
+		log.Debug("computeNodePrice: incomplete auction config, using the genesis node price", "epoch", epoch)
+		return baseNodePrice, nil
+	}
+
+	return auctionConfig.NodePrice, nil
+}
+
+func isAuctionConfigCorrect(config *systemSmartContracts.AuctionConfig) bool {
+	return config.MinStakeValue != nil &&
+		config.NodePrice != nil &&
+		config.TotalSupply != nil &&
+		config.MinStep != nil &&
+		config.UnJailPrice != nil
+}
+
+// computeTotalStaked returns the value staked by the eligible and waiting nodes of the epoch which ends now
+func (e *economics) computeTotalStaked(epoch uint32, nodePrice *big.Int) (*big.Int, error) {
+	eligible, err := e.nodesConfigProvider.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	waiting, err := e.nodesConfigProvider.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	numNodes := int64(0)
+	for _, shardEligible := range eligible {
+		numNodes += int64(len(shardEligible))
+	}
+	for _, shardWaiting := range waiting {
+		numNodes += int64(len(shardWaiting))
+	}
+
+	return big.NewInt(0).Mul(nodePrice, big.NewInt(numNodes)), nil
+}
+
+// computeInflationRate computes the inflation rate, in basis points, from the staking ratio, which is the fraction of
+// the previous total supply staked by the eligible and waiting nodes. The inflation rate grows linearly from the minimum
+// inflation rate, when nothing is staked, up to the maximum inflation rate, reached at the optimal staking ratio, and
+// then decreases linearly back to the minimum inflation rate, reached when the whole total supply is staked. Only
+// integer arithmetic is used so that all the nodes compute the same value
+func (e *economics) computeInflationRate(prevTotalSupply *big.Int, totalStaked *big.Int) uint64 {
+	minInflationRate := toBasisPoints(e.rewardsHandler.MinInflationRate())
+	maxInflationRate := toBasisPoints(e.rewardsHandler.MaxInflationRate())
+	optimalStakingRatio := toBasisPoints(e.rewardsHandler.OptimalStakingRatio())
+	denominator := big.NewInt(core.InflationRateDenominator)
+
+	if prevTotalSupply.Cmp(big.NewInt(0)) <= 0 {
+		return minInflationRate.Uint64()
+	}
+	staked := big.NewInt(0).Set(totalStaked)
+	if staked.Cmp(prevTotalSupply) > 0 {
+		staked.Set(prevTotalSupply)
+	}
+
+	// the staking ratio is staked / prevTotalSupply and the optimal staking ratio is optimalStakingRatio / denominator,
+	// so both of them are scaled by prevTotalSupply * denominator
+	scaledStakingRatio := big.NewInt(0).Mul(staked, denominator)
+	scaledOptimalStakingRatio := big.NewInt(0).Mul(prevTotalSupply, optimalStakingRatio)
+	inflationRange := big.NewInt(0).Sub(maxInflationRate, minInflationRate)
+
+	isBelowOptimalStakingRatio := scaledOptimalStakingRatio.Sign() > 0 && scaledStakingRatio.Cmp(scaledOptimalStakingRatio) <= 0
+	if isBelowOptimalStakingRatio {
+		increase := big.NewInt(0).Mul(inflationRange, scaledStakingRatio)
+		increase.Div(increase, scaledOptimalStakingRatio)
+
+		return big.NewInt(0).Add(minInflationRate, increase).Uint64()
+	}
+
+	decrease := big.NewInt(0).Sub(scaledStakingRatio, scaledOptimalStakingRatio)
+	decrease.Mul(decrease, inflationRange)
+	decrease.Div(decrease, big.NewInt(0).Mul(prevTotalSupply, big.NewInt(0).Sub(denominator, optimalStakingRatio)))
+
+	return big.NewInt(0).Sub(maxInflationRate, decrease).Uint64()
+}
+
+func toBasisPoints(value float64) *big.Int {
+	return big.NewInt(int64(math.Round(value * core.InflationRateDenominator)))
 }
 
 // compute rewards per block from according to inflation rate and total supply from previous block and maxBlocksPerEpoch
 func (e *economics) computeRewardsPerBlock(
 	prevTotalSupply *big.Int,
 	maxBlocksInEpoch uint64,
-	inflationRate float64,
+	inflationRate uint64,
 ) *big.Int {
-
-	inflationRatePerDay := inflationRate / numberOfDaysInYear
 	roundsPerDay := numberOfSecondsInDay / uint64(e.roundTime.TimeDuration().Seconds())
 	maxBlocksInADay := core.MaxUint64(1, roundsPerDay*uint64(e.shardCoordinator.NumberOfShards()+1))
 
-	inflationRateForEpoch := inflationRatePerDay * (float64(maxBlocksInEpoch) / float64(maxBlocksInADay))
-
+	// rewardsPerBlock = prevTotalSupply / maxBlocksInEpoch * inflationRateForEpoch, where
+	// inflationRateForEpoch = inflationRate / denominator / numberOfDaysInYear * maxBlocksInEpoch / maxBlocksInADay
 	rewardsPerBlock := big.NewInt(0).Div(prevTotalSupply, big.NewInt(0).SetUint64(maxBlocksInEpoch))
-	rewardsPerBlock = core.GetPercentageOfValue(rewardsPerBlock, inflationRateForEpoch)
+	rewardsPerBlock.Mul(rewardsPerBlock, big.NewInt(0).SetUint64(inflationRate))
+	rewardsPerBlock.Mul(rewardsPerBlock, big.NewInt(0).SetUint64(maxBlocksInEpoch))
+	rewardsPerBlock.Div(rewardsPerBlock, big.NewInt(core.InflationRateDenominator*numberOfDaysInYear))
+	rewardsPerBlock.Div(rewardsPerBlock, big.NewInt(0).SetUint64(maxBlocksInADay))
 
 	return rewardsPerBlock
 }
@@ -313,11 +443,15 @@ func logEconomicsDifferences(computed *block.Economics, received *block.Economic
 		"computed rewards per block per node", computed.RewardsPerBlockPerNode,
 		"computed rewards for community", computed.RewardsForCommunity,
 		"computed node price", computed.NodePrice,
+		"computed total staked", computed.TotalStaked,
+		"computed inflation rate", computed.InflationRate,
 		"\nreceived total to distribute", received.TotalToDistribute,
 		"received total newly minted", received.TotalNewlyMinted,
 		"received total supply", received.TotalSupply,
 		"received rewards per block per node", received.RewardsPerBlockPerNode,
 		"received rewards for community", received.RewardsForCommunity,
 		"received node price", received.NodePrice,
+		"received total staked", received.TotalStaked,
+		"received inflation rate", received.InflationRate,
 	)
 }
@@ -2,6 +2,7 @@ package metachain
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -15,6 +16,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,6 +34,8 @@ func createMockEpochEconomicsArguments() ArgsNewEpochEconomics {
 		NodesConfigProvider: &mock.NodesCoordinatorStub{},
 		RewardsHandler:      &mock.RewardsHandlerStub{},
 		RoundTime:           &mock.RoundTimeDurationHandler{},
+		ScQuery:             &mock.ScQueryStub{},
+		ValidatorSettings:   &mock.ValidatorSettingsStub{},
 	}
 	return argsNewEpochEconomics
 }
@@ -176,6 +182,28 @@ func TestNewEndOfEpochEconomicsDataCreator_NilRoundTimeDurationHandler(t *testin
 	assert.Equal(t, epochStart.ErrNilRounder, err)
 }
 
+func TestNewEndOfEpochEconomicsDataCreator_NilScQuery(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.ScQuery = nil
+	eoeedc, err := NewEndOfEpochEconomicsDataCreator(args)
+
+	assert.True(t, check.IfNil(eoeedc))
+	assert.Equal(t, epochStart.ErrNilScQuery, err)
+}
+
+func TestNewEndOfEpochEconomicsDataCreator_NilValidatorSettings(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.ValidatorSettings = nil
+	eoeedc, err := NewEndOfEpochEconomicsDataCreator(args)
+
+	assert.True(t, check.IfNil(eoeedc))
+	assert.Equal(t, epochStart.ErrNilValidatorSettings, err)
+}
+
 func TestNewEndOfEpochEconomicsDataCreator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -350,6 +378,9 @@ func TestEconomics_VerifyRewardsPerBlock_DifferentHitRates(t *testing.T) {
 	roundDur := 4
 	args := getArguments()
 	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MinInflationRateCalled: func() float64 {
+			return 0.1
+		},
 		MaxInflationRateCalled: func() float64 {
 			return 0.1
 		},
@@ -365,6 +396,11 @@ func TestEconomics_VerifyRewardsPerBlock_DifferentHitRates(t *testing.T) {
 			return time.Duration(roundDur) * time.Second
 		},
 	}
+	args.ValidatorSettings = &mock.ValidatorSettingsStub{
+		GenesisNodePriceCalled: func() *big.Int {
+			return big.NewInt(10)
+		},
+	}
 	hdrPrevEpochStart := block.MetaBlock{
 		Round: 0,
 		Nonce: 0,
@@ -432,6 +468,8 @@ func TestEconomics_VerifyRewardsPerBlock_DifferentHitRates(t *testing.T) {
 					NodePrice:              big.NewInt(10),
 					PrevEpochStartHash:     hdrPrevEpochStartHash,
 					RewardsForCommunity:    expectedCommunityRewards,
+					TotalStaked:            big.NewInt(0),
+					InflationRate:          1000,
 				},
 			},
 			Epoch:                  1,
@@ -474,7 +512,7 @@ func TestComputeEndOfEpochEconomics(t *testing.T) {
 		{blockPerEpochOneShard: roundsPerEpoch, accumulatedFeesInEpoch: intToErd(100000000000000), devFeesInEpoch: intToErd(30000000000000)},
 	}
 
-	rewardsPerBlock, _ := big.NewInt(0).SetString("84559445290038908043", 10) // *based on 0.1 inflation
+	rewardsPerBlock, _ := big.NewInt(0).SetString("84559445290038897344", 10) // *based on 0.1 inflation
 	for _, input := range testInputs {
 		meta := &block.MetaBlock{
 			AccumulatedFeesInEpoch: input.accumulatedFeesInEpoch,
@@ -507,6 +545,9 @@ func createArgsForComputeEndOfEpochEconomics(
 
 	args := getArguments()
 	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MinInflationRateCalled: func() float64 {
+			return 0.1
+		},
 		MaxInflationRateCalled: func() float64 {
 			return 0.1
 		},
@@ -525,6 +566,11 @@ func createArgsForComputeEndOfEpochEconomics(
 			return time.Duration(roundDuration) * time.Second
 		},
 	}
+	args.ValidatorSettings = &mock.ValidatorSettingsStub{
+		GenesisNodePriceCalled: func() *big.Int {
+			return nodePrice
+		},
+	}
 	hdrPrevEpochStart := block.MetaBlock{
 		Round: 0,
 		Nonce: 0,
@@ -632,5 +678,327 @@ func getArguments() ArgsNewEpochEconomics {
 		NodesConfigProvider: &mock.NodesCoordinatorStub{},
 		RewardsHandler:      &mock.RewardsHandlerStub{},
 		RoundTime:           &mock.RoundTimeDurationHandler{},
+		ScQuery:             &mock.ScQueryStub{},
+		ValidatorSettings:   &mock.ValidatorSettingsStub{},
+	}
+}
+
+func createAuctionConfigData(nodePrice *big.Int) []byte {
+	auctionConfig := systemSmartContracts.AuctionConfig{
+		MinStakeValue: nodePrice,
+		NodePrice:     nodePrice,
+		TotalSupply:   big.NewInt(0),
+		MinStep:       big.NewInt(0),
+		UnJailPrice:   big.NewInt(0),
+	}
+	configData, _ := json.Marshal(auctionConfig)
+
+	return configData
+}
+
+func createInflationRewardsHandler(minInflationRate float64, maxInflationRate float64, optimalStakingRatio float64) *mock.RewardsHandlerStub {
+	return &mock.RewardsHandlerStub{
+		MinInflationRateCalled: func() float64 {
+			return minInflationRate
+		},
+		MaxInflationRateCalled: func() float64 {
+			return maxInflationRate
+		},
+		OptimalStakingRatioCalled: func() float64 {
+			return optimalStakingRatio
+		},
+	}
+}
+
+func createNodesMap(numNodesPerShard map[uint32]int) map[uint32][][]byte {
+	nodes := make(map[uint32][][]byte)
+	for shardID, numNodes := range numNodesPerShard {
+		for i := 0; i < numNodes; i++ {
+			nodes[shardID] = append(nodes[shardID], []byte(fmt.Sprintf("pk_%d_%d", shardID, i)))
+		}
+	}
+
+	return nodes
+}
+
+func TestEconomics_ComputeInflationRateNothingStakedShouldReturnMinimum(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(0))
+	assert.Equal(t, uint64(100), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateBelowOptimalStakingRatioShouldIncreaseLinearly(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(250))
+	assert.Equal(t, uint64(400), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateOptimalStakingRatioShouldReturnMaximum(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(500))
+	assert.Equal(t, uint64(700), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateShouldTruncateToBasisPoints(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	// a staking ratio of 1/7 gives 0.01 + 0.06 * 2 / 7 = 0.0271428...
+	inflationRate := ec.computeInflationRate(big.NewInt(7000), big.NewInt(1000))
+	assert.Equal(t, uint64(271), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateAboveOptimalStakingRatioShouldDecreaseLinearly(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(750))
+	assert.Equal(t, uint64(400), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateEverythingStakedShouldReturnMinimum(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(1000))
+	assert.Equal(t, uint64(100), inflationRate)
+
+	inflationRate = ec.computeInflationRate(big.NewInt(1000), big.NewInt(2000))
+	assert.Equal(t, uint64(100), inflationRate)
+}
+
+func TestEconomics_ComputeInflationRateOptimalStakingRatioOfOneShouldOnlyIncrease(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 1)
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	inflationRate := ec.computeInflationRate(big.NewInt(1000), big.NewInt(500))
+	assert.Equal(t, uint64(400), inflationRate)
+
+	inflationRate = ec.computeInflationRate(big.NewInt(1000), big.NewInt(2000))
+	assert.Equal(t, uint64(700), inflationRate)
+}
+
+func TestEconomics_ComputeNodePriceShouldReadTheAuctionConfig(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(7)
+	nodePrice := big.NewInt(2500)
+	args := getArguments()
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, vmFactory.AuctionSCAddress, query.ScAddress)
+			assert.Equal(t, auctionGetFunctionName, query.FuncName)
+			assert.Equal(t, [][]byte{big.NewInt(int64(epoch)).Bytes()}, query.Arguments)
+
+			return &vmcommon.VMOutput{ReturnData: [][]byte{createAuctionConfigData(nodePrice)}}, nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	computedNodePrice, err := ec.computeNodePrice(epoch)
+	assert.Nil(t, err)
+	assert.Equal(t, nodePrice, computedNodePrice)
+}
+
+func TestEconomics_ComputeNodePriceNoAuctionConfigShouldReturnTheGenesisNodePrice(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.ValidatorSettings = &mock.ValidatorSettingsStub{
+		GenesisNodePriceCalled: func() *big.Int {
+			return big.NewInt(1000)
+		},
+	}
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{{}}}, nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	computedNodePrice, err := ec.computeNodePrice(1)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), computedNodePrice)
+}
+
+func TestEconomics_ComputeNodePriceInvalidAuctionConfigShouldReturnTheGenesisNodePrice(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.ValidatorSettings = &mock.ValidatorSettingsStub{
+		GenesisNodePriceCalled: func() *big.Int {
+			return big.NewInt(1000)
+		},
+	}
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("invalid config")}}, nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	computedNodePrice, err := ec.computeNodePrice(1)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), computedNodePrice)
+}
+
+func TestEconomics_ComputeNodePriceIncompleteAuctionConfigShouldReturnTheGenesisNodePrice(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.ValidatorSettings = &mock.ValidatorSettingsStub{
+		GenesisNodePriceCalled: func() *big.Int {
+			return big.NewInt(1000)
+		},
+	}
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			configData, _ := json.Marshal(map[string]interface{}{"NodePrice": big.NewInt(2500)})
+			return &vmcommon.VMOutput{ReturnData: [][]byte{configData}}, nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	computedNodePrice, err := ec.computeNodePrice(1)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), computedNodePrice)
+}
+
+func TestEconomics_ComputeNodePriceQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getArguments()
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	computedNodePrice, err := ec.computeNodePrice(1)
+	assert.Nil(t, computedNodePrice)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestEconomics_ComputeTotalStakedShouldCountEligibleAndWaitingNodes(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	args := getArguments()
+	args.NodesConfigProvider = &mock.NodesCoordinatorStub{
+		GetAllEligibleValidatorsPublicKeysCalled: func(e uint32) (map[uint32][][]byte, error) {
+			assert.Equal(t, epoch, e)
+			return createNodesMap(map[uint32]int{0: 3, 1: 3, core.MetachainShardId: 3}), nil
+		},
+		GetAllWaitingValidatorsPublicKeysCalled: func(e uint32) (map[uint32][][]byte, error) {
+			assert.Equal(t, epoch, e)
+			return createNodesMap(map[uint32]int{0: 1, core.MetachainShardId: 2}), nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	totalStaked, err := ec.computeTotalStaked(epoch, big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1200), totalStaked)
+}
+
+func TestEconomics_ComputeTotalStakedNodesConfigErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getArguments()
+	args.NodesConfigProvider = &mock.NodesCoordinatorStub{
+		GetAllWaitingValidatorsPublicKeysCalled: func(_ uint32) (map[uint32][][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	totalStaked, err := ec.computeTotalStaked(1, big.NewInt(100))
+	assert.Nil(t, totalStaked)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestEconomics_ComputeEndOfEpochEconomicsShouldUseTheStakingRatio(t *testing.T) {
+	t.Parallel()
+
+	totalSupply := big.NewInt(0).Mul(intToErd(1000), big.NewInt(1000))
+	genesisNodePrice := intToErd(10)
+	nodePrice := intToErd(50)
+	roundDuration := 4
+
+	args := createArgsForComputeEndOfEpochEconomics(roundDuration, totalSupply, genesisNodePrice)
+	args.RewardsHandler = createInflationRewardsHandler(0.01, 0.07, 0.5)
+	args.NodesConfigProvider = &mock.NodesCoordinatorStub{
+		GetAllEligibleValidatorsPublicKeysCalled: func(_ uint32) (map[uint32][][]byte, error) {
+			return createNodesMap(map[uint32]int{0: 1500, 1: 1500, core.MetachainShardId: 1500}), nil
+		},
+		GetAllWaitingValidatorsPublicKeysCalled: func(_ uint32) (map[uint32][][]byte, error) {
+			return createNodesMap(map[uint32]int{0: 500, 1: 500, core.MetachainShardId: 500}), nil
+		},
+	}
+	args.ScQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{createAuctionConfigData(nodePrice)}}, nil
+		},
+	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	roundsPerEpoch := uint64(numberOfSecondsInDay / roundDuration)
+	meta := &block.MetaBlock{
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+		Epoch:                  1,
+		Round:                  roundsPerEpoch,
+		Nonce:                  roundsPerEpoch,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, Round: roundsPerEpoch, Nonce: roundsPerEpoch},
+				{ShardID: 1, Round: roundsPerEpoch, Nonce: roundsPerEpoch},
+			},
+		},
 	}
+
+	economicsBlock, err := ec.ComputeEndOfEpochEconomics(meta)
+	require.Nil(t, err)
+
+	// 6000 nodes staking 50 ERD each out of 1000000 ERD is a staking ratio of 0.3
+	expectedTotalStaked := big.NewInt(0).Mul(nodePrice, big.NewInt(6000))
+	assert.Equal(t, expectedTotalStaked, economicsBlock.TotalStaked)
+	assert.Equal(t, nodePrice, economicsBlock.NodePrice)
+	assert.Equal(t, uint64(460), economicsBlock.InflationRate)
+
+	meta.EpochStart.Economics = *economicsBlock
+	assert.Nil(t, ec.VerifyRewardsPerBlock(meta))
+
+	meta.EpochStart.Economics.InflationRate = 700
+	assert.Equal(t, epochStart.ErrEndOfEpochEconomicsDataDoesNotMatch, ec.VerifyRewardsPerBlock(meta))
 }
@@ -241,6 +241,12 @@ func (t *trigger) SetProcessed(header data.HeaderHandler, body data.BodyHandler)
 
 	t.appStatusHandler.SetUInt64Value(core.MetricRoundAtEpochStart, metaBlock.Round)
 	t.appStatusHandler.SetUInt64Value(core.MetricNonceAtEpochStart, metaBlock.Nonce)
+	economics := metaBlock.EpochStart.Economics
+	if economics.TotalStaked != nil && economics.NodePrice != nil {
+		t.appStatusHandler.SetStringValue(core.MetricInflationRate, fmt.Sprintf("%f", float64(economics.InflationRate)/core.InflationRateDenominator))
+		t.appStatusHandler.SetStringValue(core.MetricTotalStaked, economics.TotalStaked.String())
+		t.appStatusHandler.SetStringValue(core.MetricNodePrice, economics.NodePrice.String())
+	}
 
 	metaHash := t.hasher.Compute(string(metaBuff))
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -373,3 +374,30 @@ func TestTrigger_RevertBehindEpochStartBlock(t *testing.T) {
 	ret = epochStartTrigger.IsEpochStart()
 	assert.False(t, ret)
 }
+
+func TestTrigger_SetProcessedShouldSetTheEconomicsMetrics(t *testing.T) {
+	t.Parallel()
+
+	epochStartTrigger, _ := NewEpochStartTrigger(createMockEpochStartTriggerArguments())
+	statusMetrics := statusHandler.NewStatusMetrics()
+	_ = epochStartTrigger.SetAppStatusHandler(statusMetrics)
+
+	metaBlock := &block.MetaBlock{
+		Round: 10,
+		Epoch: 1,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+			Economics: block.Economics{
+				NodePrice:     big.NewInt(2500),
+				TotalStaked:   big.NewInt(25000),
+				InflationRate: 500,
+			},
+		},
+	}
+	epochStartTrigger.SetProcessed(metaBlock, &block.Body{})
+
+	configMetrics := statusMetrics.ConfigMetrics()
+	assert.Equal(t, "0.050000", configMetrics[core.MetricInflationRate])
+	assert.Equal(t, "25000", configMetrics[core.MetricTotalStaked])
+	assert.Equal(t, "2500", configMetrics[core.MetricNodePrice])
+}
@@ -7,6 +7,7 @@ type RewardsHandlerStub struct {
 	CommunityAddressCalled    func() string
 	MinInflationRateCalled    func() float64
 	MaxInflationRateCalled    func() float64
+	OptimalStakingRatioCalled func() float64
 }
 
 // LeaderPercentage -
@@ -54,6 +55,15 @@ func (r *RewardsHandlerStub) MaxInflationRate() float64 {
 	return 1000000
 }
 
+// OptimalStakingRatio -
+func (r *RewardsHandlerStub) OptimalStakingRatio() float64 {
+	if r.OptimalStakingRatioCalled != nil {
+		return r.OptimalStakingRatioCalled()
+	}
+
+	return 0.5
+}
+
 // IsInterfaceNil -
 func (r *RewardsHandlerStub) IsInterfaceNil() bool {
 	return r == nil
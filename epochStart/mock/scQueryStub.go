@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ScQueryStub -
+type ScQueryStub struct {
+	ExecuteQueryCalled func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+}
+
+// ExecuteQuery -
+func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if s.ExecuteQueryCalled != nil {
+		return s.ExecuteQueryCalled(query)
+	}
+	return &vmcommon.VMOutput{}, nil
+}
+
+// IsInterfaceNil -
+func (s *ScQueryStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -25,8 +26,10 @@ type TrieStub struct {
 	GetSerializedNodesCalled func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled           func() data.DBWriteCacher
 	GetAllLeavesCalled       func() (map[string][]byte, error)
+	GetProofCalled           func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled   func() bool
 	ClosePersisterCalled     func() error
+	IterateLeavesCalled      func(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error
 }
 
 // EnterSnapshotMode -
@@ -114,6 +117,24 @@ func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
 	return nil, errNotImplemented
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, errNotImplemented
+}
+
+// IterateLeaves -
+func (ts *TrieStub) IterateLeaves(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error {
+	if ts.IterateLeavesCalled != nil {
+		return ts.IterateLeavesCalled(ctx, filter, handler)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	return ts == nil
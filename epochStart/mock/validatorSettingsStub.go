@@ -0,0 +1,30 @@
+package mock
+
+import "math/big"
+
+// ValidatorSettingsStub -
+type ValidatorSettingsStub struct {
+	UnBondPeriodCalled     func() uint64
+	GenesisNodePriceCalled func() *big.Int
+}
+
+// UnBondPeriod -
+func (v *ValidatorSettingsStub) UnBondPeriod() uint64 {
+	if v.UnBondPeriodCalled != nil {
+		return v.UnBondPeriodCalled()
+	}
+	return 0
+}
+
+// GenesisNodePrice -
+func (v *ValidatorSettingsStub) GenesisNodePrice() *big.Int {
+	if v.GenesisNodePriceCalled != nil {
+		return v.GenesisNodePriceCalled()
+	}
+	return big.NewInt(0)
+}
+
+// IsInterfaceNil -
+func (v *ValidatorSettingsStub) IsInterfaceNil() bool {
+	return v == nil
+}
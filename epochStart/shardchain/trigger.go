@@ -794,6 +794,12 @@ func (t *trigger) SetProcessed(header data.HeaderHandler, _ data.BodyHandler) {
 
 	t.appStatusHandler.SetUInt64Value(core.MetricRoundAtEpochStart, shardHdr.Round)
 	t.appStatusHandler.SetUInt64Value(core.MetricNonceAtEpochStart, shardHdr.Nonce)
+	economics := t.epochStartMeta.EpochStart.Economics
+	if t.epochStartMeta.Epoch == shardHdr.Epoch && economics.TotalStaked != nil && economics.NodePrice != nil {
+		t.appStatusHandler.SetStringValue(core.MetricInflationRate, fmt.Sprintf("%f", float64(economics.InflationRate)/core.InflationRateDenominator))
+		t.appStatusHandler.SetStringValue(core.MetricTotalStaked, economics.TotalStaked.String())
+		t.appStatusHandler.SetStringValue(core.MetricNodePrice, economics.NodePrice.String())
+	}
 
 	t.epoch = shardHdr.Epoch
 	if t.metaEpoch < t.epoch {
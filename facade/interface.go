@@ -3,6 +3,8 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -17,19 +19,22 @@ type NodeHandler interface {
 	// StartConsensus will start the consesus service for the current node
 	StartConsensus() error
 
-	//GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	//GetBalance returns the balance for a specific address, optionally as it was at a past block
+	GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error)
 
-	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	// GetValueForKey returns the value of a key from a given account, optionally as it was at a past block
+	GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error)
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
 
 	//ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
 
+	//CreateRelayedTransaction will return the relayed transaction carrying the provided signed user transaction
+	CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error)
+
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
@@ -39,9 +44,46 @@ type NodeHandler interface {
 	//GetTransactionStatus gets the transaction status
 	GetTransactionStatus(hash string) (string, error)
 
+	// GetKeyValuePairs returns a page of the key-value pairs from the data trie of a given account, optionally as it
+	// was at a past block
+	GetKeyValuePairs(address string, prefix string, cursor string, pageSize int, options state.AccountQueryOptions) (*state.ApiKeyValuePairs, error)
+
+	// GetTransactionsHistory returns a page of the transactions which touched the given address
+	GetTransactionsHistory(address string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error)
+
 	// GetAccount returns an accountResponse containing information
-	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	//  about the account corelated with provided address, optionally as it was at a past block
+	GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+
+	// GetRootHashForQueryOptions returns the state root hash of the past block selected by the provided options
+	GetRootHashForQueryOptions(options state.AccountQueryOptions) ([]byte, error)
+
+	// GetAllESDTTokens returns all the elrond standard digital tokens held by the given address
+	GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error)
+
+	// GetESDTBalance returns the balance of the given elrond standard digital token held by the given address
+	GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error)
+
+	// GetESDTTokenData returns the data of the given elrond standard digital token from the ESDT system smart contract
+	GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error)
+
+	// GetProof returns the Merkle proof of the given address against the state of the last committed block
+	GetProof(address string) (*state.ApiProof, error)
+
+	// GetProofDataTrie returns the Merkle proof of the given key from the data trie of the given address
+	GetProofDataTrie(address string, key string) (*state.ApiProof, error)
+
+	// GetBlockByNonce returns the block with the given nonce, optionally with all its transactions
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+
+	// GetBlockByHash returns the block with the given hash, optionally with all its transactions
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
+
+	// GetHyperblockByNonce returns the metachain block with the given nonce and all the notarized shard blocks
+	GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error)
+
+	// GetHyperblockByHash returns the metachain block with the given hash and all the notarized shard blocks
+	GetHyperblockByHash(hash string) (*block.ApiHyperblock, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
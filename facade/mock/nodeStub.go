@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -15,14 +17,15 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options state.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
+	CreateRelayedTransactionHandler                func(userTx *transaction.Transaction) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -32,18 +35,148 @@ type NodeStub struct {
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetTransactionStatusCalled                     func(hash string) (string, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options state.AccountQueryOptions) (string, error)
+	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.ApiBlock, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64) (*block.ApiHyperblock, error)
+	GetHyperblockByHashCalled                      func(hash string) (*block.ApiHyperblock, error)
+	GetKeyValuePairsCalled                         func(address string, prefix string, cursor string, pageSize int, options state.AccountQueryOptions) (*state.ApiKeyValuePairs, error)
+	GetTransactionsHistoryCalled                   func(address string, cursor *transaction.ApiHistoryCursor, pageSize int) (*transaction.ApiAddressHistory, error)
+	GetAllESDTTokensCalled                         func(address string) ([]*esdt.ApiESDTBalance, error)
+	GetESDTBalanceCalled                           func(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error)
+	GetESDTTokenDataCalled                         func(tokenIdentifier string) (*esdt.ApiESDTToken, error)
+	GetProofCalled                                 func(address string) (*state.ApiProof, error)
+	GetProofDataTrieCalled                         func(address string, key string) (*state.ApiProof, error)
+	GetRootHashForQueryOptionsCalled               func(options state.AccountQueryOptions) ([]byte, error)
+}
+
+// GetRootHashForQueryOptions -
+func (ns *NodeStub) GetRootHashForQueryOptions(options state.AccountQueryOptions) ([]byte, error) {
+	if ns.GetRootHashForQueryOptionsCalled != nil {
+		return ns.GetRootHashForQueryOptionsCalled(options)
+	}
+
+	return nil, nil
+}
+
+// GetProof -
+func (ns *NodeStub) GetProof(address string) (*state.ApiProof, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie -
+func (ns *NodeStub) GetProofDataTrie(address string, key string) (*state.ApiProof, error) {
+	if ns.GetProofDataTrieCalled != nil {
+		return ns.GetProofDataTrieCalled(address, key)
+	}
+
+	return nil, nil
+}
+
+// GetAllESDTTokens -
+func (ns *NodeStub) GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error) {
+	if ns.GetAllESDTTokensCalled != nil {
+		return ns.GetAllESDTTokensCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetESDTBalance -
+func (ns *NodeStub) GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+	if ns.GetESDTBalanceCalled != nil {
+		return ns.GetESDTBalanceCalled(address, tokenIdentifier)
+	}
+
+	return nil, nil
+}
+
+// GetESDTTokenData -
+func (ns *NodeStub) GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error) {
+	if ns.GetESDTTokenDataCalled != nil {
+		return ns.GetESDTTokenDataCalled(tokenIdentifier)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsHistory -
+func (ns *NodeStub) GetTransactionsHistory(
+	address string,
+	cursor *transaction.ApiHistoryCursor,
+	pageSize int,
+) (*transaction.ApiAddressHistory, error) {
+	if ns.GetTransactionsHistoryCalled != nil {
+		return ns.GetTransactionsHistoryCalled(address, cursor, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByNonce -
+func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByNonceCalled != nil {
+		return ns.GetBlockByNonceCalled(nonce, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByHash -
+func (ns *NodeStub) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByHashCalled != nil {
+		return ns.GetBlockByHashCalled(hash, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (ns *NodeStub) GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error) {
+	if ns.GetHyperblockByNonceCalled != nil {
+		return ns.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (ns *NodeStub) GetHyperblockByHash(hash string) (*block.ApiHyperblock, error) {
+	if ns.GetHyperblockByHashCalled != nil {
+		return ns.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
+// GetKeyValuePairs -
+func (ns *NodeStub) GetKeyValuePairs(
+	address string,
+	prefix string,
+	cursor string,
+	pageSize int,
+	options state.AccountQueryOptions,
+) (*state.ApiKeyValuePairs, error) {
+	if ns.GetKeyValuePairsCalled != nil {
+		return ns.GetKeyValuePairsCalled(address, prefix, cursor, pageSize, options)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionStatus -
 func (ns *NodeStub) GetTransactionStatus(hash string) (string, error) {
 	if ns.GetTransactionStatusCalled != nil {
@@ -69,15 +202,15 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
 func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error) {
+	gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error) {
 
-	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex)
+	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version)
 }
 
 //ValidateTransaction --
@@ -85,6 +218,11 @@ func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
 
+// CreateRelayedTransaction -
+func (ns *NodeStub) CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error) {
+	return ns.CreateRelayedTransactionHandler(userTx)
+}
+
 // GetTransaction -
 func (ns *NodeStub) GetTransaction(hash string) (*transaction.ApiTransactionResult, error) {
 	return ns.GetTransactionHandler(hash)
@@ -96,8 +234,8 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetHeartbeats -
@@ -8,7 +8,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	blockApi "github.com/ElrondNetwork/elrond-go/api/block"
+	esdtApi "github.com/ElrondNetwork/elrond-go/api/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
@@ -17,6 +20,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -35,7 +40,10 @@ const DefaultRestInterface = "localhost:8080"
 const DefaultRestPortOff = "off"
 
 var _ = address.FacadeHandler(&nodeFacade{})
+var _ = blockApi.FacadeHandler(&nodeFacade{})
+var _ = esdtApi.FacadeHandler(&nodeFacade{})
 var _ = hardfork.TriggerHardforkHandler(&nodeFacade{})
+var _ = hyperblock.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.TxService(&nodeFacade{})
 var _ = validator.ValidatorsStatisticsApiHandler(&nodeFacade{})
@@ -198,14 +206,27 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 	}
 }
 
-// GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+// GetBalance gets the balance for a specified address, either the current one or the one at the block selected by
+// the provided options
+func (nf *nodeFacade) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
-// GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+// GetValueForKey gets the value for a key in a given address, optionally as it was at a past block
+func (nf *nodeFacade) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
+}
+
+// GetKeyValuePairs returns a page of the key-value pairs from the data trie of a given address, starting at the
+// cursor, optionally as it was at a past block
+func (nf *nodeFacade) GetKeyValuePairs(
+	address string,
+	prefix string,
+	cursor string,
+	pageSize int,
+	options state.AccountQueryOptions,
+) (*state.ApiKeyValuePairs, error) {
+	return nf.node.GetKeyValuePairs(address, prefix, cursor, pageSize, options)
 }
 
 // CreateTransaction creates a transaction from all needed fields
@@ -218,9 +239,11 @@ func (nf *nodeFacade) CreateTransaction(
 	gasLimit uint64,
 	txData string,
 	signatureHex string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, []byte, error) {
 
-	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex)
+	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex, chainID, version)
 }
 
 // ValidateTransaction will validate a transaction
@@ -228,6 +251,11 @@ func (nf *nodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
 	return nf.node.ValidateTransaction(tx)
 }
 
+// CreateRelayedTransaction returns the relayed transaction carrying the provided signed user transaction
+func (nf *nodeFacade) CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error) {
+	return nf.node.CreateRelayedTransaction(userTx)
+}
+
 // ValidatorStatisticsApi will return the statistics for all validators
 func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nf.node.ValidatorStatisticsApi()
@@ -248,15 +276,69 @@ func (nf *nodeFacade) GetTransactionStatus(hash string) (string, error) {
 	return nf.node.GetTransactionStatus(hash)
 }
 
+// GetTransactionsHistory returns a page of the transactions which touched the given address, older than the cursor
+func (nf *nodeFacade) GetTransactionsHistory(
+	address string,
+	cursor *transaction.ApiHistoryCursor,
+	pageSize int,
+) (*transaction.ApiAddressHistory, error) {
+	return nf.node.GetTransactionsHistory(address, cursor, pageSize)
+}
+
+// GetAllESDTTokens returns all the elrond standard digital tokens held by the given address
+func (nf *nodeFacade) GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error) {
+	return nf.node.GetAllESDTTokens(address)
+}
+
+// GetESDTBalance returns the balance of the given elrond standard digital token held by the given address
+func (nf *nodeFacade) GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+	return nf.node.GetESDTBalance(address, tokenIdentifier)
+}
+
+// GetESDTTokenData returns the data of the given elrond standard digital token from the ESDT system smart contract
+func (nf *nodeFacade) GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error) {
+	return nf.node.GetESDTTokenData(tokenIdentifier)
+}
+
+// GetProof returns the Merkle proof of the given address against the state of the last committed block
+func (nf *nodeFacade) GetProof(address string) (*state.ApiProof, error) {
+	return nf.node.GetProof(address)
+}
+
+// GetProofDataTrie returns the Merkle proof of the given key from the data trie of the given address
+func (nf *nodeFacade) GetProofDataTrie(address string, key string) (*state.ApiProof, error) {
+	return nf.node.GetProofDataTrie(address, key)
+}
+
+// GetBlockByNonce returns the block with the given nonce, optionally with all its transactions
+func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByNonce(nonce, withTxs)
+}
+
+// GetBlockByHash returns the block with the given hash, optionally with all its transactions
+func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByHash(hash, withTxs)
+}
+
+// GetHyperblockByNonce returns the metachain block with the given nonce and all the notarized shard blocks
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error) {
+	return nf.node.GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the metachain block with the given hash and all the notarized shard blocks
+func (nf *nodeFacade) GetHyperblockByHash(hash string) (*block.ApiHyperblock, error) {
+	return nf.node.GetHyperblockByHash(hash)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
 // GetAccount returns an accountResponse containing information
-// about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+// about the account correlated with provided address, optionally as it was at a past block
+func (nf *nodeFacade) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -274,8 +356,18 @@ func (nf *nodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return nf.apiResolver.StatusMetrics()
 }
 
-// ExecuteSCQuery retrieves data from existing SC trie
-func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+// ExecuteSCQuery retrieves data from existing SC trie, optionally from the state of the block selected by the
+// provided options
+func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery, options state.AccountQueryOptions) (*vmcommon.VMOutput, error) {
+	if options.IsHistorical() {
+		rootHash, err := nf.node.GetRootHashForQueryOptions(options)
+		if err != nil {
+			return nil, err
+		}
+
+		query.RootHash = rootHash
+	}
+
 	return nf.apiResolver.ExecuteSCQuery(query)
 }
 
@@ -134,7 +134,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -146,7 +146,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -161,7 +161,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -173,7 +173,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, state.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -185,7 +185,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -194,7 +194,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -273,7 +273,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -282,7 +282,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", state.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -350,10 +350,58 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	}
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.ExecuteSCQuery(nil)
+	_, _ = nf.ExecuteSCQuery(nil, state.AccountQueryOptions{})
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_ExecuteSCQueryWithBlockNonceShouldSetRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	options := state.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetRootHashForQueryOptionsCalled: func(providedOptions state.AccountQueryOptions) ([]byte, error) {
+			assert.Equal(t, options, providedOptions)
+			return rootHash, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, rootHash, query.RootHash)
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	vmOutput, err := nf.ExecuteSCQuery(&process.SCQuery{}, options)
+	assert.Nil(t, err)
+	assert.NotNil(t, vmOutput)
+}
+
+func TestNodeFacade_ExecuteSCQueryWithMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("block not found")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetRootHashForQueryOptionsCalled: func(_ state.AccountQueryOptions) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	vmOutput, err := nf.ExecuteSCQuery(&process.SCQuery{}, state.AccountQueryOptions{BlockHash: []byte("hash")})
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
@@ -465,7 +513,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	nodeCreateTxWasCalled := false
 	node := &mock.NodeStub{
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string,
-			gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error) {
+			gasPrice uint64, gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error) {
 			nodeCreateTxWasCalled = true
 			return nil, nil, nil
 		},
@@ -474,7 +522,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, "0", "0")
+	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, "0", "0", "chainID", 1)
 
 	assert.True(t, nodeCreateTxWasCalled)
 }
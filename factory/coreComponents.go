@@ -65,5 +65,6 @@ func (ccf *CoreComponentsFactory) Create() (*CoreComponents, error) {
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		StatusHandler:            statusHandler.NewNilStatusHandler(),
 		ChainID:                  ccf.chainID,
+		MinTransactionVersion:    ccf.config.GeneralSettings.MinTransactionVersion,
 	}, nil
 }
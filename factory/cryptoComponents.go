@@ -13,6 +13,7 @@ import (
 	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
 	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
@@ -29,6 +30,7 @@ type CryptoComponentsFactoryArgs struct {
 	ShardCoordinator                     sharding.Coordinator
 	KeyGen                               crypto.KeyGenerator
 	PrivKey                              crypto.PrivateKey
+	RemoteSigner                         RemoteSignerHandler
 	ActivateBLSPubKeyMessageVerification bool
 }
 
@@ -38,6 +40,7 @@ type cryptoComponentsFactory struct {
 	shardCoordinator                     sharding.Coordinator
 	keyGen                               crypto.KeyGenerator
 	privKey                              crypto.PrivateKey
+	remoteSigner                         RemoteSignerHandler
 	activateBLSPubKeyMessageVerification bool
 }
 
@@ -62,6 +65,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 		shardCoordinator:                     args.ShardCoordinator,
 		keyGen:                               args.KeyGen,
 		privKey:                              args.PrivKey,
+		remoteSigner:                         args.RemoteSigner,
 		activateBLSPubKeyMessageVerification: args.ActivateBLSPubKeyMessageVerification,
 	}, nil
 }
@@ -119,6 +123,9 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, error) {
 	switch ccf.config.Consensus.Type {
 	case consensus.BlsConsensusType:
+		if !check.IfNil(ccf.remoteSigner) {
+			return ccf.remoteSigner, nil
+		}
 		return &mclsig.BlsSingleSigner{}, nil
 	default:
 		return nil, ErrMissingConsensusConfig
@@ -156,7 +163,14 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 
 	switch ccf.config.Consensus.Type {
 	case consensus.BlsConsensusType:
-		blsSigner := &mclmultisig.BlsMultiSigner{Hasher: hasher}
+		var blsSigner crypto.LowLevelSignerBLS = &mclmultisig.BlsMultiSigner{Hasher: hasher}
+		if !check.IfNil(ccf.remoteSigner) {
+			remoteBlsSigner, err := remote.NewLowLevelSigner(ccf.remoteSigner, blsSigner)
+			if err != nil {
+				return nil, err
+			}
+			blsSigner = remoteBlsSigner
+		}
 		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
 	default:
 		return nil, ErrMissingConsensusConfig
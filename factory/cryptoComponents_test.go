@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -81,6 +82,38 @@ func TestCryptoComponentsFactory_Create(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestCryptoComponentsFactory_CreateWithRemoteSignerShouldSignRemotely(t *testing.T) {
+	t.Parallel()
+
+	signedMessages := make(map[string][]byte)
+	remoteSigner := &mock.RemoteSignerStub{
+		SignCalled: func(_ crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signedMessages["single"] = msg
+			return []byte("signature"), nil
+		},
+		SignShareCalled: func(_ crypto.PrivateKey, message []byte) ([]byte, error) {
+			signedMessages["share"] = message
+			return []byte("signature share"), nil
+		},
+	}
+	args := getCryptoArgs()
+	args.RemoteSigner = remoteSigner
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+
+	signature, err := cc.SingleSigner.Sign(args.PrivKey, []byte("message"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("signature"), signature)
+	require.Equal(t, []byte("message"), signedMessages["single"])
+
+	signatureShare, err := cc.MultiSigner.CreateSignatureShare([]byte("header hash"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("signature share"), signatureShare)
+	require.Equal(t, []byte("header hash"), signedMessages["share"])
+}
+
 func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 	return factory.CryptoComponentsFactoryArgs{
 		Config: config.Config{
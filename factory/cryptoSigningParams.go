@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 )
 
@@ -17,6 +18,8 @@ type cryptoSigningParamsLoader struct {
 	pubkeyConverter     core.PubkeyConverter
 	skIndex             int
 	skPemFileName       string
+	keystoreFileName    string
+	keystorePassword    []byte
 	suite               crypto.Suite
 	skPkProviderHandler func() ([]byte, []byte, error)
 }
@@ -46,6 +49,35 @@ func NewCryptoSigningParamsLoader(
 	return cspf, nil
 }
 
+// NewKeystoreCryptoSigningParamsLoader returns a new instance of cryptoSigningParamsLoader which reads the private
+// key from a password protected keystore file
+func NewKeystoreCryptoSigningParamsLoader(
+	pubkeyConverter core.PubkeyConverter,
+	keystoreFileName string,
+	keystorePassword []byte,
+	suite crypto.Suite,
+) (*cryptoSigningParamsLoader, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(suite) {
+		return nil, ErrNilSuite
+	}
+	if len(keystorePassword) == 0 {
+		return nil, keystore.ErrEmptyPassword
+	}
+
+	cspf := &cryptoSigningParamsLoader{
+		pubkeyConverter:  pubkeyConverter,
+		keystoreFileName: keystoreFileName,
+		keystorePassword: keystorePassword,
+		suite:            suite,
+	}
+	cspf.skPkProviderHandler = cspf.getSkPkFromKeystore
+
+	return cspf, nil
+}
+
 // Get returns a key generator, a private key, and a public key
 func (cspf *cryptoSigningParamsLoader) Get() (*CryptoParams, error) {
 	cryptoParams := &CryptoParams{}
@@ -97,3 +129,22 @@ func (cspf *cryptoSigningParamsLoader) getSkPk() ([]byte, []byte, error) {
 
 	return skBytes, pkBytes, nil
 }
+
+func (cspf *cryptoSigningParamsLoader) getSkPkFromKeystore() ([]byte, []byte, error) {
+	keyFile, err := keystore.LoadKeyFile(cspf.keystoreFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skBytes, err := keyFile.Decrypt(cspf.keystorePassword)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkBytes, err := cspf.pubkeyConverter.Decode(keyFile.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for encoded public key %s", err, keyFile.PublicKey)
+	}
+
+	return skBytes, pkBytes, nil
+}
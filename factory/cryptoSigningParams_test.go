@@ -2,9 +2,13 @@ package factory
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -122,3 +126,75 @@ func TestCryptoSigningParamsLoader_GetSkPk_PathNotFound(t *testing.T) {
 	require.Nil(t, sk)
 	require.Nil(t, pk)
 }
+
+func TestNewKeystoreCryptoSigningParamsLoader_NilPubKeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewKeystoreCryptoSigningParamsLoader(nil, "name", []byte("pass"), &mock.SuiteStub{})
+	require.Nil(t, cspf)
+	require.Equal(t, ErrNilPubKeyConverter, err)
+}
+
+func TestNewKeystoreCryptoSigningParamsLoader_NilSuiteShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewKeystoreCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, "name", []byte("pass"), nil)
+	require.Nil(t, cspf)
+	require.Equal(t, ErrNilSuite, err)
+}
+
+func TestNewKeystoreCryptoSigningParamsLoader_EmptyPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewKeystoreCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, "name", nil, &mock.SuiteStub{})
+	require.Nil(t, cspf)
+	require.Equal(t, keystore.ErrEmptyPassword, err)
+}
+
+func TestCryptoSigningParamsLoader_GetSkPkFromKeystore(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	skBytes := []byte("secret key")
+	pkBytes := []byte("public key")
+	password := []byte("password")
+	kdfParams := keystore.KdfParams{
+		DkLen: 32,
+		N:     16,
+		R:     8,
+		P:     1,
+	}
+	keyFile, err := keystore.EncryptKey(skBytes, "encoded public key", password, keystore.KdfScrypt, kdfParams)
+	require.Nil(t, err)
+
+	keystoreFileName := filepath.Join(dir, "validatorKey.json")
+	err = keystore.SaveKeyFile(keystoreFileName, keyFile)
+	require.Nil(t, err)
+
+	pubkeyConverter := &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			require.Equal(t, "encoded public key", humanReadable)
+			return pkBytes, nil
+		},
+	}
+
+	t.Run("wrong password should err", func(t *testing.T) {
+		cspf, _ := NewKeystoreCryptoSigningParamsLoader(pubkeyConverter, keystoreFileName, []byte("wrong"), &mock.SuiteStub{})
+		sk, pk, errGet := cspf.GetSkPkFromKeystore()
+		require.Equal(t, keystore.ErrDecryptionFailed, errGet)
+		require.Nil(t, sk)
+		require.Nil(t, pk)
+	})
+	t.Run("correct password should work", func(t *testing.T) {
+		cspf, _ := NewKeystoreCryptoSigningParamsLoader(pubkeyConverter, keystoreFileName, password, &mock.SuiteStub{})
+		sk, pk, errGet := cspf.GetSkPkFromKeystore()
+		require.Nil(t, errGet)
+		require.Equal(t, skBytes, sk)
+		require.Equal(t, pkBytes, pk)
+	})
+}
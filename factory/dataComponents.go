@@ -8,7 +8,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal"
 	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -18,23 +20,25 @@ import (
 
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
 type DataComponentsFactoryArgs struct {
-	Config             config.Config
-	EconomicsData      *economics.EconomicsData
-	ShardCoordinator   sharding.Coordinator
-	Core               *CoreComponents
-	PathManager        storage.PathManagerHandler
-	EpochStartNotifier EpochStartNotifier
-	CurrentEpoch       uint32
+	Config              config.Config
+	EconomicsData       *economics.EconomicsData
+	ShardCoordinator    sharding.Coordinator
+	Core                *CoreComponents
+	PathManager         storage.PathManagerHandler
+	EpochStartNotifier  EpochStartNotifier
+	CurrentEpoch        uint32
+	TrieStorageManagers map[string]data.StorageManager
 }
 
 type dataComponentsFactory struct {
-	config             config.Config
-	economicsData      *economics.EconomicsData
-	shardCoordinator   sharding.Coordinator
-	core               *CoreComponents
-	pathManager        storage.PathManagerHandler
-	epochStartNotifier EpochStartNotifier
-	currentEpoch       uint32
+	config              config.Config
+	economicsData       *economics.EconomicsData
+	shardCoordinator    sharding.Coordinator
+	core                *CoreComponents
+	pathManager         storage.PathManagerHandler
+	epochStartNotifier  EpochStartNotifier
+	currentEpoch        uint32
+	trieStorageManagers map[string]data.StorageManager
 }
 
 // NewDataComponentsFactory will return a new instance of dataComponentsFactory
@@ -56,13 +60,14 @@ func NewDataComponentsFactory(args DataComponentsFactoryArgs) (*dataComponentsFa
 	}
 
 	return &dataComponentsFactory{
-		config:             args.Config,
-		economicsData:      args.EconomicsData,
-		shardCoordinator:   args.ShardCoordinator,
-		core:               args.Core,
-		pathManager:        args.PathManager,
-		epochStartNotifier: args.EpochStartNotifier,
-		currentEpoch:       args.CurrentEpoch,
+		config:              args.Config,
+		economicsData:       args.EconomicsData,
+		shardCoordinator:    args.ShardCoordinator,
+		core:                args.Core,
+		pathManager:         args.PathManager,
+		epochStartNotifier:  args.EpochStartNotifier,
+		currentEpoch:        args.CurrentEpoch,
+		trieStorageManagers: args.TrieStorageManagers,
 	}, nil
 }
 
@@ -79,6 +84,17 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		return nil, err
 	}
 
+	argsCommitJournal := commitJournal.ArgsCommitJournal{
+		Store:               store,
+		JournalUnit:         dataRetriever.BlockCommitJournalUnit,
+		JournaledUnits:      commitJournal.BlockCommitUnits(dcf.shardCoordinator.NumberOfShards()),
+		TrieStorageManagers: dcf.createJournaledTrieStorageManagers(),
+	}
+	blockCommitJournal, err := commitJournal.NewCommitJournal(argsCommitJournal)
+	if err != nil {
+		return nil, err
+	}
+
 	dataPoolArgs := dataRetrieverFactory.ArgsDataPool{
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
@@ -90,12 +106,35 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 	}
 
 	return &DataComponents{
-		Blkc:     blkc,
-		Store:    store,
-		Datapool: datapool,
+		Blkc:               blkc,
+		Store:              store,
+		Datapool:           datapool,
+		BlockCommitJournal: blockCommitJournal,
+		BlockCommitStore:   blockCommitJournal.JournaledStore(),
 	}, nil
 }
 
+// createJournaledTrieStorageManagers maps the trie storage managers on the units the block commit journal records
+// their trie nodes under
+func (dcf *dataComponentsFactory) createJournaledTrieStorageManagers() map[dataRetriever.UnitType]data.StorageManager {
+	trieUnits := map[string]dataRetriever.UnitType{
+		trieFactory.UserAccountTrie: dataRetriever.UserAccountsUnit,
+		trieFactory.PeerAccountTrie: dataRetriever.PeerAccountsUnit,
+	}
+
+	trieStorageManagers := make(map[dataRetriever.UnitType]data.StorageManager)
+	for trieName, unit := range trieUnits {
+		tsm, ok := dcf.trieStorageManagers[trieName]
+		if !ok {
+			continue
+		}
+
+		trieStorageManagers[unit] = tsm
+	}
+
+	return trieStorageManagers
+}
+
 func (dcf *dataComponentsFactory) createBlockChainFromConfig() (data.ChainHandler, error) {
 	if dcf.shardCoordinator.SelfId() < dcf.shardCoordinator.NumberOfShards() {
 		blockChain := blockchain.NewBlockChain()
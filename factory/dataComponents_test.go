@@ -5,9 +5,13 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/stretchr/testify/require"
 )
 
@@ -115,6 +119,24 @@ func TestDataComponentsFactory_CreateForMetaShouldWork(t *testing.T) {
 	require.NotNil(t, dc)
 }
 
+func TestDataComponentsFactory_CreateShouldJournalTheTrieDatabases(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+	args := getDataArgs()
+	args.TrieStorageManagers = map[string]data.StorageManager{
+		trieFactory.UserAccountTrie: tsm,
+	}
+	dcf, err := factory.NewDataComponentsFactory(args)
+	require.NoError(t, err)
+
+	dc, err := dcf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, dc.BlockCommitStore)
+	require.False(t, tsm.Database() == db)
+}
+
 func getDataArgs() factory.DataComponentsFactoryArgs {
 	return factory.DataComponentsFactoryArgs{
 		Config:             getGeneralConfig(),
@@ -162,9 +184,10 @@ func getGeneralConfig() config.Config {
 		Heartbeat: config.HeartbeatConfig{
 			HeartbeatStorage: storageCfg,
 		},
-		StatusMetricsStorage: storageCfg,
-		PeerBlockBodyStorage: storageCfg,
-		BootstrapStorage:     storageCfg,
-		TxLogsStorage:        storageCfg,
+		StatusMetricsStorage:      storageCfg,
+		PeerBlockBodyStorage:      storageCfg,
+		BootstrapStorage:          storageCfg,
+		TxLogsStorage:             storageCfg,
+		BlockCommitJournalStorage: storageCfg,
 	}
 }
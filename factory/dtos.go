@@ -34,6 +34,7 @@ type CoreComponents struct {
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	StatusHandler            core.AppStatusHandler
 	ChainID                  []byte
+	MinTransactionVersion    uint32
 }
 
 // CryptoParams is a DTO for holding block signing parameters
@@ -43,13 +44,16 @@ type CryptoParams struct {
 	PublicKey       crypto.PublicKey
 	PublicKeyBytes  []byte
 	PublicKeyString string
+	RemoteSigner    RemoteSignerHandler
 }
 
 // DataComponents struct holds the data components
 type DataComponents struct {
-	Blkc     data.ChainHandler
-	Store    dataRetriever.StorageService
-	Datapool dataRetriever.PoolsHolder
+	Blkc               data.ChainHandler
+	Store              dataRetriever.StorageService
+	Datapool           dataRetriever.PoolsHolder
+	BlockCommitJournal process.BlockCommitJournal
+	BlockCommitStore   dataRetriever.StorageService
 }
 
 // TriesComponents holds the tries components
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -32,3 +33,16 @@ type P2PAntifloodHandler interface {
 	ApplyConsensusSize(size int)
 	IsInterfaceNil() bool
 }
+
+// RemoteSignerHandler defines the node side of a remote signer process holding the validator key. It creates the
+// single signatures and the signature shares and enforces the signing history on the signer side
+type RemoteSignerHandler interface {
+	Sign(private crypto.PrivateKey, msg []byte) ([]byte, error)
+	Verify(public crypto.PublicKey, msg []byte, sig []byte) error
+	SignRandSeed(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error)
+	SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error)
+	CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
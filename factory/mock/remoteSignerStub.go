@@ -0,0 +1,80 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// RemoteSignerStub -
+type RemoteSignerStub struct {
+	SignCalled                          func(private crypto.PrivateKey, msg []byte) ([]byte, error)
+	VerifyCalled                        func(public crypto.PublicKey, msg []byte, sig []byte) error
+	SignRandSeedCalled                  func(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error)
+	SignShareCalled                     func(privKey crypto.PrivateKey, message []byte) ([]byte, error)
+	CheckAndRecordLeaderSignatureCalled func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureShareCalled  func(epoch uint32, shardID uint32, round uint64, headerHash []byte) error
+}
+
+// Sign -
+func (rss *RemoteSignerStub) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	if rss.SignCalled != nil {
+		return rss.SignCalled(private, msg)
+	}
+
+	return nil, nil
+}
+
+// Verify -
+func (rss *RemoteSignerStub) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	if rss.VerifyCalled != nil {
+		return rss.VerifyCalled(public, msg, sig)
+	}
+
+	return nil
+}
+
+// SignRandSeed -
+func (rss *RemoteSignerStub) SignRandSeed(private crypto.PrivateKey, prevHeader data.HeaderHandler, round uint64) ([]byte, error) {
+	if rss.SignRandSeedCalled != nil {
+		return rss.SignRandSeedCalled(private, prevHeader, round)
+	}
+
+	return nil, nil
+}
+
+// SignShare -
+func (rss *RemoteSignerStub) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	if rss.SignShareCalled != nil {
+		return rss.SignShareCalled(privKey, message)
+	}
+
+	return nil, nil
+}
+
+// CheckAndRecordLeaderSignature -
+func (rss *RemoteSignerStub) CheckAndRecordLeaderSignature(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	if rss.CheckAndRecordLeaderSignatureCalled != nil {
+		return rss.CheckAndRecordLeaderSignatureCalled(epoch, shardID, round, headerHash)
+	}
+
+	return nil
+}
+
+// CheckAndRecordSignatureShare -
+func (rss *RemoteSignerStub) CheckAndRecordSignatureShare(epoch uint32, shardID uint32, round uint64, headerHash []byte) error {
+	if rss.CheckAndRecordSignatureShareCalled != nil {
+		return rss.CheckAndRecordSignatureShareCalled(epoch, shardID, round, headerHash)
+	}
+
+	return nil
+}
+
+// Close -
+func (rss *RemoteSignerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (rss *RemoteSignerStub) IsInterfaceNil() bool {
+	return rss == nil
+}
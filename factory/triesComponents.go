@@ -78,7 +78,7 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 	userStorageManager, userAccountTrie, err := trieFactoryObj.Create(
 		tcf.config.AccountsTrieStorage,
 		shardIDString,
-		tcf.config.StateTriesConfig.AccountsStatePruningEnabled,
+		tcf.config.StateTriesConfig.AccountsStatePruningEnabled && !tcf.config.StateTriesConfig.ArchiveMode,
 		tcf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
 	)
 	if err != nil {
@@ -332,7 +332,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics, virtualMachineFactory.BlockChainHookImpl(), arg.Accounts)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +214,8 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		MapDNSAddresses:      make(map[string]struct{}),
 		EnableUserNameChange: false,
 		Marshalizer:          arg.Marshalizer,
+		Accounts:             arg.Accounts,
+		ShardCoordinator:     arg.ShardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -337,6 +339,7 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		genesisFeeHandler,
 		receiptTxInterim,
 		badTxInterim,
+		scForwarder,
 	)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
@@ -393,7 +396,7 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics, vmFactoryImpl.BlockChainHookImpl(), arg.Accounts)
 	if err != nil {
 		return nil, err
 	}
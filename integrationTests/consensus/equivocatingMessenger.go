@@ -0,0 +1,101 @@
+package consensus
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// equivocatingMessenger wraps a messenger and, for every signature share the wrapped node broadcasts on the
+// consensus topic, also broadcasts a second, properly signed, signature share for a different block header hash
+type equivocatingMessenger struct {
+	p2p.Messenger
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	singleSigner    crypto.SingleSigner
+	privKey         atomic.Value
+	numEquivocation uint32
+}
+
+func newEquivocatingMessenger(
+	messenger p2p.Messenger,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	singleSigner crypto.SingleSigner,
+) *equivocatingMessenger {
+	return &equivocatingMessenger{
+		Messenger:    messenger,
+		marshalizer:  marshalizer,
+		hasher:       hasher,
+		singleSigner: singleSigner,
+	}
+}
+
+// setPrivateKey sets the key used to sign the conflicting messages
+func (em *equivocatingMessenger) setPrivateKey(privKey crypto.PrivateKey) {
+	em.privKey.Store(privKey)
+}
+
+// Broadcast broadcasts the message and, if it is a signature share, a conflicting one for the same round
+func (em *equivocatingMessenger) Broadcast(topic string, buff []byte) {
+	em.Messenger.Broadcast(topic, buff)
+
+	if !strings.HasPrefix(topic, core.ConsensusTopic) {
+		return
+	}
+
+	conflictingBuff, ok := em.createConflictingMessage(buff)
+	if !ok {
+		return
+	}
+
+	atomic.AddUint32(&em.numEquivocation, 1)
+	em.Messenger.Broadcast(topic, conflictingBuff)
+}
+
+func (em *equivocatingMessenger) createConflictingMessage(buff []byte) ([]byte, bool) {
+	privKey, ok := em.privKey.Load().(crypto.PrivateKey)
+	if !ok {
+		return nil, false
+	}
+
+	cnsMsg := &consensus.Message{}
+	err := em.marshalizer.Unmarshal(cnsMsg, buff)
+	if err != nil {
+		return nil, false
+	}
+	if consensus.MessageType(cnsMsg.MsgType) != bls.MtSignature {
+		return nil, false
+	}
+
+	cnsMsg.BlockHeaderHash = em.hasher.Compute(string(cnsMsg.BlockHeaderHash))
+	cnsMsg.Signature = nil
+	msgNoSig, err := em.marshalizer.Marshal(cnsMsg)
+	if err != nil {
+		return nil, false
+	}
+
+	cnsMsg.Signature, err = em.singleSigner.Sign(privKey, msgNoSig)
+	if err != nil {
+		return nil, false
+	}
+
+	conflictingBuff, err := em.marshalizer.Marshal(cnsMsg)
+	if err != nil {
+		return nil, false
+	}
+
+	return conflictingBuff, true
+}
+
+// numEquivocations returns how many conflicting messages were broadcast
+func (em *equivocatingMessenger) numEquivocations() uint32 {
+	return atomic.LoadUint32(&em.numEquivocation)
+}
@@ -0,0 +1,153 @@
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclsinglesig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusBLSWithEquivocatingNodeShouldProduceEvidence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numNodes := 4
+	consensusSize := 4
+	roundTime := uint64(4000)
+	numCommBlock := uint64(3)
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	network := memp2p.NewNetwork()
+	messengers := make([]p2p.Messenger, numNodes)
+	for i := 0; i < numNodes; i++ {
+		messengers[i], _ = memp2p.NewMessenger(network)
+	}
+	misbehavingMessenger := newEquivocatingMessenger(
+		messengers[0],
+		marshalizer,
+		createHasher(blsConsensusType),
+		&mclsinglesig.BlsSingleSigner{},
+	)
+	messengers[0] = misbehavingMessenger
+
+	nodes := createNodesWithMessengers(messengers, consensusSize, roundTime, blsConsensusType)[0]
+	misbehavingMessenger.setPrivateKey(nodes[0].sk)
+	misbehavingPk, _ := nodes[0].pk.ToByteArray()
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.mesenger.Close()
+		}
+	}()
+
+	mutex := &sync.Mutex{}
+	nonceForRoundMap := make(map[uint64]uint64)
+	totalCalled := 0
+	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	require.Nil(t, err)
+
+	chDone := make(chan bool)
+	go checkBlockProposedEveryRound(numCommBlock, nonceForRoundMap, mutex, chDone, t)
+
+	extraTime := uint64(2)
+	endTime := time.Duration(roundTime) * time.Duration(numCommBlock+extraTime) * time.Millisecond
+	select {
+	case <-chDone:
+	case <-time.After(endTime):
+		assert.Fail(t, "consensus too slow, not working.")
+		return
+	}
+
+	require.True(t, misbehavingMessenger.numEquivocations() > 0)
+
+	verifier := createTestEvidenceVerifier(marshalizer)
+	for i, n := range nodes {
+		evidenceKeys := n.evidencePool.Keys()
+		assert.True(t, len(evidenceKeys) > 0, fmt.Sprintf("node %d has no evidence", i))
+
+		for _, key := range evidenceKeys {
+			value, _ := n.evidencePool.Peek(key)
+			evidence, ok := value.([]byte)
+			require.True(t, ok)
+
+			equivocation, errVerify := verifier.Verify(evidence)
+			require.Nil(t, errVerify)
+			assert.True(t, bytes.Equal(misbehavingPk, equivocation.PubKey))
+		}
+	}
+}
+
+func TestConsensusBLSWithHonestNodesShouldNotProduceEvidence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numNodes := 4
+	consensusSize := 4
+	roundTime := uint64(4000)
+	numCommBlock := uint64(3)
+
+	network := memp2p.NewNetwork()
+	messengers := make([]p2p.Messenger, numNodes)
+	for i := 0; i < numNodes; i++ {
+		messengers[i], _ = memp2p.NewMessenger(network)
+	}
+
+	nodes := createNodesWithMessengers(messengers, consensusSize, roundTime, blsConsensusType)[0]
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.mesenger.Close()
+		}
+	}()
+
+	mutex := &sync.Mutex{}
+	nonceForRoundMap := make(map[uint64]uint64)
+	totalCalled := 0
+	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	require.Nil(t, err)
+
+	chDone := make(chan bool)
+	go checkBlockProposedEveryRound(numCommBlock, nonceForRoundMap, mutex, chDone, t)
+
+	extraTime := uint64(2)
+	endTime := time.Duration(roundTime) * time.Duration(numCommBlock+extraTime) * time.Millisecond
+	select {
+	case <-chDone:
+	case <-time.After(endTime):
+		assert.Fail(t, "consensus too slow, not working.")
+		return
+	}
+
+	for _, n := range nodes {
+		assert.Equal(t, 0, n.evidencePool.Len())
+	}
+}
+
+func createTestEvidenceVerifier(marshalizer marshal.Marshalizer) slashing.EvidenceVerifier {
+	consensusService, _ := sposFactory.GetConsensusCoreFactory(blsConsensusType)
+	argsVerifier := slashing.ArgsEvidenceVerifier{
+		Marshalizer:     marshalizer,
+		KeyGenerator:    signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		SingleSigner:    &mclsinglesig.BlsSingleSigner{},
+		Classifier:      consensusService,
+		ChainID:         consensusChainID,
+		MaxEvidenceSize: maxEvidenceSize,
+	}
+	verifier, _ := slashing.NewEvidenceVerifier(argsVerifier)
+
+	return verifier
+}
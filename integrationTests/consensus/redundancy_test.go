@@ -0,0 +1,110 @@
+package consensus
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusBLSWithBackupNodeShouldTakeOverAndStepBack(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numNodes := 4
+	consensusSize := 4
+	roundTime := uint64(4000)
+	roundDuration := time.Duration(roundTime) * time.Millisecond
+
+	network := memp2p.NewNetwork()
+	messengers := make([]p2p.Messenger, numNodes)
+	for i := 0; i < numNodes; i++ {
+		messengers[i], _ = memp2p.NewMessenger(network)
+	}
+	mainMessenger := newSwitchableMessenger(messengers[0])
+	messengers[0] = mainMessenger
+	backupNetMessenger, _ := memp2p.NewMessenger(network)
+	backupMessenger := newSwitchableMessenger(backupNetMessenger)
+
+	nodes := createNodesWithBackups(messengers, []p2p.Messenger{backupMessenger}, consensusSize, roundTime, blsConsensusType)[0]
+	backupNode := nodes[numNodes]
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.mesenger.Close()
+		}
+	}()
+
+	mutex := &sync.Mutex{}
+	nonceForRoundMap := make(map[uint64]uint64)
+	totalCalled := 0
+	err := startNodesWithCommitBlock(nodes, mutex, nonceForRoundMap, &totalCalled)
+	require.Nil(t, err)
+
+	fmt.Println("Step 1. The backup machine follows the consensus while the main machine is active")
+	time.Sleep(4 * roundDuration)
+
+	require.True(t, getCurrentNonce(nodes[1]) > 0, "consensus not working")
+	assert.True(t, backupNode.redundancy.IsMainMachineActive())
+	assert.Equal(t, uint32(0), backupMessenger.numBroadcastConsensusMessages())
+	assert.True(t, getCurrentNonce(backupNode) > 0, "backup machine is not synced")
+
+	fmt.Println("Step 2. The main machine is switched off and the backup machine takes over")
+	mainMessenger.switchOff()
+
+	isBackupActive := waitUntil(func() bool {
+		return !backupNode.redundancy.IsMainMachineActive()
+	}, 3*maxMissedRoundsForFailover*roundDuration)
+	require.True(t, isBackupActive, "backup machine did not take over")
+
+	nonceAtFailover := getCurrentNonce(nodes[1])
+	time.Sleep(3 * roundDuration)
+
+	assert.True(t, backupMessenger.numBroadcastConsensusMessages() > 0)
+	assert.True(t, getCurrentNonce(nodes[1]) > nonceAtFailover, "consensus stopped after failover")
+
+	fmt.Println("Step 3. The main machine is switched on and the backup machine steps back")
+	mainMessenger.switchOn()
+
+	isBackupInStandby := waitUntil(func() bool {
+		return backupNode.redundancy.IsMainMachineActive()
+	}, 3*roundDuration)
+	require.True(t, isBackupInStandby, "backup machine did not step back")
+
+	time.Sleep(roundDuration)
+	numBackupMessages := backupMessenger.numBroadcastConsensusMessages()
+	nonceAtStepBack := getCurrentNonce(nodes[1])
+	time.Sleep(3 * roundDuration)
+
+	assert.Equal(t, numBackupMessages, backupMessenger.numBroadcastConsensusMessages())
+	assert.True(t, backupNode.redundancy.IsMainMachineActive())
+	assert.True(t, getCurrentNonce(nodes[1]) > nonceAtStepBack, "consensus stopped after the main machine came back")
+}
+
+func getCurrentNonce(n *testNode) uint64 {
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return 0
+	}
+
+	return header.GetNonce()
+}
+
+func waitUntil(condition func() bool, timeout time.Duration) bool {
+	pollingInterval := 100 * time.Millisecond
+	for elapsed := time.Duration(0); elapsed < timeout; elapsed += pollingInterval {
+		if condition() {
+			return true
+		}
+		time.Sleep(pollingInterval)
+	}
+
+	return condition()
+}
@@ -0,0 +1,52 @@
+package consensus
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// switchableMessenger wraps a messenger and counts the messages the wrapped node broadcasts on the consensus topic.
+// While switched off, the messages are dropped, as if the machine was disconnected, but the node still receives the
+// messages of the other nodes
+type switchableMessenger struct {
+	p2p.Messenger
+	isOff                uint32
+	numConsensusMessages uint32
+}
+
+func newSwitchableMessenger(messenger p2p.Messenger) *switchableMessenger {
+	return &switchableMessenger{
+		Messenger: messenger,
+	}
+}
+
+// Broadcast broadcasts the message if the messenger is switched on
+func (sm *switchableMessenger) Broadcast(topic string, buff []byte) {
+	if atomic.LoadUint32(&sm.isOff) == 1 {
+		return
+	}
+
+	if strings.HasPrefix(topic, core.ConsensusTopic) {
+		atomic.AddUint32(&sm.numConsensusMessages, 1)
+	}
+
+	sm.Messenger.Broadcast(topic, buff)
+}
+
+// switchOff drops all the messages broadcast from now on
+func (sm *switchableMessenger) switchOff() {
+	atomic.StoreUint32(&sm.isOff, 1)
+}
+
+// switchOn resumes broadcasting the messages
+func (sm *switchableMessenger) switchOn() {
+	atomic.StoreUint32(&sm.isOff, 0)
+}
+
+// numBroadcastConsensusMessages returns how many messages were broadcast on the consensus topic
+func (sm *switchableMessenger) numBroadcastConsensusMessages() uint32 {
+	return atomic.LoadUint32(&sm.numConsensusMessages)
+}
@@ -9,7 +9,11 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/redundancy"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/signingHistory"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -24,6 +28,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	disabledCommitJournal "github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal/disabled"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
@@ -40,8 +45,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	syncFork "github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
@@ -52,6 +59,9 @@ import (
 const blsConsensusType = "bls"
 const signatureSize = 48
 const publicKeySize = 96
+const maxEvidenceSize = 262144
+const evidencePoolCapacity = 100
+const maxMissedRoundsForFailover = 2
 
 var p2pBootstrapDelay = time.Second * 5
 var consensusChainID = []byte("consensus chain ID")
@@ -65,6 +75,8 @@ type testNode struct {
 	sk           crypto.PrivateKey
 	pk           crypto.PublicKey
 	shardId      uint32
+	evidencePool storage.Cacher
+	redundancy   consensus.NodeRedundancyHandler
 }
 
 type keyPair struct {
@@ -284,7 +296,8 @@ func createConsensusOnlyNode(
 	nodesCoordinator sharding.NodesCoordinator,
 	shardId uint32,
 	selfId uint32,
-	initialAddr string,
+	messenger p2p.Messenger,
+	evidencePool storage.Cacher,
 	consensusSize uint32,
 	roundTime uint64,
 	privKey crypto.PrivateKey,
@@ -292,6 +305,7 @@ func createConsensusOnlyNode(
 	testKeyGen crypto.KeyGenerator,
 	consensusType string,
 	epochStartRegistrationHandler epochStart.RegistrationHandler,
+	nodeRedundancyHandler consensus.NodeRedundancyHandler,
 ) (
 	*node.Node,
 	p2p.Messenger,
@@ -301,7 +315,6 @@ func createConsensusOnlyNode(
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.GogoProtoMarshalizer{}
 
-	messenger := integrationTests.CreateMessengerWithKadDht(initialAddr)
 	rootHash := []byte("roothash")
 
 	blockChain := createTestBlockChain()
@@ -413,6 +426,23 @@ func createConsensusOnlyNode(
 
 	accntAdapter := createAccountsDB(testMarshalizer)
 
+	consensusService, _ := sposFactory.GetConsensusCoreFactory(consensusType)
+	argsEvidenceVerifier := slashing.ArgsEvidenceVerifier{
+		Marshalizer:     testMarshalizer,
+		KeyGenerator:    testKeyGen,
+		SingleSigner:    singleBlsSigner,
+		Classifier:      consensusService,
+		ChainID:         consensusChainID,
+		MaxEvidenceSize: maxEvidenceSize,
+	}
+	evidenceVerifier, _ := slashing.NewEvidenceVerifier(argsEvidenceVerifier)
+
+	pubKeyBytes, _ := privKey.GeneratePublic().ToByteArray()
+	validatorSigningHistory, _ := signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Persister: memorydb.New(),
+		PublicKey: pubKeyBytes,
+	})
+
 	n, err := node.NewNode(
 		node.WithInitialNodesPubKeys(inPubKeys),
 		node.WithRoundDuration(roundTime),
@@ -448,6 +478,7 @@ func createConsensusOnlyNode(
 		node.WithEpochStartEventNotifier(epochStartRegistrationHandler),
 		node.WithNetworkShardingCollector(mock.NewNetworkShardingCollectorMock()),
 		node.WithBootStorer(&mock.BoostrapStorerMock{}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithRequestedItemsHandler(&mock.RequestedItemsHandlerStub{}),
 		node.WithHeaderSigVerifier(&mock.HeaderSigVerifierStub{}),
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
@@ -458,6 +489,10 @@ func createConsensusOnlyNode(
 		node.WithInputAntifloodHandler(&mock.NilAntifloodHandler{}),
 		node.WithSignatureSize(signatureSize),
 		node.WithPublicKeySize(publicKeySize),
+		node.WithEquivocationEvidencePool(evidencePool),
+		node.WithEquivocationEvidenceVerifier(evidenceVerifier),
+		node.WithSigningHistory(validatorSigningHistory),
+		node.WithNodeRedundancyHandler(nodeRedundancyHandler),
 	)
 
 	if err != nil {
@@ -475,12 +510,42 @@ func createNodes(
 	consensusType string,
 ) map[uint32][]*testNode {
 
+	messengers := make([]p2p.Messenger, nodesPerShard)
+	for i := 0; i < nodesPerShard; i++ {
+		messengers[i] = integrationTests.CreateMessengerWithKadDht(serviceID)
+	}
+
+	return createNodesWithMessengers(messengers, consensusSize, roundTime, consensusType)
+}
+
+// createNodesWithMessengers creates one consensus node for each of the provided messengers
+func createNodesWithMessengers(
+	messengers []p2p.Messenger,
+	consensusSize int,
+	roundTime uint64,
+	consensusType string,
+) map[uint32][]*testNode {
+	return createNodesWithBackups(messengers, nil, consensusSize, roundTime, consensusType)
+}
+
+// createNodesWithBackups creates one consensus node for each of the provided messengers, followed by a backup machine
+// of the first validator for each of the provided backup messengers. The redundancy level of a backup machine is its
+// index in the backup messengers list + 1
+func createNodesWithBackups(
+	messengers []p2p.Messenger,
+	backupMessengers []p2p.Messenger,
+	consensusSize int,
+	roundTime uint64,
+	consensusType string,
+) map[uint32][]*testNode {
+
+	nodesPerShard := len(messengers)
 	nodes := make(map[uint32][]*testNode)
 	cp := createCryptoParams(nodesPerShard, 1, 1)
 	keysMap := pubKeysMapFromKeysMap(cp.keys)
 	eligibleMap := genValidatorsFromPubKeys(keysMap)
 	waitingMap := make(map[uint32][]sharding.Validator)
-	nodesList := make([]*testNode, nodesPerShard)
+	nodesList := make([]*testNode, nodesPerShard+len(backupMessengers))
 
 	nodeShuffler := &mock.NodeShufflerMock{}
 
@@ -489,16 +554,28 @@ func createNodes(
 		pubKeys[idx] = keyPairShard.pk
 	}
 
-	for i := 0; i < nodesPerShard; i++ {
+	for i := 0; i < len(nodesList); i++ {
 		testNodeObject := &testNode{
 			shardId: uint32(0),
 		}
 
-		kp := cp.keys[0][i]
+		keyIndex := i
+		redundancyLevel := int64(0)
+		messenger := messengers[0]
+		if i < nodesPerShard {
+			messenger = messengers[i]
+		} else {
+			keyIndex = 0
+			redundancyLevel = int64(i - nodesPerShard + 1)
+			messenger = backupMessengers[i-nodesPerShard]
+		}
+
+		kp := cp.keys[0][keyIndex]
 		shardCoordinator, _ := sharding.NewMultiShardCoordinator(uint32(1), uint32(0))
 		epochStartRegistrationHandler := &mock.EpochStartNotifierStub{}
 		bootStorer := integrationTests.CreateMemUnit()
 		consensusCache, _ := lrucache.NewCache(10000)
+		evidencePool, _ := lrucache.NewCache(evidencePoolCapacity)
 
 		argumentsNodesCoordinator := sharding.ArgNodesCoordinator{
 			ShardConsensusGroupSize: consensusSize,
@@ -511,18 +588,25 @@ func createNodes(
 			NbShards:                1,
 			EligibleNodes:           eligibleMap,
 			WaitingNodes:            waitingMap,
-			SelfPublicKey:           []byte(strconv.Itoa(i)),
+			SelfPublicKey:           []byte(strconv.Itoa(keyIndex)),
 			ConsensusGroupCache:     consensusCache,
 			ShuffledOutHandler:      &mock.ShuffledOutHandlerStub{},
 		}
 		nodesCoordinator, _ := sharding.NewIndexHashedNodesCoordinator(argumentsNodesCoordinator)
+		nodeRedundancy, _ := redundancy.NewNodeRedundancy(redundancy.ArgsNodeRedundancy{
+			RedundancyLevel:  redundancyLevel,
+			MaxMissedRounds:  maxMissedRoundsForFailover,
+			Messenger:        messenger,
+			AppStatusHandler: statusHandler.NewNilStatusHandler(),
+		})
 
 		n, mes, blkProcessor, blkc := createConsensusOnlyNode(
 			shardCoordinator,
 			nodesCoordinator,
 			testNodeObject.shardId,
-			uint32(i),
-			serviceID,
+			uint32(keyIndex),
+			messenger,
+			evidencePool,
 			uint32(consensusSize),
 			roundTime,
 			kp.sk,
@@ -530,6 +614,7 @@ func createNodes(
 			cp.keyGen,
 			consensusType,
 			epochStartRegistrationHandler,
+			nodeRedundancy,
 		)
 
 		testNodeObject.node = n
@@ -538,6 +623,8 @@ func createNodes(
 		testNodeObject.pk = kp.pk
 		testNodeObject.blkProcessor = blkProcessor
 		testNodeObject.blkc = blkc
+		testNodeObject.evidencePool = evidencePool
+		testNodeObject.redundancy = nodeRedundancy
 		nodesList[i] = testNodeObject
 	}
 	nodes[0] = nodesList
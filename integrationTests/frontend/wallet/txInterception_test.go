@@ -22,11 +22,13 @@ func TestInterceptedTxWhithoutDataField(t *testing.T) {
 		0,
 		value,
 		"erd1t2cct2ahdna5n2q3ljzj4tgn6fnqqrncs967pekunl7cuscqxymsgm388y",
-		"erd14t6l0x27w4d4354sqfm40wuv9p0r49uzl9598eka290x9kws2nvqlkc36j",
-		"7a98196903b09ef70cb182462a83b38ecbba819ec93e82b1d7bf29556a40afbcf739d1e2ddc8ca615a8ab1ebde1e6feafb809249c772d5cfa61562afb5d86f01",
+		"erd1t332lu4ct3zn4wwhpyc2s59v0vpqna6hlzw5d3nquzt7vx4k76kqp087mk",
+		"438810256fa4d634c880c2b29033d95776e0473bff138d963eb783840df4617ba240b2abb4b24da0d865f3091144e4a904ba9a3730a125e0f90b7a29d8008200",
 		10,
 		100000,
 		[]byte(""),
+		integrationTests.ChainID,
+		integrationTests.MinTransactionVersion,
 	)
 }
 
@@ -39,11 +41,13 @@ func TestInterceptedTxWhithDataField(t *testing.T) {
 		0,
 		value,
 		"erd12dnfhej64s6c56ka369gkyj3hwv5ms0y5rxgsk2k7hkd2vuk7rvqxkalsa",
-		"erd1zq6l0g5yg6ehz7reatt3uhyfy89udx8q3p72fy5fhmfdvr883xgqufyvte",
-		"8bdaebfc67b5b7e8a325004b3934dc6f1f0329b3d29baa1763c663925384bd93f1de3a8ac297db918a233c13c4014431a8ed7455c03e2a706828a9a400b6b107",
+		"erd1wmtx5s4jfjlys88ku7vfqa75gpz8648d3cdnpgzr4thxazynq7tsv0pjtw",
+		"2214784ebd41e9294d5461e336ab76ae1ba9922f1ce95e49cc94f743a9229666c30f49dd93a3a4155dfa742b2eeca24d32f7161565663a69a04818feac8c8c09",
 		10,
 		100000,
 		[]byte("data@~`!@#$^&*()_=[]{};'<>?,./|<>><!!!!!"),
+		integrationTests.ChainID,
+		integrationTests.MinTransactionVersion,
 	)
 }
 
@@ -59,6 +63,8 @@ func testInterceptedTxFromFrontendGeneratedParams(
 	frontendGasPrice uint64,
 	frontendGasLimit uint64,
 	frontendData []byte,
+	frontendChainID []byte,
+	frontendVersion uint32,
 ) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
@@ -124,6 +130,8 @@ func testInterceptedTxFromFrontendGeneratedParams(
 		GasLimit:  frontendGasLimit,
 		Data:      frontendData,
 		Signature: signatureBytes,
+		ChainID:   frontendChainID,
+		Version:   frontendVersion,
 	}
 	tx.Value = big.NewInt(0).Set(frontendValue)
 	txHexHash, err = node.SendTransaction(tx)
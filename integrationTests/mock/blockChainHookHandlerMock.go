@@ -10,11 +10,21 @@ import (
 
 // BlockChainHookHandlerMock -
 type BlockChainHookHandlerMock struct {
-	AddTempAccountCalled    func(address []byte, balance *big.Int, nonce uint64)
-	CleanTempAccountsCalled func()
-	TempAccountCalled       func(address []byte) state.AccountHandler
-	SetCurrentHeaderCalled  func(hdr data.HeaderHandler)
-	NewAddressCalled        func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	AddTempAccountCalled     func(address []byte, balance *big.Int, nonce uint64)
+	CleanTempAccountsCalled  func()
+	TempAccountCalled        func(address []byte) state.AccountHandler
+	SetCurrentHeaderCalled   func(hdr data.HeaderHandler)
+	NewAddressCalled         func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	SetAccountsAdapterCalled func(accounts state.AccountsAdapter) error
+}
+
+// SetAccountsAdapter -
+func (e *BlockChainHookHandlerMock) SetAccountsAdapter(accounts state.AccountsAdapter) error {
+	if e.SetAccountsAdapterCalled != nil {
+		return e.SetAccountsAdapterCalled(accounts)
+	}
+
+	return nil
 }
 
 // GetBuiltInFunctions -
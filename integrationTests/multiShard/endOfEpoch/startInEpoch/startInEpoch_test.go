@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
@@ -198,6 +199,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		NodeShuffler:               &mock.NodeShufflerMock{},
 		Rounder:                    rounder,
 		AddressPubkeyConverter:     integrationTests.TestAddressPubkeyConverter,
+		StatusHandler:              &mock.AppStatusHandlerStub{},
 	}
 	epochStartBootstrap, err := bootstrap.NewEpochStartBootstrap(argsBootstrapHandler)
 	assert.Nil(t, err)
@@ -227,6 +229,13 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 	assert.NoError(t, err)
 	assert.NotNil(t, bootstrapStorer)
 
+	blockCommitJournal, err := commitJournal.NewCommitJournal(commitJournal.ArgsCommitJournal{
+		Store:          storageServiceShard,
+		JournalUnit:    dataRetriever.BlockCommitJournalUnit,
+		JournaledUnits: commitJournal.BlockCommitUnits(shardC.NumberOfShards()),
+	})
+	assert.NoError(t, err)
+
 	argsBaseBootstrapper := storageBootstrap.ArgsBaseStorageBootstrapper{
 		BootStorer:          bootstrapStorer,
 		ForkDetector:        &mock.ForkDetectorStub{},
@@ -242,6 +251,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		BlockTracker: &mock.BlockTrackerStub{
 			RestoreToGenesisCalled: func() {},
 		},
+		BlockCommitJournal: blockCommitJournal,
 	}
 
 	bootstrapper, err := getBootstrapper(shardID, argsBaseBootstrapper)
@@ -297,6 +307,10 @@ func getGeneralConfig() config.Config {
 			NumEpochsToKeep:     3,
 			NumActivePersisters: 3,
 		},
+		TrieSync: config.TrieSyncConfig{
+			TrieSyncerVersion: 2,
+			MaxInFlightNodes:  1000,
+		},
 		EvictionWaitingList: config.EvictionWaitingListConfig{
 			Size: 100,
 			DB: config.DBConfig{
@@ -530,6 +544,20 @@ func getGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		BlockCommitJournalStorage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Capacity: 10,
+				Type:     "LRU",
+				Shards:   1,
+			},
+			DB: config.DBConfig{
+				FilePath:          "BlockCommitJournal",
+				Type:              "MemoryDB",
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
 		PeerBlockBodyStorage: config.StorageConfig{
 			Cache: config.CacheConfig{
 				Capacity: 10000,
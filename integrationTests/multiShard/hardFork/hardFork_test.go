@@ -326,22 +326,27 @@ func createHardForkExporter(
 			},
 			ExportStateStorageConfig: exportConfig,
 			MaxTrieLevelInMemory:     uint(5),
-			WhiteListHandler:         node.WhiteListHandler,
-			WhiteListerVerifiedTxs:   node.WhiteListerVerifiedTxs,
-			InterceptorsContainer:    node.InterceptorsContainer,
-			ExistingResolvers:        node.ResolversContainer,
-			MultiSigner:              node.MultiSigner,
-			NodesCoordinator:         node.NodesCoordinator,
-			SingleSigner:             node.OwnAccount.SingleSigner,
-			AddressPubkeyConverter:   integrationTests.TestAddressPubkeyConverter,
-			BlockKeyGen:              node.OwnAccount.KeygenBlockSign,
-			KeyGen:                   node.OwnAccount.KeygenTxSign,
-			BlockSigner:              node.OwnAccount.BlockSingleSigner,
-			HeaderSigVerifier:        node.HeaderSigVerifier,
-			HeaderIntegrityVerifier:  node.HeaderIntegrityVerifier,
-			ValidityAttester:         node.BlockTracker,
-			OutputAntifloodHandler:   &mock.NilAntifloodHandler{},
-			InputAntifloodHandler:    &mock.NilAntifloodHandler{},
+			TrieSyncConfig: config.TrieSyncConfig{
+				TrieSyncerVersion: 2,
+				MaxInFlightNodes:  1000,
+			},
+			StatusHandler:           &mock.AppStatusHandlerStub{},
+			WhiteListHandler:        node.WhiteListHandler,
+			WhiteListerVerifiedTxs:  node.WhiteListerVerifiedTxs,
+			InterceptorsContainer:   node.InterceptorsContainer,
+			ExistingResolvers:       node.ResolversContainer,
+			MultiSigner:             node.MultiSigner,
+			NodesCoordinator:        node.NodesCoordinator,
+			SingleSigner:            node.OwnAccount.SingleSigner,
+			AddressPubkeyConverter:  integrationTests.TestAddressPubkeyConverter,
+			BlockKeyGen:             node.OwnAccount.KeygenBlockSign,
+			KeyGen:                  node.OwnAccount.KeygenTxSign,
+			BlockSigner:             node.OwnAccount.BlockSingleSigner,
+			HeaderSigVerifier:       node.HeaderSigVerifier,
+			HeaderIntegrityVerifier: node.HeaderIntegrityVerifier,
+			ValidityAttester:        node.BlockTracker,
+			OutputAntifloodHandler:  &mock.NilAntifloodHandler{},
+			InputAntifloodHandler:   &mock.NilAntifloodHandler{},
 		}
 
 		exportHandler, err := factory.NewExportHandlerFactory(argsExportHandler)
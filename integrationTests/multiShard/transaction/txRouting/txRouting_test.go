@@ -147,6 +147,8 @@ func generateTx(sender crypto.PrivateKey, receiver crypto.PublicKey, nonce uint6
 		GasLimit:  integrationTests.MinTxGasLimit,
 		Data:      []byte(""),
 		Signature: nil,
+		ChainID:   integrationTests.ChainID,
+		Version:   integrationTests.MinTransactionVersion,
 	}
 	marshalizedTxBeforeSigning, _ := tx.GetDataForSigning(integrationTests.TestAddressPubkeyConverter, integrationTests.TestTxSignMarshalizer)
 	signer := ed25519SingleSig.Ed25519Signer{}
@@ -286,6 +286,8 @@ func createAndSendTx(
 		Data:     txData,
 		GasPrice: node.EconomicsData.GetMinGasPrice(),
 		GasLimit: gasLimit,
+		ChainID:  integrationTests.ChainID,
+		Version:  integrationTests.MinTransactionVersion,
 	}
 
 	txBuff, _ := integrationTests.TestMarshalizer.Marshal(tx)
@@ -23,6 +23,42 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
+	//we have tested even with the 50000 value and found out that it worked in a reasonable amount of time ~21 seconds
+	requestInterceptTrieNodesWithMessenger(t, trie.LegacyTrieSyncerVersion, 10000, nil)
+}
+
+func TestNode_RequestInterceptTrieNodesWithMessengerParallelSyncer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	requestInterceptTrieNodesWithMessenger(t, trie.ParallelTrieSyncerVersion, 10000, nil)
+}
+
+func BenchmarkTrieSync_LegacySyncer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		requestInterceptTrieNodesWithMessenger(b, trie.LegacyTrieSyncerVersion, 10000, b)
+	}
+}
+
+func BenchmarkTrieSync_ParallelSyncer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		requestInterceptTrieNodesWithMessenger(b, trie.ParallelTrieSyncerVersion, 10000, b)
+	}
+}
+
+// requestInterceptTrieNodesWithMessenger syncs a trie with numLeaves leaves between two connected nodes, using the
+// provided trie syncer version. If a benchmark is provided, only the syncing is timed
+func requestInterceptTrieNodesWithMessenger(
+	tb testing.TB,
+	trieSyncerVersion uint32,
+	numLeaves int,
+	benchmark *testing.B,
+) {
+	if benchmark != nil {
+		benchmark.StopTimer()
+	}
+
 	var nrOfShards uint32 = 1
 	var shardID uint32 = 0
 	var txSignPrivKeyShardId uint32 = 0
@@ -43,13 +79,12 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 
 	time.Sleep(time.Second)
 	err := nRequester.Messenger.ConnectToPeer(integrationTests.GetConnectableAddress(nResolver.Messenger))
-	assert.Nil(t, err)
+	assert.Nil(tb, err)
 
 	time.Sleep(integrationTests.SyncDelay)
 
 	resolverTrie := nResolver.TrieContainer.Get([]byte(factory2.UserAccountTrie))
-	//we have tested even with the 50000 value and found out that it worked in a reasonable amount of time ~21 seconds
-	for i := 0; i < 10000; i++ {
+	for i := 0; i < numLeaves; i++ {
 		_ = resolverTrie.Update([]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i)))
 	}
 
@@ -57,7 +92,7 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 	rootHash, _ := resolverTrie.Root()
 
 	_, err = resolverTrie.GetAllLeaves()
-	assert.Nil(t, err)
+	assert.Nil(tb, err)
 
 	requesterTrie := nRequester.TrieContainer.Get([]byte(factory2.UserAccountTrie))
 	nilRootHash, _ := requesterTrie.Root()
@@ -77,19 +112,36 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 		time.Second,
 	)
 
+	argTrieSyncer := trie.ArgTrieSyncer{
+		RequestHandler:   requestHandler,
+		InterceptedNodes: nRequester.DataPool.TrieNodes(),
+		Trie:             requesterTrie,
+		ShardId:          shardID,
+		Topic:            factory.AccountTrieNodesTopic,
+		MaxInFlightNodes: 1000,
+		AppStatusHandler: &mock.AppStatusHandlerStub{},
+	}
+	trieSyncer, err := trie.CreateTrieSyncer(argTrieSyncer, trieSyncerVersion)
+	assert.Nil(tb, err)
+
 	waitTime := 100 * time.Second
-	trieSyncer, _ := trie.NewTrieSyncer(requestHandler, nRequester.DataPool.TrieNodes(), requesterTrie, shardID, factory.AccountTrieNodesTopic)
 	ctx, cancel := context.WithTimeout(context.Background(), waitTime)
 	defer cancel()
 
 	//_ = logger.SetLogLevel("*:DEBUG")
+	if benchmark != nil {
+		benchmark.StartTimer()
+	}
 	err = trieSyncer.StartSyncing(rootHash, ctx)
-	assert.Nil(t, err)
+	if benchmark != nil {
+		benchmark.StopTimer()
+	}
+	assert.Nil(tb, err)
 
 	newRootHash, _ := requesterTrie.Root()
-	assert.NotEqual(t, nilRootHash, newRootHash)
-	assert.Equal(t, rootHash, newRootHash)
+	assert.NotEqual(tb, nilRootHash, newRootHash)
+	assert.Equal(tb, rootHash, newRootHash)
 
 	_, err = requesterTrie.GetAllLeaves()
-	assert.Nil(t, err)
+	assert.Nil(tb, err)
 }
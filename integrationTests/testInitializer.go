@@ -357,6 +357,7 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.BootstrapUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.BlockCommitJournalUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -770,6 +771,7 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	return txProcessor
@@ -1162,6 +1164,8 @@ func CreateAndSendTransaction(
 		Data:     []byte(txData),
 		GasPrice: MinTxGasPrice,
 		GasLimit: MinTxGasLimit*1000 + uint64(len(txData)),
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 
 	txBuff, _ := tx.GetDataForSigning(TestAddressPubkeyConverter, TestTxSignMarshalizer)
@@ -1190,6 +1194,8 @@ func CreateAndSendTransactionWithGasLimit(
 		Data:     txData,
 		GasPrice: MinTxGasPrice,
 		GasLimit: gasLimit,
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 
 	txBuff, _ := tx.GetDataForSigning(TestAddressPubkeyConverter, TestTxSignMarshalizer)
@@ -1228,6 +1234,8 @@ func GenerateTransferTx(
 		Data:     []byte(""),
 		GasLimit: gasLimit,
 		GasPrice: gasPrice,
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 	txBuff, _ := tx.GetDataForSigning(TestAddressPubkeyConverter, TestTxSignMarshalizer)
 	signer := &ed25519SingleSig.Ed25519Signer{}
@@ -1249,6 +1257,8 @@ func generateTx(
 		GasPrice: args.gasPrice,
 		GasLimit: args.gasLimit,
 		Data:     []byte(args.data),
+		ChainID:  ChainID,
+		Version:  MinTransactionVersion,
 	}
 	txBuff, _ := tx.GetDataForSigning(TestAddressPubkeyConverter, TestTxSignMarshalizer)
 	tx.Signature, _ = signer.Sign(skSign, txBuff)
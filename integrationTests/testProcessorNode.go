@@ -3,6 +3,7 @@ package integrationTests
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
@@ -59,12 +61,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -135,6 +141,9 @@ const roundDuration = 5 * time.Second
 // ChainID is the chain ID identifier used in integration tests, processing nodes
 var ChainID = []byte("integration tests chain ID")
 
+// MinTransactionVersion is the minimum transaction version used in integration tests, processing nodes
+var MinTransactionVersion = uint32(1)
+
 // SoftwareVersion is the software version identifier used in integration tests, processing nodes
 var SoftwareVersion = []byte("intT")
 
@@ -145,6 +154,17 @@ const sizeCheckDelta = 100
 
 const stateCheckpointModulus = 100
 
+const testConsensusType = "bls"
+
+// EquivocationSlashValue is the amount slashed by the metachain nodes from a validator's stake for a proven equivocation
+const EquivocationSlashValue = 1000
+
+const testMaxEvidencePerBlock = 2
+
+const testMaxEvidenceSize = 262144
+
+const testEvidencePoolCapacity = 100
+
 // TestKeyPair holds a pair of private/public Keys
 type TestKeyPair struct {
 	Sk crypto.PrivateKey
@@ -187,6 +207,8 @@ type TestProcessorNode struct {
 	BlockBlackListHandler process.BlackListHandler
 	HeaderValidator       process.HeaderConstructionValidator
 	BlockTracker          process.BlockTracker
+	BlockCommitJournal    process.BlockCommitJournal
+	BlockCommitStorage    dataRetriever.StorageService
 	InterceptorsContainer process.InterceptorsContainer
 	ResolversContainer    dataRetriever.ResolversContainer
 	ResolverFinder        dataRetriever.ResolversFinder
@@ -228,6 +250,9 @@ type TestProcessorNode struct {
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
 	Rater                        sharding.PeerAccountListAndRatingHandler
 
+	EvidencePool     storage.Cacher
+	EvidenceVerifier slashing.EvidenceVerifier
+
 	//Node is used to call the functionality already implemented in it
 	Node           *node.Node
 	SCQueryService external.SCQueryService
@@ -426,8 +451,8 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initHeaderValidator()
 	tpn.initRounder()
 	tpn.NetworkShardingCollector = mock.NewNetworkShardingCollectorMock()
-	tpn.initStorage()
 	tpn.initAccountDBs()
+	tpn.initStorage()
 	tpn.initEconomicsData()
 	tpn.initRatingsData()
 	tpn.initRequestedItemsHandler()
@@ -452,7 +477,8 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.AccntState)
+	tpn.initEquivocationEvidence()
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
@@ -481,6 +507,17 @@ func (tpn *TestProcessorNode) initDataPools() {
 
 func (tpn *TestProcessorNode) initStorage() {
 	tpn.Storage = CreateStore(tpn.ShardCoordinator.NumberOfShards())
+	// the peer accounts trie shares the storage manager of the user accounts trie, so its database is journaled once
+	blockCommitJournal, _ := commitJournal.NewCommitJournal(commitJournal.ArgsCommitJournal{
+		Store:          tpn.Storage,
+		JournalUnit:    dataRetriever.BlockCommitJournalUnit,
+		JournaledUnits: commitJournal.BlockCommitUnits(tpn.ShardCoordinator.NumberOfShards()),
+		TrieStorageManagers: map[dataRetriever.UnitType]data.StorageManager{
+			dataRetriever.UserAccountsUnit: tpn.TrieStorageManagers[trieFactory.UserAccountTrie],
+		},
+	})
+	tpn.BlockCommitJournal = blockCommitJournal
+	tpn.BlockCommitStorage = blockCommitJournal.JournaledStore()
 }
 
 func (tpn *TestProcessorNode) initChainHandler() {
@@ -514,9 +551,10 @@ func CreateEconomicsData() *economics.EconomicsData {
 	economicsData, _ := economics.NewEconomicsData(
 		&config.EconomicsConfig{
 			GlobalSettings: config.GlobalSettings{
-				TotalSupply:      "2000000000000000000000",
-				MinimumInflation: 0,
-				MaximumInflation: 0.05,
+				TotalSupply:         "2000000000000000000000",
+				MinimumInflation:    0,
+				MaximumInflation:    0.05,
+				OptimalStakingRatio: 0.5,
 			},
 			RewardsSettings: config.RewardsSettings{
 				LeaderPercentage:    0.1,
@@ -686,6 +724,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			WhiteListerVerifiedTxs:  tpn.WhiteListerVerifiedTxs,
 			AntifloodHandler:        &mock.NilAntifloodHandler{},
 			NonceConverter:          TestUint64Converter,
+			ChainID:                 ChainID,
+			MinTransactionVersion:   MinTransactionVersion,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaIntercContFactArgs)
 
@@ -745,6 +785,8 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			WhiteListerVerifiedTxs:  tpn.WhiteListerVerifiedTxs,
 			AntifloodHandler:        &mock.NilAntifloodHandler{},
 			NonceConverter:          TestUint64Converter,
+			ChainID:                 ChainID,
+			MinTransactionVersion:   MinTransactionVersion,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterContFactArgs)
 
@@ -833,7 +875,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		TestMarshalizer,
 		TestHasher,
 		TestAddressPubkeyConverter,
-		tpn.Storage,
+		tpn.BlockCommitStorage,
 		tpn.DataPool,
 	)
 
@@ -849,9 +891,11 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	gasSchedule := arwenConfig.MakeGasMapForTests()
 	defaults.FillGasMapInternal(gasSchedule, 1)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -933,11 +977,12 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.EconomicsData,
 		receiptsHandler,
 		badBlocskHandler,
+		tpn.ScrForwarder,
 	)
 
 	fact, _ := shard.NewPreProcessorsContainerFactory(
 		tpn.ShardCoordinator,
-		tpn.Storage,
+		tpn.BlockCommitStorage,
 		TestMarshalizer,
 		TestHasher,
 		tpn.DataPool,
@@ -978,14 +1023,22 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		TestMarshalizer,
 		TestHasher,
 		TestAddressPubkeyConverter,
-		tpn.Storage,
+		tpn.BlockCommitStorage,
 		tpn.DataPool,
 	)
 
 	tpn.InterimProcContainer, _ = interimProcFactory.Create()
 	tpn.ScrForwarder, _ = tpn.InterimProcContainer.Get(dataBlock.SmartContractResultBlock)
 
-	builtInFuncs := builtInFunctions.NewBuiltInFunctionContainer()
+	gasSchedule := make(map[string]map[string]uint64)
+	defaults.FillGasMapInternal(gasSchedule, 1)
+	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:           gasSchedule,
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+	}
+	builtInFuncs, _ := builtInFunctions.CreateMetaBuiltInFunctionContainer(argsBuiltIn)
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:         tpn.AccntState,
 		PubkeyConv:       TestAddressPubkeyConverter,
@@ -996,8 +1049,6 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		Uint64Converter:  TestUint64Converter,
 		BuiltInFunctions: builtInFuncs,
 	}
-	gasSchedule := make(map[string]map[string]uint64)
-	defaults.FillGasMapInternal(gasSchedule, 1)
 	signVerifer, _ := disabled.NewMessageSignVerifier(&mock.KeyGenMock{})
 	vmFactory, _ := metaProcess.NewVMContainerFactory(
 		argsHook,
@@ -1063,7 +1114,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 
 	fact, _ := metaProcess.NewPreProcessorsContainerFactory(
 		tpn.ShardCoordinator,
-		tpn.Storage,
+		tpn.BlockCommitStorage,
 		TestMarshalizer,
 		TestHasher,
 		tpn.DataPool,
@@ -1103,6 +1154,21 @@ func (tpn *TestProcessorNode) addMockVm(blockchainHook vmcommon.BlockchainHook)
 	_ = tpn.VMContainer.Add(factory.InternalTestingVM, mockVM)
 }
 
+func (tpn *TestProcessorNode) initEquivocationEvidence() {
+	tpn.EvidencePool, _ = storageUnit.NewCache(storageUnit.LRUCache, testEvidencePoolCapacity, 1, 0)
+
+	consensusService, _ := sposFactory.GetConsensusCoreFactory(testConsensusType)
+	argsVerifier := slashing.ArgsEvidenceVerifier{
+		Marshalizer:     TestMarshalizer,
+		KeyGenerator:    tpn.OwnAccount.KeygenBlockSign,
+		SingleSigner:    tpn.OwnAccount.BlockSingleSigner,
+		Classifier:      consensusService,
+		ChainID:         tpn.ChainID,
+		MaxEvidenceSize: testMaxEvidenceSize,
+	}
+	tpn.EvidenceVerifier, _ = slashing.NewEvidenceVerifier(argsVerifier)
+}
+
 func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 	var err error
 
@@ -1130,7 +1196,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		ForkDetector:     tpn.ForkDetector,
 		Hasher:           TestHasher,
 		Marshalizer:      TestMarshalizer,
-		Store:            tpn.Storage,
+		Store:            tpn.BlockCommitStorage,
 		ShardCoordinator: tpn.ShardCoordinator,
 		NodesCoordinator: tpn.NodesCoordinator,
 		FeeHandler:       tpn.FeeAccumulator,
@@ -1151,6 +1217,9 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		BlockChain:             tpn.BlockChain,
 		BlockSizeThrottler:     TestBlockSizeThrottler,
 		Version:                string(SoftwareVersion),
+		TxsMetadataRecorder:    disabledTxStatus.NewMetadataRecorder(),
+		TxsHistoryRecorder:     disabledTxHistory.NewHistoryRecorder(),
+		BlockCommitJournal:     tpn.BlockCommitJournal,
 	}
 
 	if check.IfNil(tpn.EpochStartNotifier) {
@@ -1191,6 +1260,18 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		}
 		scToProtocolInstance, _ := scToProtocol.NewStakingToPeer(argsStakingToPeer)
 
+		systemVM, _ := tpn.VMContainer.Get(factory.SystemVirtualMachine)
+		argsEvidenceProcessor := slashing.ArgsEvidenceProcessor{
+			Verifier:            tpn.EvidenceVerifier,
+			EvidencePool:        tpn.EvidencePool,
+			Accounts:            tpn.AccntState,
+			SystemVM:            systemVM,
+			PeerStateUpdater:    scToProtocolInstance,
+			SlashValue:          big.NewInt(EquivocationSlashValue),
+			MaxEvidencePerBlock: testMaxEvidencePerBlock,
+		}
+		evidenceProcessor, _ := slashing.NewEvidenceProcessor(argsEvidenceProcessor)
+
 		argsEpochStartData := metachain.ArgsNewEpochStartData{
 			Marshalizer:       TestMarshalizer,
 			Hasher:            TestHasher,
@@ -1211,11 +1292,13 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			NodesConfigProvider: tpn.NodesCoordinator,
 			RewardsHandler:      tpn.EconomicsData,
 			RoundTime:           tpn.Rounder,
+			ScQuery:             tpn.SCQueryService,
+			ValidatorSettings:   tpn.EconomicsData,
 		}
 		epochEconomics, _ := metachain.NewEndOfEpochEconomicsDataCreator(argsEpochEconomics)
 
-		rewardsStorage := tpn.Storage.GetStorer(dataRetriever.RewardTransactionUnit)
-		miniBlockStorage := tpn.Storage.GetStorer(dataRetriever.MiniBlockUnit)
+		rewardsStorage := tpn.BlockCommitStorage.GetStorer(dataRetriever.RewardTransactionUnit)
+		miniBlockStorage := tpn.BlockCommitStorage.GetStorer(dataRetriever.MiniBlockUnit)
 		argsEpochRewards := metachain.ArgsNewRewardsCreator{
 			ShardCoordinator: tpn.ShardCoordinator,
 			PubkeyConverter:  TestAddressPubkeyConverter,
@@ -1248,6 +1331,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochRewardsCreator:          epochStartRewards,
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
+			EvidenceProcessor:            evidenceProcessor,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -1329,6 +1413,8 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithPubKey(tpn.NodeKeys.Pk),
 		node.WithInterceptorsContainer(tpn.InterceptorsContainer),
 		node.WithHeaderIntegrityVerifier(tpn.HeaderIntegrityVerifier),
+		node.WithChainID(ChainID),
+		node.WithMinTransactionVersion(MinTransactionVersion),
 		node.WithResolversFinder(tpn.ResolverFinder),
 		node.WithBlockProcessor(tpn.BlockProcessor),
 		node.WithTxSingleSigner(tpn.OwnAccount.SingleSigner),
@@ -1339,6 +1425,8 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithDataPool(tpn.DataPool),
 		node.WithNetworkShardingCollector(tpn.NetworkShardingCollector),
 		node.WithTxAccumulator(txAccumulator),
+		node.WithEquivocationEvidencePool(tpn.EvidencePool),
+		node.WithEquivocationEvidenceVerifier(tpn.EvidenceVerifier),
 	)
 	log.LogIfError(err)
 
@@ -1370,6 +1458,8 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		tx.GasLimit,
 		string(tx.Data),
 		hex.EncodeToString(tx.Signature),
+		string(tx.ChainID),
+		tx.Version,
 	)
 	if err != nil {
 		return "", err
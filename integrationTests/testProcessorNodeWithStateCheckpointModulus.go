@@ -77,8 +77,8 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 	tpn.initHeaderValidator()
 	tpn.initRounder()
 	tpn.NetworkShardingCollector = mock.NewNetworkShardingCollectorMock()
-	tpn.initStorage()
 	tpn.initAccountDBs()
+	tpn.initStorage()
 	tpn.initChainHandler()
 	tpn.initEconomicsData()
 	tpn.initRatingsData()
@@ -103,7 +103,8 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 	tpn.initBlockTracker()
 	tpn.initInterceptors()
 	tpn.initInnerProcessors()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.AccntState)
+	tpn.initEquivocationEvidence()
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
 		TestMarshalizer,
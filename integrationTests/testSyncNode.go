@@ -13,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -99,8 +101,8 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initChainHandler()
 	tpn.initHeaderValidator()
 	tpn.initRounder()
-	tpn.initStorage()
 	tpn.initAccountDBs()
+	tpn.initStorage()
 	tpn.GenesisBlocks = CreateSimpleGenesisBlocks(tpn.ShardCoordinator)
 	tpn.initEconomicsData()
 	tpn.initRatingsData()
@@ -121,7 +123,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData)
+	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.AccntState)
 	tpn.addHandlersForCounters()
 	tpn.addGenesisBlocksIntoStorage()
 }
@@ -153,7 +155,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		ForkDetector:      nil,
 		Hasher:            TestHasher,
 		Marshalizer:       TestMarshalizer,
-		Store:             tpn.Storage,
+		Store:             tpn.BlockCommitStorage,
 		ShardCoordinator:  tpn.ShardCoordinator,
 		NodesCoordinator:  tpn.NodesCoordinator,
 		FeeHandler:        tpn.FeeAccumulator,
@@ -175,6 +177,9 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		BlockChain:             tpn.BlockChain,
 		BlockSizeThrottler:     TestBlockSizeThrottler,
 		Version:                string(SoftwareVersion),
+		TxsMetadataRecorder:    disabledTxStatus.NewMetadataRecorder(),
+		TxsHistoryRecorder:     disabledTxHistory.NewHistoryRecorder(),
+		BlockCommitJournal:     tpn.BlockCommitJournal,
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -192,6 +197,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
+			EvidenceProcessor:            &mock.EquivocationEvidenceProcessorStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -73,11 +73,16 @@ func SetupTestContext(t *testing.T) TestContext {
 	vmContainer, blockChainHook := vm.CreateVMAndBlockchainHook(context.Accounts, gasSchedule)
 	context.TxProcessor, context.ScProcessor = vm.CreateTxProcessorWithOneSCExecutorWithVMs(context.Accounts, vmContainer, blockChainHook)
 	context.ScAddress, _ = blockChainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
-	context.QueryService, _ = smartContract.NewSCQueryService(vmContainer, &mock.FeeHandlerStub{
-		MaxGasLimitPerBlockCalled: func() uint64 {
-			return uint64(math.MaxUint64)
+	context.QueryService, _ = smartContract.NewSCQueryService(
+		vmContainer,
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
 		},
-	})
+		blockChainHook,
+		context.Accounts,
+	)
 	context.VMContainer = vmContainer
 
 	require.NotNil(t, context.TxProcessor)
@@ -9,9 +9,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -93,6 +96,274 @@ func TestESDTIssueAndTransactionsOnMultiShardEnvironment(t *testing.T) {
 	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, finalSupply)
 }
 
+func TestESDTTokenLifecycleOnMultiShardEnvironment(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfShards := 2
+	nodesPerShard := 2
+	numMetachainNodes := 2
+
+	advertiser := integrationTests.CreateMessengerWithKadDht("")
+	_ = advertiser.Bootstrap()
+
+	nodes := integrationTests.CreateNodes(
+		numOfShards,
+		nodesPerShard,
+		numMetachainNodes,
+		integrationTests.GetConnectableAddress(advertiser),
+	)
+
+	idxProposers := make([]int, numOfShards+1)
+	for i := 0; i < numOfShards; i++ {
+		idxProposers[i] = i * nodesPerShard
+	}
+	idxProposers[numOfShards] = numOfShards * nodesPerShard
+
+	integrationTests.DisplayAndStartNodes(nodes)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	initialVal := big.NewInt(10000000000)
+	integrationTests.MintAllNodes(nodes, initialVal)
+
+	round := uint64(0)
+	nonce := uint64(0)
+	round = integrationTests.IncrementAndPrintRound(round)
+	nonce++
+
+	tokenName := "lifecycleToken"
+	hexTokenName := hex.EncodeToString([]byte(tokenName))
+	tokenIssuer := nodes[0]
+	receiver := nodes[1]
+	frozenHolder := nodes[2]
+	pausedHolder := nodes[3]
+	nrRoundsToPropagateMultiShard := 10
+
+	sendAndWait := func(sender *integrationTests.TestProcessorNode, rcvAddress []byte, value *big.Int, txData string) {
+		integrationTests.CreateAndSendTransaction(sender, value, rcvAddress, txData)
+		time.Sleep(time.Second)
+		nonce, round = integrationTests.WaitOperationToBeDone(t, nodes, nrRoundsToPropagateMultiShard, nonce, round, idxProposers)
+		time.Sleep(time.Second)
+	}
+	esdtTransferData := func(value *big.Int) string {
+		return core.BuiltInFunctionESDTTransfer + "@" + hexTokenName + "@" + hex.EncodeToString(value.Bytes())
+	}
+
+	///////////------- issue a token with all the properties
+	initialSupply := big.NewInt(10000)
+	txData := "issue" + "@" + hexTokenName + "@" + hex.EncodeToString(initialSupply.Bytes()) +
+		"@" + hex.EncodeToString([]byte("burnable")) +
+		"@" + hex.EncodeToString([]byte("mintable")) +
+		"@" + hex.EncodeToString([]byte("canPause")) +
+		"@" + hex.EncodeToString([]byte("canFreeze")) +
+		"@" + hex.EncodeToString([]byte("canWipe"))
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(1000), txData)
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, initialSupply)
+
+	///////////------- mint
+	mintValue := big.NewInt(500)
+	issuerBalance := big.NewInt(0).Add(initialSupply, mintValue)
+	txData = "mint" + "@" + hexTokenName + "@" + hex.EncodeToString(mintValue.Bytes())
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), txData)
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, issuerBalance)
+
+	///////////------- distribute the tokens
+	valueToSend := big.NewInt(100)
+	for _, node := range []*integrationTests.TestProcessorNode{receiver, frozenHolder, pausedHolder} {
+		integrationTests.CreateAndSendTransaction(tokenIssuer, big.NewInt(0), node.OwnAccount.Address, esdtTransferData(valueToSend))
+		issuerBalance.Sub(issuerBalance, valueToSend)
+	}
+	time.Sleep(time.Second)
+	nonce, round = integrationTests.WaitOperationToBeDone(t, nodes, nrRoundsToPropagateMultiShard, nonce, round, idxProposers)
+	time.Sleep(time.Second)
+
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, issuerBalance)
+	checkAddressHasESDTTokens(t, frozenHolder.OwnAccount.Address, nodes, tokenName, valueToSend)
+
+	///////////------- freeze an account, its transfers are refused
+	txData = "freeze" + "@" + hexTokenName + "@" + hex.EncodeToString(frozenHolder.OwnAccount.Address)
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), txData)
+
+	sendAndWait(frozenHolder, receiver.OwnAccount.Address, big.NewInt(0), esdtTransferData(valueToSend))
+	checkAddressHasESDTTokens(t, frozenHolder.OwnAccount.Address, nodes, tokenName, valueToSend)
+	checkAddressHasESDTTokens(t, receiver.OwnAccount.Address, nodes, tokenName, valueToSend)
+
+	///////////------- wipe the frozen account, then unfreeze it
+	txData = "wipe" + "@" + hexTokenName + "@" + hex.EncodeToString(frozenHolder.OwnAccount.Address)
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), txData)
+	checkAddressHasESDTTokens(t, frozenHolder.OwnAccount.Address, nodes, tokenName, big.NewInt(0))
+
+	txData = "unFreeze" + "@" + hexTokenName + "@" + hex.EncodeToString(frozenHolder.OwnAccount.Address)
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), txData)
+
+	///////////------- pause the token, transfers are refused in all shards
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), "pause"+"@"+hexTokenName)
+
+	sendAndWait(pausedHolder, receiver.OwnAccount.Address, big.NewInt(0), esdtTransferData(valueToSend))
+	checkAddressHasESDTTokens(t, pausedHolder.OwnAccount.Address, nodes, tokenName, valueToSend)
+	checkAddressHasESDTTokens(t, receiver.OwnAccount.Address, nodes, tokenName, valueToSend)
+
+	sendAndWait(tokenIssuer, factory.ESDTSCAddress, big.NewInt(0), "unPause"+"@"+hexTokenName)
+
+	sendAndWait(tokenIssuer, receiver.OwnAccount.Address, big.NewInt(0), esdtTransferData(valueToSend))
+	issuerBalance.Sub(issuerBalance, valueToSend)
+	receiverBalance := big.NewInt(0).Mul(valueToSend, big.NewInt(2))
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, issuerBalance)
+	checkAddressHasESDTTokens(t, receiver.OwnAccount.Address, nodes, tokenName, receiverBalance)
+
+	///////////------- burn
+	burnValue := big.NewInt(50)
+	txData = core.BuiltInFunctionESDTBurn + "@" + hexTokenName + "@" + hex.EncodeToString(burnValue.Bytes())
+	sendAndWait(receiver, factory.ESDTSCAddress, big.NewInt(0), txData)
+	receiverBalance.Sub(receiverBalance, burnValue)
+	checkAddressHasESDTTokens(t, receiver.OwnAccount.Address, nodes, tokenName, receiverBalance)
+
+	tokenData := getESDTTokenDataFromSystemSC(t, nodes, tokenName)
+	assert.Equal(t, big.NewInt(0).Add(initialSupply, mintValue), tokenData.MintedValue)
+	assert.Equal(t, burnValue, tokenData.BurntValue)
+	assert.False(t, tokenData.Paused)
+}
+
+func TestESDTRelayedTransferOnMultiShardEnvironment(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfShards := 2
+	nodesPerShard := 2
+	numMetachainNodes := 2
+
+	advertiser := integrationTests.CreateMessengerWithKadDht("")
+	_ = advertiser.Bootstrap()
+
+	nodes := integrationTests.CreateNodes(
+		numOfShards,
+		nodesPerShard,
+		numMetachainNodes,
+		integrationTests.GetConnectableAddress(advertiser),
+	)
+
+	idxProposers := make([]int, numOfShards+1)
+	for i := 0; i < numOfShards; i++ {
+		idxProposers[i] = i * nodesPerShard
+	}
+	idxProposers[numOfShards] = numOfShards * nodesPerShard
+
+	integrationTests.DisplayAndStartNodes(nodes)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	initialVal := big.NewInt(10000000000)
+	integrationTests.MintAllNodes(nodes, initialVal)
+
+	round := uint64(0)
+	nonce := uint64(0)
+	round = integrationTests.IncrementAndPrintRound(round)
+	nonce++
+
+	tokenName := "relayedToken"
+	tokenIssuer := nodes[0]
+	relayer := nodes[1]
+	receiver := nodes[2]
+	require.NotEqual(t, tokenIssuer.ShardCoordinator.ComputeId(tokenIssuer.OwnAccount.Address), receiver.ShardCoordinator.ComputeId(receiver.OwnAccount.Address))
+	nrRoundsToPropagateMultiShard := 10
+
+	///////////------- send token issue
+	initialSupply := big.NewInt(10000)
+	txData := "issue" + "@" + hex.EncodeToString([]byte(tokenName)) + "@" + hex.EncodeToString(initialSupply.Bytes())
+	integrationTests.CreateAndSendTransaction(tokenIssuer, big.NewInt(1000), factory.ESDTSCAddress, txData)
+
+	time.Sleep(time.Second)
+	nonce, round = integrationTests.WaitOperationToBeDone(t, nodes, nrRoundsToPropagateMultiShard, nonce, round, idxProposers)
+	time.Sleep(time.Second)
+
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, initialSupply)
+
+	///////////------- relay a transfer to a receiver from the other shard
+	valueToSend := big.NewInt(100)
+	txData = core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte(tokenName)) + "@" + hex.EncodeToString(valueToSend.Bytes())
+	createAndSendRelayedTransaction(t, relayer, tokenIssuer, receiver.OwnAccount.Address, txData)
+
+	time.Sleep(time.Second)
+	_, _ = integrationTests.WaitOperationToBeDone(t, nodes, nrRoundsToPropagateMultiShard, nonce, round, idxProposers)
+	time.Sleep(time.Second)
+
+	checkAddressHasESDTTokens(t, tokenIssuer.OwnAccount.Address, nodes, tokenName, big.NewInt(0).Sub(initialSupply, valueToSend))
+	checkAddressHasESDTTokens(t, receiver.OwnAccount.Address, nodes, tokenName, valueToSend)
+}
+
+func createAndSendRelayedTransaction(
+	t *testing.T,
+	relayer *integrationTests.TestProcessorNode,
+	user *integrationTests.TestProcessorNode,
+	rcvAddress []byte,
+	txData string,
+) {
+	userTx := &transaction.Transaction{
+		Nonce:    user.OwnAccount.Nonce,
+		Value:    big.NewInt(0),
+		SndAddr:  user.OwnAccount.Address,
+		RcvAddr:  rcvAddress,
+		Data:     []byte(txData),
+		GasPrice: integrationTests.MinTxGasPrice,
+		GasLimit: integrationTests.MinTxGasLimit*1000 + uint64(len(txData)),
+		ChainID:  integrationTests.ChainID,
+		Version:  integrationTests.MinTransactionVersion,
+	}
+	txBuff, _ := userTx.GetDataForSigning(integrationTests.TestAddressPubkeyConverter, integrationTests.TestTxSignMarshalizer)
+	userTx.Signature, _ = user.OwnAccount.SingleSigner.Sign(user.OwnAccount.SkTxSign, txBuff)
+	user.OwnAccount.Nonce++
+
+	relayedTxData, _ := txproc.CreateRelayedTxData(integrationTests.TestMarshalizer, userTx)
+	relayedTx := &transaction.Transaction{
+		Nonce:    relayer.OwnAccount.Nonce,
+		Value:    big.NewInt(0),
+		SndAddr:  relayer.OwnAccount.Address,
+		RcvAddr:  user.OwnAccount.Address,
+		Data:     relayedTxData,
+		GasPrice: integrationTests.MinTxGasPrice,
+		ChainID:  integrationTests.ChainID,
+		Version:  integrationTests.MinTransactionVersion,
+	}
+	relayedTx.GasLimit = relayer.EconomicsData.ComputeGasLimit(relayedTx) + userTx.GasLimit
+	txBuff, _ = relayedTx.GetDataForSigning(integrationTests.TestAddressPubkeyConverter, integrationTests.TestTxSignMarshalizer)
+	relayedTx.Signature, _ = relayer.OwnAccount.SingleSigner.Sign(relayer.OwnAccount.SkTxSign, txBuff)
+
+	_, err := relayer.SendTransaction(relayedTx)
+	require.Nil(t, err)
+	relayer.OwnAccount.Nonce++
+}
+
+func getESDTTokenDataFromSystemSC(
+	t *testing.T,
+	nodes []*integrationTests.TestProcessorNode,
+	tokenName string,
+) *systemSmartContracts.ESDTData {
+	esdtSCAcc := getUserAccountWithAddress(t, factory.ESDTSCAddress, nodes)
+	require.False(t, check.IfNil(esdtSCAcc))
+
+	marshalledData, err := esdtSCAcc.DataTrieTracker().RetrieveValue([]byte(tokenName))
+	require.Nil(t, err)
+
+	tokenData := &systemSmartContracts.ESDTData{}
+	err = integrationTests.TestMarshalizer.Unmarshal(tokenData, marshalledData)
+	require.Nil(t, err)
+
+	return tokenData
+}
+
 func checkAddressHasESDTTokens(
 	t *testing.T,
 	address []byte,
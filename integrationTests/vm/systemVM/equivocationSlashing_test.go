@@ -0,0 +1,159 @@
+package systemVM
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEquivocationEvidenceShouldSlashAndJailValidatorOnMetachain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	maxShards := uint32(1)
+	advertiser := integrationTests.CreateMessengerWithKadDht("")
+	_ = advertiser.Bootstrap()
+	advertiserAddr := integrationTests.GetConnectableAddress(advertiser)
+
+	// the metachain nodes are built without any shard node, so the test does not depend on the smart contracts VM
+	nodes := []*integrationTests.TestProcessorNode{
+		integrationTests.NewTestProcessorNode(maxShards, core.MetachainShardId, core.MetachainShardId, advertiserAddr),
+		integrationTests.NewTestProcessorNode(maxShards, core.MetachainShardId, core.MetachainShardId, advertiserAddr),
+	}
+	idxProposers := []int{0}
+	metaNode := nodes[1]
+
+	integrationTests.DisplayAndStartNodes(nodes)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	///////////------- stake a validator key
+	pubKey, _ := hex.DecodeString(generateUniqueKey(0))
+	stakeOnMetachainNodes(t, nodes, pubKey, nodes[0].OwnAccount.Address)
+
+	round := uint64(0)
+	nonce := uint64(0)
+	round = integrationTests.IncrementAndPrintRound(round)
+	nonce++
+
+	nrRoundsBeforeEquivocation := 2
+	nonce, round = integrationTests.WaitOperationToBeDone(t, nodes, nrRoundsBeforeEquivocation, nonce, round, idxProposers)
+
+	stakedData := getStakedData(t, metaNode, pubKey)
+	require.True(t, stakedData.Staked)
+	require.Equal(t, uint64(0), stakedData.JailedNonce)
+	stakeBeforeSlash := big.NewInt(0).Set(stakedData.StakeValue)
+
+	///////////------- the validator equivocates and the evidence reaches the metachain pool
+	evidence := createEquivocationEvidence(t, pubKey, int64(round))
+	_, _ = nodes[0].EvidencePool.HasOrAdd([]byte("equivocation"), evidence, len(evidence))
+
+	_, _ = integrationTests.ProposeAndSyncOneBlock(t, nodes, idxProposers, round, nonce)
+
+	metaBlock, ok := metaNode.BlockChain.GetCurrentBlockHeader().(*block.MetaBlock)
+	require.True(t, ok)
+	assert.Equal(t, [][]byte{evidence}, metaBlock.EquivocationEvidence)
+
+	stakedData = getStakedData(t, metaNode, pubKey)
+	assert.True(t, stakedData.JailedNonce > 0)
+	expectedStake := big.NewInt(0).Sub(stakeBeforeSlash, big.NewInt(integrationTests.EquivocationSlashValue))
+	assert.Equal(t, expectedStake, stakedData.StakeValue)
+
+	peerAccount, err := metaNode.PeerState.GetExistingAccount(pubKey)
+	require.Nil(t, err)
+	assert.Equal(t, string(core.LeavingList), peerAccount.(state.PeerAccountHandler).GetList())
+}
+
+// stakeOnMetachainNodes registers the key directly in the staking system smart contract, as the auction smart
+// contract would do it, so that the metachain nodes can run without any shard node
+func stakeOnMetachainNodes(t *testing.T, nodes []*integrationTests.TestProcessorNode, pubKey []byte, rewardAddress []byte) {
+	for _, n := range nodes {
+		systemVM, err := n.VMContainer.Get(processFactory.SystemVirtualMachine)
+		require.Nil(t, err)
+
+		vmOutput, err := systemVM.RunSmartContractCall(&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  factory.AuctionSCAddress,
+				Arguments:   [][]byte{pubKey, rewardAddress},
+				CallValue:   big.NewInt(0),
+				GasProvided: math.MaxUint64,
+			},
+			RecipientAddr: factory.StakingSCAddress,
+			Function:      "stake",
+		})
+		require.Nil(t, err)
+		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+		for _, outAcc := range vmOutput.OutputAccounts {
+			account, errLoad := n.AccntState.LoadAccount(outAcc.Address)
+			require.Nil(t, errLoad)
+
+			userAccount := account.(state.UserAccountHandler)
+			for _, storageUpdate := range outAcc.StorageUpdates {
+				userAccount.DataTrieTracker().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+			}
+			require.Nil(t, n.AccntState.SaveAccount(userAccount))
+		}
+
+		_, err = n.AccntState.Commit()
+		require.Nil(t, err)
+	}
+}
+
+func createEquivocationEvidence(t *testing.T, pubKey []byte, round int64) []byte {
+	createMessage := func(headerHash string) *consensus.Message {
+		return &consensus.Message{
+			BlockHeaderHash: integrationTests.TestHasher.Compute(headerHash),
+			SignatureShare:  []byte("signature share"),
+			PubKey:          pubKey,
+			Signature:       []byte("signature"),
+			MsgType:         int64(bls.MtSignature),
+			RoundIndex:      round,
+			ChainID:         integrationTests.ChainID,
+		}
+	}
+
+	evidence, err := slashing.CreateEvidence(integrationTests.TestMarshalizer, createMessage("A"), createMessage("B"))
+	require.Nil(t, err)
+
+	return evidence
+}
+
+func getStakedData(t *testing.T, node *integrationTests.TestProcessorNode, pubKey []byte) *systemSmartContracts.StakedData {
+	vmOutput, err := node.SCQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress: factory.StakingSCAddress,
+		FuncName:  "get",
+		Arguments: [][]byte{pubKey},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(vmOutput.ReturnData))
+
+	stakedData := &systemSmartContracts.StakedData{}
+	err = (&marshal.JsonMarshalizer{}).Unmarshal(stakedData, vmOutput.ReturnData[0])
+	require.Nil(t, err)
+
+	return stakedData
+}
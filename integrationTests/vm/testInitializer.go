@@ -222,6 +222,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.FeeHandlerStub{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	return txProcessor
@@ -266,9 +267,11 @@ func CreateVMAndBlockchainHook(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          actualGasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     testMarshalizer,
+		GasMap:           actualGasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      testMarshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: oneShardCoordinator,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -366,6 +369,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		&mock.FeeHandlerStub{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	return txProcessor, scProcessor
@@ -551,7 +555,7 @@ func GetAccountsBalance(addrBytes []byte, accnts state.AccountsAdapter) *big.Int
 
 // GetIntValueFromSC -
 func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.AccountsAdapter, scAddressBytes []byte, funcName string, args ...[]byte) *big.Int {
-	vmContainer, _ := CreateVMAndBlockchainHook(accnts, gasSchedule)
+	vmContainer, blockChainHook := CreateVMAndBlockchainHook(accnts, gasSchedule)
 	defer func() {
 		_ = vmContainer.Close()
 	}()
@@ -562,7 +566,7 @@ func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.Ac
 		},
 	}
 
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler)
+	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler, blockChainHook, accnts)
 
 	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
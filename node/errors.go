@@ -115,6 +115,9 @@ var ErrNilNetworkShardingCollector = errors.New("nil network sharding collector"
 // ErrNilBootStorer signals that a nil boot storer was provided
 var ErrNilBootStorer = errors.New("nil boot storer")
 
+// ErrNilBlockCommitJournal signals that a nil block commit journal was provided
+var ErrNilBlockCommitJournal = errors.New("nil block commit journal")
+
 // ErrNilHeaderSigVerifier signals that a nil header sig verifier has been provided
 var ErrNilHeaderSigVerifier = errors.New("nil header sig verifier")
 
@@ -127,6 +130,9 @@ var ErrNilValidatorStatistics = errors.New("nil validator statistics")
 // ErrInvalidChainID signals that an invalid chain ID has been provided
 var ErrInvalidChainID = errors.New("invalid chain ID in Node")
 
+// ErrInvalidTransactionVersion signals that an invalid transaction version has been provided
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version in Node")
+
 // ErrNilBlockTracker signals that a nil block tracker has been provided
 var ErrNilBlockTracker = errors.New("trying to set nil block tracker")
 
@@ -165,3 +171,65 @@ var ErrNilApiTransactionByHashThrottler = errors.New("nil api transaction by has
 
 // ErrSystemBusyTxHash signals that too many requests occur in the same time on the transaction by hash provider
 var ErrSystemBusyTxHash = errors.New("system busy. try again later")
+
+// ErrNilApiBlockThrottler signals that a nil API block throttler has been provided
+var ErrNilApiBlockThrottler = errors.New("nil api block throttler")
+
+// ErrSystemBusyBlocks signals that too many requests occur in the same time on the block provider
+var ErrSystemBusyBlocks = errors.New("too many block requests in progress. try again later")
+
+// ErrNilApiAccountKeysThrottler signals that a nil API account keys throttler has been provided
+var ErrNilApiAccountKeysThrottler = errors.New("nil api account keys throttler")
+
+// ErrSystemBusyAccountKeys signals that too many requests occur in the same time on the account keys provider
+var ErrSystemBusyAccountKeys = errors.New("system busy. try again later")
+
+// ErrNilApiTransactionsHistoryThrottler signals that a nil API transactions history throttler has been provided
+var ErrNilApiTransactionsHistoryThrottler = errors.New("nil api transactions history throttler")
+
+// ErrSystemBusyTransactionsHistory signals that too many requests occur in the same time on the transactions history
+// provider
+var ErrSystemBusyTransactionsHistory = errors.New("too many transactions history requests in progress. try again later")
+
+// ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
+var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrTransactionsHistoryNotEnabled signals that the transactions history of an address was requested, but the
+// transactions history index is not enabled on this node
+var ErrTransactionsHistoryNotEnabled = errors.New("transactions history index is not enabled on this node")
+
+// ErrInvalidTransactionsHistoryPageSize signals that an invalid page size was requested for the transactions history
+var ErrInvalidTransactionsHistoryPageSize = errors.New("invalid transactions history page size")
+
+// ErrEmptyESDTTokenIdentifier signals that an empty elrond standard digital token identifier has been provided
+var ErrEmptyESDTTokenIdentifier = errors.New("empty esdt token identifier")
+
+// ErrESDTTokenNotFound signals that the requested elrond standard digital token is not registered
+var ErrESDTTokenNotFound = errors.New("esdt token not found")
+
+// ErrCannotCastAccountHandlerToUserAccountHandler signals that an account handler is not a user account handler
+var ErrCannotCastAccountHandlerToUserAccountHandler = errors.New("cannot cast AccountHandler to UserAccountHandler")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilBlockHeader signals that no block header is available to compute the requested data against
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrBlockNonceAndHashProvided signals that both a block nonce and a block hash were provided for a historical query
+var ErrBlockNonceAndHashProvided = errors.New("only one of block nonce and block hash can be provided")
+
+// ErrInvalidKeyValuePairsPageSize signals that an invalid page size was requested for the key-value pairs of an account
+var ErrInvalidKeyValuePairsPageSize = errors.New("invalid key-value pairs page size")
+
+// ErrNilEquivocationEvidencePool signals that a nil equivocation evidence pool has been provided
+var ErrNilEquivocationEvidencePool = errors.New("nil equivocation evidence pool")
+
+// ErrNilEquivocationEvidenceVerifier signals that a nil equivocation evidence verifier has been provided
+var ErrNilEquivocationEvidenceVerifier = errors.New("nil equivocation evidence verifier")
+
+// ErrNilSigningHistory signals that a nil signing history has been provided
+var ErrNilSigningHistory = errors.New("nil signing history")
+
+// ErrNilNodeRedundancyHandler signals that a nil node redundancy handler has been provided
+var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -9,22 +10,26 @@ import (
 
 // AccountsStub -
 type AccountsStub struct {
-	GetExistingAccountCalled func(addressContainer []byte) (state.AccountHandler, error)
-	LoadAccountCalled        func(container []byte) (state.AccountHandler, error)
-	SaveAccountCalled        func(account state.AccountHandler) error
-	RemoveAccountCalled      func(addressContainer []byte) error
-	CommitCalled             func() ([]byte, error)
-	JournalLenCalled         func() int
-	RevertToSnapshotCalled   func(snapshot int) error
-	RootHashCalled           func() ([]byte, error)
-	RecreateTrieCalled       func(rootHash []byte) error
-	PruneTrieCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
-	CancelPruneCalled        func(rootHash []byte, identifier data.TriePruningIdentifier)
-	SnapshotStateCalled      func(rootHash []byte)
-	SetStateCheckpointCalled func(rootHash []byte)
-	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
-	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	GetExistingAccountCalled       func(addressContainer []byte) (state.AccountHandler, error)
+	LoadAccountCalled              func(container []byte) (state.AccountHandler, error)
+	SaveAccountCalled              func(account state.AccountHandler) error
+	RemoveAccountCalled            func(addressContainer []byte) error
+	CommitCalled                   func() ([]byte, error)
+	JournalLenCalled               func() int
+	RevertToSnapshotCalled         func(snapshot int) error
+	RootHashCalled                 func() ([]byte, error)
+	RecreateTrieCalled             func(rootHash []byte) error
+	PruneTrieCalled                func(rootHash []byte, identifier data.TriePruningIdentifier)
+	CancelPruneCalled              func(rootHash []byte, identifier data.TriePruningIdentifier)
+	SnapshotStateCalled            func(rootHash []byte)
+	SetStateCheckpointCalled       func(rootHash []byte)
+	IsPruningEnabledCalled         func() bool
+	GetAllLeavesCalled             func(rootHash []byte) (map[string][]byte, error)
+	RecreateAllTriesCalled         func(rootHash []byte) (map[string]data.Trie, error)
+	GetProofCalled                 func(rootHash []byte, address []byte) ([][]byte, error)
+	GetDataTrieProofCalled         func(rootHash []byte, address []byte, key []byte) (*state.DataTrieProof, error)
+	IterateLeavesCalled            func(ctx context.Context, rootHash []byte, filter data.LeavesFilter, handler data.LeafHandler) error
+	RecreateReadOnlyAccountsCalled func(rootHash []byte) (state.AccountsAdapter, error)
 }
 
 // RecreateAllTries -
@@ -59,6 +64,38 @@ func (as *AccountsStub) GetAllLeaves(rootHash []byte) (map[string][]byte, error)
 	return nil, nil
 }
 
+// GetProof -
+func (as *AccountsStub) GetProof(rootHash []byte, address []byte) ([][]byte, error) {
+	if as.GetProofCalled != nil {
+		return as.GetProofCalled(rootHash, address)
+	}
+	return nil, nil
+}
+
+// GetDataTrieProof -
+func (as *AccountsStub) GetDataTrieProof(rootHash []byte, address []byte, key []byte) (*state.DataTrieProof, error) {
+	if as.GetDataTrieProofCalled != nil {
+		return as.GetDataTrieProofCalled(rootHash, address, key)
+	}
+	return nil, nil
+}
+
+// RecreateReadOnlyAccounts -
+func (as *AccountsStub) RecreateReadOnlyAccounts(rootHash []byte) (state.AccountsAdapter, error) {
+	if as.RecreateReadOnlyAccountsCalled != nil {
+		return as.RecreateReadOnlyAccountsCalled(rootHash)
+	}
+	return nil, errNotImplemented
+}
+
+// IterateLeaves -
+func (as *AccountsStub) IterateLeaves(ctx context.Context, rootHash []byte, filter data.LeavesFilter, handler data.LeafHandler) error {
+	if as.IterateLeavesCalled != nil {
+		return as.IterateLeavesCalled(ctx, rootHash, filter, handler)
+	}
+	return nil
+}
+
 var errNotImplemented = errors.New("not implemented")
 
 // Commit -
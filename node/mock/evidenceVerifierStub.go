@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/process/slashing"
+
+// EvidenceVerifierStub -
+type EvidenceVerifierStub struct {
+	VerifyCalled func(evidence []byte) (*slashing.Equivocation, error)
+}
+
+// Verify -
+func (evs *EvidenceVerifierStub) Verify(evidence []byte) (*slashing.Equivocation, error) {
+	if evs.VerifyCalled != nil {
+		return evs.VerifyCalled(evidence)
+	}
+	return &slashing.Equivocation{}, nil
+}
+
+// IsInterfaceNil -
+func (evs *EvidenceVerifierStub) IsInterfaceNil() bool {
+	return evs == nil
+}
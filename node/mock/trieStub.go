@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -18,6 +19,9 @@ type TrieStub struct {
 	AppendToOldHashesCalled  func([][]byte)
 	GetSerializedNodesCalled func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled           func() data.DBWriteCacher
+	GetAllLeavesCalled       func() (map[string][]byte, error)
+	GetProofCalled           func(key []byte) ([][]byte, error)
+	IterateLeavesCalled      func(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error
 }
 
 // EnterSnapshotMode -
@@ -43,9 +47,31 @@ func (ts *TrieStub) SetCheckpoint(_ []byte) {
 
 // GetAllLeaves -
 func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
+	if ts.GetAllLeavesCalled != nil {
+		return ts.GetAllLeavesCalled()
+	}
+
 	return make(map[string][]byte), nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// IterateLeaves -
+func (ts *TrieStub) IterateLeaves(ctx context.Context, filter data.LeavesFilter, handler data.LeafHandler) error {
+	if ts.IterateLeavesCalled != nil {
+		return ts.IterateLeavesCalled(ctx, filter, handler)
+	}
+
+	return nil
+}
+
 // IsPruningEnabled -
 func (ts *TrieStub) IsPruningEnabled() bool {
 	return false
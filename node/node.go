@@ -41,16 +41,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
 const SendTransactionsPipe = "send transactions pipe"
 
+// numRoundsToKeepForEquivocation is the number of rounds for which the received consensus messages are kept in order
+// to detect validators signing conflicting messages
+const numRoundsToKeepForEquivocation = 3
+
 var log = logger.GetOrCreate("node")
 var numSecondsBetweenPrints = 20
 
@@ -93,6 +99,13 @@ type Node struct {
 	whiteListRequest              process.WhiteListHandler
 	whiteListerVerifiedTxs        process.WhiteListHandler
 	apiTransactionByHashThrottler Throttler
+	apiBlockThrottler             Throttler
+	apiAccountKeysThrottler       Throttler
+	apiTxsHistoryThrottler        Throttler
+	evidencePool                  storage.Cacher
+	evidenceVerifier              slashing.EvidenceVerifier
+	signingHistory                consensus.SigningHistoryHandler
+	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 
 	pubKey            crypto.PublicKey
 	privKey           crypto.PrivateKey
@@ -121,11 +134,13 @@ type Node struct {
 	indexer                 indexer.Indexer
 	blocksBlackListHandler  process.BlackListHandler
 	bootStorer              process.BootStorer
+	blockCommitJournal      process.BlockCommitJournal
 	requestedItemsHandler   dataRetriever.RequestedItemsHandler
 	headerSigVerifier       spos.RandSeedVerifier
 	headerIntegrityVerifier spos.HeaderIntegrityVerifier
 
 	chainID                  []byte
+	minTransactionVersion    uint32
 	blockTracker             process.BlockTracker
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler
 
@@ -262,6 +277,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	equivocationDetector, err := n.createEquivocationDetector(consensusService)
+	if err != nil {
+		return err
+	}
+
 	netInputMarshalizer := n.internalMarshalizer
 	if n.sizeCheckDelta > 0 {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
@@ -288,6 +308,8 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		EquivocationDetector:     equivocationDetector,
+		NodeRedundancyHandler:    n.nodeRedundancyHandler,
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -308,6 +330,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	err = n.createEquivocationEvidenceTopic()
+	if err != nil {
+		return err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    n.blkc,
 		BlockProcessor:                n.blockProcessor,
@@ -325,6 +352,8 @@ func (n *Node) StartConsensus() error {
 		SyncTimer:                     n.syncTimer,
 		EpochStartRegistrationHandler: n.epochStartRegistrationHandler,
 		AntifloodHandler:              n.inputAntifloodHandler,
+		SigningHistory:                n.signingHistory,
+		NodeRedundancyHandler:         n.nodeRedundancyHandler,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -357,8 +386,8 @@ func (n *Node) StartConsensus() error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
+// GetBalance gets the balance for a specific address, optionally as it was at a past block
+func (n *Node) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -367,7 +396,11 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
 	}
@@ -384,8 +417,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 	return account.GetBalance(), nil
 }
 
-// GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+// GetValueForKey will return the value for a key from a given account, optionally as it was at a past block
+func (n *Node) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
@@ -399,7 +432,11 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("invalid address, could not decode from: %w", err)
 	}
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return "", err
+	}
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		return "", fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
@@ -474,6 +511,7 @@ func (n *Node) createShardBootstrapper(rounder consensus.Rounder) (process.Boots
 		NodesCoordinator:    n.nodesCoordinator,
 		EpochStartTrigger:   n.epochStartTrigger,
 		BlockTracker:        n.blockTracker,
+		BlockCommitJournal:  n.blockCommitJournal,
 	}
 
 	argsShardStorageBootstrapper := storageBootstrap.ArgsShardStorageBootstrapper{
@@ -533,6 +571,7 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 		NodesCoordinator:    n.nodesCoordinator,
 		EpochStartTrigger:   n.epochStartTrigger,
 		BlockTracker:        n.blockTracker,
+		BlockCommitJournal:  n.blockCommitJournal,
 	}
 
 	argsMetaStorageBootstrapper := storageBootstrap.ArgsMetaStorageBootstrapper{
@@ -653,6 +692,48 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor) error
 	return n.messenger.RegisterMessageProcessor(n.consensusTopic, messageProcessor)
 }
 
+func (n *Node) createEquivocationDetector(classifier slashing.ConsensusMessageClassifier) (spos.EquivocationDetector, error) {
+	args := slashing.ArgsEquivocationDetector{
+		Marshalizer:     n.internalMarshalizer,
+		Classifier:      classifier,
+		Verifier:        n.evidenceVerifier,
+		EvidencePool:    n.evidencePool,
+		Broadcaster:     n.messenger,
+		Topic:           core.EquivocationEvidenceTopic,
+		NumRoundsToKeep: numRoundsToKeepForEquivocation,
+	}
+
+	return slashing.NewEquivocationDetector(args)
+}
+
+// createEquivocationEvidenceTopic registers the interceptor which validates the gossiped equivocation evidence and
+// stores it in the evidence pool, from where the metachain includes it in blocks
+func (n *Node) createEquivocationEvidenceTopic() error {
+	args := slashing.ArgsEvidenceInterceptor{
+		Verifier:         n.evidenceVerifier,
+		EvidencePool:     n.evidencePool,
+		AntifloodHandler: n.inputAntifloodHandler,
+		Topic:            core.EquivocationEvidenceTopic,
+	}
+	interceptor, err := slashing.NewEvidenceInterceptor(args)
+	if err != nil {
+		return err
+	}
+
+	if !n.messenger.HasTopic(core.EquivocationEvidenceTopic) {
+		err = n.messenger.CreateTopic(core.EquivocationEvidenceTopic, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.messenger.HasTopicValidator(core.EquivocationEvidenceTopic) {
+		return ErrValidatorAlreadySet
+	}
+
+	return n.messenger.RegisterMessageProcessor(core.EquivocationEvidenceTopic, interceptor)
+}
+
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
 func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
@@ -779,23 +860,7 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		return nil
 	}
 
-	marshalizedTx, err := n.internalMarshalizer.Marshal(tx)
-	if err != nil {
-		return err
-	}
-
-	intTx, err := procTx.NewInterceptedTransaction(
-		marshalizedTx,
-		n.internalMarshalizer,
-		n.txSignMarshalizer,
-		n.hasher,
-		n.keyGenForAccounts,
-		n.txSingleSigner,
-		n.addressPubkeyConverter,
-		n.shardCoordinator,
-		n.feeHandler,
-		n.whiteListerVerifiedTxs,
-	)
+	intTx, err := n.createInterceptedTransaction(tx)
 	if err != nil {
 		return err
 	}
@@ -814,6 +879,66 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	return err
 }
 
+// CreateRelayedTransaction checks the provided signed user transaction and returns the relayed transaction carrying
+// it. The relayer has to fill in its address, nonce and signature before broadcasting the relayed transaction
+func (n *Node) CreateRelayedTransaction(userTx *transaction.Transaction) (*transaction.Transaction, error) {
+	if userTx == nil {
+		return nil, ErrNilTransaction
+	}
+	if procTx.IsRelayedTxData(userTx.Data) {
+		return nil, process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+
+	intTx, err := n.createInterceptedTransaction(userTx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = intTx.CheckValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	relayedTxData, err := procTx.CreateRelayedTxData(n.internalMarshalizer, userTx)
+	if err != nil {
+		return nil, err
+	}
+
+	relayedTx := &transaction.Transaction{
+		Value:    big.NewInt(0).Set(userTx.Value),
+		RcvAddr:  userTx.SndAddr,
+		GasPrice: userTx.GasPrice,
+		Data:     relayedTxData,
+		ChainID:  userTx.ChainID,
+		Version:  userTx.Version,
+	}
+	relayedTx.GasLimit = n.feeHandler.ComputeGasLimit(relayedTx) + userTx.GasLimit
+
+	return relayedTx, nil
+}
+
+func (n *Node) createInterceptedTransaction(tx *transaction.Transaction) (*procTx.InterceptedTransaction, error) {
+	marshalizedTx, err := n.internalMarshalizer.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return procTx.NewInterceptedTransaction(
+		marshalizedTx,
+		n.internalMarshalizer,
+		n.txSignMarshalizer,
+		n.hasher,
+		n.keyGenForAccounts,
+		n.txSingleSigner,
+		n.addressPubkeyConverter,
+		n.shardCoordinator,
+		n.feeHandler,
+		n.whiteListerVerifiedTxs,
+		n.chainID,
+		n.minTransactionVersion,
+	)
+}
+
 func (n *Node) sendBulkTransactionsFromShard(transactions [][]byte, senderShardId uint32) error {
 	dataPacker, err := partitioning.NewSimpleDataPacker(n.internalMarshalizer)
 	if err != nil {
@@ -861,6 +986,8 @@ func (n *Node) CreateTransaction(
 	gasLimit uint64,
 	dataField string,
 	signatureHex string,
+	chainID string,
+	version uint32,
 ) (*transaction.Transaction, []byte, error) {
 
 	if check.IfNil(n.addressPubkeyConverter) {
@@ -899,6 +1026,8 @@ func (n *Node) CreateTransaction(
 		GasLimit:  gasLimit,
 		Data:      []byte(dataField),
 		Signature: signatureBytes,
+		ChainID:   []byte(chainID),
+		Version:   version,
 	}
 
 	var txHash []byte
@@ -910,8 +1039,8 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+// GetAccount will return account details for a given address, optionally as they were at a past block
+func (n *Node) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -924,7 +1053,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
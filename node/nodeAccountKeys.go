@@ -0,0 +1,93 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// MaxKeyValuePairsPageSize represents the maximum number of key-value pairs which can be requested in a single page
+// of an account's data trie
+const MaxKeyValuePairsPageSize = 1000
+
+var errPageFull = errors.New("page full")
+
+// GetKeyValuePairs returns a page of the key-value pairs stored in the data trie of the given account, optionally as it
+// was at a past block. Only the keys starting with the hex encoded prefix are returned. The pairs are returned in the
+// order in which the trie visits its leaves, which is not the ascending key order, so the cursor is an opaque position
+// in the trie: an empty cursor starts from the first leaf and the next cursor of a page is the key of the first leaf
+// that did not fit in it. Each page seeks directly to its cursor and the walk ends as soon as the page is full
+func (n *Node) GetKeyValuePairs(
+	address string,
+	prefix string,
+	cursor string,
+	pageSize int,
+	options state.AccountQueryOptions,
+) (*state.ApiKeyValuePairs, error) {
+	if pageSize < 1 || pageSize > MaxKeyValuePairsPageSize {
+		return nil, ErrInvalidKeyValuePairsPageSize
+	}
+
+	prefixBytes, err := hex.DecodeString(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix: %w", err)
+	}
+
+	startKey, err := hex.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if !n.apiAccountKeysThrottler.CanProcess() {
+		return nil, ErrSystemBusyAccountKeys
+	}
+
+	n.apiAccountKeysThrottler.StartProcessing()
+	defer n.apiAccountKeysThrottler.EndProcessing()
+
+	account, err := n.GetAccount(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &state.ApiKeyValuePairs{
+		Pairs: make([]*state.ApiKeyValuePair, 0),
+	}
+	if check.IfNil(account.DataTrie()) {
+		return page, nil
+	}
+
+	filter := data.LeavesFilter{
+		KeyPrefix: prefixBytes,
+		StartKey:  startKey,
+	}
+	tailLength := len(account.AddressBytes())
+	err = account.DataTrie().IterateLeaves(context.Background(), filter, func(key []byte, value []byte) error {
+		if len(page.Pairs) == pageSize {
+			page.NextCursor = hex.EncodeToString(key)
+			return errPageFull
+		}
+
+		dataLength := len(value) - len(key) - tailLength
+		if dataLength < 0 {
+			return fmt.Errorf("%w for key %s", state.ErrNegativeValue, hex.EncodeToString(key))
+		}
+
+		page.Pairs = append(page.Pairs, &state.ApiKeyValuePair{
+			Key:   hex.EncodeToString(key),
+			Value: hex.EncodeToString(value[:dataLength]),
+		})
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageFull) {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,163 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithAccountAndThrottler(account state.UserAccountHandler, throttler node.Throttler) *node.Node {
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return account, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithApiAccountKeysThrottler(throttler),
+	)
+
+	return n
+}
+
+func hexKey(key string) string {
+	return hex.EncodeToString([]byte(key))
+}
+
+func TestNode_GetKeyValuePairsInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccountAndThrottler(nil, createAllowingThrottler())
+
+	for _, pageSize := range []int{0, -1, node.MaxKeyValuePairsPageSize + 1} {
+		pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", "", pageSize, state.AccountQueryOptions{})
+		assert.Nil(t, pairs)
+		assert.Equal(t, node.ErrInvalidKeyValuePairsPageSize, err)
+	}
+}
+
+func TestNode_GetKeyValuePairsInvalidPrefixOrCursorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccountAndThrottler(nil, createAllowingThrottler())
+
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "zz", "", 10, state.AccountQueryOptions{})
+	assert.Nil(t, pairs)
+	assert.Error(t, err)
+
+	pairs, err = n.GetKeyValuePairs(createDummyHexAddress(64), "", "zz", 10, state.AccountQueryOptions{})
+	assert.Nil(t, pairs)
+	assert.Error(t, err)
+}
+
+func TestNode_GetKeyValuePairsThrottlerCannotProcessShouldErr(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return false
+		},
+	}
+	n := createNodeWithAccountAndThrottler(nil, throttler)
+
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", "", 10, state.AccountQueryOptions{})
+	assert.Nil(t, pairs)
+	assert.Equal(t, node.ErrSystemBusyAccountKeys, err)
+}
+
+func TestNode_GetKeyValuePairsAccountWithoutDataTrieShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	account, _ := state.NewUserAccount([]byte("12345678901234567890123456789012"))
+	n := createNodeWithAccountAndThrottler(account, createAllowingThrottler())
+
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", "", 10, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(pairs.Pairs))
+	assert.Equal(t, "", pairs.NextCursor)
+}
+
+func TestNode_GetKeyValuePairsShouldPaginateInTrieOrder(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	account := createAccountWithDataTrie(t, address, map[string][]byte{
+		"key3": []byte("value3"),
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	})
+	n := createNodeWithAccountAndThrottler(account, createAllowingThrottler())
+
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", "", 2, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(pairs.Pairs))
+	assert.Equal(t, &state.ApiKeyValuePair{Key: hexKey("key1"), Value: hexKey("value1")}, pairs.Pairs[0])
+	assert.Equal(t, &state.ApiKeyValuePair{Key: hexKey("key2"), Value: hexKey("value2")}, pairs.Pairs[1])
+	assert.Equal(t, hexKey("key3"), pairs.NextCursor)
+
+	pairs, err = n.GetKeyValuePairs(createDummyHexAddress(64), "", pairs.NextCursor, 2, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(pairs.Pairs))
+	assert.Equal(t, &state.ApiKeyValuePair{Key: hexKey("key3"), Value: hexKey("value3")}, pairs.Pairs[0])
+	assert.Equal(t, "", pairs.NextCursor)
+}
+
+func TestNode_GetKeyValuePairsShouldVisitAllKeysWhenKeyOrderDiffersFromTrieOrder(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	values := map[string][]byte{
+		"a2": []byte("value-a2"),
+		"b1": []byte("value-b1"),
+		"c3": []byte("value-c3"),
+		"d0": []byte("value-d0"),
+	}
+	account := createAccountWithDataTrie(t, address, values)
+	n := createNodeWithAccountAndThrottler(account, createAllowingThrottler())
+
+	visitedKeys := make([]string, 0)
+	cursor := ""
+	for i := 0; i <= len(values); i++ {
+		pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", cursor, 1, state.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(pairs.Pairs))
+
+		key, _ := hex.DecodeString(pairs.Pairs[0].Key)
+		assert.Equal(t, hex.EncodeToString(values[string(key)]), pairs.Pairs[0].Value)
+		visitedKeys = append(visitedKeys, string(key))
+
+		cursor = pairs.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"d0", "b1", "a2", "c3"}, visitedKeys)
+	assert.Equal(t, "", cursor)
+}
+
+func TestNode_GetKeyValuePairsShouldFilterByPrefix(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	account := createAccountWithDataTrie(t, address, map[string][]byte{
+		"a-key":  []byte("a-value"),
+		"b-key1": []byte("b-value1"),
+		"b-key2": []byte("b-value2"),
+	})
+	n := createNodeWithAccountAndThrottler(account, createAllowingThrottler())
+
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), hexKey("b-"), "", 10, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(pairs.Pairs))
+	assert.Equal(t, hexKey("b-key1"), pairs.Pairs[0].Key)
+	assert.Equal(t, hexKey("b-key2"), pairs.Pairs[1].Key)
+	assert.Equal(t, "", pairs.NextCursor)
+}
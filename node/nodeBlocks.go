@@ -0,0 +1,360 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetBlockByNonce returns the block of the node's own shard having the provided nonce. If withTxs is set, all the
+// transactions contained in the block's miniblocks are resolved from the storage and attached to the result
+func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	if !n.apiBlockThrottler.CanProcess() {
+		return nil, ErrSystemBusyBlocks
+	}
+
+	n.apiBlockThrottler.StartProcessing()
+	defer n.apiBlockThrottler.EndProcessing()
+
+	headerHash, err := n.getBlockHashByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockByHash(headerHash, withTxs)
+}
+
+// GetBlockByHash returns the block of the node's own shard having the provided hex encoded hash. If withTxs is set,
+// all the transactions contained in the block's miniblocks are resolved from the storage and attached to the result
+func (n *Node) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	if !n.apiBlockThrottler.CanProcess() {
+		return nil, ErrSystemBusyBlocks
+	}
+
+	n.apiBlockThrottler.StartProcessing()
+	defer n.apiBlockThrottler.EndProcessing()
+
+	headerHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockByHash(headerHash, withTxs)
+}
+
+// GetHyperblockByNonce returns the metachain block having the provided nonce together with all the notarized
+// shard blocks and all their transactions that can be resolved from the local storage. The miniblocks missing from
+// the local storage are reported in the result. Works only on metachain nodes
+func (n *Node) GetHyperblockByNonce(nonce uint64) (*block.ApiHyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+	if !n.apiBlockThrottler.CanProcess() {
+		return nil, ErrSystemBusyBlocks
+	}
+
+	n.apiBlockThrottler.StartProcessing()
+	defer n.apiBlockThrottler.EndProcessing()
+
+	headerHash, err := n.getBlockHashByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getHyperblockByHash(headerHash)
+}
+
+// GetHyperblockByHash returns the metachain block having the provided hex encoded hash together with all the
+// notarized shard blocks and all their transactions that can be resolved from the local storage. The miniblocks
+// missing from the local storage are reported in the result. Works only on metachain nodes
+func (n *Node) GetHyperblockByHash(hash string) (*block.ApiHyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+	if !n.apiBlockThrottler.CanProcess() {
+		return nil, ErrSystemBusyBlocks
+	}
+
+	n.apiBlockThrottler.StartProcessing()
+	defer n.apiBlockThrottler.EndProcessing()
+
+	headerHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getHyperblockByHash(headerHash)
+}
+
+func (n *Node) getBlockHashByNonce(nonce uint64) ([]byte, error) {
+	nonceToByteSlice := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+
+	hdrNonceHashDataUnit := dataRetriever.MetaHdrNonceHashDataUnit
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		hdrNonceHashDataUnit = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.shardCoordinator.SelfId())
+	}
+
+	headerHash, err := n.store.GetStorer(hdrNonceHashDataUnit).SearchFirst(nonceToByteSlice)
+	if err != nil {
+		return nil, fmt.Errorf("%w: nonce %d, %s", data.ErrBlockNotFound, nonce, err.Error())
+	}
+
+	return headerHash, nil
+}
+
+func (n *Node) getBlockByHash(headerHash []byte, withTxs bool) (*block.ApiBlock, error) {
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		metaBlock, err := n.getMetaBlock(headerHash)
+		if err != nil {
+			return nil, err
+		}
+
+		return n.convertMetaBlockToApiBlock(metaBlock, headerHash, withTxs)
+	}
+
+	header, err := n.getShardBlock(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.convertShardBlockToApiBlock(header, headerHash, withTxs)
+}
+
+func (n *Node) getHyperblockByHash(headerHash []byte) (*block.ApiHyperblock, error) {
+	metaBlock, err := n.getMetaBlock(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock := &block.ApiHyperblock{
+		Nonce:         metaBlock.Nonce,
+		Round:         metaBlock.Round,
+		Hash:          hex.EncodeToString(headerHash),
+		PrevBlockHash: hex.EncodeToString(metaBlock.PrevHash),
+		Epoch:         metaBlock.Epoch,
+		NumTxs:        metaBlock.TxCount,
+		Timestamp:     metaBlock.TimeStamp,
+		ShardBlocks:   make([]*block.ApiNotarizedBlock, 0, len(metaBlock.ShardInfo)),
+		Transactions:  make([]*transaction.ApiTransactionResult, 0),
+	}
+
+	// cross shard miniblocks are referenced by both the source and the destination shard blocks
+	processedMiniBlocks := make(map[string]struct{})
+	miniBlockHeaders := append([]block.MiniBlockHeader{}, metaBlock.MiniBlockHeaders...)
+	for _, shardData := range metaBlock.ShardInfo {
+		hyperblock.ShardBlocks = append(hyperblock.ShardBlocks, convertShardDataToApiNotarizedBlock(shardData))
+		miniBlockHeaders = append(miniBlockHeaders, shardData.ShardMiniBlockHeaders...)
+	}
+
+	for _, mbHeader := range miniBlockHeaders {
+		_, alreadyProcessed := processedMiniBlocks[string(mbHeader.Hash)]
+		if alreadyProcessed {
+			continue
+		}
+		processedMiniBlocks[string(mbHeader.Hash)] = struct{}{}
+
+		miniBlock, errGet := n.getMiniBlock(mbHeader.Hash)
+		if errGet != nil {
+			log.Trace("getHyperblockByHash: miniblock not available in local storage",
+				"hash", mbHeader.Hash, "error", errGet.Error())
+			hyperblock.MissingMiniBlocks = append(hyperblock.MissingMiniBlocks, hex.EncodeToString(mbHeader.Hash))
+			continue
+		}
+
+		txs, errGet := n.getTransactionsFromMiniBlock(miniBlock)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		hyperblock.Transactions = append(hyperblock.Transactions, txs...)
+	}
+
+	return hyperblock, nil
+}
+
+func (n *Node) getShardBlock(headerHash []byte) (*block.Header, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.BlockHeaderUnit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash %s, %s", data.ErrBlockNotFound, hex.EncodeToString(headerHash), err.Error())
+	}
+
+	header := &block.Header{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (n *Node) getMetaBlock(headerHash []byte) (*block.MetaBlock, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.MetaBlockUnit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash %s, %s", data.ErrBlockNotFound, hex.EncodeToString(headerHash), err.Error())
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = n.internalMarshalizer.Unmarshal(metaBlock, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (n *Node) getMiniBlock(miniBlockHash []byte) (*block.MiniBlock, error) {
+	miniBlockBytes, err := n.store.GetStorer(dataRetriever.MiniBlockUnit).SearchFirst(miniBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = n.internalMarshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (n *Node) convertShardBlockToApiBlock(
+	header *block.Header,
+	headerHash []byte,
+	withTxs bool,
+) (*block.ApiBlock, error) {
+	miniBlocks, err := n.convertMiniBlockHeadersToApi(header.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &block.ApiBlock{
+		Nonce:         header.Nonce,
+		Round:         header.Round,
+		Hash:          hex.EncodeToString(headerHash),
+		PrevBlockHash: hex.EncodeToString(header.PrevHash),
+		Epoch:         header.Epoch,
+		ShardID:       header.ShardID,
+		NumTxs:        header.TxCount,
+		RootHash:      hex.EncodeToString(header.RootHash),
+		Timestamp:     header.TimeStamp,
+		MiniBlocks:    miniBlocks,
+	}, nil
+}
+
+func (n *Node) convertMetaBlockToApiBlock(
+	metaBlock *block.MetaBlock,
+	headerHash []byte,
+	withTxs bool,
+) (*block.ApiBlock, error) {
+	miniBlocks, err := n.convertMiniBlockHeadersToApi(metaBlock.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	notarizedBlocks := make([]*block.ApiNotarizedBlock, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedBlocks = append(notarizedBlocks, convertShardDataToApiNotarizedBlock(shardData))
+	}
+
+	return &block.ApiBlock{
+		Nonce:           metaBlock.Nonce,
+		Round:           metaBlock.Round,
+		Hash:            hex.EncodeToString(headerHash),
+		PrevBlockHash:   hex.EncodeToString(metaBlock.PrevHash),
+		Epoch:           metaBlock.Epoch,
+		ShardID:         core.MetachainShardId,
+		NumTxs:          metaBlock.TxCount,
+		RootHash:        hex.EncodeToString(metaBlock.RootHash),
+		Timestamp:       metaBlock.TimeStamp,
+		MiniBlocks:      miniBlocks,
+		NotarizedBlocks: notarizedBlocks,
+	}, nil
+}
+
+func (n *Node) convertMiniBlockHeadersToApi(
+	miniBlockHeaders []block.MiniBlockHeader,
+	withTxs bool,
+) ([]*block.ApiMiniBlock, error) {
+	miniBlocks := make([]*block.ApiMiniBlock, 0, len(miniBlockHeaders))
+	for _, mbHeader := range miniBlockHeaders {
+		apiMiniBlock := &block.ApiMiniBlock{
+			Hash:             hex.EncodeToString(mbHeader.Hash),
+			Type:             mbHeader.Type.String(),
+			SourceShard:      mbHeader.SenderShardID,
+			DestinationShard: mbHeader.ReceiverShardID,
+		}
+
+		if withTxs {
+			miniBlock, err := n.getMiniBlock(mbHeader.Hash)
+			if err != nil {
+				return nil, err
+			}
+
+			apiMiniBlock.Transactions, err = n.getTransactionsFromMiniBlock(miniBlock)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		miniBlocks = append(miniBlocks, apiMiniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+func (n *Node) getTransactionsFromMiniBlock(miniBlock *block.MiniBlock) ([]*transaction.ApiTransactionResult, error) {
+	unit, txType, ok := getStorageUnitAndTxTypeForMiniBlock(miniBlock.Type)
+	if !ok {
+		return make([]*transaction.ApiTransactionResult, 0), nil
+	}
+
+	txs := make([]*transaction.ApiTransactionResult, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		txBytes, err := n.store.GetStorer(unit).SearchFirst(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		tx, err := n.unmarshalTransaction(txBytes, txType)
+		if err != nil {
+			return nil, err
+		}
+
+		tx.Hash = hex.EncodeToString(txHash)
+		if miniBlock.Type == block.InvalidBlock {
+			tx.Type = string(invalidTx)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func getStorageUnitAndTxTypeForMiniBlock(miniBlockType block.Type) (dataRetriever.UnitType, transactionType, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, normalTx, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, unsignedTx, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, rewardTx, true
+	default:
+		return 0, invalidTx, false
+	}
+}
+
+func convertShardDataToApiNotarizedBlock(shardData block.ShardData) *block.ApiNotarizedBlock {
+	return &block.ApiNotarizedBlock{
+		Hash:    hex.EncodeToString(shardData.HeaderHash),
+		Nonce:   shardData.Nonce,
+		Round:   shardData.Round,
+		ShardID: shardData.ShardID,
+		NumTxs:  shardData.TxCount,
+	}
+}
@@ -0,0 +1,254 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMapStorerStub(pairs map[string][]byte) storage.Storer {
+	return &mock.StorerStub{
+		SearchFirstCalled: func(key []byte) ([]byte, error) {
+			value, ok := pairs[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return value, nil
+		},
+	}
+}
+
+func createBlockStorageService(units map[dataRetriever.UnitType]map[string][]byte) dataRetriever.StorageService {
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return createMapStorerStub(units[unitType])
+		},
+	}
+}
+
+func createAllowingThrottler() *mock.ThrottlerStub {
+	return &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+}
+
+func TestNode_GetBlockByNonce_ThrottlerCannotProcessShouldErr(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return false
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(throttler),
+	)
+	_, err := n.GetBlockByNonce(1, false)
+	assert.Equal(t, node.ErrSystemBusyBlocks, err)
+}
+
+func TestNode_GetBlockByHash_InvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+	)
+	_, err := n.GetBlockByHash("zzz", false)
+	assert.Error(t, err)
+}
+
+func TestNode_GetBlockByNonce_NotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithDataStore(createBlockStorageService(nil)),
+	)
+	_, err := n.GetBlockByNonce(1, false)
+	assert.True(t, errors.Is(err, data.ErrBlockNotFound))
+}
+
+func TestNode_GetBlockByHash_NotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(createBlockStorageService(nil)),
+	)
+	_, err := n.GetBlockByHash(hex.EncodeToString([]byte("missing")), false)
+	assert.True(t, errors.Is(err, data.ErrBlockNotFound))
+}
+
+func TestNode_GetBlockByNonce_ShardBlockWithTxsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	converter := mock.NewNonceHashConverterMock()
+
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{Nonce: 7, Value: big.NewInt(10), RcvAddr: []byte("rcv"), SndAddr: []byte("snd")}
+	txBytes, _ := marshalizer.Marshal(tx)
+
+	miniBlockHash := []byte("miniBlockHash")
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 1}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+
+	headerHash := []byte("headerHash")
+	header := &block.Header{
+		Nonce:   37,
+		Round:   38,
+		ShardID: 0,
+		TxCount: 1,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: miniBlockHash, SenderShardID: 0, ReceiverShardID: 1, TxCount: 1, Type: block.TxBlock},
+		},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+
+	store := createBlockStorageService(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {string(converter.ToByteSlice(37)): headerHash},
+		dataRetriever.BlockHeaderUnit:           {string(headerHash): headerBytes},
+		dataRetriever.MiniBlockUnit:             {string(miniBlockHash): miniBlockBytes},
+		dataRetriever.TransactionUnit:           {string(txHash): txBytes},
+	})
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithUint64ByteSliceConverter(converter),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(3)),
+		node.WithDataStore(store),
+	)
+
+	apiBlock, err := n.GetBlockByNonce(37, true)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(37), apiBlock.Nonce)
+	assert.Equal(t, uint64(38), apiBlock.Round)
+	assert.Equal(t, hex.EncodeToString(headerHash), apiBlock.Hash)
+	require.Equal(t, 1, len(apiBlock.MiniBlocks))
+	assert.Equal(t, hex.EncodeToString(miniBlockHash), apiBlock.MiniBlocks[0].Hash)
+	assert.Equal(t, uint32(1), apiBlock.MiniBlocks[0].DestinationShard)
+	require.Equal(t, 1, len(apiBlock.MiniBlocks[0].Transactions))
+	assert.Equal(t, hex.EncodeToString(txHash), apiBlock.MiniBlocks[0].Transactions[0].Hash)
+	assert.Equal(t, uint64(7), apiBlock.MiniBlocks[0].Transactions[0].Nonce)
+}
+
+func TestNode_GetBlockByHash_MetaBlockWithoutTxsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	headerHash := []byte("metaHash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 5,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("shardHash"), ShardID: 1, Nonce: 4, TxCount: 10},
+		},
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("rewards"), SenderShardID: core.MetachainShardId, Type: block.RewardsBlock},
+		},
+	}
+	metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+
+	store := createBlockStorageService(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.MetaBlockUnit: {string(headerHash): metaBlockBytes},
+	})
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithDataStore(store),
+	)
+
+	apiBlock, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), apiBlock.Nonce)
+	assert.Equal(t, core.MetachainShardId, apiBlock.ShardID)
+	require.Equal(t, 1, len(apiBlock.MiniBlocks))
+	assert.Equal(t, block.RewardsBlock.String(), apiBlock.MiniBlocks[0].Type)
+	assert.Nil(t, apiBlock.MiniBlocks[0].Transactions)
+	require.Equal(t, 1, len(apiBlock.NotarizedBlocks))
+	assert.Equal(t, uint64(4), apiBlock.NotarizedBlocks[0].Nonce)
+	assert.Equal(t, uint32(1), apiBlock.NotarizedBlocks[0].ShardID)
+}
+
+func TestNode_GetHyperblockByNonce_NotOnMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+	)
+	_, err := n.GetHyperblockByNonce(1)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+}
+
+func TestNode_GetHyperblockByNonce_ShouldDeduplicateAndReportMissingMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	converter := mock.NewNonceHashConverterMock()
+
+	txHash := []byte("txHash")
+	tx := &transaction.Transaction{Nonce: 7, Value: big.NewInt(10)}
+	txBytes, _ := marshalizer.Marshal(tx)
+
+	crossMiniBlockHash := []byte("crossMiniBlock")
+	crossMiniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 1}
+	crossMiniBlockBytes, _ := marshalizer.Marshal(crossMiniBlock)
+
+	crossMiniBlockHeader := block.MiniBlockHeader{Hash: crossMiniBlockHash, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock}
+	missingMiniBlockHeader := block.MiniBlockHeader{Hash: []byte("missing"), SenderShardID: 1, ReceiverShardID: 1, Type: block.TxBlock}
+
+	headerHash := []byte("metaHash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 5,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("shard0"), ShardID: 0, ShardMiniBlockHeaders: []block.MiniBlockHeader{crossMiniBlockHeader}},
+			{HeaderHash: []byte("shard1"), ShardID: 1, ShardMiniBlockHeaders: []block.MiniBlockHeader{crossMiniBlockHeader, missingMiniBlockHeader}},
+		},
+	}
+	metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+
+	store := createBlockStorageService(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.MetaHdrNonceHashDataUnit: {string(converter.ToByteSlice(5)): headerHash},
+		dataRetriever.MetaBlockUnit:            {string(headerHash): metaBlockBytes},
+		dataRetriever.MiniBlockUnit:            {string(crossMiniBlockHash): crossMiniBlockBytes},
+		dataRetriever.TransactionUnit:          {string(txHash): txBytes},
+	})
+
+	n, _ := node.NewNode(
+		node.WithApiBlockThrottler(createAllowingThrottler()),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithUint64ByteSliceConverter(converter),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(3)),
+		node.WithDataStore(store),
+	)
+
+	hyperblock, err := n.GetHyperblockByNonce(5)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), hyperblock.Nonce)
+	assert.Equal(t, 2, len(hyperblock.ShardBlocks))
+	require.Equal(t, 1, len(hyperblock.Transactions))
+	assert.Equal(t, hex.EncodeToString(txHash), hyperblock.Transactions[0].Hash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("missing"))}, hyperblock.MissingMiniBlocks)
+}
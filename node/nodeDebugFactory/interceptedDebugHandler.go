@@ -13,6 +13,9 @@ import (
 // InterceptorResolverDebugger is the contant string for the debugger
 const InterceptorResolverDebugger = "interceptor resolver debugger"
 
+// TrieVerifierDebugger is the constant string for the state trie verifier
+const TrieVerifierDebugger = "trie verifier"
+
 // CreateInterceptedDebugHandler creates and applies an interceptor-resolver debug handler
 func CreateInterceptedDebugHandler(
 	node NodeWrapper,
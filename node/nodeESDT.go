@@ -0,0 +1,161 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// GetAllESDTTokens returns all the elrond standard digital tokens held by the given address, sorted by their identifier
+func (n *Node) GetAllESDTTokens(address string) ([]*esdt.ApiESDTBalance, error) {
+	account, err := n.GetAccount(address, state.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*esdt.ApiESDTBalance, 0)
+	if check.IfNil(account.DataTrie()) {
+		return tokens, nil
+	}
+
+	keyPrefix := createESDTKeyPrefix()
+	tokenIdentifiers := make([]string, 0)
+	filter := data.LeavesFilter{KeyPrefix: keyPrefix}
+	err = account.DataTrie().IterateLeaves(context.Background(), filter, func(key []byte, _ []byte) error {
+		tokenIdentifiers = append(tokenIdentifiers, string(key[len(keyPrefix):]))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tokenIdentifier := range tokenIdentifiers {
+		token, errGet := n.getESDTBalance(account, tokenIdentifier)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].TokenIdentifier < tokens[j].TokenIdentifier
+	})
+
+	return tokens, nil
+}
+
+// GetESDTBalance returns the balance of the given elrond standard digital token held by the given address
+func (n *Node) GetESDTBalance(address string, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+	if len(tokenIdentifier) == 0 {
+		return nil, ErrEmptyESDTTokenIdentifier
+	}
+
+	account, err := n.GetAccount(address, state.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getESDTBalance(account, tokenIdentifier)
+}
+
+func (n *Node) getESDTBalance(account state.UserAccountHandler, tokenIdentifier string) (*esdt.ApiESDTBalance, error) {
+	esdtToken := &builtInFunctions.ESDigitalToken{Value: big.NewInt(0)}
+	if !check.IfNil(account.DataTrie()) {
+		esdtTokenKey := append(createESDTKeyPrefix(), tokenIdentifier...)
+		marshalledData, err := account.DataTrieTracker().RetrieveValue(esdtTokenKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(marshalledData) > 0 {
+			err = n.internalMarshalizer.Unmarshal(esdtToken, marshalledData)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if esdtToken.Value == nil {
+		esdtToken.Value = big.NewInt(0)
+	}
+
+	return &esdt.ApiESDTBalance{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         esdtToken.Value.String(),
+		Frozen:          esdtToken.IsFrozen(),
+	}, nil
+}
+
+// GetESDTTokenData returns the data of the given elrond standard digital token, as it is registered in the ESDT
+// system smart contract. Only available on metachain nodes
+func (n *Node) GetESDTTokenData(tokenIdentifier string) (*esdt.ApiESDTToken, error) {
+	if len(tokenIdentifier) == 0 {
+		return nil, ErrEmptyESDTTokenIdentifier
+	}
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	accWrp, err := n.accounts.GetExistingAccount(factory.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	esdtSCAccount, ok := accWrp.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccountHandler
+	}
+
+	marshalledData, err := esdtSCAccount.DataTrieTracker().RetrieveValue([]byte(tokenIdentifier))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalledData) == 0 {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	tokenData := &systemSmartContracts.ESDTData{}
+	err = n.internalMarshalizer.Unmarshal(tokenData, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	mintedValue := getBigIntOrZero(tokenData.MintedValue)
+	burntValue := getBigIntOrZero(tokenData.BurntValue)
+
+	return &esdt.ApiESDTToken{
+		TokenIdentifier: string(tokenData.TokenName),
+		Issuer:          n.addressPubkeyConverter.Encode(tokenData.IssuerAddress),
+		MintedValue:     mintedValue.String(),
+		BurntValue:      burntValue.String(),
+		Supply:          big.NewInt(0).Sub(mintedValue, burntValue).String(),
+		Mintable:        tokenData.Mintable,
+		Burnable:        tokenData.Burnable,
+		CanPause:        tokenData.CanPause,
+		Paused:          tokenData.Paused,
+		CanFreeze:       tokenData.CanFreeze,
+		CanWipe:         tokenData.CanWipe,
+	}, nil
+}
+
+func createESDTKeyPrefix() []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+}
+
+func getBigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
@@ -0,0 +1,205 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var esdtKeyPrefix = core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+
+func createAccountWithDataTrie(t *testing.T, address []byte, values map[string][]byte) state.UserAccountHandler {
+	trieStorage, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+	dataTrie, err := trie.NewTrie(trieStorage, &marshal.GogoProtoMarshalizer{}, &blake2b.Blake2b{}, 5)
+	require.Nil(t, err)
+
+	for key, value := range values {
+		storedValue := append(append(append([]byte{}, value...), key...), address...)
+		err = dataTrie.Update([]byte(key), storedValue)
+		require.Nil(t, err)
+	}
+	err = dataTrie.Commit()
+	require.Nil(t, err)
+
+	account, err := state.NewUserAccount(address)
+	require.Nil(t, err)
+	account.SetDataTrie(dataTrie)
+
+	return account
+}
+
+func createNodeWithAccount(account state.UserAccountHandler, shardID uint32) *node.Node {
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return account, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithInternalMarshalizer(&marshal.GogoProtoMarshalizer{}, 0),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: shardID}),
+	)
+
+	return n
+}
+
+func marshalESDigitalToken(value int64, frozen bool) []byte {
+	esdtToken := &builtInFunctions.ESDigitalToken{Value: big.NewInt(value)}
+	if frozen {
+		esdtToken.Properties = []byte{1}
+	}
+	buff, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(esdtToken)
+
+	return buff
+}
+
+func TestNode_GetAllESDTTokensShouldReturnOnlyESDTKeysSorted(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	account := createAccountWithDataTrie(t, address, map[string][]byte{
+		esdtKeyPrefix + "TKB-3d4e5f": marshalESDigitalToken(20, true),
+		esdtKeyPrefix + "TKA-0a1b2c": marshalESDigitalToken(10, false),
+		"user defined key":           []byte("user defined value"),
+	})
+	n := createNodeWithAccount(account, 0)
+
+	tokens, err := n.GetAllESDTTokens(createDummyHexAddress(64))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(tokens))
+	assert.Equal(t, "TKA-0a1b2c", tokens[0].TokenIdentifier)
+	assert.Equal(t, "10", tokens[0].Balance)
+	assert.False(t, tokens[0].Frozen)
+	assert.Equal(t, "TKB-3d4e5f", tokens[1].TokenIdentifier)
+	assert.Equal(t, "20", tokens[1].Balance)
+	assert.True(t, tokens[1].Frozen)
+}
+
+func TestNode_GetAllESDTTokensAccountWithoutDataTrieShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	account, _ := state.NewUserAccount([]byte("12345678901234567890123456789012"))
+	n := createNodeWithAccount(account, 0)
+
+	tokens, err := n.GetAllESDTTokens(createDummyHexAddress(64))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tokens))
+}
+
+func TestNode_GetESDTBalanceEmptyTokenIdentifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccount(nil, 0)
+
+	balance, err := n.GetESDTBalance(createDummyHexAddress(64), "")
+	assert.Nil(t, balance)
+	assert.Equal(t, node.ErrEmptyESDTTokenIdentifier, err)
+}
+
+func TestNode_GetESDTBalanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	account := createAccountWithDataTrie(t, address, map[string][]byte{
+		esdtKeyPrefix + "TKA-0a1b2c": marshalESDigitalToken(37, false),
+	})
+	n := createNodeWithAccount(account, 0)
+
+	balance, err := n.GetESDTBalance(createDummyHexAddress(64), "TKA-0a1b2c")
+	require.Nil(t, err)
+	assert.Equal(t, "TKA-0a1b2c", balance.TokenIdentifier)
+	assert.Equal(t, "37", balance.Balance)
+	assert.False(t, balance.Frozen)
+
+	balance, err = n.GetESDTBalance(createDummyHexAddress(64), "MISSING-000000")
+	require.Nil(t, err)
+	assert.Equal(t, "0", balance.Balance)
+}
+
+func TestNode_GetESDTTokenDataNotMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccount(nil, 0)
+
+	tokenData, err := n.GetESDTTokenData("TKA-0a1b2c")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+}
+
+func TestNode_GetESDTTokenDataAccountsAdapterFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, expectedErr
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+	)
+
+	tokenData, err := n.GetESDTTokenData("TKA-0a1b2c")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetESDTTokenDataTokenNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	account := createAccountWithDataTrie(t, factory.ESDTSCAddress, map[string][]byte{})
+	n := createNodeWithAccount(account, core.MetachainShardId)
+
+	tokenData, err := n.GetESDTTokenData("TKA-0a1b2c")
+	assert.Nil(t, tokenData)
+	assert.Equal(t, node.ErrESDTTokenNotFound, err)
+}
+
+func TestNode_GetESDTTokenDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	issuer := []byte("12345678901234567890123456789012")
+	esdtData := &systemSmartContracts.ESDTData{
+		IssuerAddress: issuer,
+		TokenName:     []byte("TKA-0a1b2c"),
+		Mintable:      true,
+		CanFreeze:     true,
+		MintedValue:   big.NewInt(1000),
+		BurntValue:    big.NewInt(300),
+	}
+	buff, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(esdtData)
+	account := createAccountWithDataTrie(t, factory.ESDTSCAddress, map[string][]byte{
+		"TKA-0a1b2c": buff,
+	})
+	n := createNodeWithAccount(account, core.MetachainShardId)
+
+	tokenData, err := n.GetESDTTokenData("TKA-0a1b2c")
+	require.Nil(t, err)
+	assert.Equal(t, "TKA-0a1b2c", tokenData.TokenIdentifier)
+	assert.Equal(t, createMockPubkeyConverter().Encode(issuer), tokenData.Issuer)
+	assert.Equal(t, "1000", tokenData.MintedValue)
+	assert.Equal(t, "300", tokenData.BurntValue)
+	assert.Equal(t, "700", tokenData.Supply)
+	assert.True(t, tokenData.Mintable)
+	assert.False(t, tokenData.Burnable)
+	assert.True(t, tokenData.CanFreeze)
+	assert.False(t, tokenData.Paused)
+}
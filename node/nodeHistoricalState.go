@@ -0,0 +1,61 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetRootHashForQueryOptions returns the state root hash of the block selected by the provided options. A nil root
+// hash is returned if the options do not select a past block, meaning that the current state should be used
+func (n *Node) GetRootHashForQueryOptions(options state.AccountQueryOptions) ([]byte, error) {
+	if !options.IsHistorical() {
+		return nil, nil
+	}
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return nil, ErrBlockNonceAndHashProvided
+	}
+
+	headerHash := options.BlockHash
+	if options.HasBlockNonce {
+		var err error
+		headerHash, err = n.getBlockHashByNonce(options.BlockNonce)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		metaBlock, err := n.getMetaBlock(headerHash)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaBlock.GetRootHash(), nil
+	}
+
+	header, err := n.getShardBlock(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+// getAccountsAdapter returns the accounts adapter the query described by the provided options should be run on:
+// the node's own accounts for the current state or a read only view for the state of a past block
+func (n *Node) getAccountsAdapter(options state.AccountQueryOptions) (state.AccountsAdapter, error) {
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	rootHash, err := n.GetRootHashForQueryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	if len(rootHash) == 0 {
+		return n.accounts, nil
+	}
+
+	return n.accounts.RecreateReadOnlyAccounts(rootHash)
+}
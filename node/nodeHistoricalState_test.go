@@ -0,0 +1,126 @@
+package node_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithShardHeader(accounts state.AccountsAdapter, nonce uint64, headerHash []byte, rootHash []byte) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	converter := mock.NewNonceHashConverterMock()
+	headerBytes, _ := marshalizer.Marshal(&block.Header{Nonce: nonce, RootHash: rootHash})
+
+	store := createBlockStorageService(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {string(converter.ToByteSlice(nonce)): headerHash},
+		dataRetriever.BlockHeaderUnit:           {string(headerHash): headerBytes},
+	})
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+		node.WithUint64ByteSliceConverter(converter),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithDataStore(store),
+	)
+
+	return n
+}
+
+func TestNode_GetRootHashForQueryOptions_NoOptionsShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	rootHash, err := n.GetRootHashForQueryOptions(state.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, rootHash)
+}
+
+func TestNode_GetRootHashForQueryOptions_NonceAndHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	rootHash, err := n.GetRootHashForQueryOptions(state.AccountQueryOptions{
+		BlockNonce:    1,
+		HasBlockNonce: true,
+		BlockHash:     []byte("hash"),
+	})
+	assert.Equal(t, node.ErrBlockNonceAndHashProvided, err)
+	assert.Nil(t, rootHash)
+}
+
+func TestNode_GetRootHashForQueryOptions_UnknownBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithShardHeader(&mock.AccountsStub{}, 10, []byte("headerHash"), []byte("rootHash"))
+	rootHash, err := n.GetRootHashForQueryOptions(state.AccountQueryOptions{BlockNonce: 11, HasBlockNonce: true})
+	assert.NotNil(t, err)
+	assert.Nil(t, rootHash)
+}
+
+func TestNode_GetRootHashForQueryOptions_MetaBlockHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	headerHash := []byte("metaHash")
+	metaBlockBytes, _ := marshalizer.Marshal(&block.MetaBlock{Nonce: 5, RootHash: []byte("metaRootHash")})
+	store := createBlockStorageService(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.MetaBlockUnit: {string(headerHash): metaBlockBytes},
+	})
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithDataStore(store),
+	)
+
+	rootHash, err := n.GetRootHashForQueryOptions(state.AccountQueryOptions{BlockHash: headerHash})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("metaRootHash"), rootHash)
+}
+
+func TestNode_GetBalanceWithBlockNonceShouldReadHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("rootHash")
+	accounts := getAccAdapter(big.NewInt(100))
+	accounts.RecreateReadOnlyAccountsCalled = func(providedRootHash []byte) (state.AccountsAdapter, error) {
+		assert.Equal(t, rootHash, providedRootHash)
+		return getAccAdapter(big.NewInt(37)), nil
+	}
+	n := createNodeWithShardHeader(accounts, 10, []byte("headerHash"), rootHash)
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.AccountQueryOptions{BlockNonce: 10, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), balance)
+
+	balance, err = n.GetBalance(createDummyHexAddress(64), state.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+}
+
+func TestNode_GetAccountRootHashNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		RecreateReadOnlyAccountsCalled: func(_ []byte) (state.AccountsAdapter, error) {
+			return nil, fmt.Errorf("%w: pruned", state.ErrRootHashNotAvailable)
+		},
+	}
+	n := createNodeWithShardHeader(accounts, 10, []byte("headerHash"), []byte("rootHash"))
+
+	account, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{BlockHash: []byte("headerHash")})
+	assert.Nil(t, account)
+	assert.True(t, errors.Is(err, state.ErrRootHashNotAvailable))
+}
@@ -0,0 +1,95 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetProof returns the Merkle proof of the given address, computed against the root hash of the last committed block
+func (n *Node) GetProof(address string) (*state.ApiProof, error) {
+	addr, header, err := n.prepareProofRequest(address)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := n.accounts.GetProof(header.GetRootHash(), addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return createApiProof(header, proof), nil
+}
+
+// GetProofDataTrie returns the Merkle proof of the given address, computed against the root hash of the last committed
+// block, together with the data trie root hash of that account and the Merkle proof of the given hex encoded key
+// from its data trie
+func (n *Node) GetProofDataTrie(address string, key string) (*state.ApiProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	addr, header, err := n.prepareProofRequest(address)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := n.accounts.GetDataTrieProof(header.GetRootHash(), addr, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	apiProof := createApiProof(header, proof.AccountProof)
+	apiProof.DataTrieRootHash = hex.EncodeToString(proof.DataTrieRootHash)
+	apiProof.DataTrieProof = encodeProof(proof.DataTrieProof)
+
+	return apiProof, nil
+}
+
+func (n *Node) prepareProofRequest(address string) ([]byte, data.HeaderHandler, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accounts) {
+		return nil, nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(n.blkc) {
+		return nil, nil, ErrNilBlockchain
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, nil, ErrNilBlockHeader
+	}
+
+	return addr, header, nil
+}
+
+func createApiProof(header data.HeaderHandler, proof [][]byte) *state.ApiProof {
+	return &state.ApiProof{
+		RootHash:   hex.EncodeToString(header.GetRootHash()),
+		BlockNonce: header.GetNonce(),
+		Proof:      encodeProof(proof),
+	}
+}
+
+func encodeProof(proof [][]byte) []string {
+	hexProof := make([]string, 0, len(proof))
+	for _, encNode := range proof {
+		hexProof = append(hexProof, hex.EncodeToString(encNode))
+	}
+
+	return hexProof
+}
@@ -0,0 +1,204 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForProofs(accounts state.AccountsAdapter, blkc data.ChainHandler) *node.Node {
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accounts),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithBlockChain(blkc),
+	)
+
+	return n
+}
+
+func TestNode_GetProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	accounts := &mock.AccountsStub{
+		GetProofCalled: func(providedRootHash []byte, providedAddress []byte) ([][]byte, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			assert.Equal(t, addressBytes, providedAddress)
+
+			return [][]byte{[]byte("node1"), []byte("node2")}, nil
+		},
+	}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 37, RootHash: rootHash}
+		},
+	}
+	n := createNodeForProofs(accounts, blkc)
+
+	proof, err := n.GetProof(address)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.RootHash)
+	assert.Equal(t, uint64(37), proof.BlockNonce)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node1")), hex.EncodeToString([]byte("node2"))}, proof.Proof)
+}
+
+func TestNode_GetProofNoCurrentHeaderShouldUseGenesis(t *testing.T) {
+	t.Parallel()
+
+	genesisRootHash := []byte("genesis root hash")
+	accounts := &mock.AccountsStub{
+		GetProofCalled: func(providedRootHash []byte, _ []byte) ([][]byte, error) {
+			assert.Equal(t, genesisRootHash, providedRootHash)
+
+			return make([][]byte, 0), nil
+		},
+	}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: genesisRootHash}
+		},
+	}
+	n := createNodeForProofs(accounts, blkc)
+
+	proof, err := n.GetProof(createDummyHexAddress(64))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(genesisRootHash), proof.RootHash)
+	assert.Equal(t, uint64(0), proof.BlockNonce)
+	assert.Equal(t, 0, len(proof.Proof))
+}
+
+func TestNode_GetProofNoHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	n := createNodeForProofs(&mock.AccountsStub{}, blkc)
+
+	proof, err := n.GetProof(createDummyHexAddress(64))
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrNilBlockHeader, err)
+}
+
+func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	key := []byte("key")
+	accounts := &mock.AccountsStub{
+		GetDataTrieProofCalled: func(providedRootHash []byte, _ []byte, providedKey []byte) (*state.DataTrieProof, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			assert.Equal(t, key, providedKey)
+
+			return &state.DataTrieProof{
+				AccountProof:     [][]byte{[]byte("node1")},
+				DataTrieRootHash: []byte("data trie root hash"),
+				DataTrieProof:    [][]byte{[]byte("node2"), []byte("node3")},
+			}, nil
+		},
+	}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 37, RootHash: rootHash}
+		},
+	}
+	n := createNodeForProofs(accounts, blkc)
+
+	proof, err := n.GetProofDataTrie(createDummyHexAddress(64), hex.EncodeToString(key))
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.RootHash)
+	assert.Equal(t, uint64(37), proof.BlockNonce)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node1"))}, proof.Proof)
+	assert.Equal(t, hex.EncodeToString([]byte("data trie root hash")), proof.DataTrieRootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node2")), hex.EncodeToString([]byte("node3"))}, proof.DataTrieProof)
+}
+
+func TestNode_GetProofDataTrieShouldBeVerifiableFromTheHeaderRootHash(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &blake2b.Blake2b{}
+	accountsFactory := factory.NewAccountCreator()
+	trieStorage, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	mainTrie, _ := trie.NewTrie(trieStorage, marshalizer, hasher, 5)
+	accounts, _ := state.NewAccountsDB(mainTrie, hasher, marshalizer, accountsFactory)
+
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	key := []byte("key")
+	value := []byte("value")
+
+	acc, _ := accounts.LoadAccount(addressBytes)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(key, value)
+	_ = accounts.SaveAccount(acc)
+	otherAddressBytes, _ := hex.DecodeString(createDummyHexAddress(64))
+	otherAcc, _ := accounts.LoadAccount(otherAddressBytes)
+	_ = accounts.SaveAccount(otherAcc)
+	rootHash, _ := accounts.Commit()
+
+	header := &block.Header{Nonce: 37, RootHash: rootHash}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return header
+		},
+	}
+	n := createNodeForProofs(accounts, blkc)
+
+	proof, err := n.GetProofDataTrie(address, hex.EncodeToString(key))
+	require.Nil(t, err)
+
+	accountBytes, err := trie.VerifyProof(header.RootHash, addressBytes, decodeProof(t, proof.Proof), marshalizer, hasher)
+	require.Nil(t, err)
+	recoveredAcc, _ := accountsFactory.CreateAccount(addressBytes)
+	err = marshalizer.Unmarshal(recoveredAcc, accountBytes)
+	require.Nil(t, err)
+	dataTrieRootHash := recoveredAcc.(state.UserAccountHandler).GetRootHash()
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), proof.DataTrieRootHash)
+
+	storedValue, err := trie.VerifyProof(dataTrieRootHash, key, decodeProof(t, proof.DataTrieProof), marshalizer, hasher)
+	require.Nil(t, err)
+	assert.Equal(t, append(value, append(key, addressBytes...)...), storedValue)
+}
+
+func decodeProof(t *testing.T, hexProof []string) [][]byte {
+	proof := make([][]byte, 0, len(hexProof))
+	for _, hexNode := range hexProof {
+		encNode, err := hex.DecodeString(hexNode)
+		require.Nil(t, err)
+		proof = append(proof, encNode)
+	}
+
+	return proof
+}
+
+func TestNode_GetProofDataTrieInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForProofs(&mock.AccountsStub{}, &mock.BlockChainMock{})
+
+	proof, err := n.GetProofDataTrie(createDummyHexAddress(64), "not hex")
+	assert.Nil(t, proof)
+	assert.NotNil(t, err)
+}
@@ -201,6 +201,8 @@ func (n *Node) generateAndSignSingleTx(
 		RcvAddr:  rcvAddrBytes,
 		SndAddr:  sndAddrBytes,
 		Data:     []byte(dataField),
+		ChainID:  n.chainID,
+		Version:  n.minTransactionVersion,
 	}
 
 	marshalizedTx, err := tx.GetDataForSigning(n.addressPubkeyConverter, n.txSignMarshalizer)
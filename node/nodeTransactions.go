@@ -5,10 +5,12 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 )
 
 type transactionType string
@@ -41,11 +43,50 @@ func (n *Node) GetTransaction(txHash string) (*transaction.ApiTransactionResult,
 	}
 
 	txBytes, txType, found = n.getTxBytesFromStorage(hash)
-	if found {
-		return n.unmarshalTransaction(txBytes, txType)
+	if !found {
+		return nil, fmt.Errorf("transaction not found")
+	}
+
+	tx, err := n.unmarshalTransaction(txBytes, txType)
+	if err != nil {
+		return nil, err
+	}
+
+	n.putLocationInTransaction(tx, hash)
+
+	return tx, nil
+}
+
+// putLocationInTransaction fills the miniblock and the block in which the transaction was included, as recorded
+// in the transactions and miniblocks metadata storers
+func (n *Node) putLocationInTransaction(tx *transaction.ApiTransactionResult, txHash []byte) {
+	metadataStorer := n.store.GetStorer(dataRetriever.TransactionsMetadataUnit)
+	miniblocksMetadataStorer := n.store.GetStorer(dataRetriever.MiniblocksMetadataUnit)
+	if check.IfNil(metadataStorer) || check.IfNil(miniblocksMetadataStorer) {
+		return
+	}
+
+	txMetadata, err := txstatus.GetTransactionMetadata(metadataStorer, n.internalMarshalizer, txHash)
+	if err != nil {
+		log.Trace("putLocationInTransaction: transaction metadata not found",
+			"tx hash", txHash, "error", err.Error())
+		return
 	}
 
-	return nil, fmt.Errorf("transaction not found")
+	tx.MiniBlockHash = hex.EncodeToString(txMetadata.MiniblockHash)
+	tx.SourceShard = txMetadata.SenderShardID
+	tx.DestinationShard = txMetadata.ReceiverShardID
+
+	miniblockMetadata, err := txstatus.GetMiniblockMetadata(miniblocksMetadataStorer, n.internalMarshalizer, txMetadata.MiniblockHash)
+	if err != nil {
+		log.Trace("putLocationInTransaction: miniblock metadata not found",
+			"miniblock hash", txMetadata.MiniblockHash, "error", err.Error())
+		return
+	}
+
+	tx.BlockNonce = miniblockMetadata.HeaderNonce
+	tx.BlockHash = hex.EncodeToString(miniblockMetadata.HeaderHash)
+	tx.Epoch = miniblockMetadata.Epoch
 }
 
 // GetTransactionStatus gets the transaction status
@@ -64,15 +105,31 @@ func (n *Node) GetTransactionStatus(txHash string) (string, error) {
 
 	_, _, foundInDataPool := n.getTxBytesFromDataPool(hash)
 	if foundInDataPool {
-		return string(core.TxStatusReceived), nil
+		return string(core.TxStatusPending), nil
 	}
 
 	foundInStorage := n.isTxInStorage(hash)
-	if foundInStorage {
-		return string(core.TxStatusExecuted), nil
+	if !foundInStorage {
+		return string(core.TxStatusUnknown), nil
+	}
+
+	return string(n.computeStatusOfTxFromStorage(hash)), nil
+}
+
+func (n *Node) computeStatusOfTxFromStorage(hash []byte) core.TransactionStatus {
+	metadataStorer := n.store.GetStorer(dataRetriever.TransactionsMetadataUnit)
+	if check.IfNil(metadataStorer) {
+		return core.TxStatusExecuted
+	}
+
+	metadata, err := txstatus.GetTransactionMetadata(metadataStorer, n.internalMarshalizer, hash)
+	if err != nil {
+		log.Trace("computeStatusOfTxFromStorage: transaction metadata not found",
+			"tx hash", hash, "error", err.Error())
+		return core.TxStatusExecuted
 	}
 
-	return string(core.TxStatusUnknown), nil
+	return txstatus.ComputeStatus(metadata, n.shardCoordinator.SelfId())
 }
 
 func (n *Node) getTxBytesFromDataPool(hash []byte) ([]byte, transactionType, bool) {
@@ -155,6 +212,11 @@ func (n *Node) unmarshalTransaction(txBytes []byte, txType transactionType) (*tr
 			GasLimit:  tx.GasLimit,
 			Data:      string(tx.Data),
 			Signature: hex.EncodeToString(tx.Signature),
+			ChainID:   string(tx.ChainID),
+			Version:   tx.Version,
+
+			SourceShard:      n.shardCoordinator.ComputeId(tx.SndAddr),
+			DestinationShard: n.shardCoordinator.ComputeId(tx.RcvAddr),
 		}, nil
 	case rewardTx:
 		var tx rewardTxData.RewardTx
@@ -168,6 +230,9 @@ func (n *Node) unmarshalTransaction(txBytes []byte, txType transactionType) (*tr
 			Epoch:    tx.GetEpoch(),
 			Value:    tx.GetValue().String(),
 			Receiver: n.addressPubkeyConverter.Encode(tx.GetRcvAddr()),
+
+			SourceShard:      core.MetachainShardId,
+			DestinationShard: n.shardCoordinator.ComputeId(tx.GetRcvAddr()),
 		}, nil
 
 	case unsignedTx:
@@ -187,6 +252,9 @@ func (n *Node) unmarshalTransaction(txBytes []byte, txType transactionType) (*tr
 			Data:      string(tx.GetData()),
 			Code:      string(tx.GetCode()),
 			Signature: "",
+
+			SourceShard:      n.shardCoordinator.ComputeId(tx.GetSndAddr()),
+			DestinationShard: n.shardCoordinator.ComputeId(tx.GetRcvAddr()),
 		}, nil
 	default:
 		return &transaction.ApiTransactionResult{Type: string(invalidTx)}, nil // this shouldn't happen
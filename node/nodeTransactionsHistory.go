@@ -0,0 +1,107 @@
+package node
+
+import (
+	"encoding/hex"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+)
+
+// MaxTransactionsHistoryPageSize represents the maximum number of transactions which can be requested in a single
+// page of the transactions history of an address
+const MaxTransactionsHistoryPageSize = 1000
+
+// GetTransactionsHistory returns, from the newest to the oldest, the transactions which touched the given address
+// and which were included in blocks older than the provided cursor. A nil cursor will start from the newest block
+func (n *Node) GetTransactionsHistory(
+	address string,
+	cursor *transaction.ApiHistoryCursor,
+	pageSize int,
+) (*transaction.ApiAddressHistory, error) {
+	if pageSize < 1 || pageSize > MaxTransactionsHistoryPageSize {
+		return nil, ErrInvalidTransactionsHistoryPageSize
+	}
+
+	historyStorer := n.store.GetStorer(dataRetriever.TransactionsHistoryUnit)
+	if check.IfNil(historyStorer) {
+		return nil, ErrTransactionsHistoryNotEnabled
+	}
+
+	if !n.apiTxsHistoryThrottler.CanProcess() {
+		return nil, ErrSystemBusyTransactionsHistory
+	}
+
+	n.apiTxsHistoryThrottler.StartProcessing()
+	defer n.apiTxsHistoryThrottler.EndProcessing()
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := txhistory.ReadHistoryPage(
+		historyStorer,
+		n.internalMarshalizer,
+		addressBytes,
+		n.computeHistoryCursor(cursor),
+		pageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHistoryPageToApi(page), nil
+}
+
+func (n *Node) computeHistoryCursor(cursor *transaction.ApiHistoryCursor) txhistory.HistoryCursor {
+	currentEpoch := uint32(0)
+	currentHeader := n.blkc.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		currentEpoch = currentHeader.GetEpoch()
+	}
+
+	if cursor == nil || cursor.Epoch > currentEpoch {
+		return txhistory.HistoryCursor{
+			Epoch: currentEpoch,
+			Nonce: math.MaxUint64,
+		}
+	}
+
+	return txhistory.HistoryCursor{
+		Epoch: cursor.Epoch,
+		Nonce: cursor.Nonce,
+	}
+}
+
+func convertHistoryPageToApi(page *txhistory.HistoryPage) *transaction.ApiAddressHistory {
+	apiHistory := &transaction.ApiAddressHistory{
+		Transactions: make([]*transaction.ApiAddressHistoryEntry, 0, len(page.Entries)),
+	}
+
+	for _, entry := range page.Entries {
+		_, txType, _ := getStorageUnitAndTxTypeForMiniBlock(block.Type(entry.MiniblockType))
+		if block.Type(entry.MiniblockType) == block.InvalidBlock {
+			txType = invalidTx
+		}
+
+		apiHistory.Transactions = append(apiHistory.Transactions, &transaction.ApiAddressHistoryEntry{
+			Hash:       hex.EncodeToString(entry.TxHash),
+			Type:       string(txType),
+			BlockNonce: entry.BlockNonce,
+			Epoch:      entry.Epoch,
+		})
+	}
+
+	if page.NextCursor != nil {
+		apiHistory.NextCursor = &transaction.ApiHistoryCursor{
+			Epoch: page.NextCursor.Epoch,
+			Nonce: page.NextCursor.Nonce,
+		}
+	}
+
+	return apiHistory
+}
@@ -0,0 +1,182 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithTransactionsHistory(historyStorer storage.Storer, currentEpoch uint32) *node.Node {
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+
+	return createNodeWithTransactionsHistoryAndThrottler(historyStorer, currentEpoch, throttler)
+}
+
+func createNodeWithTransactionsHistoryAndThrottler(
+	historyStorer storage.Storer,
+	currentEpoch uint32,
+	throttler node.Throttler,
+) *node.Node {
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType == dataRetriever.TransactionsHistoryUnit {
+				return historyStorer
+			}
+			return nil
+		},
+	}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Epoch: currentEpoch}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithApiTransactionsHistoryThrottler(throttler),
+		node.WithDataStore(storer),
+		node.WithBlockChain(blkc),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	return n
+}
+
+func TestNode_GetTransactionsHistoryNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsHistory(nil, 0)
+	history, err := n.GetTransactionsHistory("aaaa", nil, 10)
+
+	assert.Nil(t, history)
+	assert.Equal(t, node.ErrTransactionsHistoryNotEnabled, err)
+}
+
+func TestNode_GetTransactionsHistoryInvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsHistory(&mock.StorerStub{}, 0)
+
+	history, err := n.GetTransactionsHistory("aaaa", nil, 0)
+	assert.Nil(t, history)
+	assert.Equal(t, node.ErrInvalidTransactionsHistoryPageSize, err)
+
+	history, err = n.GetTransactionsHistory("aaaa", nil, node.MaxTransactionsHistoryPageSize+1)
+	assert.Nil(t, history)
+	assert.Equal(t, node.ErrInvalidTransactionsHistoryPageSize, err)
+}
+
+func TestNode_GetTransactionsHistoryThrottlerCannotProcessShouldErr(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return false
+		},
+	}
+	n := createNodeWithTransactionsHistoryAndThrottler(&mock.StorerStub{}, 0, throttler)
+	history, err := n.GetTransactionsHistory("aaaa", nil, 10)
+
+	assert.Nil(t, history)
+	assert.Equal(t, node.ErrSystemBusyTransactionsHistory, err)
+}
+
+func TestNode_GetTransactionsHistoryInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithTransactionsHistory(&mock.StorerStub{}, 0)
+	history, err := n.GetTransactionsHistory("not hex", nil, 10)
+
+	assert.Nil(t, history)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetTransactionsHistoryShouldStartFromTheCurrentEpoch(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	storedData := make(map[string][]byte)
+	get := func(key []byte) ([]byte, error) {
+		value, ok := storedData[string(key)]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return value, nil
+	}
+	requestedEpochs := make(map[uint32]struct{})
+	historyStorer := &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			storedData[string(key)] = data
+			return nil
+		},
+		RemoveCalled: func(key []byte) error {
+			delete(storedData, string(key))
+			return nil
+		},
+		GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+			requestedEpochs[epoch] = struct{}{}
+			return get(key)
+		},
+		SearchFirstCalled: get,
+	}
+
+	recorder, _ := txhistory.NewHistoryRecorder(txhistory.ArgsHistoryRecorder{
+		HistoryStorer:    historyStorer,
+		Marshalizer:      &mock.MarshalizerFake{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+	})
+	recordedBlocks := []struct {
+		nonce         uint64
+		txHash        string
+		miniBlockType block.Type
+		tx            data.TransactionHandler
+	}{
+		{7, "tx1", block.TxBlock, &transaction.Transaction{SndAddr: address, RcvAddr: address}},
+		{8, "tx2", block.InvalidBlock, &transaction.Transaction{SndAddr: address, RcvAddr: address}},
+		{9, "tx3", block.RewardsBlock, &rewardTx.RewardTx{RcvAddr: address}},
+	}
+	for _, recordedBlock := range recordedBlocks {
+		err := recorder.RecordBlock(
+			&block.Header{Nonce: recordedBlock.nonce, Epoch: 2},
+			&block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte(recordedBlock.txHash)}, Type: recordedBlock.miniBlockType}}},
+			map[string]data.TransactionHandler{recordedBlock.txHash: recordedBlock.tx},
+		)
+		require.Nil(t, err)
+	}
+	requestedEpochs = make(map[uint32]struct{})
+
+	n := createNodeWithTransactionsHistory(historyStorer, 2)
+	apiHistory, err := n.GetTransactionsHistory(
+		hex.EncodeToString(address),
+		&transaction.ApiHistoryCursor{Epoch: 100, Nonce: 0},
+		2,
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, map[uint32]struct{}{2: {}}, requestedEpochs)
+	require.Equal(t, 2, len(apiHistory.Transactions))
+	assert.Equal(t, &transaction.ApiAddressHistoryEntry{
+		Hash:       hex.EncodeToString([]byte("tx3")),
+		Type:       "rewardTx",
+		BlockNonce: 9,
+		Epoch:      2,
+	}, apiHistory.Transactions[0])
+	assert.Equal(t, "invalidTx", apiHistory.Transactions[1].Type)
+	assert.Equal(t, &transaction.ApiHistoryCursor{Epoch: 2, Nonce: 8}, apiHistory.NextCursor)
+}
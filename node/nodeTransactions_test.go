@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,7 +46,7 @@ func TestNode_GetTransactionStatus_InvalidHashShouldErr(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestNode_GetTransactionStatus_ShouldFindInTxCacheAndReturnReceived(t *testing.T) {
+func TestNode_GetTransactionStatus_ShouldFindInTxCacheAndReturnPending(t *testing.T) {
 	t.Parallel()
 
 	throttler := &mock.ThrottlerStub{
@@ -61,10 +63,10 @@ func TestNode_GetTransactionStatus_ShouldFindInTxCacheAndReturnReceived(t *testi
 	)
 	res, err := n.GetTransactionStatus("aaaa")
 	assert.NoError(t, err)
-	assert.Equal(t, string(core.TxStatusReceived), res)
+	assert.Equal(t, string(core.TxStatusPending), res)
 }
 
-func TestNode_GetTransactionStatus_ShouldFindInRwdTxCacheAndReturnReceived(t *testing.T) {
+func TestNode_GetTransactionStatus_ShouldFindInRwdTxCacheAndReturnPending(t *testing.T) {
 	t.Parallel()
 
 	throttler := &mock.ThrottlerStub{
@@ -82,10 +84,10 @@ func TestNode_GetTransactionStatus_ShouldFindInRwdTxCacheAndReturnReceived(t *te
 	)
 	res, err := n.GetTransactionStatus("aaaa")
 	assert.NoError(t, err)
-	assert.Equal(t, string(core.TxStatusReceived), res)
+	assert.Equal(t, string(core.TxStatusPending), res)
 }
 
-func TestNode_GetTransactionStatus_ShouldFindInUnsignedTxCacheAndReturnReceived(t *testing.T) {
+func TestNode_GetTransactionStatus_ShouldFindInUnsignedTxCacheAndReturnPending(t *testing.T) {
 	t.Parallel()
 
 	throttler := &mock.ThrottlerStub{
@@ -104,7 +106,7 @@ func TestNode_GetTransactionStatus_ShouldFindInUnsignedTxCacheAndReturnReceived(
 	)
 	res, err := n.GetTransactionStatus("aaaa")
 	assert.NoError(t, err)
-	assert.Equal(t, string(core.TxStatusReceived), res)
+	assert.Equal(t, string(core.TxStatusPending), res)
 }
 
 func TestNode_GetTransactionStatus_ShouldFindInTxStorageAndReturnExecuted(t *testing.T) {
@@ -122,6 +124,10 @@ func TestNode_GetTransactionStatus_ShouldFindInTxStorageAndReturnExecuted(t *tes
 	}
 	storer := &mock.ChainStorerMock{
 		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType == dataRetriever.TransactionsMetadataUnit {
+				return getStorerStub(false)
+			}
+
 			return getStorerStub(true)
 		},
 	}
@@ -150,7 +156,7 @@ func TestNode_GetTransactionStatus_ShouldFindInRwdTxStorageAndReturnExecuted(t *
 	}
 	storer := &mock.ChainStorerMock{
 		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
-			if unitType == dataRetriever.TransactionUnit {
+			if unitType == dataRetriever.TransactionUnit || unitType == dataRetriever.TransactionsMetadataUnit {
 				return getStorerStub(false)
 			}
 
@@ -228,6 +234,106 @@ func TestNode_GetTransactionStatus_ShouldNotFindAndReturnUnknown(t *testing.T) {
 	assert.Equal(t, string(core.TxStatusUnknown), res)
 }
 
+func TestNode_GetTransactionStatus_InvalidMiniBlockShouldReturnInvalid(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.InvalidBlock),
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+	n := createNodeWithTxMetadataInStorage(metadata, 0)
+
+	res, err := n.GetTransactionStatus("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, string(core.TxStatusInvalid), res)
+}
+
+func TestNode_GetTransactionStatus_ExecutionFailedShouldReturnFail(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.TxBlock),
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+		ExecutionFailed: true,
+	}
+	n := createNodeWithTxMetadataInStorage(metadata, 0)
+
+	res, err := n.GetTransactionStatus("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, string(core.TxStatusFail), res)
+}
+
+func TestNode_GetTransactionStatus_CrossShardOnlyExecutedAtSourceShouldReturnPartiallyExecuted(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.TxBlock),
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+	n := createNodeWithTxMetadataInStorage(metadata, 0)
+
+	res, err := n.GetTransactionStatus("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, string(core.TxStatusPartiallyExecuted), res)
+}
+
+func TestNode_GetTransactionStatus_CrossShardExecutedAtDestinationShouldReturnSuccess(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:         int32(block.TxBlock),
+		SenderShardID:         0,
+		ReceiverShardID:       1,
+		ExecutedAtDestination: true,
+	}
+	n := createNodeWithTxMetadataInStorage(metadata, 0)
+
+	res, err := n.GetTransactionStatus("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, string(core.TxStatusSuccess), res)
+}
+
+func createNodeWithTxMetadataInStorage(metadata *txstatus.TransactionMetadata, selfShardID uint32) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	metadataBytes, _ := marshalizer.Marshal(metadata)
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	dataPool := &mock.PoolsHolderStub{
+		TransactionsCalled:         getCacherHandler(false),
+		RewardTransactionsCalled:   getCacherHandler(false),
+		UnsignedTransactionsCalled: getCacherHandler(false),
+	}
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType != dataRetriever.TransactionsMetadataUnit {
+				return getStorerStub(true)
+			}
+
+			return &mock.StorerStub{
+				SearchFirstCalled: func(_ []byte) ([]byte, error) {
+					return metadataBytes, nil
+				},
+			}
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiTransactionByHashThrottler(throttler),
+		node.WithDataPool(dataPool),
+		node.WithDataStore(storer),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: selfShardID}),
+	)
+
+	return n
+}
+
 func TestNode_GetTransaction_ThrottlerCannotProcessShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -272,6 +378,7 @@ func TestNode_GetTransaction_ShouldFindInTxCacheAndReturn(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -296,6 +403,7 @@ func TestNode_GetTransaction_ShouldFindInRwdTxCacheAndReturn(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -321,6 +429,7 @@ func TestNode_GetTransaction_ShouldFindInUnsignedTxCacheAndReturn(t *testing.T)
 	n, _ := node.NewNode(
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -352,6 +461,7 @@ func TestNode_GetTransaction_ShouldFindInTxStorageAndReturn(t *testing.T) {
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
 		node.WithDataStore(storer),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -387,6 +497,7 @@ func TestNode_GetTransaction_ShouldFindInRwdTxStorageAndReturn(t *testing.T) {
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
 		node.WithDataStore(storer),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -423,6 +534,7 @@ func TestNode_GetTransaction_ShouldFindInUnsignedTxStorageAndReturn(t *testing.T
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
 		node.WithDataStore(storer),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
 		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
 	)
@@ -461,6 +573,7 @@ func TestNode_GetTransaction_ShouldFindInStorageButErrorUnmarshaling(t *testing.
 		node.WithApiTransactionByHashThrottler(throttler),
 		node.WithDataPool(dataPool),
 		node.WithDataStore(storer),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerMock{
 			UnmarshalHandler: func(_ interface{}, _ []byte) error {
 				return expectedErr
@@ -472,6 +585,75 @@ func TestNode_GetTransaction_ShouldFindInStorageButErrorUnmarshaling(t *testing.
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestNode_GetTransaction_ShouldFindInStorageAndPutLocation(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	txMetadata := &txstatus.TransactionMetadata{
+		MiniblockHash:   []byte("miniblockHash"),
+		MiniblockType:   int32(block.TxBlock),
+		SenderShardID:   1,
+		ReceiverShardID: 2,
+	}
+	txMetadataBytes, _ := marshalizer.Marshal(txMetadata)
+	miniblockMetadata := &txstatus.MiniblockMetadata{
+		HeaderHash:  []byte("headerHash"),
+		HeaderNonce: 37,
+		Round:       38,
+		Epoch:       4,
+	}
+	miniblockMetadataBytes, _ := marshalizer.Marshal(miniblockMetadata)
+
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	dataPool := &mock.PoolsHolderStub{
+		TransactionsCalled:         getCacherHandler(false),
+		RewardTransactionsCalled:   getCacherHandler(false),
+		UnsignedTransactionsCalled: getCacherHandler(false),
+	}
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			switch unitType {
+			case dataRetriever.TransactionsMetadataUnit:
+				return &mock.StorerStub{
+					SearchFirstCalled: func(_ []byte) ([]byte, error) {
+						return txMetadataBytes, nil
+					},
+				}
+			case dataRetriever.MiniblocksMetadataUnit:
+				return &mock.StorerStub{
+					SearchFirstCalled: func(key []byte) ([]byte, error) {
+						assert.Equal(t, txMetadata.MiniblockHash, key)
+						return miniblockMetadataBytes, nil
+					},
+				}
+			default:
+				return getStorerStub(true)
+			}
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithApiTransactionByHashThrottler(throttler),
+		node.WithDataPool(dataPool),
+		node.WithDataStore(storer),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	tx, err := n.GetTransaction("aaaa")
+	assert.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(txMetadata.MiniblockHash), tx.MiniBlockHash)
+	assert.Equal(t, txMetadata.SenderShardID, tx.SourceShard)
+	assert.Equal(t, txMetadata.ReceiverShardID, tx.DestinationShard)
+	assert.Equal(t, miniblockMetadata.HeaderNonce, tx.BlockNonce)
+	assert.Equal(t, hex.EncodeToString(miniblockMetadata.HeaderHash), tx.BlockHash)
+	assert.Equal(t, miniblockMetadata.Epoch, tx.Epoch)
+}
+
 func TestNode_GetTransaction_ShouldNotFindAndReturnUnknown(t *testing.T) {
 	t.Parallel()
 
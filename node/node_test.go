@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	disabledCommitJournal "github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
@@ -117,7 +118,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -130,7 +131,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -149,7 +150,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), state.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "could not fetch sender address from provided param")
 }
@@ -179,7 +180,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -194,7 +195,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -447,8 +448,10 @@ func TestCreateTransaction_NilAddrConverterShouldErr(t *testing.T) {
 	gasLimit := uint64(20)
 	txData := "-"
 	signature := "-"
+	chainID := "chain ID"
+	version := uint32(1)
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, chainID, version)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -479,8 +482,10 @@ func TestCreateTransaction_NilAccountsAdapterShouldErr(t *testing.T) {
 	gasLimit := uint64(20)
 	txData := "-"
 	signature := "-"
+	chainID := "chain ID"
+	version := uint32(1)
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, chainID, version)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -513,8 +518,10 @@ func TestCreateTransaction_InvalidSignatureShouldErr(t *testing.T) {
 	gasLimit := uint64(20)
 	txData := "-"
 	signature := "-"
+	chainID := "chain ID"
+	version := uint32(1)
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, chainID, version)
 
 	assert.Nil(t, tx)
 	assert.Nil(t, txHash)
@@ -553,19 +560,49 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	gasLimit := uint64(20)
 	txData := "-"
 	signature := "617eff4f"
+	chainID := "chain ID"
+	version := uint32(1)
 
-	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature)
+	tx, txHash, err := n.CreateTransaction(nonce, value.String(), receiver, sender, gasPrice, gasLimit, txData, signature, chainID, version)
 	assert.NotNil(t, tx)
 	assert.Equal(t, expectedHash, txHash)
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, tx.Nonce)
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
+	assert.Equal(t, []byte(chainID), tx.ChainID)
+	assert.Equal(t, version, tx.Version)
 
 	err = n.ValidateTransaction(tx)
 	assert.Nil(t, err)
 }
 
+func TestCreateRelayedTransaction_NilUserTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	relayedTx, err := n.CreateRelayedTransaction(nil)
+
+	assert.Nil(t, relayedTx)
+	assert.Equal(t, node.ErrNilTransaction, err)
+}
+
+func TestCreateRelayedTransaction_RecursiveRelayedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	userTx := &transaction.Transaction{
+		Value: big.NewInt(0),
+		Data:  []byte(core.RelayedTransaction + "@aabb"),
+	}
+
+	relayedTx, err := n.CreateRelayedTransaction(userTx)
+
+	assert.Nil(t, relayedTx)
+	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
+}
+
 func TestSendBulkTransactions_NoTxShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -955,6 +992,7 @@ func TestStartConsensus_ShardBootstrapperNilAccounts(t *testing.T) {
 				return bootstrapStorage.BootstrapData{}, errors.New("localErr")
 			},
 		}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithEpochStartTrigger(&mock.EpochStartTriggerStub{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
 		node.WithNodesCoordinator(&mock.NodesCoordinatorMock{}),
@@ -1003,6 +1041,7 @@ func TestStartConsensus_ShardBootstrapperNilPoolHolder(t *testing.T) {
 		node.WithResolversFinder(rf),
 		node.WithDataStore(store),
 		node.WithBootStorer(&mock.BoostrapStorerMock{}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithForkDetector(&mock.ForkDetectorMock{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
 		node.WithInternalMarshalizer(&mock.MarshalizerMock{}, 0),
@@ -1048,6 +1087,7 @@ func TestStartConsensus_MetaBootstrapperNilPoolHolder(t *testing.T) {
 			},
 		}),
 		node.WithBootStorer(&mock.BoostrapStorerMock{}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithForkDetector(&mock.ForkDetectorMock{}),
 		node.WithBlockTracker(&mock.BlockTrackerStub{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
@@ -1155,6 +1195,7 @@ func TestStartConsensus_ShardBootstrapperPubKeyToByteArrayError(t *testing.T) {
 				return bootstrapStorage.BootstrapData{}, errors.New("localErr")
 			},
 		}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithEpochStartTrigger(&mock.EpochStartTriggerStub{}),
 		node.WithRequestedItemsHandler(&mock.RequestedItemsHandlerStub{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
@@ -1238,6 +1279,7 @@ func TestStartConsensus_ShardBootstrapperInvalidConsensusType(t *testing.T) {
 				return bootstrapStorage.BootstrapData{}, errors.New("localErr")
 			},
 		}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithEpochStartTrigger(&mock.EpochStartTriggerStub{}),
 		node.WithRequestedItemsHandler(&mock.RequestedItemsHandlerStub{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
@@ -1259,6 +1301,8 @@ func TestStartConsensus_ShardBootstrapperInvalidConsensusType(t *testing.T) {
 func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 	t.Parallel()
 
+	registeredTopics := make([]string, 0)
+
 	chainHandler := &mock.ChainHandlerStub{
 		GetGenesisHeaderHashCalled: func() []byte {
 			return []byte("hdrHash")
@@ -1325,6 +1369,7 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 				return true
 			},
 			RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+				registeredTopics = append(registeredTopics, topic)
 				return nil
 			},
 		}),
@@ -1336,6 +1381,7 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 				return bootstrapStorage.BootstrapData{}, errors.New("localErr")
 			},
 		}),
+		node.WithBlockCommitJournal(disabledCommitJournal.NewCommitJournal()),
 		node.WithEpochStartTrigger(&mock.EpochStartTriggerStub{}),
 		node.WithRequestedItemsHandler(&mock.RequestedItemsHandlerStub{}),
 		node.WithBlockProcessor(&mock.BlockProcessorStub{}),
@@ -1360,10 +1406,15 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{}),
 		node.WithInputAntifloodHandler(&mock.P2PAntifloodHandlerStub{}),
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
+		node.WithEquivocationEvidencePool(&mock.CacherStub{}),
+		node.WithEquivocationEvidenceVerifier(&mock.EvidenceVerifierStub{}),
+		node.WithSigningHistory(&mock.SigningHistoryStub{}),
+		node.WithNodeRedundancyHandler(&mock.NodeRedundancyHandlerStub{}),
 	)
 
 	err := n.StartConsensus()
 	assert.Nil(t, err)
+	assert.Contains(t, registeredTopics, core.EquivocationEvidenceTopic)
 }
 
 //------- GetAccount
@@ -1375,7 +1426,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -1394,7 +1445,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -1420,7 +1471,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 			}),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -1440,7 +1491,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -1464,7 +1515,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -1491,7 +1542,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, accnt, recovAccnt)
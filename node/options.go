@@ -20,7 +20,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // WithMessenger sets up the messenger option for the Node
@@ -461,6 +463,17 @@ func WithBootStorer(bootStorer process.BootStorer) Option {
 	}
 }
 
+// WithBlockCommitJournal sets up the block commit journal for the Node
+func WithBlockCommitJournal(blockCommitJournal process.BlockCommitJournal) Option {
+	return func(n *Node) error {
+		if check.IfNil(blockCommitJournal) {
+			return ErrNilBlockCommitJournal
+		}
+		n.blockCommitJournal = blockCommitJournal
+		return nil
+	}
+}
+
 // WithRequestedItemsHandler sets up a requested items handler for the Node
 func WithRequestedItemsHandler(requestedItemsHandler dataRetriever.RequestedItemsHandler) Option {
 	return func(n *Node) error {
@@ -528,6 +541,18 @@ func WithChainID(chainID []byte) Option {
 	}
 }
 
+// WithMinTransactionVersion sets up the minimum transaction version accepted by the node
+func WithMinTransactionVersion(minTransactionVersion uint32) Option {
+	return func(n *Node) error {
+		if minTransactionVersion == 0 {
+			return ErrInvalidTransactionVersion
+		}
+		n.minTransactionVersion = minTransactionVersion
+
+		return nil
+	}
+}
+
 // WithBlockTracker sets up the block tracker for the Node
 func WithBlockTracker(blockTracker process.BlockTracker) Option {
 	return func(n *Node) error {
@@ -675,3 +700,81 @@ func WithApiTransactionByHashThrottler(throttler Throttler) Option {
 		return nil
 	}
 }
+
+// WithApiBlockThrottler sets up the api block throttler
+func WithApiBlockThrottler(throttler Throttler) Option {
+	return func(n *Node) error {
+		if throttler == nil {
+			return ErrNilApiBlockThrottler
+		}
+		n.apiBlockThrottler = throttler
+		return nil
+	}
+}
+
+// WithApiAccountKeysThrottler sets up the api account keys throttler
+func WithApiAccountKeysThrottler(throttler Throttler) Option {
+	return func(n *Node) error {
+		if throttler == nil {
+			return ErrNilApiAccountKeysThrottler
+		}
+		n.apiAccountKeysThrottler = throttler
+		return nil
+	}
+}
+
+// WithApiTransactionsHistoryThrottler sets up the api transactions history throttler
+func WithApiTransactionsHistoryThrottler(throttler Throttler) Option {
+	return func(n *Node) error {
+		if throttler == nil {
+			return ErrNilApiTransactionsHistoryThrottler
+		}
+		n.apiTxsHistoryThrottler = throttler
+		return nil
+	}
+}
+
+// WithEquivocationEvidencePool sets up the pool holding the equivocation evidence for the Node
+func WithEquivocationEvidencePool(evidencePool storage.Cacher) Option {
+	return func(n *Node) error {
+		if check.IfNil(evidencePool) {
+			return ErrNilEquivocationEvidencePool
+		}
+		n.evidencePool = evidencePool
+		return nil
+	}
+}
+
+// WithSigningHistory sets up the signing history used by the consensus to refuse conflicting signatures
+func WithSigningHistory(signingHistory consensus.SigningHistoryHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(signingHistory) {
+			return ErrNilSigningHistory
+		}
+		n.signingHistory = signingHistory
+		return nil
+	}
+}
+
+// WithNodeRedundancyHandler sets up the node redundancy handler used by the consensus to keep a backup machine in
+// standby while the main machine is active
+func WithNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(nodeRedundancyHandler) {
+			return ErrNilNodeRedundancyHandler
+		}
+		n.nodeRedundancyHandler = nodeRedundancyHandler
+		return nil
+	}
+}
+
+// WithEquivocationEvidenceVerifier sets up the equivocation evidence verifier for the Node
+func WithEquivocationEvidenceVerifier(evidenceVerifier slashing.EvidenceVerifier) Option {
+	return func(n *Node) error {
+		if check.IfNil(evidenceVerifier) {
+			return ErrNilEquivocationEvidenceVerifier
+		}
+		n.evidenceVerifier = evidenceVerifier
+		return nil
+	}
+}
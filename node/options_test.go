@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	disabledCommitJournal "github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal/disabled"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -912,6 +913,30 @@ func TestWithTxFeeHandler_OkStorerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithBlockCommitJournal_NilJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithBlockCommitJournal(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilBlockCommitJournal, err)
+}
+
+func TestWithBlockCommitJournal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	blockCommitJournal := disabledCommitJournal.NewCommitJournal()
+	opt := WithBlockCommitJournal(blockCommitJournal)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.blockCommitJournal == blockCommitJournal)
+}
+
 func TestWithTxFeeHandler_OkHandlerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1040,6 +1065,27 @@ func TestWithChainID_OkValueShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithMinTransactionVersion_InvalidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+	opt := WithMinTransactionVersion(0)
+
+	err := opt(node)
+	assert.Equal(t, ErrInvalidTransactionVersion, err)
+}
+
+func TestWithMinTransactionVersion_OkValueShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+	opt := WithMinTransactionVersion(2)
+
+	err := opt(node)
+	assert.Equal(t, uint32(2), node.minTransactionVersion)
+	assert.Nil(t, err)
+}
+
 func TestWithBootstrapRoundIndex(t *testing.T) {
 	t.Parallel()
 
@@ -1308,3 +1354,99 @@ func TestWithNodeStopChannel_OkNodeStopChannelShouldWork(t *testing.T) {
 	assert.True(t, node.chanStopNodeProcess == ch)
 	assert.Nil(t, err)
 }
+
+func TestWithEquivocationEvidencePool_NilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationEvidencePool(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationEvidencePool, err)
+}
+
+func TestWithEquivocationEvidencePool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	evidencePool := &mock.CacherStub{}
+	opt := WithEquivocationEvidencePool(evidencePool)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.evidencePool == evidencePool)
+}
+
+func TestWithSigningHistory_NilSigningHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSigningHistory(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilSigningHistory, err)
+}
+
+func TestWithSigningHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	signingHistory := &mock.SigningHistoryStub{}
+	opt := WithSigningHistory(signingHistory)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.signingHistory == signingHistory)
+}
+
+func TestWithNodeRedundancyHandler_NilNodeRedundancyHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithNodeRedundancyHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
+}
+
+func TestWithNodeRedundancyHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	opt := WithNodeRedundancyHandler(nodeRedundancyHandler)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.nodeRedundancyHandler == nodeRedundancyHandler)
+}
+
+func TestWithEquivocationEvidenceVerifier_NilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationEvidenceVerifier(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationEvidenceVerifier, err)
+}
+
+func TestWithEquivocationEvidenceVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	evidenceVerifier := &mock.EvidenceVerifierStub{}
+	opt := WithEquivocationEvidenceVerifier(evidenceVerifier)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.evidenceVerifier == evidenceVerifier)
+}
@@ -210,7 +210,7 @@ func (messenger *Messenger) HasTopicValidator(name string) bool {
 	validator := messenger.topicValidators[name]
 	messenger.topicsMutex.RUnlock()
 
-	return check.IfNil(validator)
+	return !check.IfNil(validator)
 }
 
 // RegisterMessageProcessor sets the provided message processor to be the
@@ -237,6 +237,18 @@ func (messenger *Messenger) RegisterMessageProcessor(topic string, handler p2p.M
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors for all the topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	defer messenger.topicsMutex.Unlock()
+
+	for topic := range messenger.topicValidators {
+		messenger.topicValidators[topic] = nil
+	}
+
+	return nil
+}
+
 // UnregisterMessageProcessor unsets the message processor for the given topic
 // (sets it to nil).
 func (messenger *Messenger) UnregisterMessageProcessor(topic string) error {
@@ -47,9 +47,11 @@ func TestRegisteringTopics(t *testing.T) {
 	// The newly created topic has no MessageProcessor attached to it, so we
 	// attach one now.
 	assert.Nil(t, messenger.TopicValidator("rocket"))
+	assert.False(t, messenger.HasTopicValidator("rocket"))
 	err = messenger.RegisterMessageProcessor("rocket", processor)
 	assert.Nil(t, err)
 	assert.Equal(t, processor, messenger.TopicValidator("rocket"))
+	assert.True(t, messenger.HasTopicValidator("rocket"))
 
 	// Cannot unregister a MessageProcessor from a topic that doesn't exist.
 	err = messenger.UnregisterMessageProcessor("albatross")
@@ -75,6 +77,14 @@ func TestRegisteringTopics(t *testing.T) {
 	assert.True(t, messenger.HasTopic("more_rockets"))
 	err = messenger.CreateTopic("more_rockets", false)
 	assert.NotNil(t, err)
+
+	// Unregister all the MessageProcessors at once.
+	_ = messenger.RegisterMessageProcessor("rocket", processor)
+	_ = messenger.RegisterMessageProcessor("more_rockets", processor)
+	err = messenger.UnregisterAllMessageProcessors()
+	assert.Nil(t, err)
+	assert.Nil(t, messenger.TopicValidator("rocket"))
+	assert.Nil(t, messenger.TopicValidator("more_rockets"))
 }
 
 func TestBroadcastingMessages(t *testing.T) {
@@ -40,6 +40,9 @@ type ArgBaseProcessor struct {
 	StateCheckpointModulus uint
 	BlockSizeThrottler     process.BlockSizeThrottler
 	Version                string
+	TxsMetadataRecorder    process.TransactionsMetadataRecorder
+	TxsHistoryRecorder     process.TransactionsHistoryRecorder
+	BlockCommitJournal     process.BlockCommitJournal
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
@@ -60,4 +63,5 @@ type ArgMetaProcessor struct {
 	EpochRewardsCreator          process.EpochStartRewardsCreator
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	EvidenceProcessor            process.EquivocationEvidenceProcessor
 }
@@ -66,6 +66,9 @@ type baseProcessor struct {
 	hdrsForCurrBlock        *hdrForBlock
 	genesisNonce            uint64
 	version                 string
+	txsMetadataRecorder     process.TransactionsMetadataRecorder
+	txsHistoryRecorder      process.TransactionsHistoryRecorder
+	blockCommitJournal      process.BlockCommitJournal
 
 	appStatusHandler       core.AppStatusHandler
 	stateCheckpointModulus uint
@@ -399,6 +402,15 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if len(arguments.Version) == 0 {
 		return process.ErrEmptySoftwareVersion
 	}
+	if check.IfNil(arguments.TxsMetadataRecorder) {
+		return process.ErrNilTransactionsMetadataRecorder
+	}
+	if check.IfNil(arguments.TxsHistoryRecorder) {
+		return process.ErrNilTransactionsHistoryRecorder
+	}
+	if check.IfNil(arguments.BlockCommitJournal) {
+		return process.ErrNilBlockCommitJournal
+	}
 
 	return nil
 }
@@ -966,6 +978,73 @@ func (bp *baseProcessor) saveBody(body *block.Body) {
 	}
 }
 
+func (bp *baseProcessor) saveTransactionsMetadata(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body *block.Body,
+	notarizedMetaBlocks []data.HeaderHandler,
+) {
+	if !bp.txsMetadataRecorder.IsEnabled() {
+		return
+	}
+
+	startTime := time.Now()
+
+	intermediateTxs := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
+	for hash, rpt := range bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock) {
+		intermediateTxs[hash] = rpt
+	}
+
+	errNotCritical := bp.txsMetadataRecorder.RecordBlock(headerHash, header, body, intermediateTxs)
+	if errNotCritical != nil {
+		log.Warn("saveTransactionsMetadata.RecordBlock", "error", errNotCritical.Error())
+	}
+
+	bp.txsMetadataRecorder.RecordNotarizedMetaBlocks(notarizedMetaBlocks)
+
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.CommitMaxTime {
+		log.Warn("saveTransactionsMetadata", "elapsed time", elapsedTime)
+	}
+}
+
+func (bp *baseProcessor) saveTransactionsHistory(header data.HeaderHandler, body *block.Body) {
+	if !bp.txsHistoryRecorder.IsEnabled() {
+		return
+	}
+
+	startTime := time.Now()
+
+	txs := make(map[string]data.TransactionHandler)
+	blockTypes := []block.Type{block.TxBlock, block.InvalidBlock, block.SmartContractResultBlock, block.RewardsBlock}
+	for _, blockType := range blockTypes {
+		for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txs[hash] = tx
+		}
+	}
+
+	errNotCritical := bp.txsHistoryRecorder.RecordBlock(header, body, txs)
+	if errNotCritical != nil {
+		log.Warn("saveTransactionsHistory.RecordBlock", "error", errNotCritical.Error())
+	}
+
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.CommitMaxTime {
+		log.Warn("saveTransactionsHistory", "elapsed time", elapsedTime)
+	}
+}
+
+func (bp *baseProcessor) revertTransactionsHistory(header data.HeaderHandler) {
+	if !bp.txsHistoryRecorder.IsEnabled() {
+		return
+	}
+
+	errNotCritical := bp.txsHistoryRecorder.RevertBlock(header)
+	if errNotCritical != nil {
+		log.Warn("revertTransactionsHistory.RevertBlock", "error", errNotCritical.Error())
+	}
+}
+
 func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
 	startTime := time.Now()
 
@@ -16,12 +16,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/commitJournal"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -202,6 +205,52 @@ func initStore() *dataRetriever.ChainStorer {
 	return store
 }
 
+type commitFaultInjection struct {
+	failingUnits      []dataRetriever.UnitType
+	accountsCommitErr error
+}
+
+func createJournaledMemUnits(journaledUnits []dataRetriever.UnitType) map[dataRetriever.UnitType]storage.Storer {
+	units := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.BlockCommitJournalUnit: generateTestUnit(),
+	}
+	for _, unit := range journaledUnits {
+		units[unit] = generateTestUnit()
+	}
+
+	return units
+}
+
+// createCommitJournalOverUnits creates a block commit journal over the provided units, replacing the failing units
+// with storers which can not be written
+func createCommitJournalOverUnits(
+	t *testing.T,
+	units map[dataRetriever.UnitType]storage.Storer,
+	journaledUnits []dataRetriever.UnitType,
+	failingUnits []dataRetriever.UnitType,
+) (process.BlockCommitJournal, dataRetriever.StorageService) {
+	store := initStore()
+	for unit, storer := range units {
+		store.AddStorer(unit, storer)
+	}
+	for _, unit := range failingUnits {
+		store.AddStorer(unit, &mock.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				return errors.New("expected error")
+			},
+		})
+	}
+
+	blockCommitJournal, err := commitJournal.NewCommitJournal(commitJournal.ArgsCommitJournal{
+		Store:          store,
+		JournalUnit:    dataRetriever.BlockCommitJournalUnit,
+		JournaledUnits: journaledUnits,
+	})
+	assert.Nil(t, err)
+
+	return blockCommitJournal, blockCommitJournal.JournaledStore()
+}
+
 func createDummyMetaBlock(destShardId uint32, senderShardId uint32, miniBlockHashes ...[]byte) *block.MetaBlock {
 	metaBlock := &block.MetaBlock{
 		ShardInfo: []block.ShardData{
@@ -306,11 +355,14 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 					return nil
 				},
 			},
-			DataPool:           initDataPool([]byte("")),
-			BlockTracker:       mock.NewBlockTrackerMock(shardCoordinator, startHeaders),
-			BlockChain:         blkc,
-			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
-			Version:            "softwareVersion",
+			DataPool:            initDataPool([]byte("")),
+			BlockTracker:        mock.NewBlockTrackerMock(shardCoordinator, startHeaders),
+			BlockChain:          blkc,
+			BlockSizeThrottler:  &mock.BlockSizeThrottlerStub{},
+			Version:             "softwareVersion",
+			TxsMetadataRecorder: disabledTxStatus.NewMetadataRecorder(),
+			TxsHistoryRecorder:  disabledTxHistory.NewHistoryRecorder(),
+			BlockCommitJournal:  &mock.BlockCommitJournalStub{},
 		},
 	}
 
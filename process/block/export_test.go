@@ -14,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 )
 
 func (bp *baseProcessor) ComputeHeaderHash(hdr data.HeaderHandler) ([]byte, error) {
@@ -52,7 +54,14 @@ func (sp *shardProcessor) GetOrderedProcessedMetaBlocksFromHeader(header *block.
 }
 
 func (sp *shardProcessor) UpdateCrossShardInfo(processedMetaHdrs []data.HeaderHandler) error {
-	return sp.updateCrossShardInfo(processedMetaHdrs)
+	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	sp.updateCrossShardInfo(processedMetaHdrs, lastCrossNotarizedHeader)
+
+	return nil
 }
 
 func (sp *shardProcessor) UpdateStateStorage(finalHeaders []data.HeaderHandler, currentHeader *block.Header) {
@@ -99,11 +108,14 @@ func NewShardProcessorEmptyWith3shards(
 					return nil
 				},
 			},
-			BlockTracker:       mock.NewBlockTrackerMock(shardCoordinator, genesisBlocks),
-			DataPool:           tdp,
-			BlockChain:         blockChain,
-			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
-			Version:            "softwareVersion",
+			BlockTracker:        mock.NewBlockTrackerMock(shardCoordinator, genesisBlocks),
+			DataPool:            tdp,
+			BlockChain:          blockChain,
+			BlockSizeThrottler:  &mock.BlockSizeThrottlerStub{},
+			Version:             "softwareVersion",
+			TxsMetadataRecorder: disabledTxStatus.NewMetadataRecorder(),
+			TxsHistoryRecorder:  disabledTxHistory.NewHistoryRecorder(),
+			BlockCommitJournal:  &mock.BlockCommitJournalStub{},
 		},
 	}
 	shardProc, err := NewShardProcessor(arguments)
@@ -202,7 +214,14 @@ func (mp *metaProcessor) SetShardBlockFinality(val uint32) {
 }
 
 func (mp *metaProcessor) SaveLastNotarizedHeader(header *block.MetaBlock) error {
-	return mp.saveLastNotarizedHeader(header)
+	lastCrossNotarizedHeaders, err := mp.computeLastNotarizedHeaders(header)
+	if err != nil {
+		return err
+	}
+
+	mp.saveLastNotarizedHeaders(lastCrossNotarizedHeaders)
+
+	return nil
 }
 
 func (mp *metaProcessor) CheckShardHeadersValidity(header *block.MetaBlock) (map[uint32]data.HeaderHandler, error) {
@@ -234,7 +253,14 @@ func (mp *metaProcessor) GetShardsHeadersNonce() *sync.Map {
 }
 
 func (sp *shardProcessor) SaveLastNotarizedHeader(shardId uint32, processedHdrs []data.HeaderHandler) error {
-	return sp.saveLastNotarizedHeader(shardId, processedHdrs)
+	lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := sp.computeLastNotarizedHeader(shardId, processedHdrs)
+	if err != nil {
+		return err
+	}
+
+	sp.saveLastNotarizedHeader(shardId, lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash)
+
+	return nil
 }
 
 func (sp *shardProcessor) CheckHeaderBodyCorrelation(hdr *block.Header, body *block.Body) error {
@@ -37,6 +37,7 @@ type metaProcessor struct {
 	validatorInfoCreator         process.EpochStartValidatorInfoCreator
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	evidenceProcessor            process.EquivocationEvidenceProcessor
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -79,6 +80,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.ValidatorStatisticsProcessor) {
 		return nil, process.ErrNilValidatorStatistics
 	}
+	if check.IfNil(arguments.EvidenceProcessor) {
+		return nil, process.ErrNilEquivocationEvidenceProcessor
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -106,6 +110,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		stateCheckpointModulus: arguments.StateCheckpointModulus,
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
+		txsMetadataRecorder:    arguments.TxsMetadataRecorder,
+		txsHistoryRecorder:     arguments.TxsHistoryRecorder,
+		blockCommitJournal:     arguments.BlockCommitJournal,
 	}
 
 	mp := metaProcessor{
@@ -120,6 +127,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		epochRewardsCreator:          arguments.EpochRewardsCreator,
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
+		evidenceProcessor:            arguments.EvidenceProcessor,
 	}
 
 	mp.txCounter = NewTransactionCounter()
@@ -311,6 +319,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.evidenceProcessor.ProcessEvidence(header.EquivocationEvidence, header.Nonce)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifyFees(header)
 	if err != nil {
 		return err
@@ -333,6 +346,10 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 	header *block.MetaBlock,
 	body *block.Body,
 ) error {
+	if len(header.EquivocationEvidence) > 0 {
+		return process.ErrEquivocationEvidenceInEpochStartBlock
+	}
+
 	err := mp.epochStartDataCreator.VerifyEpochStartDataForMetablock(header)
 	if err != nil {
 		return err
@@ -616,6 +633,8 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
+	mp.revertTransactionsHistory(metaBlock)
+
 	return nil
 }
 
@@ -772,6 +791,11 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		return nil, err
 	}
 
+	metaBlock.EquivocationEvidence, err = mp.evidenceProcessor.CreateAndProcessEvidence(metaBlock.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
 	return miniBlocks, nil
 }
 
@@ -984,6 +1008,7 @@ func (mp *metaProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			mp.blockCommitJournal.Rollback()
 			mp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -1005,6 +1030,7 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.store.SetEpochForPutOperation(headerHandler.GetEpoch())
+	mp.blockCommitJournal.Begin(headerHandler.GetEpoch())
 
 	header, ok := headerHandler.(*block.MetaBlock)
 	if !ok {
@@ -1030,19 +1056,34 @@ func (mp *metaProcessor) CommitBlock(
 	headerHash := mp.hasher.Compute(string(marshalizedHeader))
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body)
+	mp.saveTransactionsMetadata(headerHash, header, body, []data.HeaderHandler{header})
+	mp.saveTransactionsHistory(header, body)
 
-	err = mp.commitAll()
+	lastCrossNotarizedHeaders, err := mp.computeLastNotarizedHeaders(header)
 	if err != nil {
 		return err
 	}
 
-	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
+	notarizedHeadersHashes, errNotCritical := mp.updateCrossShardInfo(header)
+	if errNotCritical != nil {
+		log.Debug("updateCrossShardInfo", "error", errNotCritical.Error())
+	}
 
-	err = mp.saveLastNotarizedHeader(header)
+	err = mp.commitAll()
 	if err != nil {
 		return err
 	}
 
+	// the journal is committed before the in-memory head is moved, so a failed commit leaves the node on the previous block
+	err = mp.blockCommitJournal.Commit()
+	if err != nil {
+		return err
+	}
+
+	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
+
+	mp.saveLastNotarizedHeaders(lastCrossNotarizedHeaders)
+
 	err = mp.pendingMiniBlocksHandler.AddProcessedHeader(header)
 	if err != nil {
 		return err
@@ -1054,11 +1095,6 @@ func (mp *metaProcessor) CommitBlock(
 		"nonce", header.Nonce,
 		"hash", headerHash)
 
-	notarizedHeadersHashes, errNotCritical := mp.updateCrossShardInfo(header)
-	if errNotCritical != nil {
-		log.Debug("updateCrossShardInfo", "error", errNotCritical.Error())
-	}
-
 	errNotCritical = mp.forkDetector.AddHeader(header, headerHash, process.BHProcessed, nil, nil)
 	if errNotCritical != nil {
 		log.Debug("forkDetector.AddHeader", "error", errNotCritical.Error())
@@ -1416,12 +1452,14 @@ func (mp *metaProcessor) saveMetricCrossCheckBlockHeight() {
 	mp.appStatusHandler.SetStringValue(core.MetricCrossCheckBlockHeight, crossCheckBlockHeight)
 }
 
-func (mp *metaProcessor) saveLastNotarizedHeader(header *block.MetaBlock) error {
+// computeLastNotarizedHeaders returns the last cross notarized header of each shard, once the shard headers included
+// in the given meta block are notarized
+func (mp *metaProcessor) computeLastNotarizedHeaders(header *block.MetaBlock) (map[uint32]*hashAndHdr, error) {
 	lastCrossNotarizedHeaderForShard := make(map[uint32]*hashAndHdr, mp.shardCoordinator.NumberOfShards())
 	for shardID := uint32(0); shardID < mp.shardCoordinator.NumberOfShards(); shardID++ {
 		lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := mp.blockTracker.GetLastCrossNotarizedHeader(shardID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		lastCrossNotarizedHeaderForShard[shardID] = &hashAndHdr{hdr: lastCrossNotarizedHeader, hash: lastCrossNotarizedHeaderHash}
@@ -1433,14 +1471,14 @@ func (mp *metaProcessor) saveLastNotarizedHeader(header *block.MetaBlock) error
 		headerInfo, ok := mp.hdrsForCurrBlock.hdrHashAndInfo[string(shardHeaderHash)]
 		if !ok {
 			mp.hdrsForCurrBlock.mutHdrsForBlock.RUnlock()
-			return fmt.Errorf("%w : saveLastNotarizedHeader shardHeaderHash = %s",
+			return nil, fmt.Errorf("%w : computeLastNotarizedHeaders shardHeaderHash = %s",
 				process.ErrMissingHeader, logger.DisplayByteSlice(shardHeaderHash))
 		}
 
 		shardHeader, ok := headerInfo.hdr.(*block.Header)
 		if !ok {
 			mp.hdrsForCurrBlock.mutHdrsForBlock.RUnlock()
-			return process.ErrWrongTypeAssertion
+			return nil, process.ErrWrongTypeAssertion
 		}
 
 		if lastCrossNotarizedHeaderForShard[shardHeader.ShardID].hdr.GetNonce() < shardHeader.Nonce {
@@ -1449,14 +1487,16 @@ func (mp *metaProcessor) saveLastNotarizedHeader(header *block.MetaBlock) error
 	}
 	mp.hdrsForCurrBlock.mutHdrsForBlock.RUnlock()
 
+	return lastCrossNotarizedHeaderForShard, nil
+}
+
+func (mp *metaProcessor) saveLastNotarizedHeaders(lastCrossNotarizedHeaderForShard map[uint32]*hashAndHdr) {
 	for shardID := uint32(0); shardID < mp.shardCoordinator.NumberOfShards(); shardID++ {
 		hdr := lastCrossNotarizedHeaderForShard[shardID].hdr
 		hash := lastCrossNotarizedHeaderForShard[shardID].hash
 		mp.blockTracker.AddCrossNotarizedHeader(shardID, hdr, hash)
 		DisplayLastNotarized(mp.marshalizer, mp.hasher, hdr, shardID)
 	}
-
-	return nil
 }
 
 // check if shard headers were signed and constructed correctly and returns headers which has to be
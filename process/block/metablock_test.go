@@ -19,6 +19,8 @@ import (
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	disabledTxHistory "github.com/ElrondNetwork/elrond-go/process/txhistory/disabled"
+	disabledTxStatus "github.com/ElrondNetwork/elrond-go/process/txstatus/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
@@ -69,11 +71,14 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 					return nil
 				},
 			},
-			BlockTracker:       mock.NewBlockTrackerMock(shardCoordinator, startHeaders),
-			DataPool:           mdp,
-			BlockChain:         createTestBlockchain(),
-			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
-			Version:            "softwareVersion",
+			BlockTracker:        mock.NewBlockTrackerMock(shardCoordinator, startHeaders),
+			DataPool:            mdp,
+			BlockChain:          createTestBlockchain(),
+			BlockSizeThrottler:  &mock.BlockSizeThrottlerStub{},
+			Version:             "softwareVersion",
+			TxsMetadataRecorder: disabledTxStatus.NewMetadataRecorder(),
+			TxsHistoryRecorder:  disabledTxHistory.NewHistoryRecorder(),
+			BlockCommitJournal:  &mock.BlockCommitJournalStub{},
 		},
 		SCDataGetter:                 &mock.ScQueryStub{},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
@@ -83,6 +88,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
+		EvidenceProcessor:            &mock.EquivocationEvidenceProcessorStub{},
 	}
 	return arguments
 }
@@ -322,6 +328,50 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilTxsMetadataRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.TxsMetadataRecorder = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilTransactionsMetadataRecorder, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilTxsHistoryRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.TxsHistoryRecorder = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilTransactionsHistoryRecorder, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilBlockCommitJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.BlockCommitJournal = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilBlockCommitJournal, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilEvidenceProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.EvidenceProcessor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilEquivocationEvidenceProcessor, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -809,6 +859,302 @@ func TestMetaProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func createMetaProcessorReadyToCommit(
+	accounts state.AccountsAdapter,
+	journal process.BlockCommitJournal,
+) (process.BlockProcessor, *block.MetaBlock) {
+	fd := &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	store := initStore()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			return nil
+		},
+	})
+
+	arguments := createMockMetaArguments()
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.AccountsDB[state.PeerAccountsState] = accounts
+	arguments.ForkDetector = fd
+	arguments.Store = store
+	arguments.Hasher = &mock.HasherStub{}
+	arguments.BlockCommitJournal = journal
+
+	return createMetaProcessorReadyToCommitFromArguments(arguments)
+}
+
+func createMetaProcessorReadyToCommitFromArguments(arguments blproc.ArgMetaProcessor) (process.BlockProcessor, *block.MetaBlock) {
+	mdp := initDataPool([]byte("tx_hash"))
+	hdr := createMetaBlockHeader()
+	arguments.DataPool = mdp
+	blockTrackerMock := mock.NewBlockTrackerMock(arguments.ShardCoordinator, createGenesisBlocks(arguments.ShardCoordinator))
+	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
+		return &block.Header{}, []byte("hash"), nil
+	}
+	arguments.BlockTracker = blockTrackerMock
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	mdp.HeadersCalled = func() dataRetriever.HeadersPool {
+		cs := &mock.HeadersCacherStub{}
+		cs.RegisterHandlerCalled = func(i func(header data.HeaderHandler, key []byte)) {
+		}
+		cs.GetHeaderByHashCalled = func(hash []byte) (handler data.HeaderHandler, e error) {
+			return &block.Header{}, nil
+		}
+		cs.MaxSizeCalled = func() int {
+			return 1000
+		}
+		return cs
+	}
+	mp.SetHdrForCurrentBlock([]byte("hdr_hash1"), &block.Header{}, true)
+
+	return mp, hdr
+}
+
+func createBlockCommitJournalRecorder(calls *[]string, commitErr error) *mock.BlockCommitJournalStub {
+	return &mock.BlockCommitJournalStub{
+		BeginCalled: func(epoch uint32) {
+			*calls = append(*calls, "begin")
+		},
+		CommitCalled: func() error {
+			*calls = append(*calls, "commit")
+			return commitErr
+		},
+		RollbackCalled: func() {
+			*calls = append(*calls, "rollback")
+		},
+	}
+}
+
+func TestMetaProcessor_CommitBlockShouldCommitTheJournal(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+	}
+	calls := make([]string, 0)
+	mp, hdr := createMetaProcessorReadyToCommit(accounts, createBlockCommitJournalRecorder(&calls, nil))
+
+	err := mp.CommitBlock(hdr, &block.Body{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"begin", "commit"}, calls)
+	time.Sleep(time.Second)
+}
+
+func TestMetaProcessor_CommitBlockAccountsCommitFailsShouldRollbackTheJournal(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	reverted := false
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return nil, expectedErr
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			reverted = true
+			return nil
+		},
+	}
+	calls := make([]string, 0)
+	mp, hdr := createMetaProcessorReadyToCommit(accounts, createBlockCommitJournalRecorder(&calls, nil))
+
+	err := mp.CommitBlock(hdr, &block.Body{})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []string{"begin", "rollback"}, calls)
+	assert.True(t, reverted)
+}
+
+func TestMetaProcessor_CommitBlockJournalCommitFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	reverted := false
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			reverted = true
+			return nil
+		},
+	}
+	calls := make([]string, 0)
+	mp, hdr := createMetaProcessorReadyToCommit(accounts, createBlockCommitJournalRecorder(&calls, expectedErr))
+
+	err := mp.CommitBlock(hdr, &block.Body{})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []string{"begin", "commit", "rollback"}, calls)
+	assert.True(t, reverted)
+}
+
+var metaJournaledUnits = []dataRetriever.UnitType{
+	dataRetriever.MetaBlockUnit,
+	dataRetriever.MetaHdrNonceHashDataUnit,
+}
+
+func createMetaArgumentsOverCommitJournal(
+	accounts state.AccountsAdapter,
+	blockCommitJournal process.BlockCommitJournal,
+	blockCommitStore dataRetriever.StorageService,
+	headerAdded *bool,
+	headMoved func(),
+) blproc.ArgMetaProcessor {
+	arguments := createMockMetaArguments()
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.AccountsDB[state.PeerAccountsState] = accounts
+	arguments.ForkDetector = &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			*headerAdded = true
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	blkc := createTestBlockchain()
+	blkc.SetCurrentBlockHeaderCalled = func(handler data.HeaderHandler) error {
+		headMoved()
+		return nil
+	}
+	arguments.BlockChain = blkc
+	arguments.Store = blockCommitStore
+	arguments.Hasher = &mock.HasherMock{}
+	arguments.BlockCommitJournal = blockCommitJournal
+
+	return arguments
+}
+
+func getCommittedMetaHeader(
+	arguments blproc.ArgMetaProcessor,
+	units map[dataRetriever.UnitType]storage.Storer,
+	hdr *block.MetaBlock,
+) ([]byte, []byte) {
+	nonceToByteSlice := arguments.Uint64Converter.ToByteSlice(hdr.GetNonce())
+	headerHash, _ := units[dataRetriever.MetaHdrNonceHashDataUnit].Get(nonceToByteSlice)
+
+	marshalizedHeader, _ := arguments.Marshalizer.Marshal(hdr)
+	expectedHeaderHash := arguments.Hasher.Compute(string(marshalizedHeader))
+	committedHeader, _ := units[dataRetriever.MetaBlockUnit].Get(expectedHeaderHash)
+
+	return headerHash, committedHeader
+}
+
+// testMetaProcessorCommitBlockFailure commits a meta block through a block commit journal over memory units, with a
+// failure injected inside CommitBlock, then checks that neither the in-memory head nor the storage moved, also after
+// the journal is recovered on restart
+func testMetaProcessorCommitBlockFailure(t *testing.T, fault commitFaultInjection) {
+	reverted := false
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("rootHash"), fault.accountsCommitErr
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			reverted = true
+			return nil
+		},
+	}
+	units := createJournaledMemUnits(metaJournaledUnits)
+	blockCommitJournal, blockCommitStore := createCommitJournalOverUnits(t, units, metaJournaledUnits, fault.failingUnits)
+
+	headerAdded := false
+	headMoved := false
+	arguments := createMetaArgumentsOverCommitJournal(accounts, blockCommitJournal, blockCommitStore, &headerAdded, func() {
+		headMoved = true
+	})
+	mp, hdr := createMetaProcessorReadyToCommitFromArguments(arguments)
+
+	err := mp.CommitBlock(hdr, &block.Body{})
+	assert.NotNil(t, err)
+	assert.True(t, reverted)
+	assert.False(t, headerAdded)
+	assert.False(t, headMoved)
+
+	restartedJournal, _ := createCommitJournalOverUnits(t, units, metaJournaledUnits, nil)
+	err = restartedJournal.Recover()
+	assert.Nil(t, err)
+
+	headerHash, committedHeader := getCommittedMetaHeader(arguments, units, hdr)
+	assert.Nil(t, headerHash)
+	assert.Nil(t, committedHeader)
+}
+
+func TestMetaProcessor_CommitBlockShouldApplyTheJournalBeforeMovingTheHead(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+	}
+	units := createJournaledMemUnits(metaJournaledUnits)
+	blockCommitJournal, blockCommitStore := createCommitJournalOverUnits(t, units, metaJournaledUnits, nil)
+
+	headerAdded := false
+	var headerHashWhenMoved, committedHeaderWhenMoved []byte
+	var arguments blproc.ArgMetaProcessor
+	var hdr *block.MetaBlock
+	arguments = createMetaArgumentsOverCommitJournal(accounts, blockCommitJournal, blockCommitStore, &headerAdded, func() {
+		headerHashWhenMoved, committedHeaderWhenMoved = getCommittedMetaHeader(arguments, units, hdr)
+	})
+	var mp process.BlockProcessor
+	mp, hdr = createMetaProcessorReadyToCommitFromArguments(arguments)
+
+	err := mp.CommitBlock(hdr, &block.Body{})
+	assert.Nil(t, err)
+	assert.True(t, headerAdded)
+	assert.NotNil(t, headerHashWhenMoved)
+	assert.NotNil(t, committedHeaderWhenMoved)
+	time.Sleep(time.Second)
+}
+
+func TestMetaProcessor_CommitBlockJournalUnitFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	testMetaProcessorCommitBlockFailure(t, commitFaultInjection{
+		failingUnits: []dataRetriever.UnitType{dataRetriever.BlockCommitJournalUnit},
+	})
+}
+
+func TestMetaProcessor_CommitBlockApplyingTheJournalFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	// the nonce to hash mapping is the first write of the block, so the header unit is not reached either
+	testMetaProcessorCommitBlockFailure(t, commitFaultInjection{
+		failingUnits: []dataRetriever.UnitType{dataRetriever.MetaHdrNonceHashDataUnit},
+	})
+}
+
+func TestMetaProcessor_CommitBlockAccountsCommitFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	testMetaProcessorCommitBlockFailure(t, commitFaultInjection{
+		accountsCommitErr: errors.New("expected error"),
+	})
+}
+
 func TestBlockProc_RequestTransactionFromNetwork(t *testing.T) {
 	t.Parallel()
 
@@ -2525,3 +2871,38 @@ func TestMetaProcessor_CreateBlockCreateHeaderProcessBlock(t *testing.T) {
 	err = mp.ProcessBlock(headerHandler, bodyHandler, func() time.Duration { return time.Second })
 	assert.Nil(t, err)
 }
+
+func TestMetaProcessor_CreateBlockBodyShouldIncludeEquivocationEvidence(t *testing.T) {
+	t.Parallel()
+
+	evidence := [][]byte{[]byte("evidence")}
+	arguments := createMockMetaArguments()
+	arguments.EvidenceProcessor = &mock.EquivocationEvidenceProcessorStub{
+		CreateAndProcessEvidenceCalled: func(nonce uint64) ([][]byte, error) {
+			assert.Equal(t, uint64(5), nonce)
+			return evidence, nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	metaHdr := &block.MetaBlock{Round: 10, Nonce: 5}
+	_, err := mp.CreateBlockBody(metaHdr, func() bool { return true })
+	assert.Nil(t, err)
+	assert.Equal(t, evidence, metaHdr.EquivocationEvidence)
+}
+
+func TestMetaProcessor_CreateBlockBodyEvidenceErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arguments := createMockMetaArguments()
+	arguments.EvidenceProcessor = &mock.EquivocationEvidenceProcessorStub{
+		CreateAndProcessEvidenceCalled: func(nonce uint64) ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	_, err := mp.CreateBlockBody(&block.MetaBlock{Round: 10, Nonce: 5}, func() bool { return true })
+	assert.Equal(t, expectedErr, err)
+}
@@ -176,6 +176,7 @@ func (gc *gasComputation) ComputeGasConsumedByTx(
 	isSCCall := txType == process.SCDeployment ||
 		txType == process.SCInvoking ||
 		txType == process.BuiltInFunctionCall ||
+		txType == process.RelayedTx ||
 		(core.IsSmartContractAddress(txHandler.GetRcvAddr()) && len(txHandler.GetData()) > 0)
 	if isSCCall {
 		isCrossShardSCCall := txSenderShardId != txReceiverShardId &&
@@ -156,6 +156,27 @@ func TestComputeGasConsumedByTx_ShouldWorkWhenTxReceiverAddressIsASmartContractC
 	assert.Equal(t, uint64(1), gasInRcv)
 }
 
+func TestComputeGasConsumedByTx_ShouldWorkWhenRelayedTxCrossShard(t *testing.T) {
+	t.Parallel()
+
+	gc, _ := preprocess.NewGasComputation(
+		&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 6
+			},
+		},
+		&mock.TxTypeHandlerMock{ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			return process.RelayedTx
+		}},
+	)
+
+	tx := transaction.Transaction{GasLimit: 10, RcvAddr: make([]byte, 32)}
+
+	gasInSnd, gasInRcv, _ := gc.ComputeGasConsumedByTx(0, 1, &tx)
+	assert.Equal(t, uint64(6), gasInSnd)
+	assert.Equal(t, uint64(4), gasInRcv)
+}
+
 func TestComputeGasConsumedByMiniBlock_ShouldErrMissingTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -77,6 +77,9 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		feeHandler:             arguments.FeeHandler,
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
+		txsMetadataRecorder:    arguments.TxsMetadataRecorder,
+		txsHistoryRecorder:     arguments.TxsHistoryRecorder,
+		blockCommitJournal:     arguments.BlockCommitJournal,
 	}
 
 	sp := shardProcessor{
@@ -601,6 +604,8 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
+	sp.revertTransactionsHistory(header)
+
 	return nil
 }
 
@@ -730,6 +735,7 @@ func (sp *shardProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			sp.blockCommitJournal.Rollback()
 			sp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -740,6 +746,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.store.SetEpochForPutOperation(headerHandler.GetEpoch())
+	sp.blockCommitJournal.Begin(headerHandler.GetEpoch())
 
 	log.Debug("started committing block",
 		"epoch", headerHandler.GetEpoch(),
@@ -789,6 +796,9 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	sp.saveTransactionsMetadata(headerHash, header, body, processedMetaHdrs)
+	sp.saveTransactionsHistory(header, body)
+
 	err = sp.addProcessedCrossMiniBlocksFromHeader(header)
 	if err != nil {
 		return err
@@ -799,16 +809,26 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	err = sp.saveLastNotarizedHeader(core.MetachainShardId, processedMetaHdrs)
+	lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := sp.computeLastNotarizedHeader(core.MetachainShardId, processedMetaHdrs)
 	if err != nil {
 		return err
 	}
 
+	sp.updateCrossShardInfo(processedMetaHdrs, lastCrossNotarizedHeader)
+
 	err = sp.commitAll()
 	if err != nil {
 		return err
 	}
 
+	// the journal is committed before the in-memory head is moved, so a failed commit leaves the node on the previous block
+	err = sp.blockCommitJournal.Commit()
+	if err != nil {
+		return err
+	}
+
+	sp.saveLastNotarizedHeader(core.MetachainShardId, lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash)
+
 	log.Info("shard block has been committed successfully",
 		"epoch", header.Epoch,
 		"round", header.Round,
@@ -817,12 +837,7 @@ func (sp *shardProcessor) CommitBlock(
 		"hash", headerHash,
 	)
 
-	errNotCritical := sp.updateCrossShardInfo(processedMetaHdrs)
-	if errNotCritical != nil {
-		log.Debug("updateCrossShardInfo", "error", errNotCritical.Error())
-	}
-
-	errNotCritical = sp.forkDetector.AddHeader(header, headerHash, process.BHProcessed, selfNotarizedHeaders, selfNotarizedHeadersHashes)
+	errNotCritical := sp.forkDetector.AddHeader(header, headerHash, process.BHProcessed, selfNotarizedHeaders, selfNotarizedHeadersHashes)
 	if errNotCritical != nil {
 		log.Debug("forkDetector.AddHeader", "error", errNotCritical.Error())
 	}
@@ -851,11 +866,6 @@ func (sp *shardProcessor) CommitBlock(
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
 
-	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
-	if err != nil {
-		return err
-	}
-
 	saveMetricsForACommittedBlock(
 		sp.nodesCoordinator,
 		sp.appStatusHandler,
@@ -1073,10 +1083,15 @@ func (sp *shardProcessor) getLastSelfNotarizedHeaderByMetachain() (data.HeaderHa
 	return header, hash
 }
 
-func (sp *shardProcessor) saveLastNotarizedHeader(shardId uint32, processedHdrs []data.HeaderHandler) error {
+// computeLastNotarizedHeader returns the last cross notarized header of the given shard, once the processed headers
+// are notarized
+func (sp *shardProcessor) computeLastNotarizedHeader(
+	shardId uint32,
+	processedHdrs []data.HeaderHandler,
+) (data.HeaderHandler, []byte, error) {
 	lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := sp.blockTracker.GetLastCrossNotarizedHeader(shardId)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	lenProcessedHdrs := len(processedHdrs)
@@ -1085,15 +1100,21 @@ func (sp *shardProcessor) saveLastNotarizedHeader(shardId uint32, processedHdrs
 			lastCrossNotarizedHeader = processedHdrs[lenProcessedHdrs-1]
 			lastCrossNotarizedHeaderHash, err = core.CalculateHash(sp.marshalizer, sp.hasher, lastCrossNotarizedHeader)
 			if err != nil {
-				return err
+				return nil, nil, err
 			}
 		}
 	}
 
+	return lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, nil
+}
+
+func (sp *shardProcessor) saveLastNotarizedHeader(
+	shardId uint32,
+	lastCrossNotarizedHeader data.HeaderHandler,
+	lastCrossNotarizedHeaderHash []byte,
+) {
 	sp.blockTracker.AddCrossNotarizedHeader(shardId, lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash)
 	DisplayLastNotarized(sp.marshalizer, sp.hasher, lastCrossNotarizedHeader, shardId)
-
-	return nil
 }
 
 // ApplyProcessedMiniBlocks will apply processed mini blocks
@@ -1294,12 +1315,7 @@ func (sp *shardProcessor) getOrderedProcessedMetaBlocksFromMiniBlockHashes(
 	return processedMetaHdrs, nil
 }
 
-func (sp *shardProcessor) updateCrossShardInfo(processedMetaHdrs []data.HeaderHandler) error {
-	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
-	if err != nil {
-		return err
-	}
-
+func (sp *shardProcessor) updateCrossShardInfo(processedMetaHdrs []data.HeaderHandler, lastCrossNotarizedHeader data.HeaderHandler) {
 	// processedMetaHdrs is also sorted
 	for i := 0; i < len(processedMetaHdrs); i++ {
 		hdr := processedMetaHdrs[i]
@@ -1322,8 +1338,6 @@ func (sp *shardProcessor) updateCrossShardInfo(processedMetaHdrs []data.HeaderHa
 
 		sp.processedMiniBlocks.RemoveMetaBlockHash(string(headerHash))
 	}
-
-	return nil
 }
 
 // receivedMetaBlock is a callback function when a new metablock was received
@@ -297,6 +297,39 @@ func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilTxsMetadataRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxsMetadataRecorder = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilTransactionsMetadataRecorder, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilTxsHistoryRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxsHistoryRecorder = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilTransactionsHistoryRecorder, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilBlockCommitJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockCommitJournal = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockCommitJournal, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1825,6 +1858,208 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+var shardJournaledUnits = []dataRetriever.UnitType{
+	dataRetriever.BlockHeaderUnit,
+	dataRetriever.ShardHdrNonceHashDataUnit,
+}
+
+// createShardProcessorOverCommitJournal creates a shard processor, with a processed block ready to be committed,
+// which writes its block through the provided block commit journal
+func createShardProcessorOverCommitJournal(
+	t *testing.T,
+	accounts state.AccountsAdapter,
+	blockCommitJournal process.BlockCommitJournal,
+	blockCommitStore dataRetriever.StorageService,
+	headerAdded *bool,
+	headMoved func(),
+) (process.BlockProcessor, *block.Header, *block.Body) {
+	tdp := initDataPool([]byte("tx_hash1"))
+	txHash := []byte("tx_hash1")
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+	hdr := &block.Header{
+		Nonce:           1,
+		Round:           1,
+		PubKeysBitmap:   rootHash,
+		PrevHash:        hdrHash,
+		Signature:       rootHash,
+		RootHash:        rootHash,
+		PrevRandSeed:    randSeed,
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	mb := block.MiniBlock{
+		TxHashes: [][]byte{txHash},
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{&mb}}
+	hdr.MiniBlockHeaders = []block.MiniBlockHeader{
+		{
+			TxCount: uint32(len(mb.TxHashes)),
+			Hash:    hdrHash,
+		},
+	}
+
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = tdp
+	arguments.Store = blockCommitStore
+	arguments.Hasher = hasher
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.BlockCommitJournal = blockCommitJournal
+	arguments.ForkDetector = &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			*headerAdded = true
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+		GetHighestFinalBlockHashCalled: func() []byte {
+			return nil
+		},
+	}
+	blockTrackerMock := mock.NewBlockTrackerMock(mock.NewOneShardCoordinatorMock(), createGenesisBlocks(mock.NewOneShardCoordinatorMock()))
+	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
+		return &block.MetaBlock{}, []byte("hash"), nil
+	}
+	arguments.BlockTracker = blockTrackerMock
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+	blkc.SetCurrentBlockHeaderCalled = func(handler data.HeaderHandler) error {
+		headMoved()
+		return nil
+	}
+	arguments.BlockChain = blkc
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.ProcessBlock(hdr, body, haveTime)
+	assert.Nil(t, err)
+
+	return sp, hdr, body
+}
+
+func getCommittedShardHeader(units map[dataRetriever.UnitType]storage.Storer, hdr *block.Header) ([]byte, []byte) {
+	nonceToByteSlice := (&mock.Uint64ByteSliceConverterMock{}).ToByteSlice(hdr.GetNonce())
+	headerHash, _ := units[dataRetriever.ShardHdrNonceHashDataUnit].Get(nonceToByteSlice)
+	committedHeader, _ := units[dataRetriever.BlockHeaderUnit].Get([]byte("header hash"))
+
+	return headerHash, committedHeader
+}
+
+// testShardProcessorCommitBlockFailure commits a shard block through a block commit journal over memory units, with
+// a failure injected inside CommitBlock, then checks that neither the in-memory head nor the storage moved, also after
+// the journal is recovered on restart
+func testShardProcessorCommitBlockFailure(t *testing.T, fault commitFaultInjection) {
+	reverted := false
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("root hash"), fault.accountsCommitErr
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			reverted = true
+			return nil
+		},
+	}
+	units := createJournaledMemUnits(shardJournaledUnits)
+	blockCommitJournal, blockCommitStore := createCommitJournalOverUnits(t, units, shardJournaledUnits, fault.failingUnits)
+
+	headerAdded := false
+	headMoved := false
+	sp, hdr, body := createShardProcessorOverCommitJournal(t, accounts, blockCommitJournal, blockCommitStore, &headerAdded, func() {
+		headMoved = true
+	})
+
+	err := sp.CommitBlock(hdr, body)
+	assert.NotNil(t, err)
+	assert.True(t, reverted)
+	assert.False(t, headerAdded)
+	assert.False(t, headMoved)
+
+	restartedJournal, _ := createCommitJournalOverUnits(t, units, shardJournaledUnits, nil)
+	err = restartedJournal.Recover()
+	assert.Nil(t, err)
+
+	headerHash, committedHeader := getCommittedShardHeader(units, hdr)
+	assert.Nil(t, headerHash)
+	assert.Nil(t, committedHeader)
+}
+
+func TestShardProcessor_CommitBlockJournalUnitFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	testShardProcessorCommitBlockFailure(t, commitFaultInjection{
+		failingUnits: []dataRetriever.UnitType{dataRetriever.BlockCommitJournalUnit},
+	})
+}
+
+func TestShardProcessor_CommitBlockApplyingTheJournalFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	// the nonce to hash mapping is the first write of the block, so the header unit is not reached either
+	testShardProcessorCommitBlockFailure(t, commitFaultInjection{
+		failingUnits: []dataRetriever.UnitType{dataRetriever.ShardHdrNonceHashDataUnit},
+	})
+}
+
+func TestShardProcessor_CommitBlockAccountsCommitFailsShouldNotMoveTheHead(t *testing.T) {
+	t.Parallel()
+
+	testShardProcessorCommitBlockFailure(t, commitFaultInjection{
+		accountsCommitErr: errors.New("expected error"),
+	})
+}
+
+func TestShardProcessor_CommitBlockShouldApplyTheJournalBeforeMovingTheHead(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+	}
+	units := createJournaledMemUnits(shardJournaledUnits)
+	blockCommitJournal, blockCommitStore := createCommitJournalOverUnits(t, units, shardJournaledUnits, nil)
+
+	headerAdded := false
+	var headerHashWhenMoved, committedHeaderWhenMoved []byte
+	var hdr *block.Header
+	sp, hdr, body := createShardProcessorOverCommitJournal(t, accounts, blockCommitJournal, blockCommitStore, &headerAdded, func() {
+		headerHashWhenMoved, committedHeaderWhenMoved = getCommittedShardHeader(units, hdr)
+	})
+
+	err := sp.CommitBlock(hdr, body)
+	assert.Nil(t, err)
+	assert.True(t, headerAdded)
+	assert.NotNil(t, headerHashWhenMoved)
+	assert.NotNil(t, committedHeaderWhenMoved)
+	time.Sleep(time.Second)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -3017,6 +3252,13 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	arguments.Hasher = hasherMock
 	arguments.Marshalizer = marshalizerMock
 	arguments.TxCoordinator = tc
+	var revertedHistoryHeader data.HeaderHandler
+	arguments.TxsHistoryRecorder = &mock.TransactionsHistoryRecorderStub{
+		RevertBlockCalled: func(header data.HeaderHandler) error {
+			revertedHistoryHeader = header
+			return nil
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	txHashes := make([][]byte, 0)
@@ -3054,8 +3296,10 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		ReceiverShardID: miniblock.ReceiverShardID,
 	}
 
-	err = sp.RestoreBlockIntoPools(&block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}, body)
+	restoredHeader := &block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}
+	err = sp.RestoreBlockIntoPools(restoredHeader, body)
 	assert.Nil(t, err)
+	assert.Equal(t, restoredHeader, revertedHistoryHeader)
 
 	miniblockFromPool, _ := datapool.MiniBlocks().Get(miniblockHash)
 	txFromPool, _ := datapool.Transactions().SearchFirstData(txHash)
@@ -30,6 +30,8 @@ const (
 	BuiltInFunctionCall
 	// RewardTx defines ID of a reward transaction
 	RewardTx
+	// RelayedTx defines ID of a transaction that carries a user transaction whose gas is paid by the relayer
+	RelayedTx
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
 )
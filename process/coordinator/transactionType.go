@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -73,6 +74,10 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) pro
 		return process.MoveBalance
 	}
 
+	if tth.isRelayedTransaction(tx) {
+		return process.RelayedTx
+	}
+
 	isDestInSelfShard, err := tth.isDestAddressInSelfShard(tx.GetRcvAddr())
 	if err != nil {
 		return process.InvalidTransaction
@@ -116,6 +121,25 @@ func (tth *txTypeHandler) isBuiltInFunctionCall(txData []byte) bool {
 	return ok
 }
 
+func (tth *txTypeHandler) isRelayedTransaction(tx data.TransactionHandler) bool {
+	_, isTransaction := tx.(*transaction.Transaction)
+	if !isTransaction {
+		return false
+	}
+
+	err := tth.argumentParser.ParseData(string(tx.GetData()))
+	if err != nil {
+		return false
+	}
+
+	function, err := tth.argumentParser.GetFunction()
+	if err != nil {
+		return false
+	}
+
+	return function == core.RelayedTransaction
+}
+
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	isEmptyAddress := bytes.Equal(tx.GetRcvAddr(), make([]byte, tth.pubkeyConv.Len()))
 	return isEmptyAddress
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -222,3 +223,50 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 	txType := tth.ComputeTransactionType(tx)
 	assert.Equal(t, process.BuiltInFunctionCall, txType)
 }
+
+func TestTxTypeHandler_ComputeTransactionTypeESDTMultiTransferWithSCCallShouldBeBuiltInFunc(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = make([]byte, createMockPubkeyConverter().Len())
+	tx.RcvAddr[len(tx.RcvAddr)-1] = 1
+	tx.Data = []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@544b41@0a@6465706f736974")
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.BuiltInFuncNames[core.BuiltInFunctionESDTMultiTransfer] = struct{}{}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txType := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txType)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeRelayedTx(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.RelayedTransaction + "@aabb")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txType := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.RelayedTx, txType)
+}
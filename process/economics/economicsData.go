@@ -30,6 +30,7 @@ type EconomicsData struct {
 	genesisTotalSupply       *big.Int
 	minInflation             float64
 	maxInflation             float64
+	optimalStakingRatio      float64
 	minStep                  *big.Int
 	unJailPrice              *big.Int
 	auctionEnableNonce       uint64
@@ -70,6 +71,7 @@ func NewEconomicsData(economics *config.EconomicsConfig) (*EconomicsData, error)
 		developerPercentage:      economics.RewardsSettings.DeveloperPercentage,
 		minInflation:             economics.GlobalSettings.MinimumInflation,
 		maxInflation:             economics.GlobalSettings.MaximumInflation,
+		optimalStakingRatio:      economics.GlobalSettings.OptimalStakingRatio,
 		genesisTotalSupply:       data.genesisTotalSupply,
 		minStep:                  data.minStep,
 		auctionEnableNonce:       data.auctionEnableNonce,
@@ -202,6 +204,16 @@ func checkValues(economics *config.EconomicsConfig) error {
 		return process.ErrNilCommunityAddress
 	}
 
+	if economics.GlobalSettings.MinimumInflation > economics.GlobalSettings.MaximumInflation {
+		return process.ErrInvalidInflationRates
+	}
+
+	isOptimalStakingRatioInvalid := economics.GlobalSettings.OptimalStakingRatio <= 0.0 ||
+		economics.GlobalSettings.OptimalStakingRatio > 1.0
+	if isOptimalStakingRatioInvalid {
+		return process.ErrInvalidOptimalStakingRatio
+	}
+
 	return nil
 }
 
@@ -229,6 +241,11 @@ func (ed *EconomicsData) MaxInflationRate() float64 {
 	return ed.maxInflation
 }
 
+// OptimalStakingRatio will return the staking ratio for which the inflation rate is maximum
+func (ed *EconomicsData) OptimalStakingRatio() float64 {
+	return ed.optimalStakingRatio
+}
+
 // GenesisTotalSupply will return the genesis total supply
 func (ed *EconomicsData) GenesisTotalSupply() *big.Int {
 	return ed.genesisTotalSupply
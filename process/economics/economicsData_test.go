@@ -16,9 +16,10 @@ import (
 func createDummyEconomicsConfig() *config.EconomicsConfig {
 	return &config.EconomicsConfig{
 		GlobalSettings: config.GlobalSettings{
-			TotalSupply:      "2000000000000000000000",
-			MinimumInflation: 0,
-			MaximumInflation: 0.05,
+			TotalSupply:         "2000000000000000000000",
+			MinimumInflation:    0,
+			MaximumInflation:    0.05,
+			OptimalStakingRatio: 0.5,
 		},
 		RewardsSettings: config.RewardsSettings{
 			LeaderPercentage:    0.1,
@@ -131,6 +132,37 @@ func TestNewEconomicsData_InvalidLeaderPercentageShouldErr(t *testing.T) {
 
 }
 
+func TestNewEconomicsData_MinimumInflationGreaterThanMaximumInflationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GlobalSettings.MinimumInflation = 0.1
+	economicsConfig.GlobalSettings.MaximumInflation = 0.05
+
+	_, err := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, process.ErrInvalidInflationRates, err)
+}
+
+func TestNewEconomicsData_ZeroOptimalStakingRatioShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GlobalSettings.OptimalStakingRatio = 0
+
+	_, err := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, process.ErrInvalidOptimalStakingRatio, err)
+}
+
+func TestNewEconomicsData_OptimalStakingRatioGreaterThanOneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GlobalSettings.OptimalStakingRatio = 1.1
+
+	_, err := economics.NewEconomicsData(economicsConfig)
+	assert.Equal(t, process.ErrInvalidOptimalStakingRatio, err)
+}
+
 func TestNewEconomicsData_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -151,6 +183,18 @@ func TestEconomicsData_LeaderPercentage(t *testing.T) {
 	assert.Equal(t, leaderPercentage, value)
 }
 
+func TestEconomicsData_OptimalStakingRatio(t *testing.T) {
+	t.Parallel()
+
+	optimalStakingRatio := 0.6
+	economicsConfig := createDummyEconomicsConfig()
+	economicsConfig.GlobalSettings.OptimalStakingRatio = optimalStakingRatio
+	economicsData, _ := economics.NewEconomicsData(economicsConfig)
+
+	value := economicsData.OptimalStakingRatio()
+	assert.Equal(t, optimalStakingRatio, value)
+}
+
 func TestEconomicsData_ComputeFeeNoTxData(t *testing.T) {
 	t.Parallel()
 
@@ -494,6 +494,12 @@ var ErrInvalidUnBondPeriod = errors.New("invalid unbond period")
 // ErrInvalidRewardsPercentages signals that rewards percentages are not correct
 var ErrInvalidRewardsPercentages = errors.New("invalid rewards percentages")
 
+// ErrInvalidInflationRates signals that the minimum inflation rate is greater than the maximum inflation rate
+var ErrInvalidInflationRates = errors.New("invalid inflation rates")
+
+// ErrInvalidOptimalStakingRatio signals that the optimal staking ratio is not in the (0, 1] interval
+var ErrInvalidOptimalStakingRatio = errors.New("invalid optimal staking ratio")
+
 // ErrInvalidNonceRequest signals that invalid nonce was requested
 var ErrInvalidNonceRequest = errors.New("invalid nonce request")
 
@@ -790,3 +796,72 @@ var ErrOriginatorIsBlacklisted = errors.New("originator is blacklisted")
 
 // ErrShardIsStuck signals that a shard is stuck
 var ErrShardIsStuck = errors.New("shard is stuck")
+
+// ErrNilTransactionsHistoryRecorder signals that a nil transactions history recorder has been provided
+var ErrNilTransactionsHistoryRecorder = errors.New("nil transactions history recorder")
+
+// ErrNilTransactionsMetadataRecorder signals that a nil transactions metadata recorder has been provided
+var ErrNilTransactionsMetadataRecorder = errors.New("nil transactions metadata recorder")
+
+// ErrESDTTokenIsPaused signals that the elrond standard digital token is paused
+var ErrESDTTokenIsPaused = errors.New("esdt token is paused")
+
+// ErrESDTIsFrozenForAccount signals that the account is frozen for the given elrond standard digital token
+var ErrESDTIsFrozenForAccount = errors.New("account is frozen for this esdt token")
+
+// ErrESDTIsNotFrozenForAccount signals that the account should be frozen for the requested esdt operation
+var ErrESDTIsNotFrozenForAccount = errors.New("account is not frozen for this esdt token")
+
+// ErrAddressIsNotESDTSystemSC signals that the caller or the receiver of the call is not the ESDT system smart contract
+var ErrAddressIsNotESDTSystemSC = errors.New("address is not the ESDT system smart contract")
+
+// ErrNilUserAccount signals that a nil user account has been provided
+var ErrNilUserAccount = errors.New("nil user account")
+
+// ErrNilESDTPauseHandler signals that a nil esdt pause handler has been provided
+var ErrNilESDTPauseHandler = errors.New("nil esdt pause handler")
+
+// ErrInvalidESDTGlobalSettingsAddress signals that the account is not the ESDT global settings account of the shard
+var ErrInvalidESDTGlobalSettingsAddress = errors.New("invalid ESDT global settings address")
+
+// ErrDuplicateESDTTokenInTransfer signals that the same elrond standard digital token was provided more than once
+var ErrDuplicateESDTTokenInTransfer = errors.New("duplicate esdt token in transfer")
+
+// ErrESDTTransferCallToNonSmartContract signals that a smart contract call was attached to an esdt transfer whose
+// receiver is not a smart contract
+var ErrESDTTransferCallToNonSmartContract = errors.New("esdt transfer with smart contract call to a non smart contract address")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that an invalid transaction version has been provided
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInvalidRelayedTxData signals that the data field of a relayed transaction does not carry a user transaction
+var ErrInvalidRelayedTxData = errors.New("invalid relayed transaction data")
+
+// ErrRecursiveRelayedTxIsNotAllowed signals that a relayed transaction carries another relayed transaction
+var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed transaction is not allowed")
+
+// ErrRelayedTxBeneficiaryDoesNotMatchReceiver signals that the relayed transaction receiver is not the user
+// transaction sender
+var ErrRelayedTxBeneficiaryDoesNotMatchReceiver = errors.New("relayed transaction beneficiary does not match receiver")
+
+// ErrRelayedTxValueMismatch signals that the relayed transaction value differs from the user transaction value
+var ErrRelayedTxValueMismatch = errors.New("relayed transaction value does not match user transaction value")
+
+// ErrRelayedTxGasPriceMismatch signals that the relayed transaction gas price differs from the user transaction gas price
+var ErrRelayedTxGasPriceMismatch = errors.New("relayed transaction gas price does not match user transaction gas price")
+
+// ErrRelayedTxGasLimitMismatch signals that the relayed transaction gas limit does not cover exactly its own move
+// balance cost plus the user transaction gas limit
+var ErrRelayedTxGasLimitMismatch = errors.New("relayed transaction gas limit mismatch")
+
+// ErrNilBlockCommitJournal signals that a nil block commit journal has been provided
+var ErrNilBlockCommitJournal = errors.New("nil block commit journal")
+
+// ErrNilEquivocationEvidenceProcessor signals that a nil equivocation evidence processor has been provided
+var ErrNilEquivocationEvidenceProcessor = errors.New("nil equivocation evidence processor")
+
+// ErrEquivocationEvidenceInEpochStartBlock signals that an epoch start meta block contains equivocation evidence
+var ErrEquivocationEvidenceInEpochStartBlock = errors.New("equivocation evidence in epoch start block")
@@ -41,6 +41,8 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	ChainID                 []byte
+	MinTransactionVersion   uint32
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -72,4 +74,6 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	WhiteListerVerifiedTxs  process.WhiteListHandler
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	ChainID                 []byte
+	MinTransactionVersion   uint32
 }
@@ -477,5 +477,7 @@ func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryAr
 		WhiteListHandler:        &mock.WhiteListHandlerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
+		ChainID:                 []byte("chainID"),
+		MinTransactionVersion:   1,
 	}
 }
@@ -531,5 +531,7 @@ func getArgumentsShard() interceptorscontainer.ShardInterceptorsContainerFactory
 		WhiteListHandler:        &mock.WhiteListHandlerStub{},
 		NonceConverter:          mock.NewNonceHashConverterMock(),
 		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
+		ChainID:                 []byte("chainID"),
+		MinTransactionVersion:   1,
 	}
 }
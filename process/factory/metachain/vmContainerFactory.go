@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	systemVMFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
 	systemVMProcess "github.com/ElrondNetwork/elrond-go/vm/process"
@@ -32,6 +33,7 @@ type vmContainerFactory struct {
 	hasher              hashing.Hasher
 	marshalizer         marshal.Marshalizer
 	systemSCConfig      *config.SystemSmartContractsConfig
+	shardCoordinator    sharding.Coordinator
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -85,6 +87,7 @@ func NewVMContainerFactory(
 		marshalizer:         marshalizer,
 		systemSCConfig:      systemSCConfig,
 		validatorAccountsDB: validatorAccountsDB,
+		shardCoordinator:    argBlockChainHook.ShardCoordinator,
 	}, nil
 }
 
@@ -127,6 +130,7 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 		Hasher:              vmf.hasher,
 		Marshalizer:         vmf.marshalizer,
 		SystemSCConfig:      vmf.systemSCConfig,
+		ShardCoordinator:    vmf.shardCoordinator,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
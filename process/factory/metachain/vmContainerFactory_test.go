@@ -65,9 +65,10 @@ func TestVmContainerFactory_Create(t *testing.T) {
 	economicsData, _ := economics.NewEconomicsData(
 		&config.EconomicsConfig{
 			GlobalSettings: config.GlobalSettings{
-				TotalSupply:      "2000000000000000000000",
-				MinimumInflation: 0,
-				MaximumInflation: 0.05,
+				TotalSupply:         "2000000000000000000000",
+				MinimumInflation:    0,
+				MaximumInflation:    0.05,
+				OptimalStakingRatio: 0.5,
 			},
 			RewardsSettings: config.RewardsSettings{
 				LeaderPercentage:    0.1,
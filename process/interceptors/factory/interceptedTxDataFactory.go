@@ -23,6 +23,8 @@ type interceptedTxDataFactory struct {
 	shardCoordinator       sharding.Coordinator
 	feeHandler             process.FeeHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
+	chainID                []byte
+	minTxVersion           uint32
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -57,6 +59,12 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 	if check.IfNil(argument.WhiteListerVerifiedTxs) {
 		return nil, process.ErrNilWhiteListHandler
 	}
+	if len(argument.ChainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if argument.MinTransactionVersion == 0 {
+		return nil, process.ErrInvalidTransactionVersion
+	}
 
 	return &interceptedTxDataFactory{
 		protoMarshalizer:       argument.ProtoMarshalizer,
@@ -68,6 +76,8 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		shardCoordinator:       argument.ShardCoordinator,
 		feeHandler:             argument.FeeHandler,
 		whiteListerVerifiedTxs: argument.WhiteListerVerifiedTxs,
+		chainID:                argument.ChainID,
+		minTxVersion:           argument.MinTransactionVersion,
 	}, nil
 }
 
@@ -84,6 +94,8 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.shardCoordinator,
 		itdf.feeHandler,
 		itdf.whiteListerVerifiedTxs,
+		itdf.chainID,
+		itdf.minTxVersion,
 	)
 }
 
@@ -427,6 +427,7 @@ type PendingMiniBlocksHandler interface {
 type BlockChainHookHandler interface {
 	TemporaryAccountsHandler
 	SetCurrentHeader(hdr data.HeaderHandler)
+	SetAccountsAdapter(accounts state.AccountsAdapter) error
 	GetBuiltInFunctions() BuiltInFunctionContainer
 	NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
 }
@@ -516,6 +517,7 @@ type RewardsHandler interface {
 	CommunityAddress() string
 	MinInflationRate() float64
 	MaxInflationRate() float64
+	OptimalStakingRatio() float64
 	IsInterfaceNil() bool
 }
 
@@ -565,6 +567,14 @@ type SmartContractToProtocolHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationEvidenceProcessor is able to include equivocation evidence in metachain blocks and to punish the
+// equivocating validators when processing them
+type EquivocationEvidenceProcessor interface {
+	CreateAndProcessEvidence(nonce uint64) ([][]byte, error)
+	ProcessEvidence(evidence [][]byte, nonce uint64) error
+	IsInterfaceNil() bool
+}
+
 // PeerChangesHandler will create the peer changes data for current block and will verify them
 type PeerChangesHandler interface {
 	PeerChanges() []block.PeerData
@@ -593,6 +603,7 @@ type SCQuery struct {
 	ScAddress []byte
 	FuncName  string
 	Arguments [][]byte
+	RootHash  []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -620,6 +631,33 @@ type BootStorer interface {
 	IsInterfaceNil() bool
 }
 
+// BlockCommitJournal defines the write-ahead journal which makes the storage writes of a block commit atomic
+type BlockCommitJournal interface {
+	Begin(epoch uint32)
+	Commit() error
+	Rollback()
+	Recover() error
+	IsInterfaceNil() bool
+}
+
+// TransactionsMetadataRecorder defines the component which records, on block commit, the metadata needed to locate
+// the transactions included in the committed blocks and to compute their status
+type TransactionsMetadataRecorder interface {
+	RecordBlock(headerHash []byte, header data.HeaderHandler, body *block.Body, intermediateTxs map[string]data.TransactionHandler) error
+	RecordNotarizedMetaBlocks(metaBlocks []data.HeaderHandler)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// TransactionsHistoryRecorder defines the component which records, on block commit, the transactions which touched
+// each of the addresses of the current shard, and removes them when the block is reverted
+type TransactionsHistoryRecorder interface {
+	RecordBlock(header data.HeaderHandler, body *block.Body, txs map[string]data.TransactionHandler) error
+	RevertBlock(header data.HeaderHandler) error
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
 // BootstrapperFromStorage is the interface needed by boot component to load data from storage
 type BootstrapperFromStorage interface {
 	LoadFromStorage() error
@@ -759,6 +797,20 @@ type BuiltinFunction interface {
 	IsInterfaceNil() bool
 }
 
+// BuiltinFunctionWithSCCall defines a built-in function which can be followed, in the destination shard, by the call of
+// a smart contract endpoint
+type BuiltinFunctionWithSCCall interface {
+	BuiltinFunction
+	CreateSCCallInput(vmInput *vmcommon.ContractCallInput) (*vmcommon.ContractCallInput, error)
+	CreateRevertTransferData(vmInput *vmcommon.ContractCallInput) ([]byte, error)
+}
+
+// ESDTPauseHandler provides the information whether an elrond standard digital token is paused in the current shard
+type ESDTPauseHandler interface {
+	IsPaused(tokenKey []byte) bool
+	IsInterfaceNil() bool
+}
+
 // BuiltInFunctionContainer defines the methods for the built-in protocol container
 type BuiltInFunctionContainer interface {
 	Get(key string) (BuiltinFunction, error)
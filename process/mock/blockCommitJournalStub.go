@@ -0,0 +1,46 @@
+package mock
+
+// BlockCommitJournalStub -
+type BlockCommitJournalStub struct {
+	BeginCalled    func(epoch uint32)
+	CommitCalled   func() error
+	RollbackCalled func()
+	RecoverCalled  func() error
+}
+
+// Begin -
+func (bcjs *BlockCommitJournalStub) Begin(epoch uint32) {
+	if bcjs.BeginCalled != nil {
+		bcjs.BeginCalled(epoch)
+	}
+}
+
+// Commit -
+func (bcjs *BlockCommitJournalStub) Commit() error {
+	if bcjs.CommitCalled != nil {
+		return bcjs.CommitCalled()
+	}
+
+	return nil
+}
+
+// Rollback -
+func (bcjs *BlockCommitJournalStub) Rollback() {
+	if bcjs.RollbackCalled != nil {
+		bcjs.RollbackCalled()
+	}
+}
+
+// Recover -
+func (bcjs *BlockCommitJournalStub) Recover() error {
+	if bcjs.RecoverCalled != nil {
+		return bcjs.RecoverCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (bcjs *BlockCommitJournalStub) IsInterfaceNil() bool {
+	return bcjs == nil
+}
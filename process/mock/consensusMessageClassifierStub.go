@@ -0,0 +1,39 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+// ConsensusMessageClassifierStub -
+type ConsensusMessageClassifierStub struct {
+	IsMessageWithBlockBodyAndHeaderCalled func(msgType consensus.MessageType) bool
+	IsMessageWithBlockHeaderCalled        func(msgType consensus.MessageType) bool
+	IsMessageWithSignatureCalled          func(msgType consensus.MessageType) bool
+}
+
+// IsMessageWithBlockBodyAndHeader -
+func (cmcs *ConsensusMessageClassifierStub) IsMessageWithBlockBodyAndHeader(msgType consensus.MessageType) bool {
+	if cmcs.IsMessageWithBlockBodyAndHeaderCalled != nil {
+		return cmcs.IsMessageWithBlockBodyAndHeaderCalled(msgType)
+	}
+	return false
+}
+
+// IsMessageWithBlockHeader -
+func (cmcs *ConsensusMessageClassifierStub) IsMessageWithBlockHeader(msgType consensus.MessageType) bool {
+	if cmcs.IsMessageWithBlockHeaderCalled != nil {
+		return cmcs.IsMessageWithBlockHeaderCalled(msgType)
+	}
+	return false
+}
+
+// IsMessageWithSignature -
+func (cmcs *ConsensusMessageClassifierStub) IsMessageWithSignature(msgType consensus.MessageType) bool {
+	if cmcs.IsMessageWithSignatureCalled != nil {
+		return cmcs.IsMessageWithSignatureCalled(msgType)
+	}
+	return false
+}
+
+// IsInterfaceNil -
+func (cmcs *ConsensusMessageClassifierStub) IsInterfaceNil() bool {
+	return cmcs == nil
+}
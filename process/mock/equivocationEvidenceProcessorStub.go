@@ -0,0 +1,28 @@
+package mock
+
+// EquivocationEvidenceProcessorStub -
+type EquivocationEvidenceProcessorStub struct {
+	CreateAndProcessEvidenceCalled func(nonce uint64) ([][]byte, error)
+	ProcessEvidenceCalled          func(evidence [][]byte, nonce uint64) error
+}
+
+// CreateAndProcessEvidence -
+func (eeps *EquivocationEvidenceProcessorStub) CreateAndProcessEvidence(nonce uint64) ([][]byte, error) {
+	if eeps.CreateAndProcessEvidenceCalled != nil {
+		return eeps.CreateAndProcessEvidenceCalled(nonce)
+	}
+	return nil, nil
+}
+
+// ProcessEvidence -
+func (eeps *EquivocationEvidenceProcessorStub) ProcessEvidence(evidence [][]byte, nonce uint64) error {
+	if eeps.ProcessEvidenceCalled != nil {
+		return eeps.ProcessEvidenceCalled(evidence, nonce)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (eeps *EquivocationEvidenceProcessorStub) IsInterfaceNil() bool {
+	return eeps == nil
+}
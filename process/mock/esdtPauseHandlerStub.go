@@ -0,0 +1,20 @@
+package mock
+
+// ESDTPauseHandlerStub -
+type ESDTPauseHandlerStub struct {
+	IsPausedCalled func(tokenKey []byte) bool
+}
+
+// IsPaused -
+func (e *ESDTPauseHandlerStub) IsPaused(tokenKey []byte) bool {
+	if e.IsPausedCalled != nil {
+		return e.IsPausedCalled(tokenKey)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (e *ESDTPauseHandlerStub) IsInterfaceNil() bool {
+	return e == nil
+}
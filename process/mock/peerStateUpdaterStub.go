@@ -0,0 +1,19 @@
+package mock
+
+// PeerStateUpdaterStub -
+type PeerStateUpdaterStub struct {
+	UpdateProtocolForKeysCalled func(keys [][]byte, nonce uint64) error
+}
+
+// UpdateProtocolForKeys -
+func (psus *PeerStateUpdaterStub) UpdateProtocolForKeys(keys [][]byte, nonce uint64) error {
+	if psus.UpdateProtocolForKeysCalled != nil {
+		return psus.UpdateProtocolForKeysCalled(keys, nonce)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (psus *PeerStateUpdaterStub) IsInterfaceNil() bool {
+	return psus == nil
+}
@@ -7,6 +7,7 @@ type RewardsHandlerMock struct {
 	LeaderPercentageCalled    func() float64
 	CommunityPercentageCalled func() float64
 	CommunityAddressCalled    func() string
+	OptimalStakingRatioCalled func() float64
 }
 
 // LeaderPercentage -
@@ -34,6 +35,11 @@ func (rhm *RewardsHandlerMock) MaxInflationRate() float64 {
 	return rhm.MaxInflationRateCalled()
 }
 
+// OptimalStakingRatio -
+func (rhm *RewardsHandlerMock) OptimalStakingRatio() float64 {
+	return rhm.OptimalStakingRatioCalled()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rhm *RewardsHandlerMock) IsInterfaceNil() bool {
 	return rhm == nil
@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// TransactionsHistoryRecorderStub -
+type TransactionsHistoryRecorderStub struct {
+	RecordBlockCalled func(header data.HeaderHandler, body *block.Body, txs map[string]data.TransactionHandler) error
+	RevertBlockCalled func(header data.HeaderHandler) error
+	IsEnabledCalled   func() bool
+}
+
+// RecordBlock -
+func (thrs *TransactionsHistoryRecorderStub) RecordBlock(header data.HeaderHandler, body *block.Body, txs map[string]data.TransactionHandler) error {
+	if thrs.RecordBlockCalled != nil {
+		return thrs.RecordBlockCalled(header, body, txs)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (thrs *TransactionsHistoryRecorderStub) RevertBlock(header data.HeaderHandler) error {
+	if thrs.RevertBlockCalled != nil {
+		return thrs.RevertBlockCalled(header)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (thrs *TransactionsHistoryRecorderStub) IsEnabled() bool {
+	if thrs.IsEnabledCalled != nil {
+		return thrs.IsEnabledCalled()
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (thrs *TransactionsHistoryRecorderStub) IsInterfaceNil() bool {
+	return thrs == nil
+}
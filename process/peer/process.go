@@ -2,6 +2,7 @@ package peer
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -392,7 +393,7 @@ func (vs *validatorStatistics) RootHash() ([]byte, error) {
 }
 
 func (vs *validatorStatistics) getValidatorDataFromLeaves(
-	leaves map[string][]byte,
+	sliceLeaves [][]byte,
 ) (map[uint32][]*state.ValidatorInfo, error) {
 
 	validators := make(map[uint32][]*state.ValidatorInfo, vs.shardCoordinator.NumberOfShards()+1)
@@ -401,8 +402,6 @@ func (vs *validatorStatistics) getValidatorDataFromLeaves(
 	}
 	validators[core.MetachainShardId] = make([]*state.ValidatorInfo, 0)
 
-	sliceLeaves := vs.convertMapToSortedSlice(leaves)
-
 	sort.Slice(sliceLeaves, func(i, j int) bool {
 		return bytes.Compare(sliceLeaves[i], sliceLeaves[j]) < 0
 	})
@@ -472,17 +471,6 @@ func (vs *validatorStatistics) unmarshalPeer(pa []byte) (state.PeerAccountHandle
 	return peerAccount, nil
 }
 
-func (vs *validatorStatistics) convertMapToSortedSlice(leaves map[string][]byte) [][]byte {
-	newLeaves := make([][]byte, len(leaves))
-	i := 0
-	for _, pa := range leaves {
-		newLeaves[i] = pa
-		i++
-	}
-
-	return newLeaves
-}
-
 // GetValidatorInfoForRootHash returns all the peer accounts from the trie with the given rootHash
 func (vs *validatorStatistics) GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
 	sw := core.NewStopWatch()
@@ -492,7 +480,11 @@ func (vs *validatorStatistics) GetValidatorInfoForRootHash(rootHash []byte) (map
 		log.Debug("GetValidatorInfoForRootHash", sw.GetMeasurements()...)
 	}()
 
-	allLeaves, err := vs.peerAdapter.GetAllLeaves(rootHash)
+	allLeaves := make([][]byte, 0)
+	err := vs.peerAdapter.IterateLeaves(context.Background(), rootHash, data.LeavesFilter{}, func(_ []byte, value []byte) error {
+		allLeaves = append(allLeaves, value)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package peer_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -48,9 +49,10 @@ func createMockArguments() peer.ArgValidatorStatisticsProcessor {
 	economicsData, _ := economics.NewEconomicsData(
 		&config.EconomicsConfig{
 			GlobalSettings: config.GlobalSettings{
-				TotalSupply:      "2000000000000000000000",
-				MinimumInflation: 0,
-				MaximumInflation: 0.05,
+				TotalSupply:         "2000000000000000000000",
+				MinimumInflation:    0,
+				MaximumInflation:    0.05,
+				OptimalStakingRatio: 0.5,
 			},
 			RewardsSettings: config.RewardsSettings{
 				LeaderPercentage:    0.1,
@@ -1786,9 +1788,9 @@ func TestValidatorStatistics_RootHashWithErrShouldReturnNil(t *testing.T) {
 	arguments := createMockArguments()
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateLeavesCalled = createIterateLeavesFromMap(func(rootHash []byte) (map[string][]byte, error) {
 		return nil, expectedErr
-	}
+	})
 	arguments.PeerAdapter = peerAdapter
 
 	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
@@ -1814,12 +1816,12 @@ func TestValidatorStatistics_ResetValidatorStatisticsAtNewEpoch(t *testing.T) {
 	validatorInfoMap[string(addrBytes0)] = marshalizedPa0
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateLeavesCalled = createIterateLeavesFromMap(func(rootHash []byte) (map[string][]byte, error) {
 		if bytes.Equal(rootHash, hash) {
 			return validatorInfoMap, nil
 		}
 		return nil, expectedErr
-	}
+	})
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		if bytes.Equal(pa0.GetBLSPublicKey(), address) {
 			return pa0, nil
@@ -1870,12 +1872,12 @@ func TestValidatorStatistics_Process(t *testing.T) {
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateLeavesCalled = createIterateLeavesFromMap(func(rootHash []byte) (map[string][]byte, error) {
 		if bytes.Equal(rootHash, hash) {
 			return validatorInfoMap, nil
 		}
 		return nil, expectedErr
-	}
+	})
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		if bytes.Equal(pa0.GetBLSPublicKey(), address) {
 			return pa0, nil
@@ -1916,12 +1918,12 @@ func TestValidatorStatistics_GetValidatorInfoForRootHash(t *testing.T) {
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateLeavesCalled = createIterateLeavesFromMap(func(rootHash []byte) (map[string][]byte, error) {
 		if bytes.Equal(rootHash, hash) {
 			return validatorInfoMap, nil
 		}
 		return nil, expectedErr
-	}
+	})
 	arguments.PeerAdapter = peerAdapter
 
 	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
@@ -2353,9 +2355,9 @@ func updateArgumentsWithNeeded(arguments peer.ArgValidatorStatisticsProcessor) {
 	validatorInfoMap[string(addrBytes0)] = marshalizedPa0
 	validatorInfoMap[string(addrBytesMeta)] = marshalizedPaMeta
 	peerAdapter := getAccountsMock()
-	peerAdapter.GetAllLeavesCalled = func(rootHash []byte) (m map[string][]byte, err error) {
+	peerAdapter.IterateLeavesCalled = createIterateLeavesFromMap(func(rootHash []byte) (map[string][]byte, error) {
 		return validatorInfoMap, nil
-	}
+	})
 	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
 		return pa0, nil
 	}
@@ -2393,3 +2395,23 @@ func createUpdateTestArgs(consensusGroup map[string][]sharding.Validator) peer.A
 	}
 	return arguments
 }
+
+func createIterateLeavesFromMap(
+	getAllLeaves func(rootHash []byte) (map[string][]byte, error),
+) func(ctx context.Context, rootHash []byte, filter data.LeavesFilter, handler data.LeafHandler) error {
+	return func(_ context.Context, rootHash []byte, _ data.LeavesFilter, handler data.LeafHandler) error {
+		leaves, err := getAllLeaves(rootHash)
+		if err != nil {
+			return err
+		}
+
+		for key, value := range leaves {
+			err = handler([]byte(key), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
-	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 var _ process.SmartContractToProtocolHandler = (*stakingToPeer)(nil)
@@ -138,6 +137,21 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 		return err
 	}
 
+	return stp.updateProtocolForKeys(affectedStates, nonce)
+}
+
+// UpdateProtocolForKeys applies the staking smart contract changes of the provided keys to the peer state. It is used
+// when the staking smart contract was called directly by the protocol, without generating smart contract results
+func (stp *stakingToPeer) UpdateProtocolForKeys(keys [][]byte, nonce uint64) error {
+	affectedStates := make([]string, 0, len(keys))
+	for _, key := range keys {
+		affectedStates = append(affectedStates, string(key))
+	}
+
+	return stp.updateProtocolForKeys(affectedStates, nonce)
+}
+
+func (stp *stakingToPeer) updateProtocolForKeys(affectedStates []string, nonce uint64) error {
 	for _, key := range affectedStates {
 		if len(key) != stp.pubkeyConv.Len() {
 			continue
@@ -151,8 +165,7 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 			FuncName:  "get",
 			Arguments: [][]byte{blsPubKey},
 		}
-		var vmOutput *vmcommon.VMOutput
-		vmOutput, err = stp.scQuery.ExecuteQuery(&query)
+		vmOutput, err := stp.scQuery.ExecuteQuery(&query)
 		if err != nil {
 			return err
 		}
@@ -629,3 +629,47 @@ func TestStakingToPeer_UpdatePeerState(t *testing.T) {
 	_ = stp.updatePeerState(stakingData, blsPubKey, stakingData.UnStakedNonce)
 	assert.Equal(t, string(core.LeavingList), peerAccount.GetList())
 }
+
+func TestStakingToPeer_UpdateProtocolForKeysShouldJailPeer(t *testing.T) {
+	t.Parallel()
+
+	peerAccount := state.NewEmptyPeerAccount()
+	peerAccount.SetListAndIndex(0, string(core.EligibleList), 5)
+	peerState := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return peerAccount, nil
+		},
+	}
+
+	nonce := uint64(20)
+	stakingData := systemSmartContracts.StakedData{
+		RegisterNonce: 1,
+		Staked:        true,
+		RewardAddress: []byte("rwd"),
+		StakeValue:    big.NewInt(100),
+		JailedRound:   nonce,
+		JailedNonce:   nonce,
+		StakedNonce:   1,
+	}
+	queriedKeys := make([][]byte, 0)
+	scDataGetter := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			queriedKeys = append(queriedKeys, query.Arguments[0])
+			retData, _ := json.Marshal(&stakingData)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{retData}}, nil
+		},
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.PeerState = peerState
+	arguments.ScQuery = scDataGetter
+	arguments.VmMarshalizer = &mock.MarshalizerMock{}
+	stp, _ := NewStakingToPeer(arguments)
+
+	blsPubKey := bytes.Repeat([]byte{1}, arguments.PubkeyConv.Len())
+	err := stp.UpdateProtocolForKeys([][]byte{[]byte("nodesConfig"), blsPubKey}, nonce)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{blsPubKey}, queriedKeys)
+	assert.Equal(t, string(core.LeavingList), peerAccount.GetList())
+	assert.Equal(t, arguments.RatingsData.MinRating(), peerAccount.GetTempRating())
+}
@@ -0,0 +1,167 @@
+package slashing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsEquivocationDetector holds the arguments needed to create a new equivocation detector
+type ArgsEquivocationDetector struct {
+	Marshalizer     marshal.Marshalizer
+	Classifier      ConsensusMessageClassifier
+	Verifier        EvidenceVerifier
+	EvidencePool    storage.Cacher
+	Broadcaster     Broadcaster
+	Topic           string
+	NumRoundsToKeep int64
+}
+
+type equivocationDetector struct {
+	marshalizer     marshal.Marshalizer
+	classifier      ConsensusMessageClassifier
+	verifier        EvidenceVerifier
+	evidencePool    storage.Cacher
+	broadcaster     Broadcaster
+	topic           string
+	numRoundsToKeep int64
+
+	mutMessages  sync.Mutex
+	messages     map[string]*consensus.Message
+	reported     map[string]struct{}
+	highestRound int64
+}
+
+// NewEquivocationDetector creates a component which watches the validated consensus messages and, whenever a
+// validator signs two different block header hashes for the same round, broadcasts the evidence
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Classifier) {
+		return nil, ErrNilMessageClassifier
+	}
+	if check.IfNil(args.Verifier) {
+		return nil, ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, ErrNilEvidencePool
+	}
+	if check.IfNil(args.Broadcaster) {
+		return nil, ErrNilBroadcaster
+	}
+	if len(args.Topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+	if args.NumRoundsToKeep < 1 {
+		return nil, ErrInvalidNumRoundsToKeep
+	}
+
+	return &equivocationDetector{
+		marshalizer:     args.Marshalizer,
+		classifier:      args.Classifier,
+		verifier:        args.Verifier,
+		evidencePool:    args.EvidencePool,
+		broadcaster:     args.Broadcaster,
+		topic:           args.Topic,
+		numRoundsToKeep: args.NumRoundsToKeep,
+		messages:        make(map[string]*consensus.Message),
+		reported:        make(map[string]struct{}),
+	}, nil
+}
+
+// AddMessage records a consensus message which already passed the worker validation (signature included).
+// If the same validator already signed a different block header hash in the same round, the evidence is
+// added in the local pool and broadcast
+func (ed *equivocationDetector) AddMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || len(cnsMsg.BlockHeaderHash) == 0 {
+		return
+	}
+
+	category := getCategory(ed.classifier, cnsMsg)
+	if category == categoryNone {
+		return
+	}
+
+	key := fmt.Sprintf("%s_%d_%d", cnsMsg.PubKey, cnsMsg.RoundIndex, category)
+	previous, found := ed.recordMessage(key, cnsMsg)
+	if !found {
+		return
+	}
+
+	ed.reportEquivocation(previous, cnsMsg)
+}
+
+func (ed *equivocationDetector) recordMessage(key string, cnsMsg *consensus.Message) (*consensus.Message, bool) {
+	ed.mutMessages.Lock()
+	defer ed.mutMessages.Unlock()
+
+	if cnsMsg.RoundIndex > ed.highestRound {
+		ed.highestRound = cnsMsg.RoundIndex
+		ed.removeOldMessages()
+	}
+	if cnsMsg.RoundIndex <= ed.highestRound-ed.numRoundsToKeep {
+		return nil, false
+	}
+
+	previous, ok := ed.messages[key]
+	if !ok {
+		ed.messages[key] = cnsMsg
+		return nil, false
+	}
+	if string(previous.BlockHeaderHash) == string(cnsMsg.BlockHeaderHash) {
+		return nil, false
+	}
+
+	_, alreadyReported := ed.reported[key]
+	if alreadyReported {
+		return nil, false
+	}
+	ed.reported[key] = struct{}{}
+
+	return previous, true
+}
+
+func (ed *equivocationDetector) removeOldMessages() {
+	for key, cnsMsg := range ed.messages {
+		if cnsMsg.RoundIndex <= ed.highestRound-ed.numRoundsToKeep {
+			delete(ed.messages, key)
+			delete(ed.reported, key)
+		}
+	}
+}
+
+func (ed *equivocationDetector) reportEquivocation(first *consensus.Message, second *consensus.Message) {
+	evidence, err := CreateEvidence(ed.marshalizer, first, second)
+	if err != nil {
+		log.Debug("equivocationDetector.CreateEvidence", "error", err.Error())
+		return
+	}
+
+	equivocation, err := ed.verifier.Verify(evidence)
+	if err != nil {
+		log.Debug("equivocationDetector: evidence not verified", "error", err.Error())
+		return
+	}
+
+	log.Warn("equivocation detected",
+		"pk", core.GetTrimmedPk(hex.EncodeToString(equivocation.PubKey)),
+		"round", equivocation.Round,
+		"first header hash", first.BlockHeaderHash,
+		"second header hash", second.BlockHeaderHash,
+	)
+
+	_, _ = ed.evidencePool.HasOrAdd(equivocation.ID(), evidence, len(evidence))
+	ed.broadcaster.Broadcast(ed.topic, evidence)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
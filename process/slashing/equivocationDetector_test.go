@@ -0,0 +1,218 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEvidenceTopic = "evidence"
+
+func createMockArgsEquivocationDetector() slashing.ArgsEquivocationDetector {
+	verifier, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	return slashing.ArgsEquivocationDetector{
+		Marshalizer:  testMarshalizer,
+		Classifier:   createTestClassifier(),
+		Verifier:     verifier,
+		EvidencePool: mock.NewCacherMock(),
+		Broadcaster: &mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+		Topic:           testEvidenceTopic,
+		NumRoundsToKeep: 2,
+	}
+}
+
+func TestNewEquivocationDetectorNilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Marshalizer = nil
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+}
+
+func TestNewEquivocationDetectorNilClassifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Classifier = nil
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilMessageClassifier, err)
+}
+
+func TestNewEquivocationDetectorNilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Verifier = nil
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilEvidenceVerifier, err)
+}
+
+func TestNewEquivocationDetectorNilEvidencePoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.EvidencePool = nil
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilEvidencePool, err)
+}
+
+func TestNewEquivocationDetectorNilBroadcasterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = nil
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilBroadcaster, err)
+}
+
+func TestNewEquivocationDetectorEmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Topic = ""
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrEmptyTopic, err)
+}
+
+func TestNewEquivocationDetectorInvalidNumRoundsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.NumRoundsToKeep = 0
+
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrInvalidNumRoundsToKeep, err)
+}
+
+func TestNewEquivocationDetectorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ed, err := slashing.NewEquivocationDetector(createMockArgsEquivocationDetector())
+	assert.False(t, check.IfNil(ed))
+	assert.Nil(t, err)
+}
+
+func TestEquivocationDetector_AddMessageConflictingSignaturesShouldBroadcastOnce(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	var broadcastEvidence []byte
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Equal(t, testEvidenceTopic, topic)
+			numBroadcasts++
+			broadcastEvidence = buff
+		},
+	}
+	ed, _ := slashing.NewEquivocationDetector(args)
+
+	sk, pk := testKeyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+	ed.AddMessage(createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature))
+	assert.Equal(t, 0, numBroadcasts)
+
+	ed.AddMessage(createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash C"), 5, testMsgTypeSignature))
+	require.Equal(t, 1, numBroadcasts)
+
+	verifier, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	equivocation, err := verifier.Verify(broadcastEvidence)
+	require.Nil(t, err)
+	assert.Equal(t, pkBytes, equivocation.PubKey)
+
+	pooled, ok := args.EvidencePool.Peek(equivocation.ID())
+	require.True(t, ok)
+	assert.Equal(t, broadcastEvidence, pooled)
+}
+
+func TestEquivocationDetector_AddMessageDifferentCategoriesShouldNotReport(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := slashing.NewEquivocationDetector(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	ed.AddMessage(createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeProposal))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash C"), 5, testMsgTypeFinalInfo))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash D"), 5, testMsgTypeFinalInfo))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash E"), 6, testMsgTypeSignature))
+
+	assert.Equal(t, 0, numBroadcasts)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_AddMessageOldRoundShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := slashing.NewEquivocationDetector(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	ed.AddMessage(createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash A"), 7, testMsgTypeSignature))
+	ed.AddMessage(createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature))
+
+	assert.Equal(t, 0, numBroadcasts)
+}
+
+func TestEquivocationDetector_AddMessageInvalidEvidenceShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	args := createMockArgsEquivocationDetector()
+	args.Verifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence []byte) (*slashing.Equivocation, error) {
+			return nil, errors.New("invalid evidence")
+		},
+	}
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := slashing.NewEquivocationDetector(args)
+
+	ed.AddMessage(&consensus.Message{BlockHeaderHash: []byte("hash A"), MsgType: int64(testMsgTypeSignature)})
+	ed.AddMessage(&consensus.Message{BlockHeaderHash: []byte("hash B"), MsgType: int64(testMsgTypeSignature)})
+
+	assert.Equal(t, 0, numBroadcasts)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: equivocationEvidence.proto
+
+package slashing
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EquivocationEvidence holds two conflicting consensus messages signed by the same validator for the same round.
+// The messages are kept marshalized, as they were signed, and ordered by their block header hash
+type EquivocationEvidence struct {
+	FirstMessage  []byte `protobuf:"bytes,1,opt,name=FirstMessage,proto3" json:"FirstMessage,omitempty"`
+	SecondMessage []byte `protobuf:"bytes,2,opt,name=SecondMessage,proto3" json:"SecondMessage,omitempty"`
+}
+
+func (m *EquivocationEvidence) Reset()      { *m = EquivocationEvidence{} }
+func (*EquivocationEvidence) ProtoMessage() {}
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc9cf2ba42569dc7, []int{0}
+}
+func (m *EquivocationEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EquivocationEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationEvidence.Merge(m, src)
+}
+func (m *EquivocationEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationEvidence proto.InternalMessageInfo
+
+func (m *EquivocationEvidence) GetFirstMessage() []byte {
+	if m != nil {
+		return m.FirstMessage
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetSecondMessage() []byte {
+	if m != nil {
+		return m.SecondMessage
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EquivocationEvidence)(nil), "proto.EquivocationEvidence")
+}
+
+func init() { proto.RegisterFile("equivocationEvidence.proto", fileDescriptor_cc9cf2ba42569dc7) }
+
+var fileDescriptor_cc9cf2ba42569dc7 = []byte{
+	// 209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2d, 0x2c, 0xcd,
+	0x2c, 0xcb, 0x4f, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x73, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e,
+	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2,
+	0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0x12, 0xb8, 0x44, 0x5c, 0xb1, 0x98, 0x29,
+	0xa4, 0xc4, 0xc5, 0xe3, 0x96, 0x59, 0x54, 0x5c, 0xe2, 0x9b, 0x5a, 0x5c, 0x9c, 0x98, 0x9e, 0x2a,
+	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x84, 0x22, 0x26, 0xa4, 0xc2, 0xc5, 0x1b, 0x9c, 0x9a, 0x9c,
+	0x9f, 0x97, 0x02, 0x53, 0xc4, 0x04, 0x56, 0x84, 0x2a, 0xe8, 0xe4, 0x74, 0xe1, 0xa1, 0x1c, 0xc3,
+	0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0x36, 0x3c, 0x92, 0x63, 0x5c, 0xf1, 0x48, 0x8e,
+	0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x6f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
+	0x8e, 0xf1, 0xc5, 0x23, 0x39, 0x86, 0x0f, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8e, 0xe2, 0x9c, 0xc4, 0xe2, 0x8c, 0xcc, 0xbc,
+	0xf4, 0x24, 0x36, 0xb0, 0x63, 0x8d, 0x01, 0x03, 0x00, 0x05, 0x52, 0xf2, 0xd1, 0x00, 0x01, 0x00,
+	0x00,
+}
+
+func (this *EquivocationEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EquivocationEvidence)
+	if !ok {
+		that2, ok := that.(EquivocationEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.FirstMessage, that1.FirstMessage) {
+		return false
+	}
+	if !bytes.Equal(this.SecondMessage, that1.SecondMessage) {
+		return false
+	}
+	return true
+}
+func (this *EquivocationEvidence) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&slashing.EquivocationEvidence{")
+	s = append(s, "FirstMessage: "+fmt.Sprintf("%#v", this.FirstMessage)+",\n")
+	s = append(s, "SecondMessage: "+fmt.Sprintf("%#v", this.SecondMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEquivocationEvidence(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EquivocationEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondMessage) > 0 {
+		i -= len(m.SecondMessage)
+		copy(dAtA[i:], m.SecondMessage)
+		i = encodeVarintEquivocationEvidence(dAtA, i, uint64(len(m.SecondMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstMessage) > 0 {
+		i -= len(m.FirstMessage)
+		copy(dAtA[i:], m.FirstMessage)
+		i = encodeVarintEquivocationEvidence(dAtA, i, uint64(len(m.FirstMessage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEquivocationEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEquivocationEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EquivocationEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstMessage)
+	if l > 0 {
+		n += 1 + l + sovEquivocationEvidence(uint64(l))
+	}
+	l = len(m.SecondMessage)
+	if l > 0 {
+		n += 1 + l + sovEquivocationEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEquivocationEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEquivocationEvidence(x uint64) (n int) {
+	return sovEquivocationEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EquivocationEvidence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EquivocationEvidence{`,
+		`FirstMessage:` + fmt.Sprintf("%v", this.FirstMessage) + `,`,
+		`SecondMessage:` + fmt.Sprintf("%v", this.SecondMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEquivocationEvidence(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EquivocationEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEquivocationEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstMessage = append(m.FirstMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstMessage == nil {
+				m.FirstMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondMessage = append(m.SecondMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondMessage == nil {
+				m.SecondMessage = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEquivocationEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEquivocationEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEquivocationEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEquivocationEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEquivocationEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEquivocationEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEquivocationEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEquivocationEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEquivocationEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEquivocationEvidence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,102 @@
+package slashing
+
+import "errors"
+
+// ErrNilMarshalizer signals that an operation has been attempted to or with a nil marshalizer implementation
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMessageClassifier signals that a nil consensus message classifier has been provided
+var ErrNilMessageClassifier = errors.New("nil consensus message classifier")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidMaxEvidenceSize signals that an invalid maximum evidence size has been provided
+var ErrInvalidMaxEvidenceSize = errors.New("invalid maximum evidence size")
+
+// ErrNilEvidence signals that a nil evidence has been provided
+var ErrNilEvidence = errors.New("nil equivocation evidence")
+
+// ErrEvidenceTooLarge signals that the provided evidence exceeds the maximum allowed size
+var ErrEvidenceTooLarge = errors.New("equivocation evidence too large")
+
+// ErrNilConsensusMessage signals that a nil consensus message has been provided
+var ErrNilConsensusMessage = errors.New("nil consensus message")
+
+// ErrPublicKeyMismatch signals that the two messages of an evidence were not signed by the same public key
+var ErrPublicKeyMismatch = errors.New("public key mismatch between evidence messages")
+
+// ErrRoundMismatch signals that the two messages of an evidence do not belong to the same round
+var ErrRoundMismatch = errors.New("round mismatch between evidence messages")
+
+// ErrMessageCategoryMismatch signals that the two messages of an evidence are not of the same category
+var ErrMessageCategoryMismatch = errors.New("message category mismatch between evidence messages")
+
+// ErrNotEquivocatingMessageType signals that the message type can not be used as equivocation proof
+var ErrNotEquivocatingMessageType = errors.New("message type can not be used as equivocation proof")
+
+// ErrNotConflictingMessages signals that the two messages of an evidence do not conflict
+var ErrNotConflictingMessages = errors.New("evidence messages are not conflicting")
+
+// ErrInvalidMessagesOrder signals that the two messages of an evidence are not in the canonical order
+var ErrInvalidMessagesOrder = errors.New("evidence messages are not in the canonical order")
+
+// ErrNilBroadcaster signals that a nil broadcaster has been provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrNilEvidenceVerifier signals that a nil evidence verifier has been provided
+var ErrNilEvidenceVerifier = errors.New("nil equivocation evidence verifier")
+
+// ErrNilEvidencePool signals that a nil evidence pool has been provided
+var ErrNilEvidencePool = errors.New("nil equivocation evidence pool")
+
+// ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
+// ErrNilMessage signals that a nil p2p message has been provided
+var ErrNilMessage = errors.New("nil message")
+
+// ErrNilDataToProcess signals that a nil data has been provided for processing
+var ErrNilDataToProcess = errors.New("nil data to process")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilSystemVM signals that a nil system VM has been provided
+var ErrNilSystemVM = errors.New("nil system VM")
+
+// ErrNilPeerStateUpdater signals that a nil peer state updater has been provided
+var ErrNilPeerStateUpdater = errors.New("nil peer state updater")
+
+// ErrInvalidSlashValue signals that an invalid slash value has been provided
+var ErrInvalidSlashValue = errors.New("invalid slash value")
+
+// ErrInvalidMaxEvidencePerBlock signals that an invalid maximum number of evidence per block has been provided
+var ErrInvalidMaxEvidencePerBlock = errors.New("invalid maximum number of evidence per block")
+
+// ErrTooManyEvidence signals that a block contains more evidence than allowed
+var ErrTooManyEvidence = errors.New("too many equivocation evidence in block")
+
+// ErrDuplicatedEvidence signals that the same equivocation has been included twice
+var ErrDuplicatedEvidence = errors.New("duplicated equivocation evidence")
+
+// ErrEquivocationAlreadyPunished signals that the equivocation has already been punished in a previous block
+var ErrEquivocationAlreadyPunished = errors.New("equivocation already punished")
+
+// ErrPunishmentFailed signals that the system smart contract refused to punish the equivocating validator
+var ErrPunishmentFailed = errors.New("equivocation punishment failed")
+
+// ErrWrongTypeAssertion signals that a type assertion failed
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
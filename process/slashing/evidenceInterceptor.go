@@ -0,0 +1,91 @@
+package slashing
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ p2p.MessageProcessor = (*evidenceInterceptor)(nil)
+
+// ArgsEvidenceInterceptor holds the arguments needed to create a new equivocation evidence interceptor
+type ArgsEvidenceInterceptor struct {
+	Verifier         EvidenceVerifier
+	EvidencePool     storage.Cacher
+	AntifloodHandler P2PAntifloodHandler
+	Topic            string
+}
+
+type evidenceInterceptor struct {
+	verifier         EvidenceVerifier
+	evidencePool     storage.Cacher
+	antifloodHandler P2PAntifloodHandler
+	topic            string
+}
+
+// NewEvidenceInterceptor creates the component which receives the equivocation evidence gossiped on the network,
+// verifies it and stores it in the evidence pool
+func NewEvidenceInterceptor(args ArgsEvidenceInterceptor) (*evidenceInterceptor, error) {
+	if check.IfNil(args.Verifier) {
+		return nil, ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, ErrNilEvidencePool
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+	if len(args.Topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+
+	return &evidenceInterceptor{
+		verifier:         args.Verifier,
+		evidencePool:     args.EvidencePool,
+		antifloodHandler: args.AntifloodHandler,
+		topic:            args.Topic,
+	}, nil
+}
+
+// ProcessReceivedMessage verifies the received evidence and adds it to the pool. An error is returned for
+// invalid evidence so that the message is not propagated further
+func (ei *evidenceInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer p2p.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if len(message.Data()) == 0 {
+		return ErrNilDataToProcess
+	}
+
+	err := ei.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = ei.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, ei.topic, 1)
+	if err != nil {
+		return err
+	}
+
+	equivocation, err := ei.verifier.Verify(message.Data())
+	if err != nil {
+		return err
+	}
+
+	has, _ := ei.evidencePool.HasOrAdd(equivocation.ID(), message.Data(), len(message.Data()))
+	if !has {
+		log.Debug("received equivocation evidence",
+			"pk", core.GetTrimmedPk(hex.EncodeToString(equivocation.PubKey)),
+			"round", equivocation.Round,
+		)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *evidenceInterceptor) IsInterfaceNil() bool {
+	return ei == nil
+}
@@ -0,0 +1,133 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFromConnectedPeer = p2p.PeerID("from connected peer")
+
+func createMockArgsEvidenceInterceptor() slashing.ArgsEvidenceInterceptor {
+	verifier, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	return slashing.ArgsEvidenceInterceptor{
+		Verifier:         verifier,
+		EvidencePool:     mock.NewCacherMock(),
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		Topic:            testEvidenceTopic,
+	}
+}
+
+func TestNewEvidenceInterceptorNilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	args.Verifier = nil
+
+	ei, err := slashing.NewEvidenceInterceptor(args)
+	assert.True(t, check.IfNil(ei))
+	assert.Equal(t, slashing.ErrNilEvidenceVerifier, err)
+}
+
+func TestNewEvidenceInterceptorNilEvidencePoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	args.EvidencePool = nil
+
+	ei, err := slashing.NewEvidenceInterceptor(args)
+	assert.True(t, check.IfNil(ei))
+	assert.Equal(t, slashing.ErrNilEvidencePool, err)
+}
+
+func TestNewEvidenceInterceptorNilAntifloodHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	args.AntifloodHandler = nil
+
+	ei, err := slashing.NewEvidenceInterceptor(args)
+	assert.True(t, check.IfNil(ei))
+	assert.Equal(t, slashing.ErrNilAntifloodHandler, err)
+}
+
+func TestNewEvidenceInterceptorEmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	args.Topic = ""
+
+	ei, err := slashing.NewEvidenceInterceptor(args)
+	assert.True(t, check.IfNil(ei))
+	assert.Equal(t, slashing.ErrEmptyTopic, err)
+}
+
+func TestEvidenceInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ei, _ := slashing.NewEvidenceInterceptor(createMockArgsEvidenceInterceptor())
+
+	err := ei.ProcessReceivedMessage(nil, testFromConnectedPeer)
+	assert.Equal(t, slashing.ErrNilMessage, err)
+
+	err = ei.ProcessReceivedMessage(&mock.P2PMessageMock{}, testFromConnectedPeer)
+	assert.Equal(t, slashing.ErrNilDataToProcess, err)
+}
+
+func TestEvidenceInterceptor_ProcessReceivedMessageFloodedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("flooded")
+	args := createMockArgsEvidenceInterceptor()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer p2p.PeerID, topic string, numMessages uint32) error {
+			assert.Equal(t, testFromConnectedPeer, peer)
+			assert.Equal(t, testEvidenceTopic, topic)
+			return expectedErr
+		},
+	}
+	ei, _ := slashing.NewEvidenceInterceptor(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	err := ei.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createValidEvidence(sk, 5)}, testFromConnectedPeer)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEvidenceInterceptor_ProcessReceivedMessageInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	ei, _ := slashing.NewEvidenceInterceptor(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	evidence := createMarshalizedEvidence(first, second)
+
+	err := ei.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: evidence}, testFromConnectedPeer)
+	assert.Equal(t, slashing.ErrNotConflictingMessages, err)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEvidenceInterceptor_ProcessReceivedMessageValidEvidenceShouldAddToPool(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceInterceptor()
+	ei, _ := slashing.NewEvidenceInterceptor(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	evidence := createValidEvidence(sk, 5)
+
+	err := ei.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: evidence}, testFromConnectedPeer)
+	assert.Nil(t, err)
+	err = ei.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: evidence}, testFromConnectedPeer)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, args.EvidencePool.Len())
+}
@@ -0,0 +1,348 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const registryKeyPrefix = "equivocation_"
+
+// ArgsEvidenceProcessor holds the arguments needed to create a new equivocation evidence processor
+type ArgsEvidenceProcessor struct {
+	Verifier            EvidenceVerifier
+	EvidencePool        storage.Cacher
+	Accounts            state.AccountsAdapter
+	SystemVM            vmcommon.VMExecutionHandler
+	PeerStateUpdater    PeerStateUpdater
+	SlashValue          *big.Int
+	MaxEvidencePerBlock int
+}
+
+type evidenceProcessor struct {
+	verifier            EvidenceVerifier
+	evidencePool        storage.Cacher
+	accounts            state.AccountsAdapter
+	systemVM            vmcommon.VMExecutionHandler
+	peerStateUpdater    PeerStateUpdater
+	slashValue          *big.Int
+	maxEvidencePerBlock int
+}
+
+// NewEvidenceProcessor creates the metachain component which includes equivocation evidence in blocks and punishes
+// the equivocating validators by calling the staking system smart contract
+func NewEvidenceProcessor(args ArgsEvidenceProcessor) (*evidenceProcessor, error) {
+	if check.IfNil(args.Verifier) {
+		return nil, ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, ErrNilEvidencePool
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if args.SystemVM == nil {
+		return nil, ErrNilSystemVM
+	}
+	if check.IfNil(args.PeerStateUpdater) {
+		return nil, ErrNilPeerStateUpdater
+	}
+	if args.SlashValue == nil || args.SlashValue.Sign() < 0 {
+		return nil, ErrInvalidSlashValue
+	}
+	if args.MaxEvidencePerBlock < 1 {
+		return nil, ErrInvalidMaxEvidencePerBlock
+	}
+
+	return &evidenceProcessor{
+		verifier:            args.Verifier,
+		evidencePool:        args.EvidencePool,
+		accounts:            args.Accounts,
+		systemVM:            args.SystemVM,
+		peerStateUpdater:    args.PeerStateUpdater,
+		slashValue:          big.NewInt(0).Set(args.SlashValue),
+		maxEvidencePerBlock: args.MaxEvidencePerBlock,
+	}, nil
+}
+
+// CreateAndProcessEvidence selects the evidence from the pool which was not yet punished, punishes the equivocating
+// validators and returns the evidence to be included in the block with the provided nonce
+func (ep *evidenceProcessor) CreateAndProcessEvidence(nonce uint64) ([][]byte, error) {
+	included := make([][]byte, 0)
+	includedIDs := make(map[string]struct{})
+
+	for _, key := range ep.evidencePool.Keys() {
+		if len(included) >= ep.maxEvidencePerBlock {
+			break
+		}
+
+		value, ok := ep.evidencePool.Peek(key)
+		if !ok {
+			continue
+		}
+		evidence, ok := value.([]byte)
+		if !ok {
+			ep.evidencePool.Remove(key)
+			continue
+		}
+
+		equivocation, err := ep.verifier.Verify(evidence)
+		if err != nil {
+			ep.evidencePool.Remove(key)
+			continue
+		}
+
+		id := string(equivocation.ID())
+		_, isIncluded := includedIDs[id]
+		if isIncluded {
+			continue
+		}
+
+		err = ep.punish(equivocation, nonce)
+		if err == ErrEquivocationAlreadyPunished || err == ErrPunishmentFailed {
+			log.Debug("evidenceProcessor.CreateAndProcessEvidence: evidence removed from pool",
+				"pk", core.GetTrimmedPk(hex.EncodeToString(equivocation.PubKey)),
+				"round", equivocation.Round,
+				"reason", err.Error(),
+			)
+			ep.evidencePool.Remove(key)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		includedIDs[id] = struct{}{}
+		included = append(included, evidence)
+	}
+
+	if len(included) == 0 {
+		return nil, nil
+	}
+
+	return included, nil
+}
+
+// ProcessEvidence punishes the equivocating validators proven by the evidence included in the block with the
+// provided nonce. Any invalid, duplicated or already punished evidence makes the whole block invalid
+func (ep *evidenceProcessor) ProcessEvidence(evidence [][]byte, nonce uint64) error {
+	if len(evidence) > ep.maxEvidencePerBlock {
+		return fmt.Errorf("%w: %d evidence, maximum %d", ErrTooManyEvidence, len(evidence), ep.maxEvidencePerBlock)
+	}
+
+	includedIDs := make(map[string]struct{})
+	for _, buff := range evidence {
+		equivocation, err := ep.verifier.Verify(buff)
+		if err != nil {
+			return err
+		}
+
+		id := string(equivocation.ID())
+		_, isIncluded := includedIDs[id]
+		if isIncluded {
+			return ErrDuplicatedEvidence
+		}
+		includedIDs[id] = struct{}{}
+
+		err = ep.punish(equivocation, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ep *evidenceProcessor) punish(equivocation *Equivocation, nonce uint64) error {
+	registry, err := ep.loadUserAccount(factory.JailingAddress)
+	if err != nil {
+		return err
+	}
+
+	registryKey := append([]byte(registryKeyPrefix), equivocation.ID()...)
+	isPunished, err := isRegistered(registry, registryKey)
+	if err != nil {
+		return err
+	}
+	if isPunished {
+		return ErrEquivocationAlreadyPunished
+	}
+
+	vmOutput, err := ep.callStakingSC(equivocation.PubKey)
+	if err != nil {
+		return err
+	}
+
+	modifiedKeys, err := ep.applyStorageUpdates(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	registry, err = ep.loadUserAccount(factory.JailingAddress)
+	if err != nil {
+		return err
+	}
+	registry.DataTrieTracker().SaveKeyValue(registryKey, big.NewInt(0).SetUint64(nonce).Bytes())
+	err = ep.accounts.SaveAccount(registry)
+	if err != nil {
+		return err
+	}
+
+	log.Info("equivocating validator punished",
+		"pk", core.GetTrimmedPk(hex.EncodeToString(equivocation.PubKey)),
+		"round", equivocation.Round,
+		"nonce", nonce,
+		"slash value", ep.slashValue,
+	)
+
+	return ep.peerStateUpdater.UpdateProtocolForKeys(modifiedKeys, nonce)
+}
+
+// callStakingSC slashes the validator if a slash value is configured and the node is still staked, otherwise only
+// jails it. The staking smart contract accepts the slash call only from its owner, which is the contract itself
+func (ep *evidenceProcessor) callStakingSC(pubKey []byte) (*vmcommon.VMOutput, error) {
+	if ep.slashValue.Sign() > 0 {
+		vmOutput, err := ep.runStakingSCCall(factory.StakingSCAddress, "slash", [][]byte{pubKey, ep.slashValue.Bytes()})
+		if err != nil {
+			return nil, err
+		}
+		if vmOutput.ReturnCode == vmcommon.Ok {
+			return vmOutput, nil
+		}
+
+		log.Debug("evidenceProcessor: slash call failed, trying jail",
+			"return code", vmOutput.ReturnCode.String(),
+			"return message", vmOutput.ReturnMessage,
+		)
+	}
+
+	vmOutput, err := ep.runStakingSCCall(factory.JailingAddress, "jail", [][]byte{pubKey})
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		log.Debug("evidenceProcessor: jail call failed",
+			"return code", vmOutput.ReturnCode.String(),
+			"return message", vmOutput.ReturnMessage,
+		)
+		return nil, ErrPunishmentFailed
+	}
+
+	return vmOutput, nil
+}
+
+func (ep *evidenceProcessor) runStakingSCCall(caller []byte, function string, arguments [][]byte) (*vmcommon.VMOutput, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   arguments,
+			CallValue:   big.NewInt(0),
+			GasPrice:    0,
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: factory.StakingSCAddress,
+		Function:      function,
+	}
+
+	vmOutput, err := ep.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, ErrPunishmentFailed
+	}
+
+	return vmOutput, nil
+}
+
+func (ep *evidenceProcessor) applyStorageUpdates(vmOutput *vmcommon.VMOutput) ([][]byte, error) {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	modifiedKeys := make([][]byte, 0)
+	for _, address := range addresses {
+		outAcc := vmOutput.OutputAccounts[address]
+		if len(outAcc.StorageUpdates) == 0 {
+			continue
+		}
+
+		account, err := ep.loadUserAccount(outAcc.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, storageUpdate := range getSortedStorageUpdates(outAcc) {
+			account.DataTrieTracker().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+			if bytes.Equal(outAcc.Address, factory.StakingSCAddress) {
+				modifiedKeys = append(modifiedKeys, storageUpdate.Offset)
+			}
+		}
+
+		err = ep.accounts.SaveAccount(account)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return modifiedKeys, nil
+}
+
+// isRegistered checks the punishment marker in the registry account, whose data trie does not exist until the first
+// punishment is saved
+func isRegistered(registry state.UserAccountHandler, registryKey []byte) (bool, error) {
+	if check.IfNil(registry.DataTrie()) {
+		return false, nil
+	}
+
+	marker, err := registry.DataTrieTracker().RetrieveValue(registryKey)
+	if err != nil {
+		return false, err
+	}
+
+	return len(marker) > 0, nil
+}
+
+func (ep *evidenceProcessor) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	account, err := ep.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func getSortedStorageUpdates(account *vmcommon.OutputAccount) []*vmcommon.StorageUpdate {
+	storageUpdates := make([]*vmcommon.StorageUpdate, 0, len(account.StorageUpdates))
+	for _, update := range account.StorageUpdates {
+		storageUpdates = append(storageUpdates, update)
+	}
+
+	sort.Slice(storageUpdates, func(i, j int) bool {
+		return bytes.Compare(storageUpdates[i].Offset, storageUpdates[j].Offset) < 0
+	})
+
+	return storageUpdates
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *evidenceProcessor) IsInterfaceNil() bool {
+	return ep == nil
+}
@@ -0,0 +1,385 @@
+package slashing_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsStub() *mock.AccountsStub {
+	accounts := make(map[string]state.UserAccountHandler)
+
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, ok := accounts[string(address)]
+			if ok {
+				return account, nil
+			}
+
+			newAccount, _ := state.NewUserAccount(address)
+			newAccount.SetDataTrie(&mock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return nil, nil
+				},
+			})
+			accounts[string(address)] = newAccount
+
+			return newAccount, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+// createVerifierStub returns a verifier which considers the evidence to be the public key of the equivocating
+// validator, for round 1
+func createVerifierStub() *mock.EvidenceVerifierStub {
+	return &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence []byte) (*slashing.Equivocation, error) {
+			if string(evidence) == "invalid" {
+				return nil, errors.New("invalid evidence")
+			}
+
+			return &slashing.Equivocation{PubKey: evidence, Round: 1}, nil
+		},
+	}
+}
+
+func createStakingSCOutput(pubKey []byte) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(factory.StakingSCAddress): {
+				Address: factory.StakingSCAddress,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(pubKey): {Offset: pubKey, Data: []byte("jailed")},
+				},
+			},
+		},
+	}
+}
+
+func createMockArgsEvidenceProcessor() slashing.ArgsEvidenceProcessor {
+	return slashing.ArgsEvidenceProcessor{
+		Verifier:     createVerifierStub(),
+		EvidencePool: mock.NewCacherMock(),
+		Accounts:     createAccountsStub(),
+		SystemVM: &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return createStakingSCOutput(input.Arguments[0]), nil
+			},
+		},
+		PeerStateUpdater:    &mock.PeerStateUpdaterStub{},
+		SlashValue:          big.NewInt(100),
+		MaxEvidencePerBlock: 2,
+	}
+}
+
+func TestNewEvidenceProcessorNilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.Verifier = nil
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrNilEvidenceVerifier, err)
+}
+
+func TestNewEvidenceProcessorNilEvidencePoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.EvidencePool = nil
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrNilEvidencePool, err)
+}
+
+func TestNewEvidenceProcessorNilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.Accounts = nil
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrNilAccountsAdapter, err)
+}
+
+func TestNewEvidenceProcessorNilSystemVMShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.SystemVM = nil
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrNilSystemVM, err)
+}
+
+func TestNewEvidenceProcessorNilPeerStateUpdaterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.PeerStateUpdater = nil
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrNilPeerStateUpdater, err)
+}
+
+func TestNewEvidenceProcessorInvalidSlashValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.SlashValue = big.NewInt(-1)
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrInvalidSlashValue, err)
+}
+
+func TestNewEvidenceProcessorInvalidMaxEvidencePerBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.MaxEvidencePerBlock = 0
+
+	ep, err := slashing.NewEvidenceProcessor(args)
+	assert.True(t, check.IfNil(ep))
+	assert.Equal(t, slashing.ErrInvalidMaxEvidencePerBlock, err)
+}
+
+func TestNewEvidenceProcessorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ep, err := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+	assert.False(t, check.IfNil(ep))
+	assert.Nil(t, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceShouldSlashAndUpdatePeerState(t *testing.T) {
+	t.Parallel()
+
+	calledFunctions := make([]string, 0)
+	var updatedKeys [][]byte
+	args := createMockArgsEvidenceProcessor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			calledFunctions = append(calledFunctions, input.Function)
+			assert.Equal(t, factory.StakingSCAddress, input.CallerAddr)
+			assert.Equal(t, factory.StakingSCAddress, input.RecipientAddr)
+			assert.Equal(t, big.NewInt(100).Bytes(), input.Arguments[1])
+			return createStakingSCOutput(input.Arguments[0]), nil
+		},
+	}
+	args.PeerStateUpdater = &mock.PeerStateUpdaterStub{
+		UpdateProtocolForKeysCalled: func(keys [][]byte, nonce uint64) error {
+			updatedKeys = keys
+			assert.Equal(t, uint64(7), nonce)
+			return nil
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"slash"}, calledFunctions)
+	assert.Equal(t, [][]byte{[]byte("pk1")}, updatedKeys)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceNewRegistryAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return state.NewUserAccount(address)
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	assert.Nil(t, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceSlashFailedShouldJail(t *testing.T) {
+	t.Parallel()
+
+	calledFunctions := make([]string, 0)
+	args := createMockArgsEvidenceProcessor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			calledFunctions = append(calledFunctions, input.Function)
+			if input.Function == "slash" {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			}
+
+			assert.Equal(t, factory.JailingAddress, input.CallerAddr)
+			return createStakingSCOutput(input.Arguments[0]), nil
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"slash", "jail"}, calledFunctions)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceZeroSlashValueShouldOnlyJail(t *testing.T) {
+	t.Parallel()
+
+	calledFunctions := make([]string, 0)
+	args := createMockArgsEvidenceProcessor()
+	args.SlashValue = big.NewInt(0)
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			calledFunctions = append(calledFunctions, input.Function)
+			return createStakingSCOutput(input.Arguments[0]), nil
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"jail"}, calledFunctions)
+}
+
+func TestEvidenceProcessor_ProcessEvidencePunishmentFailedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	assert.Equal(t, slashing.ErrPunishmentFailed, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceTooManyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}, 7)
+	assert.True(t, errors.Is(err, slashing.ErrTooManyEvidence))
+}
+
+func TestEvidenceProcessor_ProcessEvidenceInvalidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	err := ep.ProcessEvidence([][]byte{[]byte("invalid")}, 7)
+	assert.NotNil(t, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceDuplicatedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1"), []byte("pk1")}, 7)
+	assert.Equal(t, slashing.ErrDuplicatedEvidence, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidenceAlreadyPunishedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 7)
+	require.Nil(t, err)
+
+	err = ep.ProcessEvidence([][]byte{[]byte("pk1")}, 8)
+	assert.Equal(t, slashing.ErrEquivocationAlreadyPunished, err)
+}
+
+func TestEvidenceProcessor_CreateAndProcessEvidenceEmptyPoolShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slashing.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	evidence, err := ep.CreateAndProcessEvidence(7)
+	assert.Nil(t, err)
+	assert.Nil(t, evidence)
+}
+
+func TestEvidenceProcessor_CreateAndProcessEvidenceShouldSkipInvalidAndPunished(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.MaxEvidencePerBlock = 10
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	err := ep.ProcessEvidence([][]byte{[]byte("pk1")}, 6)
+	require.Nil(t, err)
+
+	args.EvidencePool.Put([]byte("key0"), []byte("pk1"), 0)
+	args.EvidencePool.Put([]byte("key1"), []byte("invalid"), 0)
+	args.EvidencePool.Put([]byte("key2"), "not a byte slice", 0)
+	args.EvidencePool.Put([]byte("key3"), []byte("pk2"), 0)
+
+	evidence, err := ep.CreateAndProcessEvidence(7)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("pk2")}, evidence)
+
+	_, found := args.EvidencePool.Peek([]byte("key0"))
+	assert.False(t, found)
+	_, found = args.EvidencePool.Peek([]byte("key1"))
+	assert.False(t, found)
+	_, found = args.EvidencePool.Peek([]byte("key2"))
+	assert.False(t, found)
+}
+
+func TestEvidenceProcessor_CreateAndProcessEvidenceShouldRespectMaxEvidencePerBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	ep, _ := slashing.NewEvidenceProcessor(args)
+
+	args.EvidencePool.Put([]byte("key1"), []byte("pk1"), 0)
+	args.EvidencePool.Put([]byte("key2"), []byte("pk2"), 0)
+	args.EvidencePool.Put([]byte("key3"), []byte("pk3"), 0)
+
+	evidence, err := ep.CreateAndProcessEvidence(7)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(evidence))
+}
+
+func TestEvidenceProcessor_CreateAndProcessEvidenceVMErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("vm error")
+	args := createMockArgsEvidenceProcessor()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	ep, _ := slashing.NewEvidenceProcessor(args)
+	args.EvidencePool.Put([]byte("key1"), []byte("pk1"), 0)
+
+	evidence, err := ep.CreateAndProcessEvidence(7)
+	assert.Nil(t, evidence)
+	assert.Equal(t, expectedErr, err)
+}
@@ -0,0 +1,236 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. equivocationEvidence.proto
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("process/slashing")
+
+const (
+	categoryNone = iota
+	categoryProposal
+	categorySignature
+)
+
+// Equivocation describes a validator that signed two conflicting consensus messages in the same round
+type Equivocation struct {
+	PubKey []byte
+	Round  int64
+}
+
+// ID returns the identifier of the equivocation. A validator is punished at most once for each round
+func (e *Equivocation) ID() []byte {
+	return []byte(fmt.Sprintf("%s_%d", e.PubKey, e.Round))
+}
+
+// ArgsEvidenceVerifier holds the arguments needed to create a new equivocation evidence verifier
+type ArgsEvidenceVerifier struct {
+	Marshalizer     marshal.Marshalizer
+	KeyGenerator    crypto.KeyGenerator
+	SingleSigner    crypto.SingleSigner
+	Classifier      ConsensusMessageClassifier
+	ChainID         []byte
+	MaxEvidenceSize int
+}
+
+type evidenceVerifier struct {
+	marshalizer     marshal.Marshalizer
+	keyGen          crypto.KeyGenerator
+	singleSigner    crypto.SingleSigner
+	classifier      ConsensusMessageClassifier
+	chainID         []byte
+	maxEvidenceSize int
+}
+
+// NewEvidenceVerifier creates a component able to check equivocation evidence. The verification only depends on
+// the evidence content, so all the nodes reach the same result for the same evidence
+func NewEvidenceVerifier(args ArgsEvidenceVerifier) (*evidenceVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.Classifier) {
+		return nil, ErrNilMessageClassifier
+	}
+	if len(args.ChainID) == 0 {
+		return nil, ErrInvalidChainID
+	}
+	if args.MaxEvidenceSize <= 0 {
+		return nil, ErrInvalidMaxEvidenceSize
+	}
+
+	return &evidenceVerifier{
+		marshalizer:     args.Marshalizer,
+		keyGen:          args.KeyGenerator,
+		singleSigner:    args.SingleSigner,
+		classifier:      args.Classifier,
+		chainID:         args.ChainID,
+		maxEvidenceSize: args.MaxEvidenceSize,
+	}, nil
+}
+
+// Verify checks that the provided marshalized evidence holds two conflicting consensus messages, in canonical
+// order, signed by the same validator for the same round
+func (ev *evidenceVerifier) Verify(evidence []byte) (*Equivocation, error) {
+	if len(evidence) == 0 {
+		return nil, ErrNilEvidence
+	}
+	if len(evidence) > ev.maxEvidenceSize {
+		return nil, fmt.Errorf("%w: size %d, maximum %d", ErrEvidenceTooLarge, len(evidence), ev.maxEvidenceSize)
+	}
+
+	eqEvidence := &EquivocationEvidence{}
+	err := ev.marshalizer.Unmarshal(eqEvidence, evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := ev.unmarshalMessage(eqEvidence.FirstMessage)
+	if err != nil {
+		return nil, err
+	}
+	second, err := ev.unmarshalMessage(eqEvidence.SecondMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ev.checkConflict(first, second)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ev.checkSignature(first)
+	if err != nil {
+		return nil, err
+	}
+	err = ev.checkSignature(second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Equivocation{
+		PubKey: first.PubKey,
+		Round:  first.RoundIndex,
+	}, nil
+}
+
+func (ev *evidenceVerifier) unmarshalMessage(buff []byte) (*consensus.Message, error) {
+	if len(buff) == 0 {
+		return nil, ErrNilConsensusMessage
+	}
+
+	cnsMsg := &consensus.Message{}
+	err := ev.marshalizer.Unmarshal(cnsMsg, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return cnsMsg, nil
+}
+
+func (ev *evidenceVerifier) checkConflict(first *consensus.Message, second *consensus.Message) error {
+	if !bytes.Equal(first.PubKey, second.PubKey) {
+		return ErrPublicKeyMismatch
+	}
+	if first.RoundIndex != second.RoundIndex {
+		return ErrRoundMismatch
+	}
+	if !bytes.Equal(first.ChainID, ev.chainID) || !bytes.Equal(second.ChainID, ev.chainID) {
+		return ErrInvalidChainID
+	}
+
+	category := getCategory(ev.classifier, first)
+	if category == categoryNone {
+		return ErrNotEquivocatingMessageType
+	}
+	if category != getCategory(ev.classifier, second) {
+		return ErrMessageCategoryMismatch
+	}
+
+	if len(first.BlockHeaderHash) == 0 || len(second.BlockHeaderHash) == 0 {
+		return ErrNotConflictingMessages
+	}
+
+	switch bytes.Compare(first.BlockHeaderHash, second.BlockHeaderHash) {
+	case 0:
+		return ErrNotConflictingMessages
+	case 1:
+		return ErrInvalidMessagesOrder
+	}
+
+	return nil
+}
+
+func (ev *evidenceVerifier) checkSignature(cnsMsg *consensus.Message) error {
+	pubKey, err := ev.keyGen.PublicKeyFromByteArray(cnsMsg.PubKey)
+	if err != nil {
+		return err
+	}
+
+	dataNoSig := *cnsMsg
+	dataNoSig.Signature = nil
+	buff, err := ev.marshalizer.Marshal(&dataNoSig)
+	if err != nil {
+		return err
+	}
+
+	return ev.singleSigner.Verify(pubKey, buff, cnsMsg.Signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *evidenceVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
+
+func getCategory(classifier ConsensusMessageClassifier, cnsMsg *consensus.Message) int {
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	if classifier.IsMessageWithBlockBodyAndHeader(msgType) || classifier.IsMessageWithBlockHeader(msgType) {
+		return categoryProposal
+	}
+	if classifier.IsMessageWithSignature(msgType) {
+		return categorySignature
+	}
+
+	return categoryNone
+}
+
+// CreateEvidence packs the two conflicting consensus messages in canonical order and returns the marshalized evidence
+func CreateEvidence(marshalizer marshal.Marshalizer, first *consensus.Message, second *consensus.Message) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if first == nil || second == nil {
+		return nil, ErrNilConsensusMessage
+	}
+
+	if bytes.Compare(first.BlockHeaderHash, second.BlockHeaderHash) > 0 {
+		first, second = second, first
+	}
+
+	firstBuff, err := marshalizer.Marshal(first)
+	if err != nil {
+		return nil, err
+	}
+	secondBuff, err := marshalizer.Marshal(second)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalizer.Marshal(&EquivocationEvidence{
+		FirstMessage:  firstBuff,
+		SecondMessage: secondBuff,
+	})
+}
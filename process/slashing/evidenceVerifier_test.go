@@ -0,0 +1,365 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	ed25519SingleSig "github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMsgTypeProposal  = consensus.MessageType(1)
+	testMsgTypeSignature = consensus.MessageType(2)
+	testMsgTypeFinalInfo = consensus.MessageType(3)
+)
+
+var testChainID = []byte("chain ID")
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testKeyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+var testSingleSigner = &ed25519SingleSig.Ed25519Signer{}
+
+func createTestClassifier() *mock.ConsensusMessageClassifierStub {
+	return &mock.ConsensusMessageClassifierStub{
+		IsMessageWithBlockHeaderCalled: func(msgType consensus.MessageType) bool {
+			return msgType == testMsgTypeProposal
+		},
+		IsMessageWithSignatureCalled: func(msgType consensus.MessageType) bool {
+			return msgType == testMsgTypeSignature
+		},
+	}
+}
+
+func createMockArgsEvidenceVerifier() slashing.ArgsEvidenceVerifier {
+	return slashing.ArgsEvidenceVerifier{
+		Marshalizer:     testMarshalizer,
+		KeyGenerator:    testKeyGen,
+		SingleSigner:    testSingleSigner,
+		Classifier:      createTestClassifier(),
+		ChainID:         testChainID,
+		MaxEvidenceSize: 10000,
+	}
+}
+
+func createSignedMessage(
+	sk crypto.PrivateKey,
+	headerHash []byte,
+	round int64,
+	msgType consensus.MessageType,
+) *consensus.Message {
+	pkBytes, _ := sk.GeneratePublic().ToByteArray()
+	cnsMsg := &consensus.Message{
+		BlockHeaderHash: headerHash,
+		PubKey:          pkBytes,
+		MsgType:         int64(msgType),
+		RoundIndex:      round,
+		ChainID:         testChainID,
+	}
+	buff, _ := testMarshalizer.Marshal(cnsMsg)
+	cnsMsg.Signature, _ = testSingleSigner.Sign(sk, buff)
+
+	return cnsMsg
+}
+
+func createMarshalizedEvidence(first *consensus.Message, second *consensus.Message) []byte {
+	firstBuff, _ := testMarshalizer.Marshal(first)
+	secondBuff, _ := testMarshalizer.Marshal(second)
+	evidence, _ := testMarshalizer.Marshal(&slashing.EquivocationEvidence{
+		FirstMessage:  firstBuff,
+		SecondMessage: secondBuff,
+	})
+
+	return evidence
+}
+
+func createValidEvidence(sk crypto.PrivateKey, round int64) []byte {
+	first := createSignedMessage(sk, []byte("hash A"), round, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash B"), round, testMsgTypeSignature)
+
+	return createMarshalizedEvidence(first, second)
+}
+
+func TestNewEvidenceVerifierNilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.Marshalizer = nil
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+}
+
+func TestNewEvidenceVerifierNilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.KeyGenerator = nil
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrNilKeyGenerator, err)
+}
+
+func TestNewEvidenceVerifierNilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.SingleSigner = nil
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrNilSingleSigner, err)
+}
+
+func TestNewEvidenceVerifierNilClassifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.Classifier = nil
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrNilMessageClassifier, err)
+}
+
+func TestNewEvidenceVerifierEmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.ChainID = nil
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrInvalidChainID, err)
+}
+
+func TestNewEvidenceVerifierInvalidMaxEvidenceSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.MaxEvidenceSize = 0
+
+	ev, err := slashing.NewEvidenceVerifier(args)
+	assert.True(t, check.IfNil(ev))
+	assert.Equal(t, slashing.ErrInvalidMaxEvidenceSize, err)
+}
+
+func TestNewEvidenceVerifierShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ev, err := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	assert.False(t, check.IfNil(ev))
+	assert.Nil(t, err)
+}
+
+func TestEvidenceVerifier_VerifyEmptyEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	equivocation, err := ev.Verify(nil)
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrNilEvidence, err)
+}
+
+func TestEvidenceVerifier_VerifyTooLargeEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.MaxEvidenceSize = 10
+	ev, _ := slashing.NewEvidenceVerifier(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	equivocation, err := ev.Verify(createValidEvidence(sk, 5))
+	assert.Nil(t, equivocation)
+	assert.True(t, errors.Is(err, slashing.ErrEvidenceTooLarge))
+}
+
+func TestEvidenceVerifier_VerifyMissingMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	firstBuff, _ := testMarshalizer.Marshal(first)
+	evidence, _ := testMarshalizer.Marshal(&slashing.EquivocationEvidence{FirstMessage: firstBuff})
+
+	equivocation, err := ev.Verify(evidence)
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrNilConsensusMessage, err)
+}
+
+func TestEvidenceVerifier_VerifyDifferentSignersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk1, _ := testKeyGen.GeneratePair()
+	sk2, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk1, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk2, []byte("hash B"), 5, testMsgTypeSignature)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrPublicKeyMismatch, err)
+}
+
+func TestEvidenceVerifier_VerifyDifferentRoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash B"), 6, testMsgTypeSignature)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrRoundMismatch, err)
+}
+
+func TestEvidenceVerifier_VerifyOtherChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.ChainID = []byte("other chain ID")
+	ev, _ := slashing.NewEvidenceVerifier(args)
+
+	sk, _ := testKeyGen.GeneratePair()
+	equivocation, err := ev.Verify(createValidEvidence(sk, 5))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrInvalidChainID, err)
+}
+
+func TestEvidenceVerifier_VerifyNotEquivocatingMessageTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeFinalInfo)
+	second := createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeFinalInfo)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrNotEquivocatingMessageType, err)
+}
+
+func TestEvidenceVerifier_VerifyDifferentCategoriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeProposal)
+	second := createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrMessageCategoryMismatch, err)
+}
+
+func TestEvidenceVerifier_VerifySameHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrNotConflictingMessages, err)
+}
+
+func TestEvidenceVerifier_VerifyNotCanonicalOrderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature)
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(second, first))
+	assert.Nil(t, equivocation)
+	assert.Equal(t, slashing.ErrInvalidMessagesOrder, err)
+}
+
+func TestEvidenceVerifier_VerifyTamperedMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeSignature)
+	second := createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeSignature)
+	second.BlockHeaderHash = []byte("hash C")
+
+	equivocation, err := ev.Verify(createMarshalizedEvidence(first, second))
+	assert.Nil(t, equivocation)
+	assert.NotNil(t, err)
+}
+
+func TestEvidenceVerifier_VerifyValidEvidenceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, pk := testKeyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	equivocation, err := ev.Verify(createValidEvidence(sk, 5))
+	require.Nil(t, err)
+	assert.Equal(t, pkBytes, equivocation.PubKey)
+	assert.Equal(t, int64(5), equivocation.Round)
+}
+
+func TestCreateEvidence_ShouldBeIndependentOfMessagesOrder(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slashing.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	sk, _ := testKeyGen.GeneratePair()
+	first := createSignedMessage(sk, []byte("hash A"), 5, testMsgTypeProposal)
+	second := createSignedMessage(sk, []byte("hash B"), 5, testMsgTypeProposal)
+
+	evidence1, err := slashing.CreateEvidence(testMarshalizer, first, second)
+	require.Nil(t, err)
+	evidence2, err := slashing.CreateEvidence(testMarshalizer, second, first)
+	require.Nil(t, err)
+	assert.Equal(t, evidence1, evidence2)
+
+	_, err = ev.Verify(evidence1)
+	assert.Nil(t, err)
+}
+
+func TestCreateEvidence_NilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	evidence, err := slashing.CreateEvidence(testMarshalizer, &consensus.Message{}, nil)
+	assert.Nil(t, evidence)
+	assert.Equal(t, slashing.ErrNilConsensusMessage, err)
+}
+
+func TestEquivocation_IDShouldDifferPerRound(t *testing.T) {
+	t.Parallel()
+
+	eq1 := &slashing.Equivocation{PubKey: []byte("pk"), Round: 1}
+	eq2 := &slashing.Equivocation{PubKey: []byte("pk"), Round: 2}
+
+	assert.NotEqual(t, eq1.ID(), eq2.ID())
+	assert.Equal(t, eq1.ID(), (&slashing.Equivocation{PubKey: []byte("pk"), Round: 1}).ID())
+}
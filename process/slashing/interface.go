@@ -0,0 +1,40 @@
+package slashing
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ConsensusMessageClassifier is able to tell which consensus message types carry a block proposal or a signature share
+type ConsensusMessageClassifier interface {
+	IsMessageWithBlockBodyAndHeader(consensus.MessageType) bool
+	IsMessageWithBlockHeader(consensus.MessageType) bool
+	IsMessageWithSignature(consensus.MessageType) bool
+	IsInterfaceNil() bool
+}
+
+// EvidenceVerifier is able to check that a marshalized evidence proves an equivocation
+type EvidenceVerifier interface {
+	Verify(evidence []byte) (*Equivocation, error)
+	IsInterfaceNil() bool
+}
+
+// Broadcaster is able to send a buffer on a given topic
+type Broadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
+
+// PeerStateUpdater is able to move the staking smart contract data of the provided keys into the peer state
+type PeerStateUpdater interface {
+	UpdateProtocolForKeys(keys [][]byte, nonce uint64) error
+	IsInterfaceNil() bool
+}
+
+// P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded)
+// processing p2p messages
+type P2PAntifloodHandler interface {
+	CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer p2p.PeerID) error
+	CanProcessMessagesOnTopic(peer p2p.PeerID, topic string, numMessages uint32) error
+	IsInterfaceNil() bool
+}
@@ -4,6 +4,7 @@
 package builtInFunctions
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -29,7 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ESDigitalToken holds the data for a elrond standard digital token transaction
 type ESDigitalToken struct {
-	Value *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Value      *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Properties []byte        `protobuf:"bytes,2,opt,name=Properties,proto3" json:"properties"`
 }
 
 func (m *ESDigitalToken) Reset()      { *m = ESDigitalToken{} }
@@ -67,6 +69,13 @@ func (m *ESDigitalToken) GetValue() *math_big.Int {
 	return nil
 }
 
+func (m *ESDigitalToken) GetProperties() []byte {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ESDigitalToken)(nil), "protoBuiltInFunctions.ESDigitalToken")
 }
@@ -74,24 +83,25 @@ func init() {
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4a, 0x2d, 0x4e, 0x29,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x05, 0x53, 0x4e, 0xa5, 0x99, 0x39, 0x25, 0x9e,
-	0x79, 0x6e, 0xa5, 0x79, 0xc9, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0x65, 0x49, 0xa5,
-	0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0x4c, 0x51, 0x2a, 0xe3, 0xe2, 0x73, 0x0d, 0x76, 0xc9,
-	0x4c, 0xcf, 0x2c, 0x49, 0xcc, 0x09, 0xc9, 0xcf, 0x4e, 0xcd, 0x13, 0x4a, 0xe1, 0x62, 0x0d, 0x4b,
-	0xcc, 0x29, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x71, 0xf2, 0x7b, 0x75, 0x4f, 0x9e, 0xb5,
-	0x0c, 0x24, 0xb0, 0xea, 0xbe, 0xbc, 0x63, 0x6e, 0x62, 0x49, 0x86, 0x7e, 0x52, 0x66, 0xba, 0x9e,
-	0x67, 0x5e, 0x89, 0x35, 0x92, 0x4d, 0xae, 0x39, 0x45, 0xf9, 0x79, 0x29, 0x7e, 0xa9, 0x25, 0xe5,
-	0xf9, 0x45, 0xd9, 0xfa, 0xa9, 0x60, 0x9e, 0x6e, 0x7a, 0xbe, 0x7e, 0x4a, 0x62, 0x49, 0xa2, 0x9e,
-	0x53, 0x66, 0xba, 0x67, 0x5e, 0x89, 0x73, 0x62, 0x71, 0x49, 0x6a, 0x51, 0x10, 0xc4, 0x70, 0x27,
-	0xaf, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50, 0x8e, 0xb1, 0xe1, 0x91,
-	0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x78, 0xe3,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x09, 0x24, 0xa1,
-	0x79, 0x39, 0x89, 0x0d, 0xec, 0x15, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x85, 0x25,
-	0x21, 0x1e, 0x01, 0x00, 0x00,
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
+	0x14, 0x85, 0x67, 0x7e, 0xc8, 0x5f, 0x0c, 0x12, 0x24, 0x20, 0x04, 0x8b, 0x1b, 0xb1, 0xb2, 0xc9,
+	0x6e, 0x61, 0x69, 0xe5, 0x6a, 0x84, 0xb5, 0x08, 0x12, 0xc5, 0xc2, 0x6e, 0x36, 0x3b, 0x4e, 0x86,
+	0x6c, 0x66, 0x96, 0xd9, 0xbb, 0xda, 0xfa, 0x08, 0x3e, 0x85, 0x88, 0x4f, 0x62, 0xb9, 0xe5, 0x56,
+	0xd1, 0x9d, 0x6d, 0x24, 0x55, 0x1e, 0x41, 0x9c, 0x80, 0x04, 0xab, 0x7b, 0xbf, 0xc3, 0xe1, 0x1c,
+	0xee, 0x65, 0x4c, 0x14, 0x29, 0x06, 0xb9, 0x35, 0x68, 0x7a, 0x7b, 0x7e, 0x44, 0xa5, 0xca, 0x30,
+	0xd6, 0x17, 0xa5, 0x9e, 0xa2, 0x32, 0xba, 0xd8, 0x1f, 0x4a, 0x85, 0xb3, 0x32, 0x09, 0xa6, 0x66,
+	0x11, 0x4a, 0x23, 0x4d, 0xe8, 0x6d, 0x49, 0x79, 0xef, 0xc9, 0x83, 0xdf, 0x36, 0x29, 0x87, 0x2f,
+	0x94, 0x75, 0x47, 0xd7, 0xe7, 0x4a, 0x2a, 0xe4, 0xd9, 0x8d, 0x99, 0x0b, 0xdd, 0x4b, 0x59, 0xe7,
+	0x96, 0x67, 0xa5, 0xe8, 0xd3, 0x03, 0x7a, 0xb4, 0x13, 0x8d, 0x57, 0xcb, 0x41, 0xe7, 0xe1, 0x47,
+	0x78, 0xfb, 0x18, 0x9c, 0x2e, 0x38, 0xce, 0xc2, 0x44, 0xc9, 0x20, 0xd6, 0x78, 0xb2, 0x55, 0x35,
+	0xca, 0xac, 0xd1, 0xe9, 0x58, 0xe0, 0xa3, 0xb1, 0xf3, 0x50, 0x78, 0x1a, 0x4a, 0x13, 0xa6, 0x1c,
+	0x79, 0x10, 0x29, 0x19, 0x6b, 0x3c, 0xe3, 0x05, 0x0a, 0x3b, 0xd9, 0x84, 0xf7, 0x02, 0xc6, 0xae,
+	0xac, 0xc9, 0x85, 0x45, 0x25, 0x8a, 0xfe, 0x3f, 0x5f, 0xd5, 0x5d, 0x2d, 0x07, 0x2c, 0xff, 0x55,
+	0x27, 0x5b, 0x8e, 0xe8, 0xb2, 0x6a, 0x80, 0xd4, 0x0d, 0x90, 0x75, 0x03, 0xf4, 0xc9, 0x01, 0x7d,
+	0x75, 0x40, 0xdf, 0x1d, 0xd0, 0xca, 0x01, 0xad, 0x1d, 0xd0, 0x4f, 0x07, 0xf4, 0xcb, 0x01, 0x59,
+	0x3b, 0xa0, 0xcf, 0x2d, 0x90, 0xaa, 0x05, 0x52, 0xb7, 0x40, 0xee, 0x76, 0x93, 0x3f, 0x3f, 0x4a,
+	0xfe, 0xfb, 0xdb, 0x8f, 0xbf, 0x07, 0x00, 0xa7, 0xce, 0x93, 0x19, 0x4f, 0x01, 0x00, 0x00,
 }
 
 func (this *ESDigitalToken) Equal(that interface{}) bool {
@@ -119,15 +129,19 @@ func (this *ESDigitalToken) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Properties, that1.Properties) {
+		return false
+	}
 	return true
 }
 func (this *ESDigitalToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&builtInFunctions.ESDigitalToken{")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -159,6 +173,13 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Properties) > 0 {
+		i -= len(m.Properties)
+		copy(dAtA[i:], m.Properties)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Properties)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.Value)
@@ -195,6 +216,10 @@ func (m *ESDigitalToken) Size() (n int) {
 		l = __caster.Size(m.Value)
 		n += 1 + l + sovEsdt(uint64(l))
 	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
 	return n
 }
 
@@ -210,6 +235,7 @@ func (this *ESDigitalToken) String() string {
 	}
 	s := strings.Join([]string{`&ESDigitalToken{`,
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -289,6 +315,40 @@ func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties[:0], dAtA[iNdEx:postIndex]...)
+			if m.Properties == nil {
+				m.Properties = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -0,0 +1,136 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunctionWithSCCall = (*esdtBurn)(nil)
+
+type esdtBurn struct {
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	keyPrefix    []byte
+	pauseHandler process.ESDTPauseHandler
+}
+
+// NewESDTBurnFunc returns the esdt burn built-in function component. The burn transaction has to be sent to the ESDT
+// system smart contract, which will account the burnt value on the metachain. The system smart contract is called only
+// by this built-in function, on the metachain, after the burnt value was removed in the sender shard
+func NewESDTBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilESDTPauseHandler
+	}
+
+	return &esdtBurn{
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		keyPrefix:    createESDTKeyPrefix(),
+		pauseHandler: pauseHandler,
+	}, nil
+}
+
+// ProcessBuiltinFunction will remove the burnt value from the esdt balance of the sender. On the metachain, where the
+// sender account is missing, only the arguments are checked
+func (e *esdtBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	value, err := e.checkArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(acntSnd) {
+		return &vmcommon.VMOutput{}, nil
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	if e.pauseHandler.IsPaused(esdtTokenKey) {
+		return nil, process.ErrESDTTokenIsPaused
+	}
+
+	err = addToESDTBalance(acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtBurn", "sender", vmInput.CallerAddr, "value", value, "token", esdtTokenKey)
+
+	// the remaining gas is given back by the metachain, after the ESDT system smart contract accounts the burnt value
+	return &vmcommon.VMOutput{GasRemaining: 0}, nil
+}
+
+// CreateSCCallInput returns the input of the ESDT system smart contract call which accounts the burnt value. The call
+// is marked with the built-in function call type, which the system smart contract requires
+func (e *esdtBurn) CreateSCCallInput(vmInput *vmcommon.ContractCallInput) (*vmcommon.ContractCallInput, error) {
+	_, err := e.checkArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	scCallInput := &vmcommon.ContractCallInput{
+		VMInput:       vmInput.VMInput,
+		RecipientAddr: vmInput.RecipientAddr,
+		Function:      core.BuiltInFunctionESDTBurn,
+	}
+	scCallInput.CallType = vm.BuiltInFunctionCallType
+	scCallInput.CallValue = big.NewInt(0)
+	scCallInput.GasProvided = vmInput.GasProvided - e.funcGasCost
+
+	return scCallInput, nil
+}
+
+// CreateRevertTransferData returns no data: the metachain can not tell whether the burnt value was removed in the
+// sender shard, so nothing is given back if the ESDT system smart contract call fails
+func (e *esdtBurn) CreateRevertTransferData(_ *vmcommon.ContractCallInput) ([]byte, error) {
+	return nil, nil
+}
+
+func (e *esdtBurn) checkArguments(vmInput *vmcommon.ContractCallInput) (*big.Int, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+	if !bytes.Equal(vmInput.RecipientAddr, factory.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+
+	return value, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtBurn) IsInterfaceNil() bool {
+	return e == nil
+}
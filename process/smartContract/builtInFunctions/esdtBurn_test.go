@@ -0,0 +1,168 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTBurnInput(tokenName []byte, value *big.Int) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("snd"),
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{tokenName, value.Bytes()},
+			GasProvided: 50,
+		},
+		RecipientAddr: factory.ESDTSCAddress,
+	}
+}
+
+func TestNewESDTBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	burn, err := NewESDTBurnFunc(10, nil, &mock.ESDTPauseHandlerStub{})
+	assert.True(t, check.IfNil(burn))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	burn, err = NewESDTBurnFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.True(t, check.IfNil(burn))
+	assert.Equal(t, process.ErrNilESDTPauseHandler, err)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	burn, _ := NewESDTBurnFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	_, err := burn.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	key := []byte("key")
+	input := createESDTBurnInput(key, big.NewInt(10))
+	input.Arguments = input.Arguments[:1]
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTBurnInput(key, big.NewInt(0))
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createESDTBurnInput(key, big.NewInt(10))
+	input.RecipientAddr = []byte("dst")
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input = createESDTBurnInput(key, big.NewInt(10))
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	input.GasProvided = burn.funcGasCost - 1
+	_, err = burn.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createESDTBurnInput(key, big.NewInt(10))
+	_, err = burn.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burn, _ := NewESDTBurnFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	key := []byte("key")
+	esdtKey := append(burn.keyPrefix, key...)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	vmOutput, err := burn.ProcessBuiltinFunction(accSnd, nil, createESDTBurnInput(key, big.NewInt(30)))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+
+	esdtToken, _ = getESDTDataFromKey(accSnd, esdtKey, marshalizer)
+	assert.Equal(t, big.NewInt(70), esdtToken.Value)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionOnMetachainShouldNotChangeBalances(t *testing.T) {
+	t.Parallel()
+
+	burn, _ := NewESDTBurnFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenKey []byte) bool {
+			assert.Fail(t, "the pause state should be checked only in the sender shard")
+			return true
+		},
+	})
+
+	vmOutput, err := burn.ProcessBuiltinFunction(nil, nil, createESDTBurnInput([]byte("key"), big.NewInt(30)))
+	assert.Nil(t, err)
+	assert.Equal(t, &vmcommon.VMOutput{}, vmOutput)
+}
+
+func TestESDTBurn_CreateSCCallInputShouldMarkTheCallAsBuiltInFunctionCall(t *testing.T) {
+	t.Parallel()
+
+	burn, _ := NewESDTBurnFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+
+	_, err := burn.CreateSCCallInput(nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createESDTBurnInput([]byte("key"), big.NewInt(30))
+	input.GasProvided = burn.funcGasCost - 1
+	_, err = burn.CreateSCCallInput(input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createESDTBurnInput([]byte("key"), big.NewInt(30))
+	scCallInput, err := burn.CreateSCCallInput(input)
+	assert.Nil(t, err)
+	assert.Equal(t, vm.BuiltInFunctionCallType, scCallInput.CallType)
+	assert.Equal(t, core.BuiltInFunctionESDTBurn, scCallInput.Function)
+	assert.Equal(t, factory.ESDTSCAddress, scCallInput.RecipientAddr)
+	assert.Equal(t, input.CallerAddr, scCallInput.CallerAddr)
+	assert.Equal(t, input.Arguments, scCallInput.Arguments)
+	assert.Equal(t, input.GasProvided-burn.funcGasCost, scCallInput.GasProvided)
+	assert.Equal(t, vmcommon.DirectCall, input.CallType)
+
+	revertData, err := burn.CreateRevertTransferData(input)
+	assert.Nil(t, err)
+	assert.Nil(t, revertData)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionPausedOrFrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	paused := true
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenKey []byte) bool {
+			return paused
+		},
+	}
+	burn, _ := NewESDTBurnFunc(10, marshalizer, pauseHandler)
+
+	key := []byte("key")
+	esdtKey := append(burn.keyPrefix, key...)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100), Properties: []byte{esdtFrozenFlag}}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := burn.ProcessBuiltinFunction(accSnd, nil, createESDTBurnInput(key, big.NewInt(30)))
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	paused = false
+	_, err = burn.ProcessBuiltinFunction(accSnd, nil, createESDTBurnInput(key, big.NewInt(30)))
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+
+	esdtToken, _ = getESDTDataFromKey(accSnd, esdtKey, marshalizer)
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+}
@@ -0,0 +1,141 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	// esdtFrozenFlag is set in the properties of a token held by an account which is frozen for that token
+	esdtFrozenFlag byte = 1 << 0
+	// esdtPausedFlag is set in the properties of a token saved in the global settings account when the token is paused
+	esdtPausedFlag byte = 1 << 1
+)
+
+var zero = big.NewInt(0)
+
+func createESDTKeyPrefix() []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+}
+
+// IsFrozen returns true if the account holding the token is frozen for it
+func (m *ESDigitalToken) IsFrozen() bool {
+	return hasESDTProperty(m, esdtFrozenFlag)
+}
+
+// IsPaused returns true if the token is marked as paused. Only the tokens saved in the ESDT global settings accounts
+// can be marked as paused
+func (m *ESDigitalToken) IsPaused() bool {
+	return hasESDTProperty(m, esdtPausedFlag)
+}
+
+func hasESDTProperty(esdtData *ESDigitalToken, flag byte) bool {
+	if len(esdtData.Properties) == 0 {
+		return false
+	}
+
+	return esdtData.Properties[0]&flag != 0
+}
+
+func setESDTProperty(esdtData *ESDigitalToken, flag byte, value bool) {
+	if len(esdtData.Properties) == 0 {
+		esdtData.Properties = make([]byte, 1)
+	}
+
+	if value {
+		esdtData.Properties[0] |= flag
+		return
+	}
+
+	esdtData.Properties[0] &^= flag
+}
+
+func checkESDTSystemSCCall(vmInput *vmcommon.ContractCallInput, acntDst state.UserAccountHandler) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return process.ErrInvalidArguments
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, factory.ESDTSCAddress) {
+		return process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return process.ErrNilUserAccount
+	}
+
+	return nil
+}
+
+func addToESDTBalance(
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	value *big.Int,
+	marshalizer marshal.Marshalizer,
+) error {
+	esdtData, err := getESDTDataFromKey(userAcnt, key, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	if value.Cmp(zero) < 0 && hasESDTProperty(esdtData, esdtFrozenFlag) {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
+	esdtData.Value.Add(esdtData.Value, value)
+	if esdtData.Value.Cmp(zero) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	return saveESDTData(userAcnt, key, esdtData, marshalizer)
+}
+
+func getESDTDataFromKey(
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+) (*ESDigitalToken, error) {
+	esdtData := &ESDigitalToken{Value: big.NewInt(0)}
+	marshalledData, err := userAcnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshalledData) == 0 {
+		return esdtData, nil
+	}
+
+	err = marshalizer.Unmarshal(esdtData, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.Value == nil {
+		esdtData.Value = big.NewInt(0)
+	}
+
+	return esdtData, nil
+}
+
+func saveESDTData(
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	esdtData *ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	marshalledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("esdt data saved", "addr", userAcnt.AddressBytes(), "value", esdtData.Value, "tokenKey", key)
+	userAcnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
+
+	return nil
+}
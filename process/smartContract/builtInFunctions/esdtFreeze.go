@@ -0,0 +1,63 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtFreeze)(nil)
+
+type esdtFreeze struct {
+	marshalizer marshal.Marshalizer
+	keyPrefix   []byte
+	freeze      bool
+}
+
+// NewESDTFreezeFunc returns the esdt freeze (or unfreeze) built-in function component. This function can be called
+// only by the ESDT system smart contract and it is executed in the shard of the frozen account
+func NewESDTFreezeFunc(marshalizer marshal.Marshalizer, freeze bool) (*esdtFreeze, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &esdtFreeze{
+		marshalizer: marshalizer,
+		keyPrefix:   createESDTKeyPrefix(),
+		freeze:      freeze,
+	}, nil
+}
+
+// ProcessBuiltinFunction will freeze or unfreeze the esdt balance of the destination account
+func (e *esdtFreeze) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkESDTSystemSCCall(vmInput, acntDst)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	setESDTProperty(esdtData, esdtFrozenFlag, e.freeze)
+	err = saveESDTData(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtFreeze", "account", acntDst.AddressBytes(), "token", esdtTokenKey, "frozen", e.freeze)
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtFreeze) IsInterfaceNil() bool {
+	return e == nil
+}
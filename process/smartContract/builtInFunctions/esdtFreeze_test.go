@@ -0,0 +1,88 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createESDTSystemSCInput(tokenName []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: factory.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenName},
+		},
+	}
+}
+
+func TestNewESDTFreezeFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	freeze, err := NewESDTFreezeFunc(nil, true)
+	assert.True(t, check.IfNil(freeze))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTFreeze_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	freeze, _ := NewESDTFreezeFunc(&mock.MarshalizerMock{}, true)
+	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createESDTSystemSCInput([]byte("key"))
+	input.Arguments = nil
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTSystemSCInput([]byte("key"))
+	input.CallValue = big.NewInt(1)
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createESDTSystemSCInput([]byte("key"))
+	input.CallerAddr = []byte("caller")
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input = createESDTSystemSCInput([]byte("key"))
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTFreeze_ProcessBuiltInFunctionFreezeAndUnFreeze(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	freeze, _ := NewESDTFreezeFunc(marshalizer, true)
+	unFreeze, _ := NewESDTFreezeFunc(marshalizer, false)
+
+	key := []byte("key")
+	esdtKey := append(freeze.keyPrefix, key...)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := freeze.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+
+	esdtToken, _ = getESDTDataFromKey(acnt, esdtKey, marshalizer)
+	assert.True(t, hasESDTProperty(esdtToken, esdtFrozenFlag))
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+
+	_, err = unFreeze.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+
+	esdtToken, _ = getESDTDataFromKey(acnt, esdtKey, marshalizer)
+	assert.False(t, hasESDTProperty(esdtToken, esdtFrozenFlag))
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+}
@@ -0,0 +1,275 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunctionWithSCCall = (*esdtMultiTransfer)(nil)
+
+type esdtTransferData struct {
+	tokenKey []byte
+	value    *big.Int
+}
+
+type esdtMultiTransferData struct {
+	transfers       []*esdtTransferData
+	numTransferArgs int
+	callFunction    string
+	callArgs        [][]byte
+}
+
+type esdtMultiTransfer struct {
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+}
+
+// NewESDTMultiTransferFunc returns the esdt multi transfer built-in function component. The call data has the
+// following format: ESDTMultiTransfer@numTransfers@token1@value1@...@tokenN@valueN[@function@arg1@...@argM]
+// where the optional function is the smart contract endpoint called, in the destination shard, after all the tokens
+// were transferred. The gas cost is paid for each transferred token
+func NewESDTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilESDTPauseHandler
+	}
+
+	e := &esdtMultiTransfer{
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction will transfer all the provided esdt balances of the sender to the receiver. Either all the
+// transfers succeed or none of them is applied
+func (e *esdtMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	multiTransfer, err := e.parseArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCost := e.funcGasCost * uint64(len(multiTransfer.transfers))
+	gasRemaining := uint64(0)
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		if vmInput.GasProvided < gasCost {
+			return nil, process.ErrNotEnoughGas
+		}
+
+		gasRemaining = vmInput.GasProvided - gasCost
+		err = e.removeFromSender(acntSnd, multiTransfer.transfers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hasSCCall := len(multiTransfer.callFunction) > 0
+	if hasSCCall {
+		// the remaining gas is kept for the smart contract call which follows the transfers
+		gasRemaining = 0
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining}
+	if !check.IfNil(acntDst) {
+		for _, transfer := range multiTransfer.transfers {
+			err = addToESDTBalance(acntDst, transfer.tokenKey, transfer.value, e.marshalizer)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return vmOutput, nil
+	}
+
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		// cross-shard ESDT multi transfer call through a smart contract - needs the storage update in order to create the smart contract result
+		outputAccount := &vmcommon.OutputAccount{
+			Address: vmInput.RecipientAddr,
+			Data:    createBuiltInFunctionCallData(core.BuiltInFunctionESDTMultiTransfer, vmInput.Arguments),
+		}
+		if hasSCCall && vmInput.GasProvided > gasCost {
+			outputAccount.GasLimit = vmInput.GasProvided - gasCost
+		}
+
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+		vmOutput.OutputAccounts[string(vmInput.RecipientAddr)] = outputAccount
+	}
+
+	return vmOutput, nil
+}
+
+// CreateSCCallInput returns the input of the smart contract call which follows the transfers or nil if no smart
+// contract endpoint was provided. The called endpoint receives, in front of its own arguments, the transferred
+// tokens as numTransfers@token1@value1@...@tokenN@valueN
+func (e *esdtMultiTransfer) CreateSCCallInput(vmInput *vmcommon.ContractCallInput) (*vmcommon.ContractCallInput, error) {
+	multiTransfer, err := e.parseArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(multiTransfer.callFunction) == 0 {
+		return nil, nil
+	}
+
+	gasCost := e.funcGasCost * uint64(len(multiTransfer.transfers))
+	if vmInput.GasProvided < gasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	arguments := make([][]byte, 0, multiTransfer.numTransferArgs+len(multiTransfer.callArgs))
+	arguments = append(arguments, vmInput.Arguments[:multiTransfer.numTransferArgs]...)
+	arguments = append(arguments, multiTransfer.callArgs...)
+
+	scCallInput := &vmcommon.ContractCallInput{
+		VMInput:       vmInput.VMInput,
+		RecipientAddr: vmInput.RecipientAddr,
+		Function:      multiTransfer.callFunction,
+	}
+	scCallInput.Arguments = arguments
+	scCallInput.CallValue = big.NewInt(0)
+	scCallInput.GasProvided = vmInput.GasProvided - gasCost
+
+	return scCallInput, nil
+}
+
+// CreateRevertTransferData returns the call data which gives back the transferred tokens to the sender
+func (e *esdtMultiTransfer) CreateRevertTransferData(vmInput *vmcommon.ContractCallInput) ([]byte, error) {
+	multiTransfer, err := e.parseArguments(vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	transferArgs := vmInput.Arguments[:multiTransfer.numTransferArgs]
+
+	return createBuiltInFunctionCallData(core.BuiltInFunctionESDTMultiTransfer, transferArgs), nil
+}
+
+func (e *esdtMultiTransfer) parseArguments(vmInput *vmcommon.ContractCallInput) (*esdtMultiTransferData, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 3 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	maxTransfers := uint64(len(vmInput.Arguments)-1) / 2
+	numTransfers := big.NewInt(0).SetBytes(vmInput.Arguments[0])
+	if !numTransfers.IsUint64() || numTransfers.Uint64() == 0 || numTransfers.Uint64() > maxTransfers {
+		return nil, process.ErrInvalidArguments
+	}
+
+	multiTransfer := &esdtMultiTransferData{
+		transfers:       make([]*esdtTransferData, 0, numTransfers.Uint64()),
+		numTransferArgs: 1 + 2*int(numTransfers.Uint64()),
+	}
+
+	usedTokens := make(map[string]struct{})
+	for i := 1; i < multiTransfer.numTransferArgs; i += 2 {
+		tokenIdentifier := vmInput.Arguments[i]
+		if len(tokenIdentifier) == 0 {
+			return nil, process.ErrInvalidArguments
+		}
+		if _, ok := usedTokens[string(tokenIdentifier)]; ok {
+			return nil, process.ErrDuplicateESDTTokenInTransfer
+		}
+		usedTokens[string(tokenIdentifier)] = struct{}{}
+
+		value := big.NewInt(0).SetBytes(vmInput.Arguments[i+1])
+		if value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		multiTransfer.transfers = append(multiTransfer.transfers, &esdtTransferData{
+			tokenKey: append(createESDTKeyPrefix(), tokenIdentifier...),
+			value:    value,
+		})
+	}
+
+	if len(vmInput.Arguments) == multiTransfer.numTransferArgs {
+		return multiTransfer, nil
+	}
+
+	multiTransfer.callFunction = string(vmInput.Arguments[multiTransfer.numTransferArgs])
+	multiTransfer.callArgs = vmInput.Arguments[multiTransfer.numTransferArgs+1:]
+	if len(multiTransfer.callFunction) == 0 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !core.IsSmartContractAddress(vmInput.RecipientAddr) {
+		return nil, process.ErrESDTTransferCallToNonSmartContract
+	}
+
+	return multiTransfer, nil
+}
+
+func (e *esdtMultiTransfer) removeFromSender(acntSnd state.UserAccountHandler, transfers []*esdtTransferData) error {
+	esdtDataList := make([]*ESDigitalToken, 0, len(transfers))
+	for _, transfer := range transfers {
+		// pause and freeze are enforced only in the sender shard, so that the transfers already in flight are completed
+		if e.pauseHandler.IsPaused(transfer.tokenKey) {
+			return process.ErrESDTTokenIsPaused
+		}
+
+		esdtData, err := getESDTDataFromKey(acntSnd, transfer.tokenKey, e.marshalizer)
+		if err != nil {
+			return err
+		}
+		if hasESDTProperty(esdtData, esdtFrozenFlag) {
+			return process.ErrESDTIsFrozenForAccount
+		}
+
+		esdtData.Value.Sub(esdtData.Value, transfer.value)
+		if esdtData.Value.Cmp(zero) < 0 {
+			return process.ErrInsufficientFunds
+		}
+
+		esdtDataList = append(esdtDataList, esdtData)
+	}
+
+	// all the transfers were validated, so the sender balances can be updated without leaving partial transfers behind
+	for i, transfer := range transfers {
+		err := saveESDTData(acntSnd, transfer.tokenKey, esdtDataList[i], e.marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Trace("esdtMultiTransfer", "sender", acntSnd.AddressBytes(), "num transfers", len(transfers))
+
+	return nil
+}
+
+func createBuiltInFunctionCallData(function string, arguments [][]byte) []byte {
+	callData := function
+	for _, arg := range arguments {
+		callData += "@" + hex.EncodeToString(arg)
+	}
+
+	return []byte(callData)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,284 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createESDTMultiTransferInput(gasProvided uint64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: gasProvided,
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+		},
+	}
+}
+
+func setESDTBalance(t *testing.T, acnt state.UserAccountHandler, token string, value int64, marshalizer marshal.Marshalizer) {
+	esdtKey := append(createESDTKeyPrefix(), token...)
+	err := saveESDTData(acnt, esdtKey, &ESDigitalToken{Value: big.NewInt(value)}, marshalizer)
+	require.Nil(t, err)
+}
+
+func getESDTBalance(acnt state.UserAccountHandler, token string, marshalizer marshal.Marshalizer) *big.Int {
+	esdtKey := append(createESDTKeyPrefix(), token...)
+	esdtData, _ := getESDTDataFromKey(acnt, esdtKey, marshalizer)
+
+	return esdtData.Value
+}
+
+func TestNewESDTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, err := NewESDTMultiTransferFunc(10, nil, &mock.ESDTPauseHandlerStub{})
+	assert.True(t, check.IfNil(esdt))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	esdt, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.True(t, check.IfNil(esdt))
+	assert.Equal(t, process.ErrNilESDTPauseHandler, err)
+
+	esdt, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	assert.False(t, check.IfNil(esdt))
+	assert.Nil(t, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+
+	_, err := esdt.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createESDTMultiTransferInput(100, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(1).Bytes())
+	input.CallValue = big.NewInt(1)
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(1).Bytes(), []byte("TKA"))
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(0).Bytes(), []byte("TKA"), big.NewInt(1).Bytes())
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(2).Bytes(), []byte("TKA"), big.NewInt(1).Bytes())
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(0).Bytes())
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(1).Bytes(),
+		[]byte("TKA"), big.NewInt(2).Bytes(),
+	)
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrDuplicateESDTTokenInTransfer, err)
+
+	input = createESDTMultiTransferInput(100, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(1).Bytes(), []byte("function"))
+	input.RecipientAddr = []byte("not a smart contract address")
+	_, err = esdt.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrESDTTransferCallToNonSmartContract, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionNotEnoughGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(19, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(1).Bytes(),
+		[]byte("TKB"), big.NewInt(1).Bytes(),
+	)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(10).Bytes(),
+		[]byte("TKB"), big.NewInt(20).Bytes(),
+	)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(t, accSnd, "TKA", 100, marshalizer)
+	setESDTBalance(t, accSnd, "TKB", 100, marshalizer)
+
+	vmOutput, err := esdt.ProcessBuiltinFunction(accSnd, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(30), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, "TKA", marshalizer))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, "TKB", marshalizer))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, "TKA", marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, "TKB", marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionInsufficientFundsShouldNotChangeAnyBalance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(10).Bytes(),
+		[]byte("TKB"), big.NewInt(200).Bytes(),
+	)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(t, accSnd, "TKA", 100, marshalizer)
+	setESDTBalance(t, accSnd, "TKB", 100, marshalizer)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, "TKA", marshalizer))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, "TKB", marshalizer))
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accDst, "TKA", marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionPausedOrFrozenTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenKey []byte) bool {
+			return bytes.HasSuffix(tokenKey, []byte("TKB"))
+		},
+	}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, pauseHandler)
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(10).Bytes(),
+		[]byte("TKB"), big.NewInt(10).Bytes(),
+	)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	setESDTBalance(t, accSnd, "TKA", 100, marshalizer)
+	setESDTBalance(t, accSnd, "TKB", 100, marshalizer)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	esdt, _ = NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	frozenToken := &ESDigitalToken{Value: big.NewInt(100)}
+	setESDTProperty(frozenToken, esdtFrozenFlag, true)
+	_ = saveESDTData(accSnd, append(createESDTKeyPrefix(), "TKB"...), frozenToken, marshalizer)
+
+	_, err = esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, "TKA", marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(10).Bytes(),
+		[]byte("TKB"), big.NewInt(20).Bytes(),
+	)
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+
+	vmOutput, err := esdt.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, "TKA", marshalizer))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, "TKB", marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionWithSCCallKeepsTheGas(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("deposit"))
+	input.RecipientAddr = make([]byte, 32)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	setESDTBalance(t, accSnd, "TKA", 100, marshalizer)
+
+	vmOutput, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, "TKA", marshalizer))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionSenderIsSCCrossShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("deposit"))
+	input.CallerAddr = make([]byte, 32)
+	input.RecipientAddr = make([]byte, 32)
+	input.RecipientAddr[31] = 1
+	accSnd, _ := state.NewUserAccount(input.CallerAddr)
+	setESDTBalance(t, accSnd, "TKA", 100, marshalizer)
+
+	vmOutput, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(vmOutput.OutputAccounts))
+	outputAccount := vmOutput.OutputAccounts[string(input.RecipientAddr)]
+	assert.Equal(t, []byte(core.BuiltInFunctionESDTMultiTransfer+"@01@544b41@0a@6465706f736974"), outputAccount.Data)
+	assert.Equal(t, uint64(40), outputAccount.GasLimit)
+}
+
+func TestESDTMultiTransfer_CreateSCCallInput(t *testing.T) {
+	t.Parallel()
+
+	esdt, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes())
+	input.RecipientAddr = make([]byte, 32)
+
+	scCallInput, err := esdt.CreateSCCallInput(input)
+	assert.Nil(t, err)
+	assert.Nil(t, scCallInput)
+
+	input.Arguments = append(input.Arguments, []byte("deposit"), []byte("arg"))
+	input.CallerAddr = []byte("caller")
+	scCallInput, err = esdt.CreateSCCallInput(input)
+	require.Nil(t, err)
+	assert.Equal(t, "deposit", scCallInput.Function)
+	assert.Equal(t, input.RecipientAddr, scCallInput.RecipientAddr)
+	assert.Equal(t, input.CallerAddr, scCallInput.CallerAddr)
+	assert.Equal(t, uint64(40), scCallInput.GasProvided)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("arg")}, scCallInput.Arguments)
+
+	input.GasProvided = 5
+	scCallInput, err = esdt.CreateSCCallInput(input)
+	assert.Nil(t, scCallInput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTMultiTransfer_CreateRevertTransferData(t *testing.T) {
+	t.Parallel()
+
+	esdt, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	input := createESDTMultiTransferInput(50, big.NewInt(2).Bytes(),
+		[]byte("TKA"), big.NewInt(10).Bytes(),
+		[]byte("TKB"), big.NewInt(20).Bytes(),
+		[]byte("deposit"),
+	)
+	input.RecipientAddr = make([]byte, 32)
+
+	revertData, err := esdt.CreateRevertTransferData(input)
+	require.Nil(t, err)
+	assert.Equal(t, []byte(core.BuiltInFunctionESDTMultiTransfer+"@02@544b41@0a@544b42@14"), revertData)
+}
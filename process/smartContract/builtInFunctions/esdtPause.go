@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtPause)(nil)
+var _ process.ESDTPauseHandler = (*esdtPause)(nil)
+
+type esdtPause struct {
+	accounts              state.AccountsAdapter
+	marshalizer           marshal.Marshalizer
+	keyPrefix             []byte
+	globalSettingsAddress []byte
+	pause                 bool
+}
+
+// NewESDTPauseFunc returns the esdt pause (or unpause) built-in function component. This function can be called only
+// by the ESDT system smart contract and it is executed, in each shard, on the ESDT global settings account
+func NewESDTPauseFunc(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	pause bool,
+) (*esdtPause, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &esdtPause{
+		accounts:              accounts,
+		marshalizer:           marshalizer,
+		keyPrefix:             createESDTKeyPrefix(),
+		globalSettingsAddress: core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()),
+		pause:                 pause,
+	}, nil
+}
+
+// ProcessBuiltinFunction will pause or unpause the esdt token in the current shard
+func (e *esdtPause) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkESDTSystemSCCall(vmInput, acntDst)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(acntDst.AddressBytes(), e.globalSettingsAddress) {
+		return nil, process.ErrInvalidESDTGlobalSettingsAddress
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	setESDTProperty(esdtData, esdtPausedFlag, e.pause)
+	err = saveESDTData(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtPause", "token", esdtTokenKey, "paused", e.pause)
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsPaused returns true if the esdt token identified by the provided key is paused in the current shard
+func (e *esdtPause) IsPaused(tokenKey []byte) bool {
+	account, err := e.accounts.GetExistingAccount(e.globalSettingsAddress)
+	if err != nil {
+		return false
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return false
+	}
+
+	esdtData, err := getESDTDataFromKey(userAccount, tokenKey, e.marshalizer)
+	if err != nil {
+		return false
+	}
+
+	return hasESDTProperty(esdtData, esdtPausedFlag)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtPause) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,108 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTPauseFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pause, err := NewESDTPauseFunc(nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerMock{}, true)
+	assert.True(t, check.IfNil(pause))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	pause, err = NewESDTPauseFunc(&mock.AccountsStub{}, nil, &mock.MarshalizerMock{}, true)
+	assert.True(t, check.IfNil(pause))
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+
+	pause, err = NewESDTPauseFunc(&mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), nil, true)
+	assert.True(t, check.IfNil(pause))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTPause_ProcessBuiltInFunctionWrongAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pause, _ := NewESDTPauseFunc(&mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerMock{}, true)
+
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	_, err := pause.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput([]byte("key")))
+	assert.Equal(t, process.ErrInvalidESDTGlobalSettingsAddress, err)
+
+	acnt, _ = state.NewUserAccount(core.ESDTGlobalSettingsAddress(1))
+	_, err = pause.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput([]byte("key")))
+	assert.Equal(t, process.ErrInvalidESDTGlobalSettingsAddress, err)
+}
+
+func TestESDTPause_ProcessBuiltInFunctionPauseAndUnPause(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	globalSettings, _ := state.NewUserAccount(core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()))
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return globalSettings, nil
+		},
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	pause, _ := NewESDTPauseFunc(accounts, shardCoordinator, marshalizer, true)
+	unPause, _ := NewESDTPauseFunc(accounts, shardCoordinator, marshalizer, false)
+
+	key := []byte("key")
+	esdtKey := append(pause.keyPrefix, key...)
+	assert.False(t, pause.IsPaused(esdtKey))
+
+	_, err := pause.ProcessBuiltinFunction(nil, globalSettings, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+	assert.True(t, pause.IsPaused(esdtKey))
+	assert.True(t, unPause.IsPaused(esdtKey))
+	assert.False(t, pause.IsPaused(append(pause.keyPrefix, []byte("other")...)))
+
+	_, err = unPause.ProcessBuiltinFunction(nil, globalSettings, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+	assert.False(t, pause.IsPaused(esdtKey))
+}
+
+func TestESDTPause_IsPausedMissingAccountShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, errors.New("account not found")
+		},
+	}
+	pause, _ := NewESDTPauseFunc(accounts, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerMock{}, true)
+
+	assert.False(t, pause.IsPaused([]byte("key")))
+}
+
+func TestESDTPause_ProcessBuiltInFunctionKeepsTheBalance(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	marshalizer := &mock.MarshalizerMock{}
+	pause, _ := NewESDTPauseFunc(&mock.AccountsStub{}, shardCoordinator, marshalizer, true)
+
+	key := []byte("key")
+	esdtKey := append(pause.keyPrefix, key...)
+	globalSettings, _ := state.NewUserAccount(core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(5)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	globalSettings.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := pause.ProcessBuiltinFunction(nil, globalSettings, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+
+	esdtToken, _ = getESDTDataFromKey(globalSettings, esdtKey, marshalizer)
+	assert.Equal(t, big.NewInt(5), esdtToken.Value)
+	assert.True(t, hasESDTProperty(esdtToken, esdtPausedFlag))
+}
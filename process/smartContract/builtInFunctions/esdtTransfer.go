@@ -13,31 +13,33 @@ import (
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-const esdtKeyIdentifier = "esdt"
-
 var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 
-var zero = big.NewInt(0)
-
 type esdtTransfer struct {
-	funcGasCost uint64
-	marshalizer marshal.Marshalizer
-	keyPrefix   []byte
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	keyPrefix    []byte
+	pauseHandler process.ESDTPauseHandler
 }
 
 // NewESDTTransferFunc returns the esdt transfer built-in function component
 func NewESDTTransferFunc(
 	funcGasCost uint64,
 	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
 ) (*esdtTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, process.ErrNilMarshalizer
 	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilESDTPauseHandler
+	}
 
 	e := &esdtTransfer{
-		funcGasCost: funcGasCost,
-		marshalizer: marshalizer,
-		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		keyPrefix:    createESDTKeyPrefix(),
+		pauseHandler: pauseHandler,
 	}
 
 	return e, nil
@@ -72,9 +74,13 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 		if vmInput.GasProvided < e.funcGasCost {
 			return nil, process.ErrNotEnoughGas
 		}
+		// pause and freeze are enforced only in the sender shard, so that the transfers already in flight are completed
+		if e.pauseHandler.IsPaused(esdtTokenKey) {
+			return nil, process.ErrESDTTokenIsPaused
+		}
 
 		gasRemaining = vmInput.GasProvided - e.funcGasCost
-		err := e.addToESDTBalance(acntSnd, esdtTokenKey, big.NewInt(0).Neg(value))
+		err := addToESDTBalance(acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer)
 		if err != nil {
 			return nil, err
 		}
@@ -82,7 +88,7 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 
 	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining}
 	if !check.IfNil(acntDst) {
-		err := e.addToESDTBalance(acntDst, esdtTokenKey, value)
+		err := addToESDTBalance(acntDst, esdtTokenKey, value, e.marshalizer)
 		if err != nil {
 			return nil, err
 		}
@@ -103,43 +109,6 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
-func (e *esdtTransfer) addToESDTBalance(userAcnt state.UserAccountHandler, key []byte, value *big.Int) error {
-	esdtData, err := e.getESDTDataFromKey(userAcnt, key)
-	if err != nil {
-		return err
-	}
-
-	esdtData.Value.Add(esdtData.Value, value)
-	if esdtData.Value.Cmp(zero) < 0 {
-		return process.ErrInsufficientFunds
-	}
-
-	marshalledData, err := e.marshalizer.Marshal(esdtData)
-	if err != nil {
-		return err
-	}
-
-	log.Trace("esdt after transfer", "addr", userAcnt.AddressBytes(), "value", esdtData.Value, "tokenKey", key)
-	userAcnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
-
-	return nil
-}
-
-func (e *esdtTransfer) getESDTDataFromKey(userAcnt state.UserAccountHandler, key []byte) (*ESDigitalToken, error) {
-	esdtData := &ESDigitalToken{Value: big.NewInt(0)}
-	marshalledData, err := userAcnt.DataTrieTracker().RetrieveValue(key)
-	if err != nil {
-		return esdtData, nil
-	}
-
-	err = e.marshalizer.Unmarshal(esdtData, marshalledData)
-	if err != nil {
-		return nil, err
-	}
-
-	return esdtData, nil
-}
-
 // IsInterfaceNil returns true if underlying object in nil
 func (e *esdtTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -11,10 +12,22 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNewESDTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, err := NewESDTTransferFunc(10, nil, &mock.ESDTPauseHandlerStub{})
+	assert.True(t, check.IfNil(esdt))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	esdt, err = NewESDTTransferFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.True(t, check.IfNil(esdt))
+	assert.Equal(t, process.ErrNilESDTPauseHandler, err)
+}
+
 func TestESDTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
-	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{})
+	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
 	_, err := esdt.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, process.ErrNilVmInput)
 
@@ -44,7 +57,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -81,7 +94,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -110,7 +123,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -131,3 +144,66 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
 	assert.True(t, esdtToken.Value.Cmp(big.NewInt(10)) == 0)
 }
+
+func TestESDTTransfer_ProcessBuiltInFunctionPausedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenKey []byte) bool {
+			return true
+		},
+	}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, pauseHandler)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+		},
+	}
+	key := []byte("key")
+	input.Arguments = [][]byte{key, big.NewInt(10).Bytes()}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+
+	esdtKey := append(esdt.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	_, err = esdt.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Nil(t, err)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionFrozenSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+		},
+	}
+	key := []byte("key")
+	input.Arguments = [][]byte{key, big.NewInt(10).Bytes()}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+
+	esdtKey := append(esdt.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100), Properties: []byte{esdtFrozenFlag}}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+
+	_, err = esdt.ProcessBuiltinFunction(accDst, accSnd, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
@@ -0,0 +1,66 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtWipe)(nil)
+
+type esdtWipe struct {
+	marshalizer marshal.Marshalizer
+	keyPrefix   []byte
+}
+
+// NewESDTWipeFunc returns the esdt wipe built-in function component. This function can be called only by the ESDT
+// system smart contract and it is executed in the shard of the wiped account
+func NewESDTWipeFunc(marshalizer marshal.Marshalizer) (*esdtWipe, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &esdtWipe{
+		marshalizer: marshalizer,
+		keyPrefix:   createESDTKeyPrefix(),
+	}, nil
+}
+
+// ProcessBuiltinFunction will wipe the esdt balance of the destination account. The account has to be frozen
+func (e *esdtWipe) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkESDTSystemSCCall(vmInput, acntDst)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if !hasESDTProperty(esdtData, esdtFrozenFlag) {
+		return nil, process.ErrESDTIsNotFrozenForAccount
+	}
+
+	log.Trace("esdtWipe", "account", acntDst.AddressBytes(), "token", esdtTokenKey, "wiped value", esdtData.Value)
+
+	esdtData.Value = big.NewInt(0)
+	err = saveESDTData(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtWipe) IsInterfaceNil() bool {
+	return e == nil
+}
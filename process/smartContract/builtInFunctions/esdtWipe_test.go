@@ -0,0 +1,66 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTWipeFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wipe, err := NewESDTWipeFunc(nil)
+	assert.True(t, check.IfNil(wipe))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTWipe_ProcessBuiltInFunctionNotFrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	wipe, _ := NewESDTWipeFunc(marshalizer)
+
+	key := []byte("key")
+	esdtKey := append(wipe.keyPrefix, key...)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput(key))
+	assert.Equal(t, process.ErrESDTIsNotFrozenForAccount, err)
+
+	esdtToken, _ = getESDTDataFromKey(acnt, esdtKey, marshalizer)
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+}
+
+func TestESDTWipe_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	wipe, _ := NewESDTWipeFunc(marshalizer)
+
+	key := []byte("key")
+	esdtKey := append(wipe.keyPrefix, key...)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100), Properties: []byte{esdtFrozenFlag}}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	input := createESDTSystemSCInput(key)
+	input.CallerAddr = []byte("caller")
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	_, err = wipe.ProcessBuiltinFunction(nil, acnt, createESDTSystemSCInput(key))
+	assert.Nil(t, err)
+
+	esdtToken, _ = getESDTDataFromKey(acnt, esdtKey, marshalizer)
+	assert.Equal(t, big.NewInt(0), esdtToken.Value)
+	assert.True(t, hasESDTProperty(esdtToken, esdtFrozenFlag))
+}
@@ -4,8 +4,10 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -17,6 +19,8 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	MapDNSAddresses      map[string]struct{}
 	EnableUserNameChange bool
 	Marshalizer          marshal.Marshalizer
+	Accounts             state.AccountsAdapter
+	ShardCoordinator     sharding.Coordinator
 }
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
@@ -58,7 +62,25 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
-	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer)
+	pauseFunc, err := NewESDTPauseFunc(args.Accounts, args.ShardCoordinator, args.Marshalizer, true)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTPause, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTPauseFunc(args.Accounts, args.ShardCoordinator, args.Marshalizer, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTUnPause, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer, pauseFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +89,78 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
+	newFunc, err = NewESDTMultiTransferFunc(gasConfig.BuiltInCost.ESDTMultiTransfer, args.Marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTMultiTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTBurnFunc(gasConfig.BuiltInCost.ESDTBurn, args.Marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeFunc(args.Marshalizer, true)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeFunc(args.Marshalizer, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTUnFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTWipeFunc(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTWipe, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// CreateMetaBuiltInFunctionContainer will create the list of built-in functions which, after being executed in the
+// sender shard, end with a system smart contract call on the metachain
+func CreateMetaBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (process.BuiltInFunctionContainer, error) {
+	gasConfig, err := createGasConfig(args.GasMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pause state is checked only in the sender shard
+	pauseFunc, err := NewESDTPauseFunc(args.Accounts, args.ShardCoordinator, args.Marshalizer, true)
+	if err != nil {
+		return nil, err
+	}
+
+	container := NewBuiltInFunctionContainer()
+	newFunc, err := NewESDTBurnFunc(gasConfig.BuiltInCost.ESDTBurn, args.Marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -18,6 +18,8 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		MapDNSAddresses:      make(map[string]struct{}),
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
+		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewOneShardCoordinatorMock(),
 	}
 
 	return args
@@ -48,6 +50,8 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveUserName"] = value
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
+	gasMap["ESDTBurn"] = value
+	gasMap["ESDTMultiTransfer"] = value
 
 	return gasMap
 }
@@ -68,7 +72,37 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Nil(t, container)
 
 	args = createMockArguments()
+	args.Accounts = nil
 	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Nil(t, err)
+	assert.Equal(t, container.Len(), 12)
+}
+
+func TestCreateMetaBuiltInFunctionContainer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.GasMap = nil
+	container, err := CreateMetaBuiltInFunctionContainer(args)
+	assert.NotNil(t, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	args.Accounts = nil
+	container, err = CreateMetaBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	container, err = CreateMetaBuiltInFunctionContainer(args)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, container.Len())
+
+	_, err = container.Get(core.BuiltInFunctionESDTBurn)
 	assert.Nil(t, err)
-	assert.Equal(t, container.Len(), 5)
 }
@@ -1,6 +1,7 @@
 package hooks
 
 import (
+	"context"
 	"encoding/binary"
 	"math/big"
 	"sync"
@@ -473,7 +474,20 @@ func (bh *BlockChainHookImpl) GetAllState(address []byte) (map[string][]byte, er
 		return nil, process.ErrWrongTypeAssertion
 	}
 
-	return dstAccount.DataTrie().GetAllLeaves()
+	if check.IfNil(dstAccount.DataTrie()) {
+		return make(map[string][]byte), nil
+	}
+
+	allState := make(map[string][]byte)
+	err = dstAccount.DataTrie().IterateLeaves(context.Background(), data.LeavesFilter{}, func(key []byte, value []byte) error {
+		allState[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allState, nil
 }
 
 func hashFromAddressAndNonce(creatorAddress []byte, creatorNonce uint64) []byte {
@@ -574,6 +588,17 @@ func (bh *BlockChainHookImpl) SetCurrentHeader(hdr data.HeaderHandler) {
 	bh.mutCurrentHdr.Unlock()
 }
 
+// SetAccountsAdapter changes the accounts adapter used by the hook. It is used to execute smart contract queries
+// on past states and must not be called while a smart contract is executed
+func (bh *BlockChainHookImpl) SetAccountsAdapter(accounts state.AccountsAdapter) error {
+	if check.IfNil(accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+
+	bh.accounts = accounts
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bh *BlockChainHookImpl) IsInterfaceNil() bool {
 	return bh == nil
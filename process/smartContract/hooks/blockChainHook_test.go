@@ -2,6 +2,7 @@ package hooks_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -601,3 +602,56 @@ func TestBlockChainHookImpl_GettersFromCurrentHeader(t *testing.T) {
 	assert.Equal(t, epoch, bh.CurrentEpoch())
 	assert.Equal(t, randSeed, bh.CurrentRandomSeed())
 }
+
+//------- GetAllState
+
+func TestBlockChainHookImpl_GetAllStateShouldIterateTheDataTrie(t *testing.T) {
+	t.Parallel()
+
+	accnt := mock.NewAccountWrapMock(nil)
+	accnt.SetDataTrie(&mock.TrieStub{
+		IterateLeavesCalled: func(_ context.Context, filter data.LeavesFilter, handler data.LeafHandler) error {
+			assert.Equal(t, data.LeavesFilter{}, filter)
+			_ = handler([]byte("key1"), []byte("value1"))
+			return handler([]byte("key2"), []byte("value2"))
+		},
+	})
+
+	args := createMockVMAccountsArguments()
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	allState, err := bh.GetAllState(make([]byte, 32))
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")}, allState)
+}
+
+func TestBlockChainHookImpl_GetAllStateIterateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected err")
+	accnt := mock.NewAccountWrapMock(nil)
+	accnt.SetDataTrie(&mock.TrieStub{
+		IterateLeavesCalled: func(_ context.Context, _ data.LeavesFilter, _ data.LeafHandler) error {
+			return errExpected
+		},
+	})
+
+	args := createMockVMAccountsArguments()
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	allState, err := bh.GetAllState(make([]byte, 32))
+
+	assert.Equal(t, errExpected, err)
+	assert.Nil(t, allState)
+}
@@ -190,6 +190,7 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 
 	var vmOutput *vmcommon.VMOutput
 	var executedBuiltIn bool
+	var revertTransferData []byte
 	snapshot := sc.accounts.JournalLen()
 	defer func() {
 		if err != nil && !executedBuiltIn {
@@ -201,6 +202,11 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 			if errNotCritical != nil {
 				log.Debug("error while processing error in smart contract processor")
 			}
+
+			errNotCritical = sc.revertBuiltInFunctionTransfer(txHash, tx, acntSnd, revertTransferData)
+			if errNotCritical != nil {
+				log.Debug("error while reverting the built-in function transfer in smart contract processor")
+			}
 		}
 	}()
 
@@ -219,7 +225,8 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 		return nil
 	}
 
-	executedBuiltIn, err = sc.resolveBuiltInFunctions(txHash, tx, acntSnd, acntDst, vmInput)
+	var scCallInput *vmcommon.ContractCallInput
+	executedBuiltIn, scCallInput, revertTransferData, err = sc.resolveBuiltInFunctions(txHash, tx, acntSnd, acntDst, vmInput)
 	if err != nil {
 		returnMessage = "cannot resolve build in function"
 		log.Debug("processed built in functions error", "error", err.Error())
@@ -228,6 +235,11 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 	if executedBuiltIn {
 		return nil
 	}
+	if scCallInput != nil {
+		// the built-in function already moved the tokens, so the attached smart contract call is executed as a
+		// regular one. Any failure from now on reverts the whole transaction, tokens included
+		vmInput = scCallInput
+	}
 
 	if check.IfNil(acntDst) {
 		return process.ErrNilSCDestAccount
@@ -333,11 +345,11 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 	tx data.TransactionHandler,
 	acntSnd, acntDst state.UserAccountHandler,
 	vmInput *vmcommon.ContractCallInput,
-) (bool, error) {
+) (bool, *vmcommon.ContractCallInput, []byte, error) {
 
 	builtIn, err := sc.builtInFunctions.Get(vmInput.Function)
 	if err != nil {
-		return false, nil
+		return false, nil, nil, nil
 	}
 
 	// return error here only if acntSnd is not nil - so this is sender shard
@@ -345,12 +357,25 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 	if err != nil {
 		if !check.IfNil(acntSnd) {
 			log.Trace("built in function error at sender", "err", err, "function", vmInput.Function)
-			return true, err
+			return true, nil, nil, err
 		}
 
 		vmOutput = &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: err.Error()}
 	}
 
+	builtInWithSCCall, hasSCCall := builtIn.(process.BuiltinFunctionWithSCCall)
+	var scCallInput *vmcommon.ContractCallInput
+	if hasSCCall && vmOutput.ReturnCode == vmcommon.Ok {
+		scCallInput, err = builtInWithSCCall.CreateSCCallInput(vmInput)
+		if err != nil {
+			return true, nil, nil, err
+		}
+		hasSCCall = scCallInput != nil
+	}
+	if hasSCCall && !check.IfNil(acntDst) {
+		return sc.prepareSCCallAfterBuiltInFunction(builtInWithSCCall, tx, acntSnd, acntDst, vmInput, scCallInput)
+	}
+
 	scrResults := make([]data.TransactionHandler, 0, len(vmOutput.OutputAccounts)+1)
 
 	outputAccounts := sortVMOutputInsideData(vmOutput)
@@ -378,7 +403,7 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 	if !check.IfNil(acntSnd) {
 		err = acntSnd.AddToBalance(scrForSender.Value)
 		if err != nil {
-			return true, err
+			return true, nil, nil, err
 		}
 	}
 
@@ -387,18 +412,76 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 	err = sc.scrForwarder.AddIntermediateTransactions(finalResults)
 	if err != nil {
 		log.Debug("AddIntermediateTransactions error", "error", err.Error())
-		return true, err
+		return true, nil, nil, err
 	}
 
 	if check.IfNil(acntSnd) {
 		// it was already consumed in sender shard
 		consumedFee = big.NewInt(0)
 	}
+	if hasSCCall {
+		// only the move balance fee is consumed in the sender shard, the rest of the gas travels with the transaction
+		// and is consumed by the smart contract call in the destination shard
+		consumedFee = sc.economicsFee.ComputeFee(tx)
+	}
 
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
 	sc.txFeeHandler.ProcessTransactionFee(consumedFee, big.NewInt(0), txHash)
 
-	return true, sc.saveAccounts(acntSnd, acntDst)
+	return true, nil, nil, sc.saveAccounts(acntSnd, acntDst)
+}
+
+// prepareSCCallAfterBuiltInFunction saves the accounts changed by the built-in function, so that they are visible to
+// the smart contract call, and returns the input of that call together with the data needed, in the destination
+// shard, to give back the transferred tokens if the call fails
+func (sc *scProcessor) prepareSCCallAfterBuiltInFunction(
+	builtIn process.BuiltinFunctionWithSCCall,
+	tx data.TransactionHandler,
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	scCallInput *vmcommon.ContractCallInput,
+) (bool, *vmcommon.ContractCallInput, []byte, error) {
+	var revertTransferData []byte
+	if check.IfNil(acntSnd) {
+		var err error
+		revertTransferData, err = builtIn.CreateRevertTransferData(vmInput)
+		if err != nil {
+			return true, nil, nil, err
+		}
+	}
+
+	err := sc.saveAccounts(acntSnd, acntDst)
+	if err != nil {
+		return true, nil, nil, err
+	}
+
+	return false, scCallInput, revertTransferData, nil
+}
+
+// revertBuiltInFunctionTransfer gives back the tokens which were transferred from another shard by a built-in
+// function, when the smart contract call which followed the transfer failed
+func (sc *scProcessor) revertBuiltInFunctionTransfer(
+	txHash []byte,
+	tx data.TransactionHandler,
+	acntSnd state.UserAccountHandler,
+	revertTransferData []byte,
+) error {
+	if len(revertTransferData) == 0 || !check.IfNil(acntSnd) {
+		return nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:      tx.GetNonce(),
+		Value:      big.NewInt(0),
+		RcvAddr:    tx.GetSndAddr(),
+		SndAddr:    tx.GetRcvAddr(),
+		Data:       revertTransferData,
+		PrevTxHash: txHash,
+		GasPrice:   tx.GetGasPrice(),
+	}
+	setOriginalTxHash(scr, txHash, tx)
+
+	return sc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
 }
 
 // ProcessIfError creates a smart contract result, consumed the gas and returns the value to the user
@@ -1762,3 +1762,108 @@ func TestScProcessor_ProcessSmartContractResultExecuteSC(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, executeCalled)
 }
+
+func createESDTMultiTransferWithSCCallProcessor(
+	t *testing.T,
+	vm *mock.VMExecutionHandlerStub,
+	scrForwarder *mock.IntermediateTransactionHandlerMock,
+	callArgs [][]byte,
+) *scProcessor {
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	multiTransfer, _ := builtInFunctions.NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
+	_ = container.Add(core.BuiltInFunctionESDTMultiTransfer, multiTransfer)
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.BuiltInFunctions = container
+	arguments.ScrForwarder = scrForwarder
+	arguments.AccountsDB = &mock.AccountsStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+	arguments.ArgsParser = &mock.ArgumentParserMock{
+		GetFunctionCalled: func() (string, error) {
+			return core.BuiltInFunctionESDTMultiTransfer, nil
+		},
+		GetFunctionArgumentsCalled: func() ([][]byte, error) {
+			return callArgs, nil
+		},
+	}
+	arguments.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return vm, nil
+		},
+	}
+	sc, err := NewSmartContractProcessor(arguments)
+	require.Nil(t, err)
+
+	return sc
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionESDTMultiTransferWithSCCallShouldCallTheSC(t *testing.T) {
+	t.Parallel()
+
+	callArgs := [][]byte{big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("deposit"), []byte("arg")}
+	var scCallInput *vmcommon.ContractCallInput
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			scCallInput = input
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRefund: big.NewInt(0)}, nil
+		},
+	}
+	sc := createESDTMultiTransferWithSCCallProcessor(t, vm, &mock.IntermediateTransactionHandlerMock{}, callArgs)
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  make([]byte, 32),
+		Data:     []byte("ESDTMultiTransfer"),
+		Value:    big.NewInt(0),
+		GasLimit: 100,
+	}
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+
+	err := sc.ExecuteSmartContractTransaction(tx, nil, acntDst)
+	require.Nil(t, err)
+	require.NotNil(t, scCallInput)
+	require.Equal(t, "deposit", scCallInput.Function)
+	require.Equal(t, [][]byte{big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("arg")}, scCallInput.Arguments)
+	require.Equal(t, uint64(90), scCallInput.GasProvided)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionESDTMultiTransferSCCallFailsShouldGiveBackTheTokens(t *testing.T) {
+	t.Parallel()
+
+	callArgs := [][]byte{big.NewInt(1).Bytes(), []byte("TKA"), big.NewInt(10).Bytes(), []byte("deposit")}
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, GasRefund: big.NewInt(0)}, nil
+		},
+	}
+	revertTransferData := []byte(core.BuiltInFunctionESDTMultiTransfer + "@01@544b41@0a")
+	revertFound := false
+	scrForwarder := &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			for _, scr := range txs {
+				if bytes.Equal(scr.GetData(), revertTransferData) {
+					revertFound = true
+					require.Equal(t, []byte("SRC"), scr.GetRcvAddr())
+				}
+			}
+			return nil
+		},
+	}
+	sc := createESDTMultiTransferWithSCCallProcessor(t, vm, scrForwarder, callArgs)
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  make([]byte, 32),
+		Data:     []byte("ESDTMultiTransfer"),
+		Value:    big.NewInt(0),
+		GasLimit: 100,
+	}
+	acntDst, _ := state.NewUserAccount(tx.RcvAddr)
+
+	err := sc.ExecuteSmartContractTransaction(tx, nil, acntDst)
+	require.Nil(t, err)
+	require.True(t, revertFound)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -16,15 +17,20 @@ var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer  process.VirtualMachinesContainer
-	economicsFee process.FeeHandler
-	mutRunSc     sync.Mutex
+	vmContainer    process.VirtualMachinesContainer
+	economicsFee   process.FeeHandler
+	blockChainHook process.BlockChainHookHandler
+	accounts       state.AccountsAdapter
+	mutRunSc       sync.Mutex
 }
 
-// NewSCQueryService returns a new instance of SCQueryService
+// NewSCQueryService returns a new instance of SCQueryService. The block chain hook must be the one used by the
+// provided virtual machines and the accounts adapter must be the one used by the block chain hook
 func NewSCQueryService(
 	vmContainer process.VirtualMachinesContainer,
 	economicsFee process.FeeHandler,
+	blockChainHook process.BlockChainHookHandler,
+	accounts state.AccountsAdapter,
 ) (*SCQueryService, error) {
 	if check.IfNil(vmContainer) {
 		return nil, process.ErrNoVM
@@ -32,14 +38,23 @@ func NewSCQueryService(
 	if check.IfNil(economicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(blockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
 
 	return &SCQueryService{
-		vmContainer:  vmContainer,
-		economicsFee: economicsFee,
+		vmContainer:    vmContainer,
+		economicsFee:   economicsFee,
+		blockChainHook: blockChainHook,
+		accounts:       accounts,
 	}, nil
 }
 
-// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
+// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract. If the query has a root
+// hash, the function is run on the state having that root hash instead of the current state
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	if query.ScAddress == nil {
 		return nil, process.ErrNilScAddress
@@ -51,6 +66,30 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
+	if len(query.RootHash) > 0 {
+		return service.executeScCallOnRootHash(query)
+	}
+
+	return service.executeScCall(query, 0)
+}
+
+func (service *SCQueryService) executeScCallOnRootHash(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	accounts, err := service.accounts.RecreateReadOnlyAccounts(query.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.blockChainHook.SetAccountsAdapter(accounts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errSet := service.blockChainHook.SetAccountsAdapter(service.accounts)
+		if errSet != nil {
+			log.Error("SCQueryService: could not restore the current accounts adapter", "error", errSet)
+		}
+	}()
+
 	return service.executeScCall(query, 0)
 }
 
@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"errors"
 	"math"
 	"math/big"
 	"sync"
@@ -8,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -21,7 +23,7 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{})
+	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.AccountsStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -30,16 +32,34 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil)
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.AccountsStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewSCQueryService_NilBlockChainHookShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, nil, &mock.AccountsStub{})
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilBlockChainHook, err)
+}
+
+func TestNewSCQueryService_NilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, nil)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.AccountsStub{})
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -49,7 +69,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.AccountsStub{})
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -66,7 +86,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.AccountsStub{})
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -113,6 +133,8 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.AccountsStub{},
 	)
 
 	dataArgs := make([][]byte, len(args))
@@ -154,6 +176,8 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.AccountsStub{},
 	)
 
 	query := process.SCQuery{
@@ -190,6 +214,8 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.AccountsStub{},
 	)
 
 	query := process.SCQuery{
@@ -237,6 +263,8 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.AccountsStub{},
 	)
 
 	noOfGoRoutines := 50
@@ -282,6 +310,8 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
+		&mock.BlockChainHookHandlerMock{},
+		&mock.AccountsStub{},
 	)
 
 	tx := &transaction.Transaction{
@@ -292,3 +322,101 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func TestExecuteQuery_WithRootHashRecreateFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	setAccountsCalled := false
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{
+			SetAccountsAdapterCalled: func(accounts state.AccountsAdapter) error {
+				setAccountsCalled = true
+				return nil
+			},
+		},
+		&mock.AccountsStub{
+			RecreateReadOnlyAccountsCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				return nil, expectedErr
+			},
+		},
+	)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("root hash"),
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, setAccountsCalled)
+}
+
+func TestExecuteQuery_WithRootHashShouldRunOnHistoricalStateAndRestore(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	currentAccounts := &mock.AccountsStub{}
+	historicalAccounts := &mock.AccountsStub{}
+	currentAccounts.RecreateReadOnlyAccountsCalled = func(providedRootHash []byte) (state.AccountsAdapter, error) {
+		assert.Equal(t, rootHash, providedRootHash)
+		return historicalAccounts, nil
+	}
+
+	var hookAccounts state.AccountsAdapter = currentAccounts
+	setAccounts := make([]state.AccountsAdapter, 0)
+	hook := &mock.BlockChainHookHandlerMock{
+		SetAccountsAdapterCalled: func(accounts state.AccountsAdapter) error {
+			hookAccounts = accounts
+			setAccounts = append(setAccounts, accounts)
+			return nil
+		},
+	}
+
+	runCalled := false
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			runCalled = true
+			assert.True(t, hookAccounts == historicalAccounts)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+		hook,
+		currentAccounts,
+	)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  rootHash,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	require.Nil(t, err)
+	assert.True(t, runCalled)
+	require.Equal(t, 2, len(setAccounts))
+	assert.True(t, setAccounts[0] == historicalAccounts)
+	assert.True(t, setAccounts[1] == currentAccounts)
+	assert.True(t, hookAccounts == currentAccounts)
+}
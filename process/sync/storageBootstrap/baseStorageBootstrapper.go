@@ -32,6 +32,7 @@ type ArgsBaseStorageBootstrapper struct {
 	NodesCoordinator    sharding.NodesCoordinator
 	EpochStartTrigger   process.EpochStartTriggerHandler
 	BlockTracker        process.BlockTracker
+	BlockCommitJournal  process.BlockCommitJournal
 }
 
 // ArgsShardStorageBootstrapper is structure used to create a new storage bootstrapper for shard
@@ -46,17 +47,18 @@ type ArgsMetaStorageBootstrapper struct {
 }
 
 type storageBootstrapper struct {
-	bootStorer        process.BootStorer
-	forkDetector      process.ForkDetector
-	blkExecutor       process.BlockProcessor
-	blkc              data.ChainHandler
-	marshalizer       marshal.Marshalizer
-	store             dataRetriever.StorageService
-	uint64Converter   typeConverters.Uint64ByteSliceConverter
-	shardCoordinator  sharding.Coordinator
-	nodesCoordinator  sharding.NodesCoordinator
-	epochStartTrigger process.EpochStartTriggerHandler
-	blockTracker      process.BlockTracker
+	bootStorer         process.BootStorer
+	forkDetector       process.ForkDetector
+	blkExecutor        process.BlockProcessor
+	blkc               data.ChainHandler
+	marshalizer        marshal.Marshalizer
+	store              dataRetriever.StorageService
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	shardCoordinator   sharding.Coordinator
+	nodesCoordinator   sharding.NodesCoordinator
+	epochStartTrigger  process.EpochStartTriggerHandler
+	blockTracker       process.BlockTracker
+	blockCommitJournal process.BlockCommitJournal
 
 	bootstrapRoundIndex  uint64
 	bootstrapper         storageBootstrapperHandler
@@ -68,6 +70,11 @@ func (st *storageBootstrapper) loadBlocks() error {
 	var err error
 	var headerInfo bootstrapStorage.BootstrapData
 
+	err = st.blockCommitJournal.Recover()
+	if err != nil {
+		log.Warn("bootstrapper: cannot recover the last block commit", "error", err)
+	}
+
 	round := st.bootStorer.GetHighestRound()
 	storageHeadersInfo := make([]bootstrapStorage.BootstrapData, 0)
 
@@ -396,6 +403,9 @@ func checkBaseStorageBootrstrapperArguments(args ArgsBaseStorageBootstrapper) er
 	if check.IfNil(args.BlockTracker) {
 		return process.ErrNilBlockTracker
 	}
+	if check.IfNil(args.BlockCommitJournal) {
+		return process.ErrNilBlockCommitJournal
+	}
 
 	return nil
 }
@@ -24,16 +24,17 @@ func NewMetaStorageBootstrapper(arguments ArgsMetaStorageBootstrapper) (*metaSto
 	}
 
 	base := &storageBootstrapper{
-		bootStorer:        arguments.BootStorer,
-		forkDetector:      arguments.ForkDetector,
-		blkExecutor:       arguments.BlockProcessor,
-		blkc:              arguments.ChainHandler,
-		marshalizer:       arguments.Marshalizer,
-		store:             arguments.Store,
-		shardCoordinator:  arguments.ShardCoordinator,
-		nodesCoordinator:  arguments.NodesCoordinator,
-		epochStartTrigger: arguments.EpochStartTrigger,
-		blockTracker:      arguments.BlockTracker,
+		bootStorer:         arguments.BootStorer,
+		forkDetector:       arguments.ForkDetector,
+		blkExecutor:        arguments.BlockProcessor,
+		blkc:               arguments.ChainHandler,
+		marshalizer:        arguments.Marshalizer,
+		store:              arguments.Store,
+		shardCoordinator:   arguments.ShardCoordinator,
+		nodesCoordinator:   arguments.NodesCoordinator,
+		epochStartTrigger:  arguments.EpochStartTrigger,
+		blockTracker:       arguments.BlockTracker,
+		blockCommitJournal: arguments.BlockCommitJournal,
 
 		uint64Converter:     arguments.Uint64Converter,
 		bootstrapRoundIndex: arguments.BootstrapRoundIndex,
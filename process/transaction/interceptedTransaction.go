@@ -36,6 +36,8 @@ type InterceptedTransaction struct {
 	isForCurrentShard      bool
 	feeHandler             process.FeeHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
+	chainID                []byte
+	minTxVersion           uint32
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -50,6 +52,8 @@ func NewInterceptedTransaction(
 	coordinator sharding.Coordinator,
 	feeHandler process.FeeHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
+	chainID []byte,
+	minTxVersion uint32,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -82,6 +86,12 @@ func NewInterceptedTransaction(
 	if check.IfNil(whiteListerVerifiedTxs) {
 		return nil, process.ErrNilWhiteListHandler
 	}
+	if len(chainID) == 0 {
+		return nil, process.ErrInvalidChainID
+	}
+	if minTxVersion == 0 {
+		return nil, process.ErrInvalidTransactionVersion
+	}
 
 	tx, err := createTx(protoMarshalizer, txBuff)
 	if err != nil {
@@ -99,6 +109,8 @@ func NewInterceptedTransaction(
 		coordinator:            coordinator,
 		feeHandler:             feeHandler,
 		whiteListerVerifiedTxs: whiteListerVerifiedTxs,
+		chainID:                chainID,
+		minTxVersion:           minTxVersion,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -121,22 +133,53 @@ func createTx(marshalizer marshal.Marshalizer, txBuff []byte) (*transaction.Tran
 
 // CheckValidity checks if the received transaction is valid (not nil fields, valid sig and so on)
 func (inTx *InterceptedTransaction) CheckValidity() error {
-	err := inTx.integrity()
+	err := inTx.integrity(inTx.tx)
 	if err != nil {
 		return err
 	}
 
 	whiteListedVerified := inTx.whiteListerVerifiedTxs.IsWhiteListed(inTx)
 	if !whiteListedVerified {
-		err = inTx.verifySig()
+		err = inTx.verifySig(inTx.tx)
+		if err != nil {
+			return err
+		}
+
+		err = inTx.verifyIfRelayedTx()
 		if err != nil {
 			return err
 		}
+
+		inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
 	}
 
 	return nil
 }
 
+// verifyIfRelayedTx checks the user transaction carried by a relayed transaction as if it was received on its own
+func (inTx *InterceptedTransaction) verifyIfRelayedTx() error {
+	if !IsRelayedTxData(inTx.tx.Data) {
+		return nil
+	}
+
+	userTx, err := GetRelayedUserTx(inTx.protoMarshalizer, inTx.tx.Data)
+	if err != nil {
+		return err
+	}
+
+	err = CheckRelayedTx(inTx.tx, userTx, inTx.feeHandler)
+	if err != nil {
+		return err
+	}
+
+	err = inTx.integrity(userTx)
+	if err != nil {
+		return err
+	}
+
+	return inTx.verifySig(userTx)
+}
+
 func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	inTx.hash = inTx.hasher.Compute(string(txBuff))
 
@@ -154,47 +197,46 @@ func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	return nil
 }
 
-// integrity checks for not nil fields and negative value
-func (inTx *InterceptedTransaction) integrity() error {
-	if inTx.tx.Signature == nil {
+// integrity checks for not nil fields, negative value, chain ID and version
+func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error {
+	if !bytes.Equal(tx.ChainID, inTx.chainID) {
+		return process.ErrInvalidChainID
+	}
+	if tx.Version < inTx.minTxVersion {
+		return process.ErrInvalidTransactionVersion
+	}
+	if tx.Signature == nil {
 		return process.ErrNilSignature
 	}
-	if inTx.tx.RcvAddr == nil {
+	if tx.RcvAddr == nil {
 		return process.ErrNilRcvAddr
 	}
-	if inTx.tx.SndAddr == nil {
+	if tx.SndAddr == nil {
 		return process.ErrNilSndAddr
 	}
-	if inTx.tx.Value == nil {
+	if tx.Value == nil {
 		return process.ErrNilValue
 	}
-	if inTx.tx.Value.Sign() < 0 {
+	if tx.Value.Sign() < 0 {
 		return process.ErrNegativeValue
 	}
 
-	return inTx.feeHandler.CheckValidityTxValues(inTx.tx)
+	return inTx.feeHandler.CheckValidityTxValues(tx)
 }
 
 // verifySig checks if the tx is correctly signed
-func (inTx *InterceptedTransaction) verifySig() error {
-	buffCopiedTx, err := inTx.tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error {
+	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
 	if err != nil {
 		return err
 	}
 
-	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(inTx.tx.SndAddr)
+	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.SndAddr)
 	if err != nil {
 		return err
 	}
 
-	err = inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, inTx.tx.Signature)
-	if err != nil {
-		return err
-	}
-
-	inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
-
-	return nil
+	return inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, tx.Signature)
 }
 
 // ReceiverShardId returns the receiver shard id
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -25,6 +26,8 @@ var recvShard = uint32(3)
 var senderAddress = []byte("sender")
 var recvAddress = []byte("receiver")
 var sigOk = []byte("signature")
+var testChainID = []byte("chainID")
+var testMinTxVersion = uint32(1)
 
 func createMockPubkeyConverter() *mock.PubkeyConverterMock {
 	return mock.NewPubkeyConverterMock(32)
@@ -92,6 +95,8 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		shardCoordinator,
 		txFeeHandler,
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 }
 
@@ -111,6 +116,8 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -131,6 +138,8 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -151,6 +160,8 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -171,6 +182,8 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -191,6 +204,8 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -211,6 +226,8 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -231,6 +248,8 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -251,6 +270,8 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		nil,
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -271,6 +292,8 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		nil,
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -291,12 +314,58 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		nil,
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilWhiteListHandler, err)
 }
 
+func TestNewInterceptedTransaction_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		createMockPubkeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+		nil,
+		testMinTxVersion,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestNewInterceptedTransaction_ZeroMinTxVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		createMockPubkeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+		testChainID,
+		0,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+}
+
 func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +386,8 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, txi)
@@ -335,6 +406,8 @@ func TestNewInterceptedTransaction_ShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, err := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -358,6 +431,8 @@ func TestInterceptedTransaction_CheckValidityNilSignatureShouldErr(t *testing.T)
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: nil,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -378,6 +453,8 @@ func TestInterceptedTransaction_CheckValidityNilRecvAddressShouldErr(t *testing.
 		RcvAddr:   nil,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -398,6 +475,8 @@ func TestInterceptedTransaction_CheckValidityNilSenderAddressShouldErr(t *testin
 		RcvAddr:   recvAddress,
 		SndAddr:   nil,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -418,6 +497,8 @@ func TestInterceptedTransaction_CheckValidityNilValueShouldErr(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, err := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 	assert.Nil(t, err)
@@ -439,6 +520,8 @@ func TestInterceptedTransaction_CheckValidityNilNegativeValueShouldErr(t *testin
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -461,6 +544,8 @@ func TestNewInterceptedTransaction_InsufficientFeeShouldErr(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	errExpected := errors.New("insufficient fee")
 	feeHandler := &mock.FeeHandlerStub{
@@ -487,6 +572,8 @@ func TestInterceptedTransaction_CheckValidityInvalidSenderShouldErr(t *testing.T
 		RcvAddr:   recvAddress,
 		SndAddr:   []byte(""),
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -507,6 +594,8 @@ func TestInterceptedTransaction_CheckValidityVerifyFailsShouldErr(t *testing.T)
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: []byte("wrong sig"),
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -527,6 +616,8 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
 
@@ -535,6 +626,50 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInterceptedTransaction_CheckValidityWrongChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   []byte("another chain"),
+		Version:   testMinTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityLowerVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion - 1,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+}
+
 func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -547,6 +682,8 @@ func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -574,6 +711,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		RcvAddr:   recvAddressDeploy,
 		SndAddr:   senderAddressInShard1,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, _ := marshalizer.Marshal(tx)
@@ -602,6 +741,8 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		shardCoordinator,
 		createFreeTxFeeHandler(),
 		&mock.WhiteListHandlerStub{},
+		testChainID,
+		testMinTxVersion,
 	)
 
 	assert.Nil(t, err)
@@ -623,6 +764,8 @@ func TestInterceptedTransaction_GetNonce(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -643,6 +786,8 @@ func TestInterceptedTransaction_SenderShardId(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -663,6 +808,8 @@ func TestInterceptedTransaction_FeeCallsTxFeeHandler(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	computeFeeCalled := false
@@ -691,6 +838,8 @@ func TestInterceptedTransaction_GetSenderAddress(t *testing.T) {
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
@@ -710,6 +859,8 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		RcvAddr:   recvAddress,
 		SndAddr:   senderAddress,
 		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
 	}
 
 	var sigVerified bool
@@ -744,6 +895,8 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		shardCoordinator,
 		createFreeTxFeeHandler(),
 		whiteListerVerifiedTxs,
+		testChainID,
+		testMinTxVersion,
 	)
 	require.Nil(t, err)
 
@@ -760,6 +913,102 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 	require.False(t, sigVerified)
 }
 
+//------- relayed transactions
+
+func createRelayedTx(t *testing.T, userTx *dataTransaction.Transaction) *dataTransaction.Transaction {
+	relayedTxData, err := transaction.CreateRelayedTxData(&mock.MarshalizerMock{}, userTx)
+	require.Nil(t, err)
+
+	return &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(0).Set(userTx.Value),
+		Data:      relayedTxData,
+		GasLimit:  userTx.GasLimit,
+		GasPrice:  userTx.GasPrice,
+		RcvAddr:   userTx.SndAddr,
+		SndAddr:   []byte("relayer"),
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
+	}
+}
+
+func createRelayedUserTx() *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:     0,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   testChainID,
+		Version:   testMinTxVersion,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(t, createRelayedUserTx())
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(t, createRelayedUserTx())
+	tx.Data = []byte(core.RelayedTransaction + "@00")
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidRelayedTxData, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWrongUserSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.Signature = []byte("wrong signature")
+	tx := createRelayedTx(t, userTx)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWrongUserChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userTx := createRelayedUserTx()
+	userTx.ChainID = []byte("another chain")
+	tx := createRelayedTx(t, userTx)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrInvalidChainID, err)
+}
+
+func TestInterceptedTransaction_CheckValidityRelayedTxWrongBeneficiaryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTx(t, createRelayedUserTx())
+	tx.RcvAddr = recvAddress
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver, err)
+}
+
 //------- IsInterfaceNil
 
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
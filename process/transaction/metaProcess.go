@@ -111,6 +111,8 @@ func (txProc *metaTxProcessor) ProcessTransaction(tx *transaction.Transaction) e
 		return txProc.processSCDeployment(tx, tx.SndAddr)
 	case process.SCInvoking:
 		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
+	case process.BuiltInFunctionCall:
+		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
 	}
 
 	snapshot := txProc.accounts.JournalLen()
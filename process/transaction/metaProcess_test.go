@@ -328,6 +328,62 @@ func TestMetaTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 	assert.Equal(t, 0, saveAccountCalled)
 }
 
+func TestMetaTxProcessor_ProcessTransactionBuiltInFunctionCallShouldExecuteTheSCTransaction(t *testing.T) {
+	t.Parallel()
+
+	saveAccountCalled := 0
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = generateRandomByteSlice(createMockPubkeyConverter().Len())
+	tx.Value = big.NewInt(45)
+	tx.GasPrice = 1
+	tx.GasLimit = 1
+
+	acntSrc, err := state.NewUserAccount(tx.SndAddr)
+	assert.Nil(t, err)
+
+	acntDst, err := state.NewUserAccount(tx.RcvAddr)
+	assert.Nil(t, err)
+
+	acntSrc.Balance = big.NewInt(46)
+	acntDst.SetCode([]byte{65})
+
+	adb := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+	adb.SaveAccountCalled = func(account state.AccountHandler) error {
+		saveAccountCalled++
+		return nil
+	}
+	scProcessorMock := &mock.SCProcessorMock{}
+
+	wasCalled := false
+	scProcessorMock.ExecuteSmartContractTransactionCalled = func(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) error {
+		wasCalled = true
+		return nil
+	}
+
+	execTx, _ := txproc.NewMetaTxProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		adb,
+		createMockPubkeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		scProcessorMock,
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (transactionType process.TransactionType) {
+				return process.BuiltInFunctionCall
+			},
+		},
+		createFreeTxFeeHandler(),
+	)
+
+	err = execTx.ProcessTransaction(&tx)
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+	assert.Equal(t, 0, saveAccountCalled)
+}
+
 func TestMetaTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *testing.T) {
 	t.Parallel()
 
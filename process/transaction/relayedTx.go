@@ -0,0 +1,94 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const relayedTxDataPrefix = core.RelayedTransaction + "@"
+
+// IsRelayedTxData returns true if the provided data field belongs to a relayed transaction
+func IsRelayedTxData(txData []byte) bool {
+	return strings.HasPrefix(string(txData), relayedTxDataPrefix)
+}
+
+// CreateRelayedTxData returns the data field of a relayed transaction that carries the provided, already signed,
+// user transaction
+func CreateRelayedTxData(marshalizer marshal.Marshalizer, userTx *transaction.Transaction) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(userTx) {
+		return nil, process.ErrNilTransaction
+	}
+
+	userTxBuff, err := marshalizer.Marshal(userTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(relayedTxDataPrefix + hex.EncodeToString(userTxBuff)), nil
+}
+
+// GetRelayedUserTx returns the user transaction carried by the data field of a relayed transaction
+func GetRelayedUserTx(marshalizer marshal.Marshalizer, txData []byte) (*transaction.Transaction, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if !IsRelayedTxData(txData) {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTxBuff, err := hex.DecodeString(string(txData[len(relayedTxDataPrefix):]))
+	if err != nil || len(userTxBuff) == 0 {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	userTx := &transaction.Transaction{}
+	err = marshalizer.Unmarshal(userTx, userTxBuff)
+	if err != nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+	if userTx.Value == nil {
+		return nil, process.ErrInvalidRelayedTxData
+	}
+
+	return userTx, nil
+}
+
+// CheckRelayedTx verifies that the relayed transaction matches the user transaction it carries: the relayer sends
+// the user's value to the user, at the same gas price, paying its own move balance cost on top of the user's gas limit
+func CheckRelayedTx(relayedTx *transaction.Transaction, userTx *transaction.Transaction, feeHandler process.FeeHandler) error {
+	if check.IfNil(relayedTx) || check.IfNil(userTx) {
+		return process.ErrNilTransaction
+	}
+	if check.IfNil(feeHandler) {
+		return process.ErrNilEconomicsFeeHandler
+	}
+	if IsRelayedTxData(userTx.Data) {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+	if !bytes.Equal(userTx.SndAddr, relayedTx.RcvAddr) {
+		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
+	}
+	if relayedTx.Value == nil || userTx.Value == nil || relayedTx.Value.Cmp(userTx.Value) != 0 {
+		return process.ErrRelayedTxValueMismatch
+	}
+	if relayedTx.GasPrice != userTx.GasPrice {
+		return process.ErrRelayedTxGasPriceMismatch
+	}
+
+	relayerGasLimit := feeHandler.ComputeGasLimit(relayedTx)
+	if relayedTx.GasLimit < relayerGasLimit || relayedTx.GasLimit-relayerGasLimit != userTx.GasLimit {
+		return process.ErrRelayedTxGasLimitMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package transaction_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCheckRelayedTxArguments(t *testing.T) (*dataTransaction.Transaction, *dataTransaction.Transaction, process.FeeHandler) {
+	userTx := &dataTransaction.Transaction{
+		Value:    big.NewInt(10),
+		SndAddr:  []byte("user"),
+		RcvAddr:  []byte("receiver"),
+		GasPrice: 2,
+		GasLimit: 5,
+	}
+
+	relayedTxData, err := transaction.CreateRelayedTxData(&mock.MarshalizerMock{}, userTx)
+	require.Nil(t, err)
+
+	relayedTx := &dataTransaction.Transaction{
+		Value:    big.NewInt(10),
+		SndAddr:  []byte("relayer"),
+		RcvAddr:  userTx.SndAddr,
+		GasPrice: 2,
+		GasLimit: 6,
+		Data:     relayedTxData,
+	}
+
+	feeHandler := &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 1
+		},
+	}
+
+	return relayedTx, userTx, feeHandler
+}
+
+func TestIsRelayedTxData(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, transaction.IsRelayedTxData([]byte(core.RelayedTransaction+"@aa")))
+	assert.False(t, transaction.IsRelayedTxData([]byte(core.RelayedTransaction)))
+	assert.False(t, transaction.IsRelayedTxData([]byte("transfer@aa")))
+	assert.False(t, transaction.IsRelayedTxData(nil))
+}
+
+func TestCreateRelayedTxData_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff, err := transaction.CreateRelayedTxData(nil, &dataTransaction.Transaction{})
+
+	assert.Nil(t, buff)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestCreateRelayedTxData_NilUserTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff, err := transaction.CreateRelayedTxData(&mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, buff)
+	assert.Equal(t, process.ErrNilTransaction, err)
+}
+
+func TestGetRelayedUserTx_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, _ := createCheckRelayedTxArguments(t)
+
+	recoveredUserTx, err := transaction.GetRelayedUserTx(&mock.MarshalizerMock{}, relayedTx.Data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, userTx, recoveredUserTx)
+}
+
+func TestGetRelayedUserTx_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+
+	userTx, err := transaction.GetRelayedUserTx(marshalizer, []byte("transfer@aa"))
+	assert.Nil(t, userTx)
+	assert.Equal(t, process.ErrInvalidRelayedTxData, err)
+
+	userTx, err = transaction.GetRelayedUserTx(marshalizer, []byte(core.RelayedTransaction+"@not hex"))
+	assert.Nil(t, userTx)
+	assert.Equal(t, process.ErrInvalidRelayedTxData, err)
+
+	userTx, err = transaction.GetRelayedUserTx(marshalizer, []byte(core.RelayedTransaction+"@"))
+	assert.Nil(t, userTx)
+	assert.Equal(t, process.ErrInvalidRelayedTxData, err)
+}
+
+func TestCheckRelayedTx_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Nil(t, err)
+}
+
+func TestCheckRelayedTx_RecursiveRelayedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+	userTx.Data = relayedTx.Data
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
+}
+
+func TestCheckRelayedTx_BeneficiaryMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+	relayedTx.RcvAddr = []byte("another user")
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Equal(t, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver, err)
+}
+
+func TestCheckRelayedTx_ValueMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+	relayedTx.Value = big.NewInt(11)
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Equal(t, process.ErrRelayedTxValueMismatch, err)
+}
+
+func TestCheckRelayedTx_GasPriceMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+	relayedTx.GasPrice = 3
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Equal(t, process.ErrRelayedTxGasPriceMismatch, err)
+}
+
+func TestCheckRelayedTx_GasLimitMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	relayedTx, userTx, feeHandler := createCheckRelayedTxArguments(t)
+	relayedTx.GasLimit = 5
+
+	err := transaction.CheckRelayedTx(relayedTx, userTx, feeHandler)
+
+	assert.Equal(t, process.ErrRelayedTxGasLimitMismatch, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -25,6 +26,7 @@ type txProcessor struct {
 	txTypeHandler    process.TxTypeHandler
 	receiptForwarder process.IntermediateTransactionHandler
 	badTxForwarder   process.IntermediateTransactionHandler
+	scrForwarder     process.IntermediateTransactionHandler
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -40,6 +42,7 @@ func NewTxProcessor(
 	economicsFee process.FeeHandler,
 	receiptForwarder process.IntermediateTransactionHandler,
 	badTxForwarder process.IntermediateTransactionHandler,
+	scrForwarder process.IntermediateTransactionHandler,
 ) (*txProcessor, error) {
 
 	if check.IfNil(accounts) {
@@ -75,6 +78,9 @@ func NewTxProcessor(
 	if check.IfNil(badTxForwarder) {
 		return nil, process.ErrNilBadTxHandler
 	}
+	if check.IfNil(scrForwarder) {
+		return nil, process.ErrNilIntermediateTransactionHandler
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         accounts,
@@ -92,6 +98,7 @@ func NewTxProcessor(
 		txTypeHandler:    txTypeHandler,
 		receiptForwarder: receiptForwarder,
 		badTxForwarder:   badTxForwarder,
+		scrForwarder:     scrForwarder,
 	}, nil
 }
 
@@ -142,6 +149,8 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) error
 		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
 	case process.BuiltInFunctionCall:
 		return txProc.processSCInvoking(tx, tx.SndAddr, tx.RcvAddr)
+	case process.RelayedTx:
+		return txProc.processRelayedTx(tx, acntSnd, acntDst)
 	}
 
 	return process.ErrWrongTransaction
@@ -393,6 +402,257 @@ func (txProc *txProcessor) moveBalances(
 	return nil
 }
 
+// processRelayedTx handles a transaction whose data field carries a signed user transaction. The relayer pays the
+// value and the whole gas limit in its shard, while the shard of the user credits them to the user account and then
+// executes the user transaction as if it was sent directly by the user
+func (txProc *txProcessor) processRelayedTx(
+	tx *transaction.Transaction,
+	acntRelayer, acntUser state.UserAccountHandler,
+) error {
+	userTx, err := GetRelayedUserTx(txProc.marshalizer, tx.Data)
+	if err == nil {
+		err = CheckRelayedTx(tx, userTx, txProc.economicsFee)
+	}
+	if err != nil {
+		if check.IfNil(acntRelayer) {
+			return err
+		}
+
+		return txProc.executingFailedTransaction(tx, acntRelayer, err)
+	}
+
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.processRelayerPayment(tx, acntRelayer, txHash)
+	if err != nil {
+		return err
+	}
+
+	if check.IfNil(acntUser) {
+		// the user transaction will be executed in the shard of the user account
+		return nil
+	}
+
+	return txProc.processRelayedUserTx(tx, userTx, txHash)
+}
+
+func (txProc *txProcessor) processRelayerPayment(
+	tx *transaction.Transaction,
+	acntRelayer state.UserAccountHandler,
+	txHash []byte,
+) error {
+	if check.IfNil(acntRelayer) {
+		return nil
+	}
+
+	totalCost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit), big.NewInt(0).SetUint64(tx.GasPrice))
+	totalCost.Add(totalCost, tx.Value)
+	err := acntRelayer.SubFromBalance(totalCost)
+	if err != nil {
+		return err
+	}
+
+	acntRelayer.IncreaseNonce(1)
+	err = txProc.accounts.SaveAccount(acntRelayer)
+	if err != nil {
+		return err
+	}
+
+	relayerFee := txProc.economicsFee.ComputeFee(tx)
+	txProc.txFeeHandler.ProcessTransactionFee(relayerFee, big.NewInt(0), txHash)
+
+	return nil
+}
+
+func (txProc *txProcessor) processRelayedUserTx(
+	relayedTx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	relayedTxHash []byte,
+) error {
+	snapshot := txProc.accounts.JournalLen()
+
+	acntUser, err := txProc.getAccountFromAddress(userTx.SndAddr)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(acntUser) {
+		return process.ErrNilUserAccount
+	}
+
+	err = acntUser.AddToBalance(computeRelayedAmount(userTx))
+	if err != nil {
+		return err
+	}
+
+	err = txProc.accounts.SaveAccount(acntUser)
+	if err != nil {
+		return err
+	}
+
+	acntSnd, acntDst, err := txProc.getAccounts(userTx.SndAddr, userTx.RcvAddr)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.checkTxValues(userTx, acntSnd, acntDst)
+	if err != nil {
+		return txProc.executingFailedRelayedUserTx(relayedTx, userTx, relayedTxHash, snapshot, err)
+	}
+
+	isMoveBalanceToMeta := txProc.shardCoordinator.ComputeId(userTx.RcvAddr) == core.MetachainShardId && len(userTx.Data) == 0
+	if isMoveBalanceToMeta {
+		return txProc.executingFailedRelayedUserTx(relayedTx, userTx, relayedTxHash, snapshot, process.ErrInvalidMetaTransaction)
+	}
+
+	txType := txProc.txTypeHandler.ComputeTransactionType(userTx)
+	switch txType {
+	case process.MoveBalance:
+		return txProc.processRelayedUserMoveBalance(userTx, relayedTxHash)
+	case process.SCDeployment:
+		return txProc.processSCDeployment(userTx, userTx.SndAddr)
+	case process.SCInvoking:
+		return txProc.processSCInvoking(userTx, userTx.SndAddr, userTx.RcvAddr)
+	case process.BuiltInFunctionCall:
+		return txProc.processRelayedUserBuiltInFunctionCall(userTx, relayedTxHash)
+	}
+
+	return txProc.executingFailedRelayedUserTx(relayedTx, userTx, relayedTxHash, snapshot, process.ErrWrongTransaction)
+}
+
+// processRelayedUserMoveBalance executes the user move balance and, as the user transaction is not part of any
+// miniblock, forwards it as a smart contract result when its receiver lives in another shard
+func (txProc *txProcessor) processRelayedUserMoveBalance(
+	userTx *transaction.Transaction,
+	relayedTxHash []byte,
+) error {
+	err := txProc.processMoveBalance(userTx, userTx.SndAddr, userTx.RcvAddr)
+	if err != nil {
+		return err
+	}
+
+	isDestInSelfShard := txProc.shardCoordinator.ComputeId(userTx.RcvAddr) == txProc.shardCoordinator.SelfId()
+	if isDestInSelfShard {
+		return nil
+	}
+
+	scr := createSCRFromRelayedUserTx(userTx, relayedTxHash)
+	isCrossShardSCCall := len(userTx.Data) > 0 && core.IsSmartContractAddress(userTx.RcvAddr)
+	if isCrossShardSCCall {
+		scr.GasLimit = userTx.GasLimit
+	}
+
+	return txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
+// processRelayedUserBuiltInFunctionCall executes the sender side of the built-in function and, as the user transaction
+// is not part of any miniblock, forwards it as a smart contract result when its receiver lives in another shard, so
+// that the destination side of the built-in function is executed there. A failure of the built-in function at the
+// sender is returned as error, so nothing is forwarded for it
+func (txProc *txProcessor) processRelayedUserBuiltInFunctionCall(
+	userTx *transaction.Transaction,
+	relayedTxHash []byte,
+) error {
+	err := txProc.processSCInvoking(userTx, userTx.SndAddr, userTx.RcvAddr)
+	if err != nil {
+		return err
+	}
+
+	isDestInSelfShard := txProc.shardCoordinator.ComputeId(userTx.RcvAddr) == txProc.shardCoordinator.SelfId()
+	if isDestInSelfShard {
+		return nil
+	}
+
+	// the destination shard needs the whole gas limit to execute the built-in function the same way it executes a
+	// cross-shard transaction
+	scr := createSCRFromRelayedUserTx(userTx, relayedTxHash)
+	scr.GasLimit = userTx.GasLimit
+
+	return txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+}
+
+// createSCRFromRelayedUserTx creates the smart contract result which carries the user transaction to its receiver
+func createSCRFromRelayedUserTx(
+	userTx *transaction.Transaction,
+	relayedTxHash []byte,
+) *smartContractResult.SmartContractResult {
+	return &smartContractResult.SmartContractResult{
+		Nonce:          userTx.Nonce,
+		Value:          big.NewInt(0).Set(userTx.Value),
+		RcvAddr:        userTx.RcvAddr,
+		SndAddr:        userTx.SndAddr,
+		Data:           userTx.Data,
+		PrevTxHash:     relayedTxHash,
+		OriginalTxHash: relayedTxHash,
+		GasPrice:       userTx.GasPrice,
+	}
+}
+
+// executingFailedRelayedUserTx reverts the amount credited to the user and gives it back to the relayer, except for
+// the fee of the user transaction which is consumed
+func (txProc *txProcessor) executingFailedRelayedUserTx(
+	relayedTx *transaction.Transaction,
+	userTx *transaction.Transaction,
+	relayedTxHash []byte,
+	snapshot int,
+	txError error,
+) error {
+	err := txProc.accounts.RevertToSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	relayedAmount := computeRelayedAmount(userTx)
+	userFee := txProc.economicsFee.ComputeFee(userTx)
+	if userFee.Cmp(relayedAmount) > 0 {
+		userFee.Set(relayedAmount)
+	}
+	refund := big.NewInt(0).Sub(relayedAmount, userFee)
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          relayedTx.Nonce,
+		Value:          refund,
+		RcvAddr:        relayedTx.SndAddr,
+		SndAddr:        userTx.SndAddr,
+		PrevTxHash:     relayedTxHash,
+		OriginalTxHash: relayedTxHash,
+		ReturnMessage:  []byte(txError.Error()),
+	}
+
+	acntRelayer, err := txProc.getAccountFromAddress(relayedTx.SndAddr)
+	if err != nil {
+		return err
+	}
+	if !check.IfNil(acntRelayer) {
+		err = acntRelayer.AddToBalance(refund)
+		if err != nil {
+			return err
+		}
+
+		err = txProc.accounts.SaveAccount(acntRelayer)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = txProc.scrForwarder.AddIntermediateTransactions([]data.TransactionHandler{scr})
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(userFee, big.NewInt(0), relayedTxHash)
+
+	return nil
+}
+
+// computeRelayedAmount returns the value and the gas budget the relayer pays on behalf of the user
+func computeRelayedAmount(userTx *transaction.Transaction) *big.Int {
+	relayedAmount := big.NewInt(0).Mul(big.NewInt(0).SetUint64(userTx.GasLimit), big.NewInt(0).SetUint64(userTx.GasPrice))
+	return relayedAmount.Add(relayedAmount, userTx.Value)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txProc *txProcessor) IsInterfaceNil() bool {
 	return txProc == nil
@@ -8,7 +8,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -19,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func generateRandomByteSlice(size int) []byte {
@@ -72,6 +76,7 @@ func createTxProcessor() txproc.TxProcessor {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	return txProc
@@ -94,6 +99,7 @@ func TestNewTxProcessor_NilAccountsShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -115,6 +121,7 @@ func TestNewTxProcessor_NilHasherShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -136,6 +143,7 @@ func TestNewTxProcessor_NilPubkeyConverterMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
@@ -157,6 +165,7 @@ func TestNewTxProcessor_NilMarshalizerMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -178,6 +187,7 @@ func TestNewTxProcessor_NilShardCoordinatorMockShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -199,6 +209,7 @@ func TestNewTxProcessor_NilSCProcessorShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -220,12 +231,35 @@ func TestNewTxProcessor_NilTxFeeHandlerShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Equal(t, process.ErrNilUnsignedTxHandler, err)
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilScrForwarderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -241,6 +275,7 @@ func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	assert.Nil(t, err)
@@ -266,6 +301,7 @@ func TestTxProcessor_GetAccountsShouldErrNilAddressContainer(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	adr1 := []byte{65}
@@ -295,6 +331,7 @@ func TestTxProcessor_GetAccountsMalfunctionAccountsShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	adr1 := []byte{65}
@@ -341,6 +378,7 @@ func TestTxProcessor_GetAccountsOkValsSrcShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
@@ -396,6 +434,7 @@ func TestTxProcessor_GetAccountsOkValsDsthouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
@@ -436,6 +475,7 @@ func TestTxProcessor_GetAccountsOkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr2)
@@ -467,6 +507,7 @@ func TestTxProcessor_GetSameAccountShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr1)
@@ -646,6 +687,7 @@ func TestTxProcessor_ProcessTransactionMalfunctionAccountsShouldErr(t *testing.T
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	tx := transaction.Transaction{}
@@ -687,6 +729,7 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -746,6 +789,7 @@ func testProcessCheck(t *testing.T, nonce uint64, value *big.Int) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -787,6 +831,7 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -832,6 +877,7 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -890,6 +936,7 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		feeHandler,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -951,6 +998,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1005,6 +1053,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1073,6 +1122,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
@@ -1102,6 +1152,7 @@ func TestTxProcessor_ProcessTxFeeIntraShard(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 	tx := &transaction.Transaction{
 		RcvAddr:  []byte("aaa"),
@@ -1142,6 +1193,7 @@ func TestTxProcessor_ProcessTxFeeCrossShardMoveBalance(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 	tx := &transaction.Transaction{
 		RcvAddr:  []byte("aaa"),
@@ -1204,6 +1256,7 @@ func TestTxProcessor_ProcessTxFeeCrossShardSCCall(t *testing.T) {
 		},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	scAddress, _ := hex.DecodeString("000000000000000000005fed9c659422cd8429ce92f8973bba2a9fb51e0eb3a1")
@@ -1261,8 +1314,479 @@ func TestTxProcessor_ProcessTransactionShouldReturnErrForInvalidMetaTx(t *testin
 		feeHandlerMock(),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
 	)
 
 	err = execTx.ProcessTransaction(&tx)
 	assert.Equal(t, err, process.ErrFailedTransaction)
 }
+
+//------- relayed transactions
+
+func relayedTxFeeHandlerMock() *mock.FeeHandlerStub {
+	return &mock.FeeHandlerStub{
+		CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+			return nil
+		},
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 1
+		},
+		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(0).SetUint64(tx.GetGasPrice())
+		},
+	}
+}
+
+func relayedTxTypeHandlerMock() *mock.TxTypeHandlerMock {
+	return &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			if txproc.IsRelayedTxData(tx.GetData()) {
+				return process.RelayedTx
+			}
+			return process.MoveBalance
+		},
+	}
+}
+
+func createRelayedTxs(t *testing.T, marshalizer *mock.MarshalizerMock) (*transaction.Transaction, *transaction.Transaction) {
+	userTx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(10),
+		SndAddr:  []byte("USR"),
+		RcvAddr:  []byte("DST"),
+		GasPrice: 1,
+		GasLimit: 5,
+	}
+
+	relayedTxData, err := txproc.CreateRelayedTxData(marshalizer, userTx)
+	assert.Nil(t, err)
+
+	relayedTx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(10),
+		SndAddr:  []byte("RLY"),
+		RcvAddr:  userTx.SndAddr,
+		GasPrice: 1,
+		GasLimit: 6,
+		Data:     relayedTxData,
+	}
+
+	return relayedTx, userTx
+}
+
+func createRelayedTxAccountsStub(accounts ...state.UserAccountHandler) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			for _, acnt := range accounts {
+				if bytes.Equal(address, acnt.AddressBytes()) {
+					return acnt, nil
+				}
+			}
+
+			return nil, errors.New("failure")
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+}
+
+func TestTxProcessor_ProcessRelayedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedTxs(t, marshalizer)
+
+	acntRelayer, _ := state.NewUserAccount(relayedTx.SndAddr)
+	acntRelayer.Balance = big.NewInt(100)
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+	acntDst, _ := state.NewUserAccount(userTx.RcvAddr)
+
+	feesProcessed := big.NewInt(0)
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntRelayer, acntUser, acntDst),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{
+			ProcessTransactionFeeCalled: func(cost *big.Int, devFee *big.Int, hash []byte) {
+				feesProcessed.Add(feesProcessed, cost)
+			},
+		},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(84), acntRelayer.Balance)
+	assert.Equal(t, uint64(1), acntRelayer.Nonce)
+	assert.Equal(t, big.NewInt(4), acntUser.Balance)
+	assert.Equal(t, uint64(1), acntUser.Nonce)
+	assert.Equal(t, big.NewInt(10), acntDst.Balance)
+	assert.Equal(t, big.NewInt(2), feesProcessed)
+}
+
+func TestTxProcessor_ProcessRelayedTxInvalidDataShouldConsumeRelayerFee(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedTxs(t, marshalizer)
+	relayedTx.Data = []byte(core.RelayedTransaction + "@not hex")
+
+	acntRelayer, _ := state.NewUserAccount(relayedTx.SndAddr)
+	acntRelayer.Balance = big.NewInt(100)
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntRelayer, acntUser),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, big.NewInt(99), acntRelayer.Balance)
+	assert.Equal(t, uint64(1), acntRelayer.Nonce)
+	assert.Equal(t, big.NewInt(0), acntUser.Balance)
+}
+
+func TestTxProcessor_ProcessRelayedTxGasLimitMismatchShouldConsumeRelayerFee(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedTxs(t, marshalizer)
+	relayedTx.GasLimit = 10
+
+	acntRelayer, _ := state.NewUserAccount(relayedTx.SndAddr)
+	acntRelayer.Balance = big.NewInt(100)
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntRelayer, acntUser),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, big.NewInt(99), acntRelayer.Balance)
+	assert.Equal(t, big.NewInt(0), acntUser.Balance)
+}
+
+func TestTxProcessor_ProcessRelayedTxUserNonceMismatchShouldRefundRelayer(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedTxs(t, marshalizer)
+
+	acntRelayer, _ := state.NewUserAccount(relayedTx.SndAddr)
+	acntRelayer.Balance = big.NewInt(100)
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+	acntUser.Nonce = 3
+	acntDst, _ := state.NewUserAccount(userTx.RcvAddr)
+
+	adb := createRelayedTxAccountsStub(acntRelayer, acntUser, acntDst)
+	revertCalled := false
+	adb.RevertToSnapshotCalled = func(snapshot int) error {
+		revertCalled = true
+		// the stub does not journal changes, the amount credited to the user is reverted by hand
+		return acntUser.SubFromBalance(big.NewInt(15))
+	}
+
+	var refundScr *smartContractResult.SmartContractResult
+	execTx, _ := txproc.NewTxProcessor(
+		adb,
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				refundScr = txs[0].(*smartContractResult.SmartContractResult)
+				return nil
+			},
+		},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.True(t, revertCalled)
+
+	// 100 - (10 value + 6 gas) + (15 relayed amount - 1 user fee)
+	assert.Equal(t, big.NewInt(98), acntRelayer.Balance)
+	assert.Equal(t, uint64(0), acntUser.Balance.Uint64())
+	assert.Equal(t, uint64(3), acntUser.Nonce)
+	assert.Equal(t, big.NewInt(0), acntDst.Balance)
+	assert.Equal(t, relayedTx.SndAddr, refundScr.RcvAddr)
+	assert.Equal(t, big.NewInt(14), refundScr.Value)
+}
+
+func TestTxProcessor_ProcessRelayedTxUserInOtherShardShouldOnlyChargeRelayer(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, _ := createRelayedTxs(t, marshalizer)
+
+	acntRelayer, _ := state.NewUserAccount(relayedTx.SndAddr)
+	acntRelayer.Balance = big.NewInt(100)
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, relayedTx.SndAddr) {
+			return 0
+		}
+		return 1
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntRelayer),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(84), acntRelayer.Balance)
+	assert.Equal(t, uint64(1), acntRelayer.Nonce)
+}
+
+func TestTxProcessor_ProcessRelayedTxCrossShardUserTxShouldCreateScr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedTxs(t, marshalizer)
+
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, userTx.SndAddr) {
+			return 0
+		}
+		return 1
+	}
+
+	var forwardedScr *smartContractResult.SmartContractResult
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntUser),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.FeeAccumulatorStub{},
+		relayedTxTypeHandlerMock(),
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				forwardedScr = txs[0].(*smartContractResult.SmartContractResult)
+				return nil
+			},
+		},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(4), acntUser.Balance)
+	assert.Equal(t, uint64(1), acntUser.Nonce)
+	assert.Equal(t, userTx.RcvAddr, forwardedScr.RcvAddr)
+	assert.Equal(t, userTx.SndAddr, forwardedScr.SndAddr)
+	assert.Equal(t, userTx.Value, forwardedScr.Value)
+}
+
+func createRelayedBuiltInFunctionCallTxs(t *testing.T, marshalizer *mock.MarshalizerMock) (*transaction.Transaction, *transaction.Transaction) {
+	userTx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(0),
+		SndAddr:  []byte("USR"),
+		RcvAddr:  []byte("DST"),
+		GasPrice: 1,
+		GasLimit: 5,
+		Data:     []byte(core.BuiltInFunctionESDTTransfer + "@746f6b656e@0a"),
+	}
+
+	relayedTxData, err := txproc.CreateRelayedTxData(marshalizer, userTx)
+	assert.Nil(t, err)
+
+	relayedTx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(0),
+		SndAddr:  []byte("RLY"),
+		RcvAddr:  userTx.SndAddr,
+		GasPrice: 1,
+		GasLimit: 6,
+		Data:     relayedTxData,
+	}
+
+	return relayedTx, userTx
+}
+
+func TestTxProcessor_ProcessRelayedTxCrossShardBuiltInFunctionCallShouldCreateScr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedBuiltInFunctionCallTxs(t, marshalizer)
+
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, userTx.SndAddr) {
+			return 0
+		}
+		return 1
+	}
+
+	builtInExecuted := false
+	scProcessor := &mock.SCProcessorMock{
+		ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) error {
+			assert.Equal(t, userTx, tx)
+			assert.Equal(t, acntUser, acntSrc)
+			assert.True(t, check.IfNil(acntDst))
+			builtInExecuted = true
+			return nil
+		},
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			if txproc.IsRelayedTxData(tx.GetData()) {
+				return process.RelayedTx
+			}
+			return process.BuiltInFunctionCall
+		},
+	}
+
+	var forwardedScr *smartContractResult.SmartContractResult
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntUser),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		shardCoordinator,
+		scProcessor,
+		&mock.FeeAccumulatorStub{},
+		txTypeHandler,
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				forwardedScr = txs[0].(*smartContractResult.SmartContractResult)
+				return nil
+			},
+		},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Nil(t, err)
+	assert.True(t, builtInExecuted)
+	require.NotNil(t, forwardedScr)
+	assert.Equal(t, userTx.RcvAddr, forwardedScr.RcvAddr)
+	assert.Equal(t, userTx.SndAddr, forwardedScr.SndAddr)
+	assert.Equal(t, userTx.Data, forwardedScr.Data)
+	assert.Equal(t, userTx.GasLimit, forwardedScr.GasLimit)
+	assert.Equal(t, userTx.GasPrice, forwardedScr.GasPrice)
+}
+
+func TestTxProcessor_ProcessRelayedTxCrossShardBuiltInFunctionCallFailureShouldNotCreateScr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	relayedTx, userTx := createRelayedBuiltInFunctionCallTxs(t, marshalizer)
+
+	acntUser, _ := state.NewUserAccount(userTx.SndAddr)
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, userTx.SndAddr) {
+			return 0
+		}
+		return 1
+	}
+
+	expectedErr := errors.New("built-in function failed")
+	scProcessor := &mock.SCProcessorMock{
+		ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) error {
+			return expectedErr
+		},
+	}
+	txTypeHandler := &mock.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) process.TransactionType {
+			if txproc.IsRelayedTxData(tx.GetData()) {
+				return process.RelayedTx
+			}
+			return process.BuiltInFunctionCall
+		},
+	}
+
+	execTx, _ := txproc.NewTxProcessor(
+		createRelayedTxAccountsStub(acntUser),
+		mock.HasherMock{},
+		createMockPubkeyConverter(),
+		marshalizer,
+		shardCoordinator,
+		scProcessor,
+		&mock.FeeAccumulatorStub{},
+		txTypeHandler,
+		relayedTxFeeHandlerMock(),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				assert.Fail(t, "should have not forwarded any smart contract result")
+				return nil
+			},
+		},
+	)
+
+	err := execTx.ProcessTransaction(relayedTx)
+	assert.Equal(t, expectedErr, err)
+}
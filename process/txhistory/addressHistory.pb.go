@@ -0,0 +1,1238 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressHistory.proto
+
+package txhistory
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressHistoryEntry holds a transaction which touched an address, together with the block in which it was included
+type AddressHistoryEntry struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	MiniblockType int32  `protobuf:"varint,2,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	BlockNonce    uint64 `protobuf:"varint,3,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Epoch         uint32 `protobuf:"varint,4,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *AddressHistoryEntry) Reset()      { *m = AddressHistoryEntry{} }
+func (*AddressHistoryEntry) ProtoMessage() {}
+func (*AddressHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{0}
+}
+func (m *AddressHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryEntry.Merge(m, src)
+}
+func (m *AddressHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryEntry proto.InternalMessageInfo
+
+func (m *AddressHistoryEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressHistoryEntry) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// AddressHistoryBlock holds, in commit order, the hashes of the transactions which touched an address in a block,
+// together with the nonce of the previous block of the same epoch in which the address was touched
+type AddressHistoryBlock struct {
+	TxHashes       [][]byte `protobuf:"bytes,1,rep,name=TxHashes,proto3" json:"TxHashes,omitempty"`
+	HasPrevBlock   bool     `protobuf:"varint,2,opt,name=HasPrevBlock,proto3" json:"HasPrevBlock,omitempty"`
+	PrevBlockNonce uint64   `protobuf:"varint,3,opt,name=PrevBlockNonce,proto3" json:"PrevBlockNonce,omitempty"`
+}
+
+func (m *AddressHistoryBlock) Reset()      { *m = AddressHistoryBlock{} }
+func (*AddressHistoryBlock) ProtoMessage() {}
+func (*AddressHistoryBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{1}
+}
+func (m *AddressHistoryBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryBlock.Merge(m, src)
+}
+func (m *AddressHistoryBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryBlock proto.InternalMessageInfo
+
+func (m *AddressHistoryBlock) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *AddressHistoryBlock) GetHasPrevBlock() bool {
+	if m != nil {
+		return m.HasPrevBlock
+	}
+	return false
+}
+
+func (m *AddressHistoryBlock) GetPrevBlockNonce() uint64 {
+	if m != nil {
+		return m.PrevBlockNonce
+	}
+	return 0
+}
+
+// AddressHistoryHead holds the nonce of the newest block of an epoch in which an address was touched
+type AddressHistoryHead struct {
+	BlockNonce uint64 `protobuf:"varint,1,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+}
+
+func (m *AddressHistoryHead) Reset()      { *m = AddressHistoryHead{} }
+func (*AddressHistoryHead) ProtoMessage() {}
+func (*AddressHistoryHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{2}
+}
+func (m *AddressHistoryHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryHead.Merge(m, src)
+}
+func (m *AddressHistoryHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryHead proto.InternalMessageInfo
+
+func (m *AddressHistoryHead) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+// RecordedBlockAddresses holds the addresses touched by a recorded block, so that their history can be reverted
+type RecordedBlockAddresses struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *RecordedBlockAddresses) Reset()      { *m = RecordedBlockAddresses{} }
+func (*RecordedBlockAddresses) ProtoMessage() {}
+func (*RecordedBlockAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{3}
+}
+func (m *RecordedBlockAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordedBlockAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RecordedBlockAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordedBlockAddresses.Merge(m, src)
+}
+func (m *RecordedBlockAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordedBlockAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordedBlockAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordedBlockAddresses proto.InternalMessageInfo
+
+func (m *RecordedBlockAddresses) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddressHistoryEntry)(nil), "proto.AddressHistoryEntry")
+	proto.RegisterType((*AddressHistoryBlock)(nil), "proto.AddressHistoryBlock")
+	proto.RegisterType((*AddressHistoryHead)(nil), "proto.AddressHistoryHead")
+	proto.RegisterType((*RecordedBlockAddresses)(nil), "proto.RecordedBlockAddresses")
+}
+
+func init() { proto.RegisterFile("addressHistory.proto", fileDescriptor_e3a475f4d12d5066) }
+
+var fileDescriptor_e3a475f4d12d5066 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0xf3, 0xec, 0x0f, 0xda, 0xa3, 0x75, 0x38, 0x4b, 0x09, 0x45, 0x1e, 0x21, 0x88, 0x64,
+	0xb1, 0x1d, 0x14, 0x77, 0x2b, 0x85, 0x2c, 0x8a, 0x1c, 0x9d, 0xdc, 0xf2, 0xe3, 0x6c, 0x82, 0xda,
+	0x2b, 0xb9, 0x54, 0xda, 0x41, 0x70, 0x75, 0xf3, 0xcf, 0xf0, 0x4f, 0x71, 0xec, 0xd8, 0xd1, 0x5e,
+	0x17, 0xc7, 0xfe, 0x09, 0xe2, 0xa5, 0xd4, 0x26, 0xd3, 0xdd, 0xe7, 0xc3, 0xbd, 0xf7, 0xbe, 0x8f,
+	0x23, 0x2d, 0x2f, 0x0c, 0x13, 0x2e, 0xa5, 0x1b, 0xcb, 0x54, 0x24, 0xf3, 0xee, 0x24, 0x11, 0xa9,
+	0xa0, 0x15, 0x7d, 0x74, 0xce, 0x46, 0x71, 0x1a, 0x4d, 0xfd, 0x6e, 0x20, 0x9e, 0x7b, 0x23, 0x31,
+	0x12, 0x3d, 0xad, 0xfd, 0xe9, 0x83, 0x26, 0x0d, 0xfa, 0x96, 0x55, 0xd9, 0xef, 0x40, 0x8e, 0xae,
+	0x72, 0xed, 0x06, 0xe3, 0x34, 0x99, 0xd3, 0x36, 0xa9, 0x0e, 0x67, 0xae, 0x27, 0x23, 0x13, 0x2c,
+	0x70, 0x1a, 0x6c, 0x4b, 0xf4, 0x84, 0x34, 0x6f, 0xe2, 0x71, 0xec, 0x3f, 0x89, 0xe0, 0x71, 0x38,
+	0x9f, 0x70, 0xf3, 0xc0, 0x02, 0xa7, 0xc2, 0xf2, 0x92, 0x22, 0x21, 0xfd, 0x3f, 0xb8, 0x15, 0xe3,
+	0x80, 0x9b, 0x25, 0x0b, 0x9c, 0x32, 0xdb, 0x33, 0xb4, 0x45, 0x2a, 0x83, 0x89, 0x08, 0x22, 0xb3,
+	0x6c, 0x81, 0xd3, 0x64, 0x19, 0xd8, 0xaf, 0xc5, 0x28, 0xba, 0x82, 0x76, 0x48, 0x2d, 0x1b, 0xce,
+	0xa5, 0x09, 0x56, 0xc9, 0x69, 0xb0, 0x1d, 0x53, 0x9b, 0x34, 0x5c, 0x4f, 0xde, 0x25, 0xfc, 0x45,
+	0xbf, 0xd5, 0x69, 0x6a, 0x2c, 0xe7, 0xe8, 0x29, 0x39, 0xdc, 0xc1, 0x7e, 0xa0, 0x82, 0xb5, 0x2f,
+	0x08, 0xcd, 0x8f, 0x77, 0xb9, 0x17, 0x16, 0x56, 0x81, 0xe2, 0x2a, 0xf6, 0x25, 0x69, 0x33, 0x1e,
+	0x88, 0x24, 0xe4, 0xa1, 0xb6, 0xdb, 0x16, 0x5c, 0xd2, 0x63, 0x52, 0xdf, 0xc1, 0x36, 0xf8, 0xbf,
+	0xe8, 0x5f, 0x2f, 0x56, 0x68, 0x2c, 0x57, 0x68, 0x6c, 0x56, 0x08, 0x6f, 0x0a, 0xe1, 0x53, 0x21,
+	0x7c, 0x29, 0x84, 0x85, 0x42, 0x58, 0x2a, 0x84, 0x6f, 0x85, 0xf0, 0xa3, 0xd0, 0xd8, 0x28, 0x84,
+	0x8f, 0x35, 0x1a, 0x8b, 0x35, 0x1a, 0xcb, 0x35, 0x1a, 0xf7, 0xf5, 0x74, 0x16, 0x65, 0x01, 0xfd,
+	0xaa, 0xfe, 0xc4, 0xf3, 0xdf, 0x01, 0x00, 0xe4, 0xba, 0x97, 0x2a, 0x12, 0x02, 0x00, 0x00,
+}
+
+func (this *AddressHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryEntry)
+	if !ok {
+		that2, ok := that.(AddressHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *AddressHistoryBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryBlock)
+	if !ok {
+		that2, ok := that.(AddressHistoryBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TxHashes) != len(that1.TxHashes) {
+		return false
+	}
+	for i := range this.TxHashes {
+		if !bytes.Equal(this.TxHashes[i], that1.TxHashes[i]) {
+			return false
+		}
+	}
+	if this.HasPrevBlock != that1.HasPrevBlock {
+		return false
+	}
+	if this.PrevBlockNonce != that1.PrevBlockNonce {
+		return false
+	}
+	return true
+}
+func (this *AddressHistoryHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryHead)
+	if !ok {
+		that2, ok := that.(AddressHistoryHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	return true
+}
+func (this *RecordedBlockAddresses) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordedBlockAddresses)
+	if !ok {
+		that2, ok := that.(RecordedBlockAddresses)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressHistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&txhistory.AddressHistoryEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressHistoryBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&txhistory.AddressHistoryBlock{")
+	s = append(s, "TxHashes: "+fmt.Sprintf("%#v", this.TxHashes)+",\n")
+	s = append(s, "HasPrevBlock: "+fmt.Sprintf("%#v", this.HasPrevBlock)+",\n")
+	s = append(s, "PrevBlockNonce: "+fmt.Sprintf("%#v", this.PrevBlockNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressHistoryHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&txhistory.AddressHistoryHead{")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordedBlockAddresses) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&txhistory.RecordedBlockAddresses{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressHistoryBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrevBlockNonce != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.PrevBlockNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasPrevBlock {
+		i--
+		if m.HasPrevBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressHistoryHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordedBlockAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordedBlockAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordedBlockAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressHistory(uint64(l))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovAddressHistory(uint64(m.MiniblockType))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAddressHistory(uint64(m.BlockNonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *AddressHistoryBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovAddressHistory(uint64(l))
+		}
+	}
+	if m.HasPrevBlock {
+		n += 2
+	}
+	if m.PrevBlockNonce != 0 {
+		n += 1 + sovAddressHistory(uint64(m.PrevBlockNonce))
+	}
+	return n
+}
+
+func (m *AddressHistoryHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		n += 1 + sovAddressHistory(uint64(m.BlockNonce))
+	}
+	return n
+}
+
+func (m *RecordedBlockAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAddressHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAddressHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressHistory(x uint64) (n int) {
+	return sovAddressHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressHistoryBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryBlock{`,
+		`TxHashes:` + fmt.Sprintf("%v", this.TxHashes) + `,`,
+		`HasPrevBlock:` + fmt.Sprintf("%v", this.HasPrevBlock) + `,`,
+		`PrevBlockNonce:` + fmt.Sprintf("%v", this.PrevBlockNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressHistoryHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryHead{`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordedBlockAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordedBlockAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressHistoryBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPrevBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPrevBlock = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockNonce", wireType)
+			}
+			m.PrevBlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevBlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressHistoryHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordedBlockAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordedBlockAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordedBlockAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+type historyRecorder struct {
+}
+
+// NewHistoryRecorder returns a transactions history recorder that does not save anything
+func NewHistoryRecorder() *historyRecorder {
+	return &historyRecorder{}
+}
+
+// RecordBlock does nothing
+func (hr *historyRecorder) RecordBlock(_ data.HeaderHandler, _ *block.Body, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (hr *historyRecorder) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// IsEnabled returns false as this recorder does not save the transactions history
+func (hr *historyRecorder) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *historyRecorder) IsInterfaceNil() bool {
+	return hr == nil
+}
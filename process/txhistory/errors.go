@@ -0,0 +1,21 @@
+package txhistory
+
+import "errors"
+
+// ErrNilHistoryStorer signals that a nil transactions history storer has been provided
+var ErrNilHistoryStorer = errors.New("nil transactions history storer")
+
+// ErrNilMarshalizer signals that an operation has been attempted to or with a nil marshalizer implementation
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilBlockBody signals that a nil block body has been provided
+var ErrNilBlockBody = errors.New("nil block body")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
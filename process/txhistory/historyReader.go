@@ -0,0 +1,230 @@
+package txhistory
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	epochKeySuffixLength   = 4
+	nonceKeySuffixLength   = 8
+	headKeyType            = byte('h')
+	blockKeyType           = byte('b')
+	entryKeyType           = byte('e')
+	recordedBlockKeyPrefix = "txHistoryRecordedBlock"
+)
+
+// HistoryCursor marks a position in the history of an address. Only the entries from blocks strictly older than
+// the cursor are returned when reading a history page
+type HistoryCursor struct {
+	Epoch uint32
+	Nonce uint64
+}
+
+// HistoryPage holds a page of entries from the history of an address, from the newest to the oldest, together with
+// the cursor from which the next page can be requested. The next cursor is nil if there are no more entries
+type HistoryPage struct {
+	Entries    []AddressHistoryEntry
+	NextCursor *HistoryCursor
+}
+
+// ReadHistoryPage loads from the provided storer the newest entries from the history of the given address which are
+// older than the provided cursor. The entries of the same block are never split between pages, so a page can contain
+// more than pageSize entries. The older epochs are not read once the page is full, so the next cursor of a full page
+// might lead to an empty page
+func ReadHistoryPage(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	address []byte,
+	cursor HistoryCursor,
+	pageSize int,
+) (*HistoryPage, error) {
+	if pageSize < 1 {
+		return nil, ErrInvalidPageSize
+	}
+
+	page := &HistoryPage{
+		Entries: make([]AddressHistoryEntry, 0, pageSize),
+	}
+
+	for epoch := int64(cursor.Epoch); epoch >= 0; epoch-- {
+		startNonce, hasBlocks := getStartNonce(storer, marshalizer, address, uint32(epoch), cursor)
+		if !hasBlocks {
+			continue
+		}
+
+		isPageFull, err := readEpochIntoPage(storer, marshalizer, address, uint32(epoch), startNonce, page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		// the entries of a block are all recorded in the block's epoch, so a full page can not grow past this epoch
+		if isPageFull {
+			setNextCursor(page)
+			return page, nil
+		}
+	}
+
+	return page, nil
+}
+
+// getStartNonce returns the nonce of the newest block of the given epoch, older than the cursor, in which the address
+// was touched. The cursor usually points to a recorded block, so its link to the previous block is followed instead of
+// walking the history from the newest block of the epoch
+func getStartNonce(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	address []byte,
+	epoch uint32,
+	cursor HistoryCursor,
+) (uint64, bool) {
+	head, err := readHead(storer, marshalizer, address, epoch)
+	if err != nil {
+		return 0, false
+	}
+	if epoch != cursor.Epoch || head.BlockNonce < cursor.Nonce {
+		return head.BlockNonce, true
+	}
+
+	cursorBlock := &AddressHistoryBlock{}
+	err = readFromEpoch(storer, marshalizer, createBlockKey(address, epoch, cursor.Nonce), epoch, cursorBlock)
+	if err == nil {
+		return cursorBlock.PrevBlockNonce, cursorBlock.HasPrevBlock
+	}
+
+	nonce := head.BlockNonce
+	for nonce >= cursor.Nonce {
+		historyBlock := &AddressHistoryBlock{}
+		err = readFromEpoch(storer, marshalizer, createBlockKey(address, epoch, nonce), epoch, historyBlock)
+		if err != nil || !historyBlock.HasPrevBlock {
+			return 0, false
+		}
+		nonce = historyBlock.PrevBlockNonce
+	}
+
+	return nonce, true
+}
+
+// readEpochIntoPage walks, from the newest to the oldest, the blocks of the given epoch in which the address was
+// touched, starting with the provided nonce. It returns true if the page is full
+func readEpochIntoPage(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	address []byte,
+	epoch uint32,
+	nonce uint64,
+	page *HistoryPage,
+	pageSize int,
+) (bool, error) {
+	for {
+		historyBlock := &AddressHistoryBlock{}
+		err := readFromEpoch(storer, marshalizer, createBlockKey(address, epoch, nonce), epoch, historyBlock)
+		if err != nil {
+			log.Trace("ReadHistoryPage: history block not found", "epoch", epoch, "nonce", nonce)
+			break
+		}
+
+		if len(page.Entries) >= pageSize {
+			return true, nil
+		}
+
+		for i := len(historyBlock.TxHashes) - 1; i >= 0; i-- {
+			entry := AddressHistoryEntry{}
+			err = readFromEpoch(storer, marshalizer, createEntryKey(address, epoch, nonce, historyBlock.TxHashes[i]), epoch, &entry)
+			if err != nil {
+				return false, err
+			}
+
+			page.Entries = append(page.Entries, entry)
+		}
+
+		if !historyBlock.HasPrevBlock {
+			break
+		}
+		nonce = historyBlock.PrevBlockNonce
+	}
+
+	return len(page.Entries) >= pageSize, nil
+}
+
+func setNextCursor(page *HistoryPage) {
+	lastEntry := page.Entries[len(page.Entries)-1]
+	page.NextCursor = &HistoryCursor{
+		Epoch: lastEntry.Epoch,
+		Nonce: lastEntry.BlockNonce,
+	}
+}
+
+func readHead(storer storage.Storer, marshalizer marshal.Marshalizer, address []byte, epoch uint32) (*AddressHistoryHead, error) {
+	head := &AddressHistoryHead{}
+	err := readFromEpoch(storer, marshalizer, createHeadKey(address, epoch), epoch, head)
+	if err != nil {
+		return nil, err
+	}
+
+	return head, nil
+}
+
+func readFromEpoch(storer storage.Storer, marshalizer marshal.Marshalizer, key []byte, epoch uint32, obj interface{}) error {
+	buff, err := storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		// the records might have been written in an active persister of a different epoch (for example when the
+		// pruning is disabled), so the active persisters are searched as well
+		buff, err = storer.SearchFirst(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return marshalizer.Unmarshal(obj, buff)
+}
+
+func createHeadKey(address []byte, epoch uint32) []byte {
+	return createAddressKey(address, epoch, headKeyType, 0, nil)
+}
+
+func createBlockKey(address []byte, epoch uint32, nonce uint64) []byte {
+	return createAddressKey(address, epoch, blockKeyType, nonce, nil)
+}
+
+func createEntryKey(address []byte, epoch uint32, nonce uint64, txHash []byte) []byte {
+	return createAddressKey(address, epoch, entryKeyType, nonce, txHash)
+}
+
+// createAddressKey returns address | epoch | key type [| nonce | tx hash]
+func createAddressKey(address []byte, epoch uint32, keyType byte, nonce uint64, txHash []byte) []byte {
+	key := make([]byte, 0, len(address)+epochKeySuffixLength+1+nonceKeySuffixLength+len(txHash))
+	key = append(key, address...)
+	key = appendUint32(key, epoch)
+	key = append(key, keyType)
+	if keyType == headKeyType {
+		return key
+	}
+	key = appendUint64(key, nonce)
+
+	return append(key, txHash...)
+}
+
+func createRecordedBlockKey(epoch uint32, nonce uint64) []byte {
+	key := make([]byte, 0, len(recordedBlockKeyPrefix)+epochKeySuffixLength+nonceKeySuffixLength)
+	key = append(key, recordedBlockKeyPrefix...)
+	key = appendUint32(key, epoch)
+
+	return appendUint64(key, nonce)
+}
+
+func appendUint32(key []byte, value uint32) []byte {
+	buff := make([]byte, epochKeySuffixLength)
+	binary.BigEndian.PutUint32(buff, value)
+
+	return append(key, buff...)
+}
+
+func appendUint64(key []byte, value uint64) []byte {
+	buff := make([]byte, nonceKeySuffixLength)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return append(key, buff...)
+}
@@ -0,0 +1,151 @@
+package txhistory_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRecordedHistory(t *testing.T) txhistory.ArgsHistoryRecorder {
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	// epoch 0: nonces 1..3, epoch 1: nonces 4..6, one transaction per block
+	for nonce := uint64(1); nonce <= 6; nonce++ {
+		epoch := uint32((nonce - 1) / 3)
+		txHash := []byte(fmt.Sprintf("tx%d", nonce))
+		recordTransaction(t, hr, epoch, nonce, txHash, selfShardAddress1, selfShardAddress2)
+	}
+
+	return args
+}
+
+func TestReadHistoryPage_InvalidPageSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	page, err := txhistory.ReadHistoryPage(createMapStorerStub(), &mock.MarshalizerMock{}, selfShardAddress1, allEpochsMaxNonces, 0)
+
+	assert.Nil(t, page)
+	assert.Equal(t, txhistory.ErrInvalidPageSize, err)
+}
+
+func TestReadHistoryPage_NoHistoryShouldReturnEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := txhistory.ReadHistoryPage(createMapStorerStub(), &mock.MarshalizerMock{}, selfShardAddress1, allEpochsMaxNonces, 10)
+
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Entries))
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestReadHistoryPage_ShouldPaginateAcrossEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createRecordedHistory(t)
+	cursor := txhistory.HistoryCursor{Epoch: 1, Nonce: math.MaxUint64}
+
+	page, err := txhistory.ReadHistoryPage(args.HistoryStorer, args.Marshalizer, selfShardAddress1, cursor, 4)
+	require.Nil(t, err)
+	require.Equal(t, 4, len(page.Entries))
+	assert.Equal(t, []byte("tx6"), page.Entries[0].TxHash)
+	assert.Equal(t, uint32(1), page.Entries[0].Epoch)
+	assert.Equal(t, []byte("tx3"), page.Entries[3].TxHash)
+	assert.Equal(t, uint32(0), page.Entries[3].Epoch)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, txhistory.HistoryCursor{Epoch: 0, Nonce: 3}, *page.NextCursor)
+
+	page, err = txhistory.ReadHistoryPage(args.HistoryStorer, args.Marshalizer, selfShardAddress1, *page.NextCursor, 4)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Entries))
+	assert.Equal(t, []byte("tx2"), page.Entries[0].TxHash)
+	assert.Equal(t, []byte("tx1"), page.Entries[1].TxHash)
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestReadHistoryPage_ShouldNotSplitTheEntriesOfABlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+	recordTransaction(t, hr, 0, 1, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	err := hr.RecordBlock(
+		&block.Header{Nonce: 2},
+		&block.Body{
+			MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx2"), []byte("tx3")}, Type: block.TxBlock}},
+		},
+		map[string]data.TransactionHandler{
+			"tx2": &transaction.Transaction{SndAddr: selfShardAddress1, RcvAddr: selfShardAddress2},
+			"tx3": &transaction.Transaction{SndAddr: selfShardAddress2, RcvAddr: selfShardAddress1},
+		},
+	)
+	require.Nil(t, err)
+
+	page, err := txhistory.ReadHistoryPage(args.HistoryStorer, args.Marshalizer, selfShardAddress1, allEpochsMaxNonces, 1)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Entries))
+	assert.Equal(t, []byte("tx3"), page.Entries[0].TxHash)
+	assert.Equal(t, []byte("tx2"), page.Entries[1].TxHash)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, txhistory.HistoryCursor{Epoch: 0, Nonce: 2}, *page.NextCursor)
+}
+
+func TestReadHistoryPage_FullPageShouldNotReadTheOlderEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := createRecordedHistory(t)
+	historyStorer := args.HistoryStorer.(*mock.StorerStub)
+	getFromEpoch := historyStorer.GetFromEpochCalled
+	readEpochs := make(map[uint32]struct{})
+	historyStorer.GetFromEpochCalled = func(key []byte, epoch uint32) ([]byte, error) {
+		readEpochs[epoch] = struct{}{}
+		return getFromEpoch(key, epoch)
+	}
+	cursor := txhistory.HistoryCursor{Epoch: 1, Nonce: math.MaxUint64}
+
+	page, err := txhistory.ReadHistoryPage(historyStorer, args.Marshalizer, selfShardAddress1, cursor, 3)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(page.Entries))
+	assert.Equal(t, []byte("tx4"), page.Entries[2].TxHash)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, txhistory.HistoryCursor{Epoch: 1, Nonce: 4}, *page.NextCursor)
+	assert.Equal(t, map[uint32]struct{}{1: {}}, readEpochs)
+
+	page, err = txhistory.ReadHistoryPage(historyStorer, args.Marshalizer, selfShardAddress1, *page.NextCursor, 3)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(page.Entries))
+	assert.Equal(t, []byte("tx3"), page.Entries[0].TxHash)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, txhistory.HistoryCursor{Epoch: 0, Nonce: 1}, *page.NextCursor)
+
+	page, err = txhistory.ReadHistoryPage(historyStorer, args.Marshalizer, selfShardAddress1, *page.NextCursor, 3)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(page.Entries))
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestReadHistoryPage_CursorNotOnARecordedBlockShouldReturnTheOlderBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+	for _, nonce := range []uint64{1, 3, 5} {
+		recordTransaction(t, hr, 0, nonce, []byte(fmt.Sprintf("tx%d", nonce)), selfShardAddress1, selfShardAddress2)
+	}
+	cursor := txhistory.HistoryCursor{Epoch: 0, Nonce: 4}
+
+	page, err := txhistory.ReadHistoryPage(args.HistoryStorer, args.Marshalizer, selfShardAddress1, cursor, 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Entries))
+	assert.Equal(t, []byte("tx3"), page.Entries[0].TxHash)
+	assert.Equal(t, []byte("tx1"), page.Entries[1].TxHash)
+	assert.Nil(t, page.NextCursor)
+}
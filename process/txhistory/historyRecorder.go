@@ -0,0 +1,252 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressHistory.proto
+package txhistory
+
+import (
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/txhistory")
+
+// ArgsHistoryRecorder holds the arguments needed to create a new transactions history recorder
+type ArgsHistoryRecorder struct {
+	HistoryStorer    storage.Storer
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+}
+
+type historyRecorder struct {
+	historyStorer    storage.Storer
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+}
+
+// NewHistoryRecorder creates a component able to save, on each committed block, the transactions which touched
+// each of the addresses belonging to the current shard
+func NewHistoryRecorder(args ArgsHistoryRecorder) (*historyRecorder, error) {
+	if check.IfNil(args.HistoryStorer) {
+		return nil, ErrNilHistoryStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &historyRecorder{
+		historyStorer:    args.HistoryStorer,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// RecordBlock appends the transactions included in the provided block body to the history of the addresses they
+// touched (sender, receiver, smart contract result receiver or reward receiver). Only the addresses belonging to
+// the current shard are indexed. The provided transactions map should contain all the transactions of the block,
+// indexed by their hash. The touched addresses are saved as well, so that the block can be reverted
+func (hr *historyRecorder) RecordBlock(
+	header data.HeaderHandler,
+	body *block.Body,
+	txs map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+	if body == nil {
+		return ErrNilBlockBody
+	}
+
+	entriesByAddress := make(map[string][]AddressHistoryEntry)
+	for _, miniBlock := range body.MiniBlocks {
+		if !isIndexedMiniBlockType(miniBlock.Type) {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := txs[string(txHash)]
+			if !ok {
+				log.Trace("RecordBlock: transaction not found", "tx hash", txHash)
+				continue
+			}
+
+			entry := AddressHistoryEntry{
+				TxHash:        txHash,
+				MiniblockType: int32(miniBlock.Type),
+				BlockNonce:    header.GetNonce(),
+				Epoch:         header.GetEpoch(),
+			}
+			for _, address := range hr.getSelfShardAddresses(tx, miniBlock.Type) {
+				entriesByAddress[string(address)] = append(entriesByAddress[string(address)], entry)
+			}
+		}
+	}
+
+	recordedAddresses := &RecordedBlockAddresses{Addresses: make([][]byte, 0, len(entriesByAddress))}
+	for address, entries := range entriesByAddress {
+		err := hr.recordEntries([]byte(address), header, entries)
+		if err != nil {
+			return err
+		}
+
+		recordedAddresses.Addresses = append(recordedAddresses.Addresses, []byte(address))
+	}
+
+	return hr.put(createRecordedBlockKey(header.GetEpoch(), header.GetNonce()), recordedAddresses)
+}
+
+// RevertBlock removes the entries recorded for the provided block from the history of all the addresses it touched.
+// It should be called for each reverted block, from the newest to the oldest
+func (hr *historyRecorder) RevertBlock(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	recordedBlockKey := createRecordedBlockKey(header.GetEpoch(), header.GetNonce())
+	recordedAddresses := &RecordedBlockAddresses{}
+	err := readFromEpoch(hr.historyStorer, hr.marshalizer, recordedBlockKey, header.GetEpoch(), recordedAddresses)
+	if err != nil {
+		log.Trace("RevertBlock: block not recorded", "epoch", header.GetEpoch(), "nonce", header.GetNonce())
+		return nil
+	}
+
+	for _, address := range recordedAddresses.Addresses {
+		err = hr.removeBlocksFromNonce(address, header.GetEpoch(), header.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	return hr.historyStorer.Remove(recordedBlockKey)
+}
+
+func isIndexedMiniBlockType(miniBlockType block.Type) bool {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock, block.SmartContractResultBlock, block.RewardsBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+func (hr *historyRecorder) getSelfShardAddresses(tx data.TransactionHandler, miniBlockType block.Type) [][]byte {
+	candidates := [][]byte{tx.GetRcvAddr()}
+	if miniBlockType != block.RewardsBlock {
+		candidates = append(candidates, tx.GetSndAddr())
+	}
+
+	addresses := make([][]byte, 0, len(candidates))
+	for _, address := range candidates {
+		if len(address) == 0 {
+			continue
+		}
+		if hr.shardCoordinator.ComputeId(address) != hr.shardCoordinator.SelfId() {
+			continue
+		}
+		if len(addresses) > 0 && string(addresses[0]) == string(address) {
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+// recordEntries saves each entry under its own key and links the block to the previous block of the same epoch in
+// which the address was touched, so only the records of the current block are written
+func (hr *historyRecorder) recordEntries(address []byte, header data.HeaderHandler, entries []AddressHistoryEntry) error {
+	epoch, nonce := header.GetEpoch(), header.GetNonce()
+
+	// a block with the same or a higher nonce was recorded before, on a fork which was not reverted
+	err := hr.removeBlocksFromNonce(address, epoch, nonce)
+	if err != nil {
+		return err
+	}
+
+	historyBlock := &AddressHistoryBlock{TxHashes: make([][]byte, 0, len(entries))}
+	head, err := readHead(hr.historyStorer, hr.marshalizer, address, epoch)
+	if err == nil {
+		historyBlock.HasPrevBlock = true
+		historyBlock.PrevBlockNonce = head.BlockNonce
+	}
+
+	for i := range entries {
+		err = hr.put(createEntryKey(address, epoch, nonce, entries[i].TxHash), &entries[i])
+		if err != nil {
+			return err
+		}
+
+		historyBlock.TxHashes = append(historyBlock.TxHashes, entries[i].TxHash)
+	}
+
+	err = hr.put(createBlockKey(address, epoch, nonce), historyBlock)
+	if err != nil {
+		return err
+	}
+
+	return hr.put(createHeadKey(address, epoch), &AddressHistoryHead{BlockNonce: nonce})
+}
+
+// removeBlocksFromNonce removes, from the history of the given address in the given epoch, the blocks having a nonce
+// higher than or equal to the provided one, together with their entries
+func (hr *historyRecorder) removeBlocksFromNonce(address []byte, epoch uint32, nonce uint64) error {
+	for {
+		head, err := readHead(hr.historyStorer, hr.marshalizer, address, epoch)
+		if err != nil || head.BlockNonce < nonce {
+			return nil
+		}
+
+		blockKey := createBlockKey(address, epoch, head.BlockNonce)
+		historyBlock := &AddressHistoryBlock{}
+		err = readFromEpoch(hr.historyStorer, hr.marshalizer, blockKey, epoch, historyBlock)
+		if err != nil {
+			return hr.historyStorer.Remove(createHeadKey(address, epoch))
+		}
+
+		for _, txHash := range historyBlock.TxHashes {
+			err = hr.historyStorer.Remove(createEntryKey(address, epoch, head.BlockNonce, txHash))
+			if err != nil {
+				return err
+			}
+		}
+
+		err = hr.historyStorer.Remove(blockKey)
+		if err != nil {
+			return err
+		}
+
+		if !historyBlock.HasPrevBlock {
+			return hr.historyStorer.Remove(createHeadKey(address, epoch))
+		}
+
+		err = hr.put(createHeadKey(address, epoch), &AddressHistoryHead{BlockNonce: historyBlock.PrevBlockNonce})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (hr *historyRecorder) put(key []byte, obj interface{}) error {
+	buff, err := hr.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return hr.historyStorer.Put(key, buff)
+}
+
+// IsEnabled returns true as this recorder saves the transactions history
+func (hr *historyRecorder) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *historyRecorder) IsInterfaceNil() bool {
+	return hr == nil
+}
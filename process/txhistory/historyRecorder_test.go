@@ -0,0 +1,327 @@
+package txhistory_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txhistory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	selfShardAddress1  = []byte("self shard address 1")
+	selfShardAddress2  = []byte("self shard address 2")
+	crossShardAddress  = []byte("cross shard address")
+	errKeyNotFound     = errors.New("key not found")
+	allEpochsMaxNonces = txhistory.HistoryCursor{Epoch: 10, Nonce: 1000}
+)
+
+func createMapStorerStub() *mock.StorerStub {
+	storedData := make(map[string][]byte)
+	get := func(key []byte) ([]byte, error) {
+		value, ok := storedData[string(key)]
+		if !ok {
+			return nil, errKeyNotFound
+		}
+		return value, nil
+	}
+
+	return &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			storedData[string(key)] = data
+			return nil
+		},
+		RemoveCalled: func(key []byte) error {
+			delete(storedData, string(key))
+			return nil
+		},
+		SearchFirstCalled: get,
+		GetFromEpochCalled: func(key []byte, _ uint32) ([]byte, error) {
+			return get(key)
+		},
+	}
+}
+
+func createShardCoordinator() sharding.Coordinator {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(crossShardAddress) {
+			return 1
+		}
+		return 0
+	}
+
+	return shardCoordinator
+}
+
+func createMockArgsHistoryRecorder() txhistory.ArgsHistoryRecorder {
+	return txhistory.ArgsHistoryRecorder{
+		HistoryStorer:    createMapStorerStub(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		ShardCoordinator: createShardCoordinator(),
+	}
+}
+
+func recordTransaction(
+	t *testing.T,
+	recorder process.TransactionsHistoryRecorder,
+	epoch uint32,
+	nonce uint64,
+	txHash []byte,
+	sender []byte,
+	receiver []byte,
+) {
+	header := &block.Header{Nonce: nonce, Epoch: epoch}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{txHash}, Type: block.TxBlock}},
+	}
+	txs := map[string]data.TransactionHandler{
+		string(txHash): &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+	}
+
+	err := recorder.RecordBlock(header, body, txs)
+	require.Nil(t, err)
+}
+
+func readAllHistory(t *testing.T, args txhistory.ArgsHistoryRecorder, address []byte) []txhistory.AddressHistoryEntry {
+	page, err := txhistory.ReadHistoryPage(args.HistoryStorer, args.Marshalizer, address, allEpochsMaxNonces, 1000)
+	require.Nil(t, err)
+
+	return page.Entries
+}
+
+func TestNewHistoryRecorder_NilHistoryStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	args.HistoryStorer = nil
+	hr, err := txhistory.NewHistoryRecorder(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, txhistory.ErrNilHistoryStorer, err)
+}
+
+func TestNewHistoryRecorder_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	args.Marshalizer = nil
+	hr, err := txhistory.NewHistoryRecorder(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, txhistory.ErrNilMarshalizer, err)
+}
+
+func TestNewHistoryRecorder_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	args.ShardCoordinator = nil
+	hr, err := txhistory.NewHistoryRecorder(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, txhistory.ErrNilShardCoordinator, err)
+}
+
+func TestNewHistoryRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hr, err := txhistory.NewHistoryRecorder(createMockArgsHistoryRecorder())
+
+	assert.False(t, check.IfNil(hr))
+	assert.Nil(t, err)
+	assert.True(t, hr.IsEnabled())
+}
+
+func TestHistoryRecorder_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := txhistory.NewHistoryRecorder(createMockArgsHistoryRecorder())
+	err := hr.RecordBlock(nil, &block.Body{}, nil)
+
+	assert.Equal(t, txhistory.ErrNilHeader, err)
+}
+
+func TestHistoryRecorder_RecordBlockNilBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := txhistory.NewHistoryRecorder(createMockArgsHistoryRecorder())
+	err := hr.RecordBlock(&block.Header{}, nil, nil)
+
+	assert.Equal(t, txhistory.ErrNilBlockBody, err)
+}
+
+func TestHistoryRecorder_RecordBlockShouldIndexOnlySelfShardAddresses(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 5, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	recordTransaction(t, hr, 0, 6, []byte("tx2"), selfShardAddress1, crossShardAddress)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, []byte("tx2"), entries[0].TxHash)
+	assert.Equal(t, uint64(6), entries[0].BlockNonce)
+	assert.Equal(t, int32(block.TxBlock), entries[0].MiniblockType)
+	assert.Equal(t, []byte("tx1"), entries[1].TxHash)
+
+	entries = readAllHistory(t, args, selfShardAddress2)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, []byte("tx1"), entries[0].TxHash)
+
+	entries = readAllHistory(t, args, crossShardAddress)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestHistoryRecorder_RecordBlockSelfTransferShouldIndexOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 5, []byte("tx1"), selfShardAddress1, selfShardAddress1)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	assert.Equal(t, 1, len(entries))
+}
+
+func TestHistoryRecorder_RecordBlockRewardsShouldIndexOnlyTheReceiver(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	header := &block.Header{Nonce: 3}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("reward")}, Type: block.RewardsBlock},
+			{TxHashes: [][]byte{[]byte("peer")}, Type: block.PeerBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"reward": &rewardTx.RewardTx{RcvAddr: selfShardAddress1},
+		"peer":   &transaction.Transaction{SndAddr: selfShardAddress1, RcvAddr: selfShardAddress1},
+	}
+
+	err := hr.RecordBlock(header, body, txs)
+	require.Nil(t, err)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, []byte("reward"), entries[0].TxHash)
+	assert.Equal(t, int32(block.RewardsBlock), entries[0].MiniblockType)
+}
+
+func TestHistoryRecorder_RecordBlockShouldReplaceEntriesOfRevertedBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 5, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	recordTransaction(t, hr, 0, 6, []byte("tx2 on fork"), selfShardAddress1, selfShardAddress2)
+	recordTransaction(t, hr, 0, 7, []byte("tx3 on fork"), selfShardAddress1, selfShardAddress2)
+	recordTransaction(t, hr, 0, 6, []byte("tx2"), selfShardAddress1, selfShardAddress2)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, []byte("tx2"), entries[0].TxHash)
+	assert.Equal(t, []byte("tx1"), entries[1].TxHash)
+}
+
+func TestHistoryRecorder_RecordBlockShouldWriteOnlyTheRecordsOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	historyStorer := args.HistoryStorer.(*mock.StorerStub)
+	put := historyStorer.PutCalled
+	numPuts := 0
+	maxPutSize := 0
+	historyStorer.PutCalled = func(key, data []byte) error {
+		numPuts++
+		if len(data) > maxPutSize {
+			maxPutSize = len(data)
+		}
+		return put(key, data)
+	}
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 1, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	numPutsForOneBlock := numPuts
+	maxPutSizeForOneBlock := maxPutSize
+	for nonce := uint64(2); nonce <= 50; nonce++ {
+		numPuts = 0
+		recordTransaction(t, hr, 0, nonce, []byte(fmt.Sprintf("tx%d", nonce)), selfShardAddress1, selfShardAddress2)
+		assert.Equal(t, numPutsForOneBlock, numPuts)
+	}
+	// the link to the previous block adds a few bytes, the size does not depend on the history length
+	assert.True(t, maxPutSize < maxPutSizeForOneBlock+16)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	assert.Equal(t, 50, len(entries))
+}
+
+func TestHistoryRecorder_RevertBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := txhistory.NewHistoryRecorder(createMockArgsHistoryRecorder())
+	err := hr.RevertBlock(nil)
+
+	assert.Equal(t, txhistory.ErrNilHeader, err)
+}
+
+func TestHistoryRecorder_RevertBlockShouldRemoveTheEntriesOfTheRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 5, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	recordTransaction(t, hr, 0, 6, []byte("tx2"), selfShardAddress1, selfShardAddress1)
+	// the replacing block does not touch any of the addresses touched by the reverted one
+	err := hr.RevertBlock(&block.Header{Nonce: 6})
+	require.Nil(t, err)
+	recordTransaction(t, hr, 0, 6, []byte("tx3"), selfShardAddress2, selfShardAddress2)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, []byte("tx1"), entries[0].TxHash)
+
+	entries = readAllHistory(t, args, selfShardAddress2)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, []byte("tx3"), entries[0].TxHash)
+	assert.Equal(t, []byte("tx1"), entries[1].TxHash)
+
+	recordTransaction(t, hr, 0, 7, []byte("tx4"), selfShardAddress1, selfShardAddress2)
+	entries = readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, []byte("tx4"), entries[0].TxHash)
+	assert.Equal(t, []byte("tx1"), entries[1].TxHash)
+}
+
+func TestHistoryRecorder_RevertBlockNotRecordedShouldNotChangeTheHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRecorder()
+	hr, _ := txhistory.NewHistoryRecorder(args)
+
+	recordTransaction(t, hr, 0, 5, []byte("tx1"), selfShardAddress1, selfShardAddress2)
+	err := hr.RevertBlock(&block.Header{Nonce: 6})
+	require.Nil(t, err)
+
+	entries := readAllHistory(t, args, selfShardAddress1)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, []byte("tx1"), entries[0].TxHash)
+}
@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+type metadataRecorder struct {
+}
+
+// NewMetadataRecorder returns a transactions metadata recorder that does not save anything
+func NewMetadataRecorder() *metadataRecorder {
+	return &metadataRecorder{}
+}
+
+// RecordBlock does nothing
+func (mr *metadataRecorder) RecordBlock(_ []byte, _ data.HeaderHandler, _ *block.Body, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RecordNotarizedMetaBlocks does nothing
+func (mr *metadataRecorder) RecordNotarizedMetaBlocks(_ []data.HeaderHandler) {
+}
+
+// IsEnabled returns false as this recorder does not save the transactions metadata
+func (mr *metadataRecorder) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *metadataRecorder) IsInterfaceNil() bool {
+	return mr == nil
+}
@@ -0,0 +1,24 @@
+package txstatus
+
+import "errors"
+
+// ErrNilMarshalizer signals that an operation has been attempted to or with a nil marshalizer implementation
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that an operation has been attempted to or with a nil hasher implementation
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMetadataStorer signals that a nil transactions metadata storer has been provided
+var ErrNilMetadataStorer = errors.New("nil transactions metadata storer")
+
+// ErrNilMiniblocksMetadataStorer signals that a nil miniblocks metadata storer has been provided
+var ErrNilMiniblocksMetadataStorer = errors.New("nil miniblocks metadata storer")
+
+// ErrNilMiniBlocksStorer signals that a nil miniblocks storer has been provided
+var ErrNilMiniBlocksStorer = errors.New("nil miniblocks storer")
+
+// ErrNilBlockBody signals that a nil block body has been provided
+var ErrNilBlockBody = errors.New("nil block body")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
@@ -0,0 +1,319 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. transactionMetadata.proto
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. miniblockMetadata.proto
+package txstatus
+
+import (
+	"encoding/hex"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("process/txstatus")
+
+const scResultDataSeparator = "@"
+
+const refundedGasReceiptData = "refundedGas"
+
+// ArgsMetadataRecorder holds the arguments needed to create a new transactions metadata recorder
+type ArgsMetadataRecorder struct {
+	MetadataStorer           storage.Storer
+	MiniblocksMetadataStorer storage.Storer
+	MiniBlocksStorer         storage.Storer
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	SelfShardID              uint32
+}
+
+type metadataRecorder struct {
+	metadataStorer           storage.Storer
+	miniblocksMetadataStorer storage.Storer
+	miniBlocksStorer         storage.Storer
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	selfShardID              uint32
+}
+
+// NewMetadataRecorder creates a component able to save, on each committed block, the metadata needed
+// to later compute the status of the transactions
+func NewMetadataRecorder(args ArgsMetadataRecorder) (*metadataRecorder, error) {
+	if check.IfNil(args.MetadataStorer) {
+		return nil, ErrNilMetadataStorer
+	}
+	if check.IfNil(args.MiniblocksMetadataStorer) {
+		return nil, ErrNilMiniblocksMetadataStorer
+	}
+	if check.IfNil(args.MiniBlocksStorer) {
+		return nil, ErrNilMiniBlocksStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &metadataRecorder{
+		metadataStorer:           args.MetadataStorer,
+		miniblocksMetadataStorer: args.MiniblocksMetadataStorer,
+		miniBlocksStorer:         args.MiniBlocksStorer,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		selfShardID:              args.SelfShardID,
+	}, nil
+}
+
+// RecordBlock saves the metadata of all the transactions included in the provided block body, together with the
+// location (header hash, nonce, round and epoch) of each of its miniblocks. The transactions for which the provided
+// intermediate transactions (smart contract results and receipts) signal an execution error are marked as failed
+func (mr *metadataRecorder) RecordBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body *block.Body,
+	intermediateTxs map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+	if body == nil {
+		return ErrNilBlockBody
+	}
+
+	miniblockMetadata := &MiniblockMetadata{
+		HeaderHash:  headerHash,
+		HeaderNonce: header.GetNonce(),
+		Round:       header.GetRound(),
+		Epoch:       header.GetEpoch(),
+	}
+	miniblockMetadataBytes, err := mr.marshalizer.Marshal(miniblockMetadata)
+	if err != nil {
+		return err
+	}
+
+	for _, miniBlock := range body.MiniBlocks {
+		miniBlockHash, err := core.CalculateHash(mr.marshalizer, mr.hasher, miniBlock)
+		if err != nil {
+			return err
+		}
+
+		err = mr.miniblocksMetadataStorer.Put(miniBlockHash, miniblockMetadataBytes)
+		if err != nil {
+			return err
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			metadata := &TransactionMetadata{
+				MiniblockHash:   miniBlockHash,
+				MiniblockType:   int32(miniBlock.Type),
+				SenderShardID:   miniBlock.SenderShardID,
+				ReceiverShardID: miniBlock.ReceiverShardID,
+			}
+
+			err = mr.putMetadata(txHash, metadata)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, intermediateTx := range intermediateTxs {
+		failedTxHash, isFailure := getFailedTxHash(intermediateTx)
+		if !isFailure {
+			continue
+		}
+
+		mr.updateMetadata(failedTxHash, func(metadata *TransactionMetadata) {
+			metadata.ExecutionFailed = true
+		})
+	}
+
+	return nil
+}
+
+func getFailedTxHash(intermediateTx data.TransactionHandler) ([]byte, bool) {
+	switch tx := intermediateTx.(type) {
+	case *smartContractResult.SmartContractResult:
+		return tx.OriginalTxHash, IsExecutionErrorResult(tx)
+	case *receipt.Receipt:
+		return tx.TxHash, IsExecutionErrorReceipt(tx)
+	default:
+		return nil, false
+	}
+}
+
+// RecordNotarizedMetaBlocks marks as executed at destination all the transactions from the cross shard miniblocks
+// sent by the current shard which the provided metachain blocks notarized as included in the destination shard
+func (mr *metadataRecorder) RecordNotarizedMetaBlocks(metaBlocks []data.HeaderHandler) {
+	for _, metaBlockHandler := range metaBlocks {
+		metaBlock, ok := metaBlockHandler.(*block.MetaBlock)
+		if !ok {
+			continue
+		}
+
+		for _, shardData := range metaBlock.ShardInfo {
+			if shardData.ShardID == mr.selfShardID {
+				continue
+			}
+
+			for _, mbHeader := range shardData.ShardMiniBlockHeaders {
+				if mbHeader.SenderShardID != mr.selfShardID || mbHeader.ReceiverShardID != shardData.ShardID {
+					continue
+				}
+
+				mr.markMiniBlockExecutedAtDestination(mbHeader.Hash)
+			}
+		}
+
+		if mr.selfShardID == core.MetachainShardId {
+			continue
+		}
+
+		for _, mbHeader := range metaBlock.MiniBlockHeaders {
+			if mbHeader.SenderShardID != mr.selfShardID || mbHeader.ReceiverShardID != core.MetachainShardId {
+				continue
+			}
+
+			mr.markMiniBlockExecutedAtDestination(mbHeader.Hash)
+		}
+	}
+}
+
+func (mr *metadataRecorder) markMiniBlockExecutedAtDestination(miniBlockHash []byte) {
+	miniBlockBytes, err := mr.miniBlocksStorer.SearchFirst(miniBlockHash)
+	if err != nil {
+		log.Debug("markMiniBlockExecutedAtDestination: miniblock not found",
+			"hash", miniBlockHash, "error", err.Error())
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = mr.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		log.Debug("markMiniBlockExecutedAtDestination: miniblock unmarshal",
+			"hash", miniBlockHash, "error", err.Error())
+		return
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		mr.updateMetadata(txHash, func(metadata *TransactionMetadata) {
+			metadata.ExecutedAtDestination = true
+		})
+	}
+}
+
+func (mr *metadataRecorder) updateMetadata(txHash []byte, updateHandler func(metadata *TransactionMetadata)) {
+	metadata, err := GetTransactionMetadata(mr.metadataStorer, mr.marshalizer, txHash)
+	if err != nil {
+		log.Trace("updateMetadata: transaction metadata not found",
+			"tx hash", txHash, "error", err.Error())
+		return
+	}
+
+	updateHandler(metadata)
+
+	err = mr.putMetadata(txHash, metadata)
+	if err != nil {
+		log.Debug("updateMetadata: put transaction metadata",
+			"tx hash", txHash, "error", err.Error())
+	}
+}
+
+func (mr *metadataRecorder) putMetadata(txHash []byte, metadata *TransactionMetadata) error {
+	metadataBytes, err := mr.marshalizer.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	return mr.metadataStorer.Put(txHash, metadataBytes)
+}
+
+// IsEnabled returns true as this recorder saves the transactions metadata
+func (mr *metadataRecorder) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *metadataRecorder) IsInterfaceNil() bool {
+	return mr == nil
+}
+
+// GetTransactionMetadata loads from the provided storer the metadata saved for the given transaction hash
+func GetTransactionMetadata(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	txHash []byte,
+) (*TransactionMetadata, error) {
+	metadataBytes, err := storer.SearchFirst(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &TransactionMetadata{}
+	err = marshalizer.Unmarshal(metadata, metadataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// GetMiniblockMetadata loads from the provided storer the metadata saved for the given miniblock hash
+func GetMiniblockMetadata(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	miniblockHash []byte,
+) (*MiniblockMetadata, error) {
+	metadataBytes, err := storer.SearchFirst(miniblockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &MiniblockMetadata{}
+	err = marshalizer.Unmarshal(metadata, metadataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// IsExecutionErrorResult returns true if the provided smart contract result was generated by a failed execution.
+// Such results carry, as the first data argument, a return code different from the ok return code
+func IsExecutionErrorResult(scResult *smartContractResult.SmartContractResult) bool {
+	if scResult == nil || len(scResult.OriginalTxHash) == 0 {
+		return false
+	}
+
+	scResultData := string(scResult.Data)
+	if !strings.HasPrefix(scResultData, scResultDataSeparator) {
+		return false
+	}
+
+	arguments := strings.Split(scResultData, scResultDataSeparator)
+	returnCode, err := hex.DecodeString(arguments[1])
+	if err != nil {
+		return false
+	}
+
+	return string(returnCode) != vmcommon.Ok.String()
+}
+
+// IsExecutionErrorReceipt returns true if the provided receipt was generated for a transaction whose execution failed.
+// Such receipts carry the execution error, while the receipts for successful executions only signal the refunded gas
+func IsExecutionErrorReceipt(rpt *receipt.Receipt) bool {
+	if rpt == nil || len(rpt.TxHash) == 0 {
+		return false
+	}
+
+	return string(rpt.Data) != refundedGasReceiptData
+}
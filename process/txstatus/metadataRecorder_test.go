@@ -0,0 +1,287 @@
+package txstatus_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMapStorerStub() *mock.StorerStub {
+	storedData := make(map[string][]byte)
+
+	return &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			storedData[string(key)] = data
+			return nil
+		},
+		SearchFirstCalled: func(key []byte) ([]byte, error) {
+			value, ok := storedData[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return value, nil
+		},
+	}
+}
+
+func createMockArgsMetadataRecorder() txstatus.ArgsMetadataRecorder {
+	return txstatus.ArgsMetadataRecorder{
+		MetadataStorer:           createMapStorerStub(),
+		MiniblocksMetadataStorer: createMapStorerStub(),
+		MiniBlocksStorer:         createMapStorerStub(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+		SelfShardID:              0,
+	}
+}
+
+func TestNewMetadataRecorder_NilMetadataStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	args.MetadataStorer = nil
+	mr, err := txstatus.NewMetadataRecorder(args)
+
+	assert.Nil(t, mr)
+	assert.Equal(t, txstatus.ErrNilMetadataStorer, err)
+}
+
+func TestNewMetadataRecorder_NilMiniblocksMetadataStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	args.MiniblocksMetadataStorer = nil
+	mr, err := txstatus.NewMetadataRecorder(args)
+
+	assert.Nil(t, mr)
+	assert.Equal(t, txstatus.ErrNilMiniblocksMetadataStorer, err)
+}
+
+func TestNewMetadataRecorder_NilMiniBlocksStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	args.MiniBlocksStorer = nil
+	mr, err := txstatus.NewMetadataRecorder(args)
+
+	assert.Nil(t, mr)
+	assert.Equal(t, txstatus.ErrNilMiniBlocksStorer, err)
+}
+
+func TestNewMetadataRecorder_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	args.Marshalizer = nil
+	mr, err := txstatus.NewMetadataRecorder(args)
+
+	assert.Nil(t, mr)
+	assert.Equal(t, txstatus.ErrNilMarshalizer, err)
+}
+
+func TestNewMetadataRecorder_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	args.Hasher = nil
+	mr, err := txstatus.NewMetadataRecorder(args)
+
+	assert.Nil(t, mr)
+	assert.Equal(t, txstatus.ErrNilHasher, err)
+}
+
+func TestNewMetadataRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mr, err := txstatus.NewMetadataRecorder(createMockArgsMetadataRecorder())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(mr))
+	assert.True(t, mr.IsEnabled())
+}
+
+func TestMetadataRecorder_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mr, _ := txstatus.NewMetadataRecorder(createMockArgsMetadataRecorder())
+	err := mr.RecordBlock([]byte("headerHash"), nil, &block.Body{}, nil)
+
+	assert.Equal(t, txstatus.ErrNilHeader, err)
+}
+
+func TestMetadataRecorder_RecordBlockNilBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mr, _ := txstatus.NewMetadataRecorder(createMockArgsMetadataRecorder())
+	err := mr.RecordBlock([]byte("headerHash"), &block.Header{}, nil, nil)
+
+	assert.Equal(t, txstatus.ErrNilBlockBody, err)
+}
+
+func TestMetadataRecorder_RecordBlockShouldSaveMetadataForAllTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	mr, _ := txstatus.NewMetadataRecorder(args)
+
+	txMiniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx1"), []byte("tx2")},
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	invalidMiniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx3")},
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+		Type:            block.InvalidBlock,
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{txMiniBlock, invalidMiniBlock}}
+
+	header := &block.Header{Nonce: 37, Round: 38, Epoch: 4}
+	err := mr.RecordBlock([]byte("headerHash"), header, body, nil)
+	require.Nil(t, err)
+
+	txMiniBlockHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, txMiniBlock)
+	metadata, err := txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("tx2"))
+	require.Nil(t, err)
+	assert.Equal(t, txMiniBlockHash, metadata.MiniblockHash)
+	assert.Equal(t, int32(block.TxBlock), metadata.MiniblockType)
+	assert.Equal(t, uint32(0), metadata.SenderShardID)
+	assert.Equal(t, uint32(1), metadata.ReceiverShardID)
+	assert.False(t, metadata.ExecutionFailed)
+	assert.False(t, metadata.ExecutedAtDestination)
+
+	metadata, err = txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("tx3"))
+	require.Nil(t, err)
+	assert.Equal(t, int32(block.InvalidBlock), metadata.MiniblockType)
+
+	miniblockMetadata, err := txstatus.GetMiniblockMetadata(args.MiniblocksMetadataStorer, args.Marshalizer, txMiniBlockHash)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("headerHash"), miniblockMetadata.HeaderHash)
+	assert.Equal(t, uint64(37), miniblockMetadata.HeaderNonce)
+	assert.Equal(t, uint64(38), miniblockMetadata.Round)
+	assert.Equal(t, uint32(4), miniblockMetadata.Epoch)
+}
+
+func TestMetadataRecorder_RecordBlockShouldMarkFailedTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	mr, _ := txstatus.NewMetadataRecorder(args)
+
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{
+		{
+			TxHashes: [][]byte{[]byte("scCallFailed"), []byte("scCallOk"), []byte("moveBalance")},
+			Type:     block.TxBlock,
+		},
+	}}
+	intermediateTxs := map[string]data.TransactionHandler{
+		"scr1": &smartContractResult.SmartContractResult{
+			OriginalTxHash: []byte("scCallFailed"),
+			Data:           []byte("@" + hex.EncodeToString([]byte(vmcommon.UserError.String())) + "@" + hex.EncodeToString([]byte("scCallFailed"))),
+		},
+		"scr2": &smartContractResult.SmartContractResult{
+			OriginalTxHash: []byte("scCallOk"),
+			Data:           []byte("@" + hex.EncodeToString([]byte(vmcommon.Ok.String())) + "@" + hex.EncodeToString([]byte("scCallOk"))),
+		},
+		"receipt": &receipt.Receipt{
+			TxHash: []byte("moveBalance"),
+			Data:   []byte("refundedGas"),
+		},
+	}
+
+	err := mr.RecordBlock([]byte("headerHash"), &block.Header{}, body, intermediateTxs)
+	require.Nil(t, err)
+
+	metadata, _ := txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("scCallFailed"))
+	assert.True(t, metadata.ExecutionFailed)
+	metadata, _ = txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("scCallOk"))
+	assert.False(t, metadata.ExecutionFailed)
+	metadata, _ = txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("moveBalance"))
+	assert.False(t, metadata.ExecutionFailed)
+}
+
+func TestMetadataRecorder_RecordNotarizedMetaBlocksShouldMarkExecutedAtDestination(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMetadataRecorder()
+	mr, _ := txstatus.NewMetadataRecorder(args)
+
+	crossMiniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx1")},
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	otherCrossMiniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx2")},
+		SenderShardID:   0,
+		ReceiverShardID: 2,
+		Type:            block.TxBlock,
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{crossMiniBlock, otherCrossMiniBlock}}
+	_ = mr.RecordBlock([]byte("headerHash"), &block.Header{}, body, nil)
+
+	crossMiniBlockHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, crossMiniBlock)
+	crossMiniBlockBytes, _ := args.Marshalizer.Marshal(crossMiniBlock)
+	_ = args.MiniBlocksStorer.Put(crossMiniBlockHash, crossMiniBlockBytes)
+
+	metaBlock := &block.MetaBlock{
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 1,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: crossMiniBlockHash, SenderShardID: 0, ReceiverShardID: 1},
+				},
+			},
+		},
+	}
+	mr.RecordNotarizedMetaBlocks([]data.HeaderHandler{metaBlock})
+
+	metadata, _ := txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("tx1"))
+	assert.True(t, metadata.ExecutedAtDestination)
+	metadata, _ = txstatus.GetTransactionMetadata(args.MetadataStorer, args.Marshalizer, []byte("tx2"))
+	assert.False(t, metadata.ExecutedAtDestination)
+}
+
+func TestIsExecutionErrorResult(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, txstatus.IsExecutionErrorResult(nil))
+	assert.False(t, txstatus.IsExecutionErrorResult(&smartContractResult.SmartContractResult{
+		Data: []byte("@" + hex.EncodeToString([]byte(vmcommon.UserError.String()))),
+	}))
+	assert.False(t, txstatus.IsExecutionErrorResult(&smartContractResult.SmartContractResult{
+		OriginalTxHash: []byte("hash"),
+		Data:           []byte("transfer@01"),
+	}))
+	assert.False(t, txstatus.IsExecutionErrorResult(&smartContractResult.SmartContractResult{
+		OriginalTxHash: []byte("hash"),
+		Data:           []byte("@" + hex.EncodeToString([]byte(vmcommon.Ok.String()))),
+	}))
+	assert.True(t, txstatus.IsExecutionErrorResult(&smartContractResult.SmartContractResult{
+		OriginalTxHash: []byte("hash"),
+		Data:           []byte("@" + hex.EncodeToString([]byte(vmcommon.UserError.String()))),
+	}))
+}
+
+func TestIsExecutionErrorReceipt(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, txstatus.IsExecutionErrorReceipt(nil))
+	assert.False(t, txstatus.IsExecutionErrorReceipt(&receipt.Receipt{TxHash: []byte("hash"), Data: []byte("refundedGas")}))
+	assert.True(t, txstatus.IsExecutionErrorReceipt(&receipt.Receipt{TxHash: []byte("hash"), Data: []byte("insufficient funds")}))
+}
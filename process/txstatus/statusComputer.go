@@ -0,0 +1,27 @@
+package txstatus
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// ComputeStatus returns the status of a transaction found in the storage of a node from the provided shard, based
+// on the metadata recorded when the blocks containing the transaction and its results were committed
+func ComputeStatus(metadata *TransactionMetadata, selfShardID uint32) core.TransactionStatus {
+	if block.Type(metadata.MiniblockType) == block.InvalidBlock {
+		return core.TxStatusInvalid
+	}
+	if metadata.ExecutionFailed {
+		return core.TxStatusFail
+	}
+
+	isCrossShard := metadata.SenderShardID != metadata.ReceiverShardID
+	if !isCrossShard || metadata.ReceiverShardID == selfShardID {
+		return core.TxStatusSuccess
+	}
+	if metadata.ExecutedAtDestination {
+		return core.TxStatusSuccess
+	}
+
+	return core.TxStatusPartiallyExecuted
+}
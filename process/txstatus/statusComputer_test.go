@@ -0,0 +1,84 @@
+package txstatus_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeStatus_InvalidMiniBlockShouldReturnInvalid(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.InvalidBlock),
+		ExecutionFailed: true,
+	}
+
+	assert.Equal(t, core.TxStatusInvalid, txstatus.ComputeStatus(metadata, 0))
+}
+
+func TestComputeStatus_ExecutionFailedShouldReturnFail(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:         int32(block.TxBlock),
+		SenderShardID:         0,
+		ReceiverShardID:       1,
+		ExecutionFailed:       true,
+		ExecutedAtDestination: true,
+	}
+
+	assert.Equal(t, core.TxStatusFail, txstatus.ComputeStatus(metadata, 0))
+}
+
+func TestComputeStatus_IntraShardShouldReturnSuccess(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.TxBlock),
+		SenderShardID:   1,
+		ReceiverShardID: 1,
+	}
+
+	assert.Equal(t, core.TxStatusSuccess, txstatus.ComputeStatus(metadata, 1))
+}
+
+func TestComputeStatus_CrossShardOnDestinationShouldReturnSuccess(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.RewardsBlock),
+		SenderShardID:   core.MetachainShardId,
+		ReceiverShardID: 1,
+	}
+
+	assert.Equal(t, core.TxStatusSuccess, txstatus.ComputeStatus(metadata, 1))
+}
+
+func TestComputeStatus_CrossShardOnSourceShouldReturnPartiallyExecuted(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:   int32(block.TxBlock),
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+
+	assert.Equal(t, core.TxStatusPartiallyExecuted, txstatus.ComputeStatus(metadata, 0))
+}
+
+func TestComputeStatus_CrossShardOnSourceExecutedAtDestinationShouldReturnSuccess(t *testing.T) {
+	t.Parallel()
+
+	metadata := &txstatus.TransactionMetadata{
+		MiniblockType:         int32(block.TxBlock),
+		SenderShardID:         0,
+		ReceiverShardID:       1,
+		ExecutedAtDestination: true,
+	}
+
+	assert.Equal(t, core.TxStatusSuccess, txstatus.ComputeStatus(metadata, 0))
+}
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: transactionMetadata.proto
+
+package txstatus
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransactionMetadata holds the information about the miniblock in which a transaction was included and about
+// the outcome of its execution, as seen by the current shard
+type TransactionMetadata struct {
+	MiniblockHash         []byte `protobuf:"bytes,1,opt,name=MiniblockHash,proto3" json:"MiniblockHash,omitempty"`
+	MiniblockType         int32  `protobuf:"varint,2,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	SenderShardID         uint32 `protobuf:"varint,3,opt,name=SenderShardID,proto3" json:"SenderShardID,omitempty"`
+	ReceiverShardID       uint32 `protobuf:"varint,4,opt,name=ReceiverShardID,proto3" json:"ReceiverShardID,omitempty"`
+	ExecutionFailed       bool   `protobuf:"varint,5,opt,name=ExecutionFailed,proto3" json:"ExecutionFailed,omitempty"`
+	ExecutedAtDestination bool   `protobuf:"varint,6,opt,name=ExecutedAtDestination,proto3" json:"ExecutedAtDestination,omitempty"`
+}
+
+func (m *TransactionMetadata) Reset()      { *m = TransactionMetadata{} }
+func (*TransactionMetadata) ProtoMessage() {}
+func (*TransactionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8b2f280c00b872, []int{0}
+}
+func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionMetadata.Merge(m, src)
+}
+func (m *TransactionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionMetadata proto.InternalMessageInfo
+
+func (m *TransactionMetadata) GetMiniblockHash() []byte {
+	if m != nil {
+		return m.MiniblockHash
+	}
+	return nil
+}
+
+func (m *TransactionMetadata) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *TransactionMetadata) GetSenderShardID() uint32 {
+	if m != nil {
+		return m.SenderShardID
+	}
+	return 0
+}
+
+func (m *TransactionMetadata) GetReceiverShardID() uint32 {
+	if m != nil {
+		return m.ReceiverShardID
+	}
+	return 0
+}
+
+func (m *TransactionMetadata) GetExecutionFailed() bool {
+	if m != nil {
+		return m.ExecutionFailed
+	}
+	return false
+}
+
+func (m *TransactionMetadata) GetExecutedAtDestination() bool {
+	if m != nil {
+		return m.ExecutedAtDestination
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TransactionMetadata)(nil), "proto.TransactionMetadata")
+}
+
+func init() { proto.RegisterFile("transactionMetadata.proto", fileDescriptor_6c8b2f280c00b872) }
+
+var fileDescriptor_6c8b2f280c00b872 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x7d, 0x7a, 0x6f, 0xab, 0x2a, 0xa2, 0x42, 0x0a, 0x42, 0x0a, 0x0c, 0x47, 0x11, 0x62,
+	0xc8, 0x42, 0x3b, 0xc0, 0x0b, 0x50, 0x15, 0x04, 0x43, 0x97, 0xb4, 0x13, 0x9b, 0x93, 0x98, 0xc6,
+	0xa2, 0xc4, 0x55, 0x72, 0x82, 0xca, 0xc6, 0x23, 0xc0, 0x5b, 0xf0, 0x28, 0x8c, 0x19, 0x33, 0x12,
+	0x67, 0x61, 0xec, 0x23, 0xa0, 0xb8, 0x03, 0x4a, 0x61, 0xb2, 0xff, 0xef, 0xff, 0x6c, 0x1d, 0x1d,
+	0xeb, 0x88, 0x52, 0x9e, 0x64, 0x3c, 0x24, 0xa9, 0x92, 0xa9, 0x20, 0x1e, 0x71, 0xe2, 0xc3, 0x55,
+	0xaa, 0x48, 0xd9, 0x5d, 0x73, 0x1c, 0x9f, 0x2d, 0x24, 0xc5, 0x79, 0x30, 0x0c, 0xd5, 0xe3, 0x68,
+	0xa1, 0x16, 0x6a, 0x64, 0x70, 0x90, 0xdf, 0x9b, 0x64, 0x82, 0xb9, 0x6d, 0x5f, 0x9d, 0xbc, 0x75,
+	0xac, 0x83, 0xf9, 0xef, 0x3f, 0xed, 0x53, 0x6b, 0x30, 0x95, 0x89, 0x0c, 0x96, 0x2a, 0x7c, 0xb8,
+	0xe1, 0x59, 0xec, 0x80, 0x0b, 0xde, 0x9e, 0xdf, 0x86, 0x2d, 0x6b, 0xfe, 0xbc, 0x12, 0x4e, 0xc7,
+	0x05, 0xaf, 0xeb, 0xb7, 0x61, 0x63, 0xcd, 0x44, 0x12, 0x89, 0x74, 0x16, 0xf3, 0x34, 0xba, 0x9d,
+	0x38, 0xff, 0x5c, 0xf0, 0x06, 0x7e, 0x1b, 0xda, 0x9e, 0xb5, 0xef, 0x8b, 0x50, 0xc8, 0xa7, 0x1f,
+	0xef, 0xbf, 0xf1, 0x76, 0x71, 0x63, 0x5e, 0xad, 0x45, 0x98, 0x37, 0x03, 0x5f, 0x73, 0xb9, 0x14,
+	0x91, 0xd3, 0x75, 0xc1, 0xeb, 0xfb, 0xbb, 0xd8, 0xbe, 0xb0, 0x0e, 0xb7, 0x48, 0x44, 0x97, 0x34,
+	0x11, 0x19, 0xc9, 0x84, 0x37, 0xb5, 0xd3, 0x33, 0xfe, 0xdf, 0xe5, 0x78, 0x5c, 0x54, 0xc8, 0xca,
+	0x0a, 0xd9, 0xa6, 0x42, 0x78, 0xd1, 0x08, 0xef, 0x1a, 0xe1, 0x43, 0x23, 0x14, 0x1a, 0xa1, 0xd4,
+	0x08, 0x9f, 0x1a, 0xe1, 0x4b, 0x23, 0xdb, 0x68, 0x84, 0xd7, 0x1a, 0x59, 0x51, 0x23, 0x2b, 0x6b,
+	0x64, 0x77, 0x7d, 0x5a, 0x67, 0xc4, 0x29, 0xcf, 0x82, 0x9e, 0x59, 0xef, 0xf9, 0xf7, 0x00, 0x8d,
+	0x57, 0x40, 0x73, 0xb1, 0x01, 0x00, 0x00,
+}
+
+func (this *TransactionMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionMetadata)
+	if !ok {
+		that2, ok := that.(TransactionMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.MiniblockHash, that1.MiniblockHash) {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if this.SenderShardID != that1.SenderShardID {
+		return false
+	}
+	if this.ReceiverShardID != that1.ReceiverShardID {
+		return false
+	}
+	if this.ExecutionFailed != that1.ExecutionFailed {
+		return false
+	}
+	if this.ExecutedAtDestination != that1.ExecutedAtDestination {
+		return false
+	}
+	return true
+}
+func (this *TransactionMetadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&txstatus.TransactionMetadata{")
+	s = append(s, "MiniblockHash: "+fmt.Sprintf("%#v", this.MiniblockHash)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "SenderShardID: "+fmt.Sprintf("%#v", this.SenderShardID)+",\n")
+	s = append(s, "ReceiverShardID: "+fmt.Sprintf("%#v", this.ReceiverShardID)+",\n")
+	s = append(s, "ExecutionFailed: "+fmt.Sprintf("%#v", this.ExecutionFailed)+",\n")
+	s = append(s, "ExecutedAtDestination: "+fmt.Sprintf("%#v", this.ExecutedAtDestination)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTransactionMetadata(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TransactionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedAtDestination {
+		i--
+		if m.ExecutedAtDestination {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecutionFailed {
+		i--
+		if m.ExecutionFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceiverShardID != 0 {
+		i = encodeVarintTransactionMetadata(dAtA, i, uint64(m.ReceiverShardID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SenderShardID != 0 {
+		i = encodeVarintTransactionMetadata(dAtA, i, uint64(m.SenderShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintTransactionMetadata(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MiniblockHash) > 0 {
+		i -= len(m.MiniblockHash)
+		copy(dAtA[i:], m.MiniblockHash)
+		i = encodeVarintTransactionMetadata(dAtA, i, uint64(len(m.MiniblockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransactionMetadata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransactionMetadata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MiniblockHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionMetadata(uint64(l))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovTransactionMetadata(uint64(m.MiniblockType))
+	}
+	if m.SenderShardID != 0 {
+		n += 1 + sovTransactionMetadata(uint64(m.SenderShardID))
+	}
+	if m.ReceiverShardID != 0 {
+		n += 1 + sovTransactionMetadata(uint64(m.ReceiverShardID))
+	}
+	if m.ExecutionFailed {
+		n += 2
+	}
+	if m.ExecutedAtDestination {
+		n += 2
+	}
+	return n
+}
+
+func sovTransactionMetadata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransactionMetadata(x uint64) (n int) {
+	return sovTransactionMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TransactionMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionMetadata{`,
+		`MiniblockHash:` + fmt.Sprintf("%v", this.MiniblockHash) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`SenderShardID:` + fmt.Sprintf("%v", this.SenderShardID) + `,`,
+		`ReceiverShardID:` + fmt.Sprintf("%v", this.ReceiverShardID) + `,`,
+		`ExecutionFailed:` + fmt.Sprintf("%v", this.ExecutionFailed) + `,`,
+		`ExecutedAtDestination:` + fmt.Sprintf("%v", this.ExecutedAtDestination) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTransactionMetadata(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TransactionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransactionMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniblockHash = append(m.MiniblockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MiniblockHash == nil {
+				m.MiniblockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShardID", wireType)
+			}
+			m.SenderShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShardID", wireType)
+			}
+			m.ReceiverShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutionFailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAtDestination", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutedAtDestination = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransactionMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransactionMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransactionMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransactionMetadata(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransactionMetadata
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionMetadata
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransactionMetadata
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransactionMetadata
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransactionMetadata
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransactionMetadata        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransactionMetadata          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransactionMetadata = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -205,3 +206,14 @@ func TestMultiShardCoordinator_CommunicationIdentifier(t *testing.T) {
 	shard, _ := NewMultiShardCoordinator(2, selfId)
 	assert.Equal(t, fmt.Sprintf("_%d_%d", selfId, destId), shard.CommunicationIdentifier(destId))
 }
+
+func TestMultiShardCoordinator_ComputeIdOfESDTGlobalSettingsAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, numOfShards := range []uint32{1, 2, 3, 5, 8, 300} {
+		sr, _ := NewMultiShardCoordinator(numOfShards, 0)
+		for shardID := uint32(0); shardID < numOfShards; shardID++ {
+			assert.Equal(t, shardID, sr.ComputeId(core.ESDTGlobalSettingsAddress(shardID)))
+		}
+	}
+}
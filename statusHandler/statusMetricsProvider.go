@@ -142,6 +142,12 @@ func (sm *statusMetrics) ConfigMetrics() map[string]interface{} {
 	configMetrics[core.MetricRoundDuration] = sm.loadUint64Metric(core.MetricRoundDuration)
 	configMetrics[core.MetricStartTime] = sm.loadUint64Metric(core.MetricStartTime)
 	configMetrics[core.MetricLatestTagSoftwareVersion] = sm.loadStringMetric(core.MetricLatestTagSoftwareVersion)
+	configMetrics[core.MetricMinInflation] = sm.loadStringMetric(core.MetricMinInflation)
+	configMetrics[core.MetricMaxInflation] = sm.loadStringMetric(core.MetricMaxInflation)
+	configMetrics[core.MetricOptimalStakingRatio] = sm.loadStringMetric(core.MetricOptimalStakingRatio)
+	configMetrics[core.MetricInflationRate] = sm.loadStringMetric(core.MetricInflationRate)
+	configMetrics[core.MetricTotalStaked] = sm.loadStringMetric(core.MetricTotalStaked)
+	configMetrics[core.MetricNodePrice] = sm.loadStringMetric(core.MetricNodePrice)
 
 	return configMetrics
 }
@@ -0,0 +1,97 @@
+package archive
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb/table"
+)
+
+// CreateArchive compacts all the pairs of the source into a read-only compressed archive stored in the given directory.
+// The source has to provide the keys in strictly ascending order. The archive file is first written under a temporary
+// name, so an interrupted archiving never leaves a partial archive behind. It returns the number of archived pairs
+func CreateArchive(path string, source storage.SortedKeysRanger) (int, error) {
+	if source == nil {
+		return 0, storage.ErrArchivingNotSupported
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return 0, err
+	}
+
+	finalPath := archiveFilePath(path)
+	tempPath := finalPath + ".tmp"
+	file, err := os.Create(filepath.Clean(tempPath))
+	if err != nil {
+		return 0, err
+	}
+
+	numPairs, err := writePairs(file, source)
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		removeErr := os.Remove(tempPath)
+		if removeErr != nil {
+			log.Debug("archive: remove temporary file", "path", tempPath, "error", removeErr.Error())
+		}
+		return 0, err
+	}
+
+	err = os.Rename(tempPath, finalPath)
+	if err != nil {
+		return 0, err
+	}
+
+	return numPairs, nil
+}
+
+func writePairs(file *os.File, source storage.SortedKeysRanger) (int, error) {
+	writer := table.NewWriter(file, archiveOptions())
+
+	var lastKey []byte
+	var errAppend error
+	numPairs := 0
+	err := source.RangeKeys(func(key []byte, value []byte) bool {
+		if numPairs > 0 && bytes.Compare(key, lastKey) <= 0 {
+			errAppend = storage.ErrKeysNotSorted
+			return false
+		}
+
+		errAppend = writer.Append(key, value)
+		if errAppend != nil {
+			return false
+		}
+
+		lastKey = key
+		numPairs++
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if errAppend != nil {
+		return 0, errAppend
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return numPairs, file.Sync()
+}
+
+// Exists returns true if an archive was created in the given directory
+func Exists(path string) bool {
+	info, err := os.Stat(archiveFilePath(path))
+	if err != nil {
+		return false
+	}
+
+	return !info.IsDir()
+}
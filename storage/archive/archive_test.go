@@ -0,0 +1,161 @@
+package archive_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/archive"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rangerStub struct {
+	RangeKeysCalled func(handler func(key []byte, value []byte) bool) error
+}
+
+func (rs *rangerStub) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	return rs.RangeKeysCalled(handler)
+}
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "archive_temp")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func TestCreateArchive_NilSourceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numPairs, err := archive.CreateArchive("path", nil)
+	assert.Equal(t, 0, numPairs)
+	assert.Equal(t, storage.ErrArchivingNotSupported, err)
+}
+
+func TestCreateArchive_UnsortedKeysShouldErrAndNotLeaveAnArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	source := &rangerStub{
+		RangeKeysCalled: func(handler func(key []byte, value []byte) bool) error {
+			_ = handler([]byte("key2"), []byte("value2")) && handler([]byte("key1"), []byte("value1"))
+			return nil
+		},
+	}
+
+	numPairs, err := archive.CreateArchive(dir, source)
+	assert.Equal(t, 0, numPairs)
+	assert.Equal(t, storage.ErrKeysNotSorted, err)
+	assert.False(t, archive.Exists(dir))
+}
+
+func TestCreateArchive_SourceErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	expectedErr := errors.New("expected error")
+	source := &rangerStub{
+		RangeKeysCalled: func(handler func(key []byte, value []byte) bool) error {
+			return expectedErr
+		},
+	}
+
+	_, err := archive.CreateArchive(dir, source)
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, archive.Exists(dir))
+}
+
+func TestArchivedDB_ShouldFindAllArchivedPairs(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	numPairs := 10000
+	source := memorydb.New()
+	for i := 0; i < numPairs; i++ {
+		_ = source.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	numArchived, err := archive.CreateArchive(dir, source)
+	require.Nil(t, err)
+	assert.Equal(t, numPairs, numArchived)
+	assert.True(t, archive.Exists(dir))
+
+	adb, err := archive.NewArchivedDB(dir)
+	require.Nil(t, err)
+	assert.False(t, adb.IsInterfaceNil())
+
+	for i := 0; i < numPairs; i++ {
+		value, errGet := adb.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.Nil(t, errGet)
+		assert.Equal(t, fmt.Sprintf("value%d", i), string(value))
+	}
+
+	assert.Nil(t, adb.Has([]byte("key7")))
+	assert.Equal(t, storage.ErrKeyNotFound, adb.Has([]byte("key")))
+	_, err = adb.Get([]byte("key99999"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	err = adb.Close()
+	assert.Nil(t, err)
+	_, err = adb.Get([]byte("key7"))
+	assert.Equal(t, storage.ErrArchiveIsClosed, err)
+}
+
+func TestArchivedDB_EmptyArchiveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err := archive.CreateArchive(dir, memorydb.New())
+	require.Nil(t, err)
+
+	adb, err := archive.NewArchivedDB(dir)
+	require.Nil(t, err)
+	defer func() {
+		_ = adb.Close()
+	}()
+
+	_, err = adb.Get([]byte("key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestNewArchivedDB_InvalidArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	adb, err := archive.NewArchivedDB(dir)
+	assert.Nil(t, adb)
+	assert.NotNil(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "archive.sst"), []byte("not a sorted table, but long enough to hold a footer"), 0600)
+	require.Nil(t, err)
+
+	adb, err = archive.NewArchivedDB(dir)
+	assert.Nil(t, adb)
+	assert.True(t, errors.Is(err, storage.ErrInvalidArchive))
+}
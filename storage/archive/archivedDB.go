@@ -0,0 +1,110 @@
+package archive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	lvlstorage "github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/table"
+)
+
+// archivedDB gives read-only access to an archive created by CreateArchive
+type archivedDB struct {
+	mutReader sync.RWMutex
+	reader    *table.Reader
+}
+
+// NewArchivedDB opens the archive stored in the given directory. Only the table index and the bloom filter are loaded
+// in memory, the data blocks being read from disk when needed
+func NewArchivedDB(path string) (*archivedDB, error) {
+	file, err := os.Open(filepath.Clean(archiveFilePath(path)))
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := openReader(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("%w for path %s: %s", storage.ErrInvalidArchive, path, err.Error())
+	}
+
+	return &archivedDB{
+		reader: reader,
+	}, nil
+}
+
+func openReader(file *os.File) (*table.Reader, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	fd := lvlstorage.FileDesc{Type: lvlstorage.TypeTable}
+	reader, err := table.NewReader(file, info.Size(), fd, nil, nil, archiveOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	// a corrupted table is only reported on the first read
+	_, _, err = reader.Find([]byte{}, false, nil)
+	if err != nil && err != errors.ErrNotFound {
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+// Get returns the value associated to the key
+func (adb *archivedDB) Get(key []byte) ([]byte, error) {
+	adb.mutReader.RLock()
+	defer adb.mutReader.RUnlock()
+
+	if adb.reader == nil {
+		return nil, storage.ErrArchiveIsClosed
+	}
+
+	foundKey, value, err := adb.reader.Find(key, true, nil)
+	if err == errors.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if string(foundKey) != string(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, nil
+}
+
+// Has returns nil if the given key is present in the archive
+func (adb *archivedDB) Has(key []byte) error {
+	_, err := adb.Get(key)
+
+	return err
+}
+
+// Close releases the archive file
+func (adb *archivedDB) Close() error {
+	adb.mutReader.Lock()
+	defer adb.mutReader.Unlock()
+
+	if adb.reader == nil {
+		return nil
+	}
+
+	// releasing the reader also closes the underlying file
+	adb.reader.Release()
+	adb.reader = nil
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *archivedDB) IsInterfaceNil() bool {
+	return adb == nil
+}
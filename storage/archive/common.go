@@ -0,0 +1,35 @@
+package archive
+
+import (
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var log = logger.GetOrCreate("storage/archive")
+
+// archiveFileName is the name of the sorted table file kept in each archive directory
+const archiveFileName = "archive.sst"
+
+// archiveBlockSize is larger than the leveldb default, as archives are rarely read and benefit more from a better
+// compression ratio than from small reads
+const archiveBlockSize = 64 * 1024
+
+const bloomFilterBitsPerKey = 10
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+func archiveOptions() *opt.Options {
+	return &opt.Options{
+		BlockSize:   archiveBlockSize,
+		Compression: opt.SnappyCompression,
+		Filter:      filter.NewBloomFilter(bloomFilterBitsPerKey),
+	}
+}
+
+func archiveFilePath(path string) string {
+	return filepath.Join(path, archiveFileName)
+}
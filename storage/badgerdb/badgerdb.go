@@ -0,0 +1,381 @@
+package badgerdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Persister = (*DB)(nil)
+var _ storage.SortedKeysRanger = (*DB)(nil)
+var _ storage.PersisterStatisticsProvider = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// a node opens tens of databases, so the memory tables, the blocks cache and the value log files are kept smaller
+// than the badger defaults
+const (
+	maxTableSize     = 8 * 1024 * 1024
+	numMemTables     = 2
+	blockCacheSize   = 8 * 1024 * 1024
+	valueLogFileSize = 256 * 1024 * 1024
+)
+
+// a value log file is rewritten by the garbage collection if at least this fraction of it is stale
+const valueLogGCDiscardRatio = 0.5
+
+const tableFileExtension = ".sst"
+const valueLogFileExtension = ".vlog"
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB is a persister backed by the Badger key-value store. Badger keeps the keys and the small values in a LSM tree,
+// the bigger values being kept only in a value log, so they are not rewritten by the tables' compactions. The writes
+// are synced on disk after each maxBatchSize writes or after batchDelaySeconds, when the stale values are also
+// reclaimed by the garbage collection of the value log. A torn tail left by a crash is dropped when opening.
+type DB struct {
+	db                *badger.DB
+	path              string
+	batchDelaySeconds int
+	maxBatchSize      int32
+
+	mutDB          sync.RWMutex
+	numUnsynced    int32
+	numValueLogGCs uint64
+	isClosed       bool
+	closeChan      chan struct{}
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the database's files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, compression CompressionType) (*DB, error) {
+	if batchDelaySeconds < 1 {
+		return nil, storage.ErrInvalidBatchDelaySeconds
+	}
+
+	badgerCompression, err := getBadgerCompression(compression)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := badger.DefaultOptions(path).
+		WithSyncWrites(false).
+		WithTruncate(true).
+		WithCompression(badgerCompression).
+		WithMaxTableSize(maxTableSize).
+		WithNumMemtables(numMemTables).
+		WithBlockCacheSize(blockCacheSize).
+		WithValueLogFileSize(valueLogFileSize).
+		WithLogger(&badgerLogger{})
+
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		batchDelaySeconds: batchDelaySeconds,
+		maxBatchSize:      int32(maxBatchSize),
+		closeChan:         make(chan struct{}),
+	}
+
+	go dbStore.backgroundLoop()
+
+	return dbStore, nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return storage.ErrBadgerDBIsClosed
+	}
+
+	err := s.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, val)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.syncIfBatchIsFull()
+}
+
+func (s *DB) syncIfBatchIsFull() error {
+	if atomic.AddInt32(&s.numUnsynced, 1) < s.maxBatchSize {
+		return nil
+	}
+
+	atomic.StoreInt32(&s.numUnsynced, 0)
+
+	return s.db.Sync()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return nil, storage.ErrBadgerDBIsClosed
+	}
+
+	var value []byte
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return storage.ErrBadgerDBIsClosed
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return storage.ErrBadgerDBIsClosed
+	}
+
+	err := s.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.syncIfBatchIsFull()
+}
+
+// RangeKeys calls the handler for each stored pair, in ascending key order, until the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return storage.ErrNilRangeHandler
+	}
+
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return storage.ErrBadgerDBIsClosed
+	}
+
+	return s.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			if !handler(item.KeyCopy(nil), value) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *DB) backgroundLoop() {
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.syncAndCollectGarbage()
+		case <-s.closeChan:
+			log.Debug("closing the badger db background handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// syncAndCollectGarbage syncs the pending writes and then rewrites the value log files having enough stale values,
+// until no such file is found
+func (s *DB) syncAndCollectGarbage() {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	if s.isClosed {
+		return
+	}
+
+	if atomic.SwapInt32(&s.numUnsynced, 0) > 0 {
+		err := s.db.Sync()
+		if err != nil {
+			log.Warn("badger db sync", "path", s.path, "error", err.Error())
+		}
+	}
+
+	for {
+		err := s.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			if err != badger.ErrNoRewrite {
+				log.Debug("badger db value log garbage collection", "path", s.path, "error", err.Error())
+			}
+			return
+		}
+
+		atomic.AddUint64(&s.numValueLogGCs, 1)
+	}
+}
+
+// PersisterStatistics returns the size and compaction statistics of the database. Badger does not keep the number of
+// the stored keys, which could only be found by a full scan, so it is not reported. The live data size is the size
+// estimated from the LSM tables, the values written since the last memory table flush not being accounted
+func (s *DB) PersisterStatistics() storage.PersisterStatistics {
+	s.mutDB.RLock()
+	defer s.mutDB.RUnlock()
+
+	statistics := storage.PersisterStatistics{
+		NumCompactions: atomic.LoadUint64(&s.numValueLogGCs),
+	}
+
+	if !s.isClosed {
+		for _, table := range s.db.Tables(false) {
+			statistics.LiveDataSize += table.EstimatedSz
+		}
+	}
+
+	err := filepath.Walk(s.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		statistics.DiskSize += uint64(info.Size())
+		extension := filepath.Ext(path)
+		if extension == tableFileExtension || extension == valueLogFileExtension {
+			statistics.NumFiles++
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Debug("badger db statistics", "path", s.path, "error", err.Error())
+	}
+
+	return statistics
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutDB.Lock()
+	defer s.mutDB.Unlock()
+
+	if s.isClosed {
+		return nil
+	}
+
+	s.isClosed = true
+	close(s.closeChan)
+
+	return s.db.Close()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	err := s.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
+
+// badgerLogger forwards the badger's messages to the node's logger, the informative ones being logged as debug
+type badgerLogger struct{}
+
+// Errorf -
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error(formatBadgerMessage(format, args...))
+}
+
+// Warningf -
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn(formatBadgerMessage(format, args...))
+}
+
+// Infof -
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug(formatBadgerMessage(format, args...))
+}
+
+// Debugf -
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace(formatBadgerMessage(format, args...))
+}
+
+func formatBadgerMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
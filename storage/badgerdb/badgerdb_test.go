@@ -0,0 +1,377 @@
+package badgerdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempDir(tb testing.TB) string {
+	dir, err := ioutil.TempDir("", "badgerdb_temp")
+	require.Nil(tb, err)
+
+	return dir
+}
+
+func createBadgerDb(tb testing.TB, dir string, compression badgerdb.CompressionType) *badgerdb.DB {
+	db, err := badgerdb.NewDB(dir, 10, 100, compression)
+	require.Nil(tb, err)
+
+	return db
+}
+
+func TestNewDB_InvalidBatchDelayShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := badgerdb.NewDB(createTempDir(t), 0, 1, badgerdb.NoCompression)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidBatchDelaySeconds, err)
+}
+
+func TestNewDB_InvalidCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, err := badgerdb.NewDB(createTempDir(t), 1, 1, "zip")
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrNotSupportedCompressionType, err)
+}
+
+func TestNewDB_AlreadyOpenedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	db := createBadgerDb(t, dir, badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	secondDb, err := badgerdb.NewDB(dir, 10, 100, badgerdb.NoCompression)
+
+	assert.Nil(t, secondDb)
+	assert.NotNil(t, err)
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, createTempDir(t), badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	err := db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, db.Has(key))
+
+	err = db.Remove(key)
+	assert.Nil(t, err)
+
+	recovered, err = db.Get(key)
+	assert.Nil(t, recovered)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	err = db.Remove([]byte("missing key"))
+	assert.Nil(t, err)
+}
+
+func TestDB_OperationsOnClosedDBShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	db := createBadgerDb(t, dir, badgerdb.NoCompression)
+	err := db.Close()
+	require.Nil(t, err)
+
+	assert.Nil(t, db.Close())
+	assert.Equal(t, storage.ErrBadgerDBIsClosed, db.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrBadgerDBIsClosed, db.Has([]byte("key")))
+	assert.Equal(t, storage.ErrBadgerDBIsClosed, db.Remove([]byte("key")))
+	assert.Equal(t, storage.ErrBadgerDBIsClosed, db.RangeKeys(func(_ []byte, _ []byte) bool {
+		return true
+	}))
+	_, err = db.Get([]byte("key"))
+	assert.Equal(t, storage.ErrBadgerDBIsClosed, err)
+}
+
+func TestDB_ReopenShouldKeepTheData(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	db := createBadgerDb(t, dir, badgerdb.SnappyCompression)
+	bigValue := bytes.Repeat([]byte("big value "), 1000)
+	for i := 0; i < 100; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	_ = db.Put([]byte("key0"), []byte("new value"))
+	_ = db.Put([]byte("big key"), bigValue)
+	_ = db.Remove([]byte("key1"))
+	err := db.Close()
+	require.Nil(t, err)
+
+	db = createBadgerDb(t, dir, badgerdb.SnappyCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	recovered, err := db.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), recovered)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("key1")))
+	recovered, err = db.Get([]byte("key99"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value99"), recovered)
+	recovered, err = db.Get([]byte("big key"))
+	assert.Nil(t, err)
+	assert.Equal(t, bigValue, recovered)
+}
+
+func TestDB_ReopenWithAnotherCompressionShouldReadOldData(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	db := createBadgerDb(t, dir, badgerdb.SnappyCompression)
+	value := bytes.Repeat([]byte("compressible "), 20)
+	_ = db.Put([]byte("key"), value)
+	err := db.Close()
+	require.Nil(t, err)
+
+	db = createBadgerDb(t, dir, badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	recovered, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+}
+
+func writeCompressiblePairsAndGetDiskSize(t *testing.T, compression badgerdb.CompressionType) uint64 {
+	db := createBadgerDb(t, createTempDir(t), compression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	value := bytes.Repeat([]byte("compressible "), 20)
+	for i := 0; i < 5000; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), value)
+	}
+	// closing the database flushes the memory table into a table file
+	err := db.Close()
+	require.Nil(t, err)
+
+	return db.PersisterStatistics().DiskSize
+}
+
+func TestDB_SnappyCompressionShouldReduceTheDiskSize(t *testing.T) {
+	t.Parallel()
+
+	uncompressedSize := writeCompressiblePairsAndGetDiskSize(t, badgerdb.NoCompression)
+	compressedSize := writeCompressiblePairsAndGetDiskSize(t, badgerdb.SnappyCompression)
+
+	assert.True(t, compressedSize < uncompressedSize)
+}
+
+func TestDB_PersisterStatisticsShouldReportTheFiles(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, createTempDir(t), badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	_ = db.Put([]byte("key"), []byte("value"))
+	statistics := db.PersisterStatistics()
+
+	assert.True(t, statistics.NumFiles > 0)
+	assert.True(t, statistics.DiskSize > 0)
+	assert.Equal(t, uint64(0), statistics.NumKeys)
+}
+
+func TestDB_RangeKeysShouldIterateInAscendingOrder(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, createTempDir(t), badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	assert.Equal(t, storage.ErrNilRangeHandler, db.RangeKeys(nil))
+
+	_ = db.Put([]byte("c"), []byte("3"))
+	_ = db.Put([]byte("a"), []byte("1"))
+	_ = db.Put([]byte("b"), []byte("2"))
+	_ = db.Put([]byte("d"), []byte("4"))
+	_ = db.Remove([]byte("d"))
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	err := db.RangeKeys(func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		values = append(values, string(value))
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []string{"1", "2", "3"}, values)
+
+	numVisited := 0
+	err = db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numVisited++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numVisited)
+}
+
+func TestDB_ConcurrentOperationsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, createTempDir(t), badgerdb.SnappyCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	numGoroutines := 10
+	numOperations := 200
+	wg := sync.WaitGroup{}
+	wg.Add(numGoroutines + 1)
+	for g := 0; g < numGoroutines; g++ {
+		go func(idx int) {
+			for i := 0; i < numOperations; i++ {
+				key := []byte(fmt.Sprintf("key%d-%d", idx, i%20))
+				_ = db.Put(key, []byte(fmt.Sprintf("value%d", i)))
+				_, _ = db.Get(key)
+				if i%7 == 0 {
+					_ = db.Remove(key)
+				}
+			}
+			wg.Done()
+		}(g)
+	}
+	go func() {
+		for i := 0; i < 10; i++ {
+			_ = db.RangeKeys(func(_ []byte, _ []byte) bool {
+				return true
+			})
+			_ = db.PersisterStatistics()
+		}
+		wg.Done()
+	}()
+	wg.Wait()
+
+	key := []byte(fmt.Sprintf("key%d-%d", 0, (numOperations-1)%20))
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(fmt.Sprintf("value%d", numOperations-1)), recovered)
+}
+
+func benchmarkPut(b *testing.B, db storage.Persister) {
+	value := bytes.Repeat([]byte("benchmark value "), 16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), value)
+	}
+}
+
+func benchmarkGet(b *testing.B, db storage.Persister) {
+	value := bytes.Repeat([]byte("benchmark value "), 16)
+	numKeys := 10000
+	for i := 0; i < numKeys; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), value)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = db.Get([]byte(fmt.Sprintf("key%d", i%numKeys)))
+	}
+}
+
+func createBenchLevelDb(b *testing.B) storage.Persister {
+	db, err := leveldb.NewDB(createTempDir(b), 10, 1000, 10)
+	require.Nil(b, err)
+
+	return db
+}
+
+func createBenchBadgerDb(b *testing.B, compression badgerdb.CompressionType) storage.Persister {
+	db, err := badgerdb.NewDB(createTempDir(b), 10, 1000, compression)
+	require.Nil(b, err)
+
+	return db
+}
+
+func BenchmarkLevelDB_Put(b *testing.B) {
+	db := createBenchLevelDb(b)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkPut(b, db)
+}
+
+func BenchmarkBadgerDB_Put(b *testing.B) {
+	db := createBenchBadgerDb(b, badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkPut(b, db)
+}
+
+func BenchmarkBadgerDB_PutWithSnappy(b *testing.B) {
+	db := createBenchBadgerDb(b, badgerdb.SnappyCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkPut(b, db)
+}
+
+func BenchmarkLevelDB_Get(b *testing.B) {
+	db := createBenchLevelDb(b)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkGet(b, db)
+}
+
+func BenchmarkBadgerDB_Get(b *testing.B) {
+	db := createBenchBadgerDb(b, badgerdb.NoCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkGet(b, db)
+}
+
+func BenchmarkBadgerDB_GetWithSnappy(b *testing.B) {
+	db := createBenchBadgerDb(b, badgerdb.SnappyCompression)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	benchmarkGet(b, db)
+}
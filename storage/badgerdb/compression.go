@@ -0,0 +1,28 @@
+package badgerdb
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2/options"
+)
+
+// CompressionType represents the compression applied on the blocks of the database's tables
+type CompressionType string
+
+const (
+	// NoCompression stores the tables' blocks as they are built
+	NoCompression CompressionType = "None"
+	// SnappyCompression compresses each block of the tables with snappy
+	SnappyCompression CompressionType = "Snappy"
+)
+
+// getBadgerCompression returns the badger compression option to be used, an empty type meaning no compression
+func getBadgerCompression(compression CompressionType) (options.CompressionType, error) {
+	switch compression {
+	case "", NoCompression:
+		return options.None, nil
+	case SnappyCompression:
+		return options.Snappy, nil
+	default:
+		return options.None, storage.ErrNotSupportedCompressionType
+	}
+}
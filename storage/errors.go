@@ -114,3 +114,33 @@ var ErrLRUCacheInvalidSize = errors.New("wrong size in bytes value for LRU cache
 
 // ErrNegativeSizeInBytes signals that the provided size in bytes value is negative
 var ErrNegativeSizeInBytes = errors.New("negative size in bytes")
+
+// ErrNilRangeHandler signals that a nil range handler has been provided
+var ErrNilRangeHandler = errors.New("nil range handler")
+
+// ErrKeysNotSorted signals that the keys provided for archiving are not in strictly ascending order
+var ErrKeysNotSorted = errors.New("keys are not sorted")
+
+// ErrArchivingNotSupported signals that the persister can not be archived as it is not able to iterate over its pairs
+var ErrArchivingNotSupported = errors.New("persister does not support archiving")
+
+// ErrRangeKeysNotSupported signals that the persister is not able to iterate over its pairs
+var ErrRangeKeysNotSupported = errors.New("persister does not support iterating over its keys")
+
+// ErrInvalidArchive signals that an archive file could not be read
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// ErrArchiveIsClosed signals that an operation was attempted on a closed archive
+var ErrArchiveIsClosed = errors.New("archive is closed")
+
+// ErrInvalidArchivePath signals that the archiving was enabled without providing a valid archive path
+var ErrInvalidArchivePath = errors.New("invalid archive path")
+
+// ErrNotSupportedCompressionType is raised when an unsupported compression type is provided
+var ErrNotSupportedCompressionType = errors.New("not supported compression type")
+
+// ErrInvalidBatchDelaySeconds signals that an invalid batch delay in seconds has been provided
+var ErrInvalidBatchDelaySeconds = errors.New("invalid batch delay seconds")
+
+// ErrBadgerDBIsClosed is raised when an operation is attempted on a closed badger database
+var ErrBadgerDBIsClosed = errors.New("badger db is closed")
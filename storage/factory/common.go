@@ -29,6 +29,7 @@ func GetDBFromConfig(cfg config.DBConfig) storageUnit.DBConfig {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       cfg.Compression,
 	}
 }
 
@@ -46,6 +46,9 @@ func NewStorageServiceFactory(
 	if config.StoragePruning.NumActivePersisters < minimumNumberOfActivePersisters {
 		return nil, storage.ErrInvalidNumberOfActivePersisters
 	}
+	if config.StoragePruning.ArchiveEnabled && len(config.StoragePruning.ArchivePath) == 0 {
+		return nil, storage.ErrInvalidArchivePath
+	}
 	if check.IfNil(shardCoordinator) {
 		return nil, storage.ErrNilShardCoordinator
 	}
@@ -78,6 +81,9 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	var shardHdrHashNonceUnit *pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var txsMetadataUnit *pruning.PruningStorer
+	var miniblocksMetadataUnit *pruning.PruningStorer
+	var txsHistoryUnit *pruning.PruningStorer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -179,6 +185,18 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	blockCommitJournalDbConfig := GetDBFromConfig(psf.generalConfig.BlockCommitJournalStorage.DB)
+	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.BlockCommitJournalStorage.DB.FilePath)
+	blockCommitJournalDbConfig.FilePath = dbPath
+	blockCommitJournalUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.BlockCommitJournalStorage.Cache),
+		blockCommitJournalDbConfig,
+		GetBloomFromConfig(psf.generalConfig.BlockCommitJournalStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, blockCommitJournalUnit)
+
 	bootstrapUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.BootstrapStorage)
 	bootstrapUnit, err = pruning.NewPruningStorer(bootstrapUnitArgs)
 	if err != nil {
@@ -193,6 +211,31 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	if psf.generalConfig.TxsMetadata.Enabled {
+		txsMetadataUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsMetadata.Storage)
+		txsMetadataUnit, err = pruning.NewPruningStorer(txsMetadataUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txsMetadataUnit)
+
+		miniblocksMetadataUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsMetadata.MiniblocksStorage)
+		miniblocksMetadataUnit, err = pruning.NewPruningStorer(miniblocksMetadataUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, miniblocksMetadataUnit)
+	}
+
+	if psf.generalConfig.TxsHistory.Enabled {
+		txsHistoryUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsHistory.Storage)
+		txsHistoryUnit, err = pruning.NewPruningStorer(txsHistoryUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txsHistoryUnit)
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -207,7 +250,15 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.BlockCommitJournalUnit, blockCommitJournalUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if txsMetadataUnit != nil {
+		store.AddStorer(dataRetriever.TransactionsMetadataUnit, txsMetadataUnit)
+		store.AddStorer(dataRetriever.MiniblocksMetadataUnit, miniblocksMetadataUnit)
+	}
+	if txsHistoryUnit != nil {
+		store.AddStorer(dataRetriever.TransactionsHistoryUnit, txsHistoryUnit)
+	}
 
 	return store, err
 }
@@ -224,6 +275,9 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	var shardHdrHashNonceUnits []*pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var txsMetadataUnit *pruning.PruningStorer
+	var miniblocksMetadataUnit *pruning.PruningStorer
+	var txsHistoryUnit *pruning.PruningStorer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -295,6 +349,18 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, statusMetricsStorageUnit)
 
+	blockCommitJournalDbConfig := GetDBFromConfig(psf.generalConfig.BlockCommitJournalStorage.DB)
+	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.BlockCommitJournalStorage.DB.FilePath)
+	blockCommitJournalDbConfig.FilePath = dbPath
+	blockCommitJournalUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.BlockCommitJournalStorage.Cache),
+		blockCommitJournalDbConfig,
+		GetBloomFromConfig(psf.generalConfig.BlockCommitJournalStorage.Bloom))
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, blockCommitJournalUnit)
+
 	txUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxStorage)
 	txUnit, err = pruning.NewPruningStorer(txUnitArgs)
 	if err != nil {
@@ -337,6 +403,31 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	if psf.generalConfig.TxsMetadata.Enabled {
+		txsMetadataUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsMetadata.Storage)
+		txsMetadataUnit, err = pruning.NewPruningStorer(txsMetadataUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txsMetadataUnit)
+
+		miniblocksMetadataUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsMetadata.MiniblocksStorage)
+		miniblocksMetadataUnit, err = pruning.NewPruningStorer(miniblocksMetadataUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, miniblocksMetadataUnit)
+	}
+
+	if psf.generalConfig.TxsHistory.Enabled {
+		txsHistoryUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.TxsHistory.Storage)
+		txsHistoryUnit, err = pruning.NewPruningStorer(txsHistoryUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txsHistoryUnit)
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.BlockHeaderUnit, headerUnit)
@@ -352,7 +443,15 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
+	store.AddStorer(dataRetriever.BlockCommitJournalUnit, blockCommitJournalUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if txsMetadataUnit != nil {
+		store.AddStorer(dataRetriever.TransactionsMetadataUnit, txsMetadataUnit)
+		store.AddStorer(dataRetriever.MiniblocksMetadataUnit, miniblocksMetadataUnit)
+	}
+	if txsHistoryUnit != nil {
+		store.AddStorer(dataRetriever.TransactionsHistoryUnit, txsHistoryUnit)
+	}
 
 	return store, err
 }
@@ -379,6 +478,8 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		NumOfActivePersisters: numOfActivePersisters,
 		Notifier:              psf.epochStartNotifier,
 		MaxBatchSize:          storageConfig.DB.MaxBatchSize,
+		ArchiveEnabled:        psf.generalConfig.StoragePruning.ArchiveEnabled,
+		ArchivePath:           psf.generalConfig.StoragePruning.ArchivePath,
 	}
 
 	return args
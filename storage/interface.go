@@ -27,6 +27,35 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// SortedKeysRanger defines a persister that can iterate over all its stored (key, value) pairs in ascending key order
+type SortedKeysRanger interface {
+	// RangeKeys calls the handler for each stored pair, in ascending key order, until the handler returns false
+	RangeKeys(handler func(key []byte, value []byte) bool) error
+}
+
+// PersisterStatistics holds the size and compaction statistics of one or more persisters
+type PersisterStatistics struct {
+	NumKeys        uint64
+	LiveDataSize   uint64
+	DiskSize       uint64
+	NumFiles       uint64
+	NumCompactions uint64
+}
+
+// Add accumulates the provided statistics into the current ones
+func (ps *PersisterStatistics) Add(other PersisterStatistics) {
+	ps.NumKeys += other.NumKeys
+	ps.LiveDataSize += other.LiveDataSize
+	ps.DiskSize += other.DiskSize
+	ps.NumFiles += other.NumFiles
+	ps.NumCompactions += other.NumCompactions
+}
+
+// PersisterStatisticsProvider defines a component able to report the size and compaction statistics of its persisters
+type PersisterStatisticsProvider interface {
+	PersisterStatistics() PersisterStatistics
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -1,6 +1,7 @@
 package leveldb
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -64,3 +65,19 @@ func (b *batch) Get(key []byte) []byte {
 func (b *batch) IsInterfaceNil() bool {
 	return b == nil
 }
+
+// IsRemoved returns true if the provided key was deleted in this batch
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return bytes.Equal(b.cachedData[string(key)], []byte(removed))
+}
+
+// Dump returns a copy of the batch content, in the leveldb batch encoding
+func (b *batch) Dump() []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return append([]byte{}, b.batch.Dump()...)
+}
@@ -67,3 +67,19 @@ func openOneTime(path string, options *opt.Options) (*leveldb.DB, error) {
 
 	return nil, errOpen
 }
+
+func rangeKeys(db *leveldb.DB, handler func(key []byte, value []byte) bool) error {
+	iterator := db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		// the iterator reuses its buffers, so the handler must receive copies
+		key := append([]byte{}, iterator.Key()...)
+		value := append([]byte{}, iterator.Value()...)
+		if !handler(key, value) {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
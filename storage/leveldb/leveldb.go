@@ -15,6 +15,7 @@ import (
 )
 
 var _ storage.Persister = (*DB)(nil)
+var _ storage.SortedKeysRanger = (*DB)(nil)
 
 // read + write + execute for owner only
 const rwxOwner = 0700
@@ -197,6 +198,27 @@ func (s *DB) putBatch(b storage.Batcher) error {
 	return s.db.Write(dbBatch.batch, wopt)
 }
 
+// RangeKeys flushes the pending batch and calls the handler for each stored pair, in ascending key order, until
+// the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return storage.ErrNilRangeHandler
+	}
+
+	s.mutBatch.Lock()
+	err := s.putBatch(s.batch)
+	if err == nil {
+		s.batch.Reset()
+		s.sizeBatch = 0
+	}
+	s.mutBatch.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return rangeKeys(s.db, handler)
+}
+
 // Close closes the files/resources associated to the storage medium
 func (s *DB) Close() error {
 	s.mutBatch.Lock()
@@ -15,6 +15,7 @@ import (
 )
 
 var _ storage.Persister = (*SerialDB)(nil)
+var _ storage.SortedKeysRanger = (*SerialDB)(nil)
 
 // SerialDB holds a pointer to the leveldb database and the path to where it is stored.
 type SerialDB struct {
@@ -230,6 +231,24 @@ func (s *SerialDB) isClosed() bool {
 	return isClosed
 }
 
+// RangeKeys flushes the pending batch and calls the handler for each stored pair, in ascending key order, until
+// the handler returns false
+func (s *SerialDB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return storage.ErrNilRangeHandler
+	}
+	if s.isClosed() {
+		return storage.ErrSerialDBIsClosed
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		return err
+	}
+
+	return rangeKeys(s.db, handler)
+}
+
 // Close closes the files/resources associated to the storage medium
 func (s *SerialDB) Close() error {
 	s.mutClosed.Lock()
@@ -195,3 +195,27 @@ func TestSerialDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestSerialDB_RangeKeysShouldIterateAllPairsInOrder(t *testing.T) {
+	ldb := createSerialLevelDb(t, 10, 100, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	_ = ldb.Put([]byte("key2"), []byte("value2"))
+	_ = ldb.Put([]byte("key1"), []byte("value1"))
+
+	keys := make([]string, 0)
+	err := ldb.RangeKeys(func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+
+	_ = ldb.Close()
+	err = ldb.RangeKeys(func(key []byte, value []byte) bool {
+		return true
+	})
+	assert.Equal(t, storage.ErrSerialDBIsClosed, err)
+}
@@ -260,3 +260,30 @@ func TestDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestDB_RangeKeysShouldIterateAllPairsInOrder(t *testing.T) {
+	ldb := createLevelDb(t, 10, 100, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	err := ldb.RangeKeys(nil)
+	assert.Equal(t, storage.ErrNilRangeHandler, err)
+
+	// the pairs are still in the batch, so they have to be flushed before iterating
+	_ = ldb.Put([]byte("key2"), []byte("value2"))
+	_ = ldb.Put([]byte("key1"), []byte("value1"))
+	_ = ldb.Put([]byte("key3"), []byte("value3"))
+
+	pairs := make(map[string]string)
+	keys := make([]string, 0)
+	err = ldb.RangeKeys(func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		pairs[string(key)] = string(value)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key1", "key2", "key3"}, keys)
+	assert.Equal(t, "value2", pairs["key2"])
+}
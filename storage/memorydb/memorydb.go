@@ -4,12 +4,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var _ storage.Persister = (*DB)(nil)
+var _ storage.SortedKeysRanger = (*DB)(nil)
 
 // DB represents the memory database storage. It holds a map of key value pairs
 // and a mutex to handle concurrent accesses to the map
@@ -69,6 +71,36 @@ func (s *DB) Init() error {
 	return nil
 }
 
+// RangeKeys calls the handler for each stored pair, in ascending key order, until the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return storage.ErrNilRangeHandler
+	}
+
+	s.mutx.RLock()
+	keys := make([]string, 0, len(s.db))
+	for key := range s.db {
+		keys = append(keys, key)
+	}
+	s.mutx.RUnlock()
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		s.mutx.RLock()
+		value, ok := s.db[key]
+		s.mutx.RUnlock()
+		if !ok {
+			continue
+		}
+
+		if !handler([]byte(key), value) {
+			break
+		}
+	}
+
+	return nil
+}
+
 // Close closes the files/resources associated to the storage medium
 func (s *DB) Close() error {
 	// nothing to do
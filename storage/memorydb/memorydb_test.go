@@ -98,3 +98,20 @@ func TestDestroy(t *testing.T) {
 	err := mdb.Destroy()
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestRangeKeysShouldIterateInAscendingKeyOrder(t *testing.T) {
+	mdb := memorydb.New()
+	_ = mdb.Put([]byte("key3"), []byte("value3"))
+	_ = mdb.Put([]byte("key1"), []byte("value1"))
+	_ = mdb.Put([]byte("key2"), []byte("value2"))
+
+	keys := make([]string, 0)
+	err := mdb.RangeKeys(func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		assert.Equal(t, "value"+string(key[3:]), string(value))
+		return len(keys) < 2
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+}
@@ -36,3 +36,7 @@ func (ps *PruningStorer) GetActivePersistersEpochs() []uint32 {
 func RemoveDirectoryIfEmpty(path string) {
 	removeDirectoryIfEmpty(path)
 }
+
+func (ps *PruningStorer) WaitForArchiving() {
+	ps.archivingWg.Wait()
+}
@@ -17,3 +17,11 @@ type DbFactoryHandler interface {
 	Create(filePath string) (storage.Persister, error)
 	IsInterfaceNil() bool
 }
+
+// ArchivedPersister defines the read-only access to the archive of an old epoch's persister
+type ArchivedPersister interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
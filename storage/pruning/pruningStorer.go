@@ -3,7 +3,12 @@ package pruning
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -13,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/archive"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -28,12 +34,18 @@ const maxNumEpochsToKeepIfAShardIsStuck = 5
 // it is useful for checking if any metablock of this kind is received
 const epochForDefaultEpochPrepareHdr = math.MaxUint32 - 7
 
+// archiveEpochDirectoryPrefix and archiveShardDirectoryPrefix are used when building the archives' directories, the same
+// way the persisters' directories are built: archivePath/Epoch_X/Shard_Y/Identifier
+const archiveEpochDirectoryPrefix = "Epoch_"
+const archiveShardDirectoryPrefix = "Shard_"
+
 // persisterData structure is used so the persister and its path can be kept in the same place
 type persisterData struct {
-	persister storage.Persister
-	path      string
-	epoch     uint32
-	isClosed  bool
+	persister   storage.Persister
+	path        string
+	epoch       uint32
+	isClosed    bool
+	isArchiving bool
 }
 
 // PruningStorer represents a storer which creates a new persister for each epoch and removes older activePersisters
@@ -55,6 +67,11 @@ type PruningStorer struct {
 	epochForPutOperation  uint32
 	fullArchive           bool
 	pruningEnabled        bool
+	archiveEnabled        bool
+	archivePath           string
+	archivesByEpoch       map[uint32]ArchivedPersister
+	archivedEpochs        []uint32
+	archivingWg           sync.WaitGroup
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -105,6 +122,9 @@ func initPruningStorer(
 	if args.MaxBatchSize > int(args.CacheConf.Capacity) {
 		return nil, storage.ErrCacheSizeIsLowerThanBatchSize
 	}
+	if args.ArchiveEnabled && len(args.ArchivePath) == 0 {
+		return nil, storage.ErrInvalidArchivePath
+	}
 
 	cache, err = storageUnit.NewCache(args.CacheConf.Type, args.CacheConf.Capacity, args.CacheConf.Shards, args.CacheConf.SizeInBytes)
 	if err != nil {
@@ -137,6 +157,10 @@ func initPruningStorer(
 		dbPath:                args.DbPath,
 		numOfEpochsToKeep:     args.NumOfEpochsToKeep,
 		numOfActivePersisters: args.NumOfActivePersisters,
+		archiveEnabled:        args.ArchiveEnabled,
+		archivePath:           args.ArchivePath,
+		archivesByEpoch:       make(map[uint32]ArchivedPersister),
+		archivedEpochs:        make([]uint32, 0),
 	}
 
 	if args.BloomFilterConf.Size != 0 { // if size is 0, that means an empty config was used so bloom filter will be nil
@@ -148,6 +172,10 @@ func initPruningStorer(
 		pdb.bloomFilter = bf
 	}
 
+	if pdb.archiveEnabled {
+		pdb.loadArchives()
+	}
+
 	pdb.registerHandler(args.Notifier)
 
 	return pdb, nil
@@ -278,6 +306,8 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 
 // Close will close PruningStorer
 func (ps *PruningStorer) Close() error {
+	ps.archivingWg.Wait()
+
 	closedSuccessfully := true
 	for _, persister := range ps.activePersisters {
 		err := persister.persister.Close()
@@ -288,6 +318,16 @@ func (ps *PruningStorer) Close() error {
 		}
 	}
 
+	ps.lock.Lock()
+	for epoch, archivedPersister := range ps.archivesByEpoch {
+		err := archivedPersister.Close()
+		if err != nil {
+			log.Error("cannot close archive", "epoch", epoch, "error", err)
+			closedSuccessfully = false
+		}
+	}
+	ps.lock.Unlock()
+
 	if closedSuccessfully {
 		return nil
 	}
@@ -308,8 +348,7 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 
 	pd, exists := ps.persistersMapByEpoch[epoch]
 	if !exists {
-		return nil, fmt.Errorf("key %s not found in %s",
-			hex.EncodeToString(key), ps.identifier)
+		return ps.getFromArchive(key, epoch)
 	}
 
 	if !pd.isClosed {
@@ -351,7 +390,24 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 
 }
 
-// SearchFirst will search a given key in all the active persisters, from the newest to the oldest
+func (ps *PruningStorer) getFromArchive(key []byte, epoch uint32) ([]byte, error) {
+	archivedPersister, ok := ps.archivesByEpoch[epoch]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in %s",
+			hex.EncodeToString(key), ps.identifier)
+	}
+
+	res, err := archivedPersister.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("key %s not found in %s archive for epoch %d",
+			hex.EncodeToString(key), ps.identifier, epoch)
+	}
+
+	return res, nil
+}
+
+// SearchFirst will search a given key in all the active persisters, from the newest to the oldest. If not found and
+// the archiving is enabled, the archives are searched as well, from the newest to the oldest
 func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
@@ -370,6 +426,13 @@ func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 		}
 	}
 
+	for _, epoch := range ps.archivedEpochs {
+		res, err = ps.archivesByEpoch[epoch].Get(key)
+		if err == nil {
+			return res, nil
+		}
+	}
+
 	return nil, fmt.Errorf("%w - SearchFirst, unit = %s, key = %s, num active persisters = %d",
 		storage.ErrKeyNotFound,
 		ps.identifier,
@@ -419,7 +482,12 @@ func (ps *PruningStorer) HasInEpoch(key []byte, epoch uint32) error {
 	if ps.bloomFilter == nil || ps.bloomFilter.MayContain(key) {
 		pd, ok := ps.persistersMapByEpoch[epoch]
 		if !ok {
-			return storage.ErrKeyNotFound
+			archivedPersister, isArchived := ps.archivesByEpoch[epoch]
+			if !isArchived {
+				return storage.ErrKeyNotFound
+			}
+
+			return archivedPersister.Has(key)
 		}
 
 		if !pd.isClosed {
@@ -482,6 +550,8 @@ func (ps *PruningStorer) ClearCache() {
 
 // DestroyUnit cleans up the bloom filter, the cache, and the dbs
 func (ps *PruningStorer) DestroyUnit() error {
+	ps.archivingWg.Wait()
+
 	ps.lock.Lock()
 	defer ps.lock.Unlock()
 
@@ -730,6 +800,15 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 			if !ok {
 				break
 			}
+
+			if ps.archiveEnabled {
+				// the persister is destroyed only after its archive replaced it
+				if !persisterToDestroy.isArchiving {
+					ps.startArchiving(idxToRemove, persisterToDestroy)
+				}
+				idxToRemove--
+				continue
+			}
 			delete(ps.persistersMapByEpoch, idxToRemove)
 
 			err := persisterToDestroy.persister.DestroyClosed()
@@ -744,6 +823,172 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	return nil
 }
 
+// startArchiving opens the persister of the given epoch, so it can still be read while it is compacted in the background
+// into a read-only archive. The storer's lock is not held during the compaction, only when the archive replaces the
+// persister. If the archiving fails, the persister is kept so the archiving will be retried on the next epoch change
+func (ps *PruningStorer) startArchiving(epoch uint32, pd *persisterData) {
+	if pd.isClosed {
+		persister, err := ps.persisterFactory.Create(pd.path)
+		if err != nil {
+			log.Warn("PruningStorer - open persister for archiving", "identifier", ps.identifier, "epoch", epoch, "error", err.Error())
+			return
+		}
+
+		err = persister.Init()
+		if err != nil {
+			log.Warn("PruningStorer - init persister for archiving", "identifier", ps.identifier, "epoch", epoch, "error", err.Error())
+			_ = persister.Close()
+			return
+		}
+
+		pd.persister = persister
+		pd.isClosed = false
+	}
+
+	ranger, ok := pd.persister.(storage.SortedKeysRanger)
+	if !ok {
+		log.Warn("PruningStorer - archive persister", "identifier", ps.identifier, "epoch", epoch,
+			"error", storage.ErrArchivingNotSupported.Error())
+		ps.closeArchivingPersister(pd)
+		return
+	}
+
+	pd.isArchiving = true
+	ps.archivingWg.Add(1)
+	go ps.archivePersister(epoch, pd, ranger)
+}
+
+// archivePersister compacts the persister of the given epoch into a read-only archive, which will be used from now on
+// when searching that epoch
+func (ps *PruningStorer) archivePersister(epoch uint32, pd *persisterData, ranger storage.SortedKeysRanger) {
+	defer ps.archivingWg.Done()
+
+	path := ps.archivePathForEpoch(epoch)
+	numPairs, err := archive.CreateArchive(path, ranger)
+	var archivedPersister ArchivedPersister
+	if err == nil {
+		archivedPersister, err = archive.NewArchivedDB(path)
+	}
+
+	ps.lock.Lock()
+	pd.isArchiving = false
+	if err != nil {
+		ps.closeArchivingPersister(pd)
+		ps.lock.Unlock()
+
+		log.Warn("PruningStorer - archive persister, the archiving will be retried on the next epoch change",
+			"identifier", ps.identifier,
+			"epoch", epoch,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	ps.addArchive(epoch, archivedPersister)
+	delete(ps.persistersMapByEpoch, epoch)
+	ps.lock.Unlock()
+
+	err = pd.persister.Close()
+	if err != nil {
+		log.Debug("persister.Close()", "error", err.Error())
+	}
+	err = pd.persister.DestroyClosed()
+	if err != nil {
+		log.Warn("PruningStorer - destroy archived persister", "identifier", ps.identifier, "epoch", epoch, "error", err.Error())
+	}
+	removeDirectoryIfEmpty(pd.path)
+
+	log.Debug("PruningStorer - persister archived",
+		"identifier", ps.identifier,
+		"epoch", epoch,
+		"num pairs", numPairs,
+		"path", path,
+	)
+}
+
+func (ps *PruningStorer) closeArchivingPersister(pd *persisterData) {
+	err := pd.persister.Close()
+	if err != nil {
+		log.Debug("persister.Close()", "error", err.Error())
+	}
+	pd.isClosed = true
+}
+
+// loadArchives opens the archives already created for this unit, for the epochs no longer kept in the persisters
+func (ps *PruningStorer) loadArchives() {
+	entries, err := ioutil.ReadDir(ps.archivePath)
+	if err != nil {
+		log.Debug("PruningStorer - no archives loaded", "identifier", ps.identifier, "error", err.Error())
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), archiveEpochDirectoryPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), archiveEpochDirectoryPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		_, isKeptInPersister := ps.persistersMapByEpoch[uint32(epoch)]
+		path := ps.archivePathForEpoch(uint32(epoch))
+		if isKeptInPersister || !archive.Exists(path) {
+			continue
+		}
+
+		archivedPersister, errOpen := archive.NewArchivedDB(path)
+		if errOpen != nil {
+			log.Warn("PruningStorer - could not open archive", "path", path, "error", errOpen.Error())
+			continue
+		}
+
+		ps.addArchive(uint32(epoch), archivedPersister)
+	}
+}
+
+func (ps *PruningStorer) addArchive(epoch uint32, archivedPersister ArchivedPersister) {
+	oldArchive, exists := ps.archivesByEpoch[epoch]
+	if exists {
+		_ = oldArchive.Close()
+	} else {
+		ps.archivedEpochs = append(ps.archivedEpochs, epoch)
+		sort.Slice(ps.archivedEpochs, func(i, j int) bool {
+			return ps.archivedEpochs[i] > ps.archivedEpochs[j]
+		})
+	}
+
+	ps.archivesByEpoch[epoch] = archivedPersister
+}
+
+func (ps *PruningStorer) archivePathForEpoch(epoch uint32) string {
+	return filepath.Join(
+		ps.archivePath,
+		fmt.Sprintf("%s%d", archiveEpochDirectoryPrefix, epoch),
+		archiveShardDirectoryPrefix+core.GetShardIdString(ps.shardCoordinator.SelfId()),
+		ps.identifier,
+	)
+}
+
+// PersisterStatistics returns the accumulated statistics of the active persisters able to report them
+func (ps *PruningStorer) PersisterStatistics() storage.PersisterStatistics {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	statistics := storage.PersisterStatistics{}
+	for _, pd := range ps.activePersisters {
+		provider, ok := pd.persister.(storage.PersisterStatisticsProvider)
+		if !ok || pd.isClosed {
+			continue
+		}
+
+		statistics.Add(provider.PersisterStatistics())
+	}
+
+	return statistics
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *PruningStorer) IsInterfaceNil() bool {
 	return ps == nil
@@ -21,4 +21,6 @@ type StorerArgs struct {
 	PruningEnabled        bool
 	FullArchive           bool
 	MaxBatchSize          int
+	ArchiveEnabled        bool
+	ArchivePath           string
 }
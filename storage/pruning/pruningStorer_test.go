@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -824,3 +826,207 @@ func TestDirectories(t *testing.T) {
 
 	_ = os.RemoveAll("user-directory")
 }
+
+func createPersisterFactoryKeepingPersistersByPath() *mock.PersisterFactoryStub {
+	mutPersisters := sync.Mutex{}
+	persistersByPath := make(map[string]storage.Persister)
+
+	return &mock.PersisterFactoryStub{
+		// simulate an opening of an existing database from the file path by saving persisters in a map based on their path
+		CreateCalled: func(path string) (storage.Persister, error) {
+			mutPersisters.Lock()
+			defer mutPersisters.Unlock()
+
+			if _, ok := persistersByPath[path]; ok {
+				return persistersByPath[path], nil
+			}
+			newPers := memorydb.New()
+			persistersByPath[path] = newPers
+
+			return newPers, nil
+		},
+	}
+}
+
+func TestNewPruningStorer_ArchiveEnabledWithoutPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.ArchiveEnabled = true
+	ps, err := pruning.NewPruningStorer(args)
+
+	assert.Nil(t, ps)
+	assert.Equal(t, storage.ErrInvalidArchivePath, err)
+}
+
+func TestPruningStorer_ChangeEpochShouldArchiveOldData(t *testing.T) {
+	t.Parallel()
+
+	archivePath, _ := ioutil.TempDir("", "pruning_archive")
+	defer func() {
+		_ = os.RemoveAll(archivePath)
+	}()
+
+	args := getDefaultArgs()
+	args.PersisterFactory = createPersisterFactoryKeepingPersistersByPath()
+	args.ArchiveEnabled = true
+	args.ArchivePath = archivePath
+	ps, _ := pruning.NewPruningStorer(args)
+
+	testKey := []byte("key")
+	testVal := []byte("value")
+	err := ps.Put(testKey, testVal)
+	require.Nil(t, err)
+
+	err = ps.ChangeEpochSimple(1)
+	require.Nil(t, err)
+	err = ps.ChangeEpochSimple(2)
+	require.Nil(t, err)
+	ps.WaitForArchiving()
+	ps.ClearCache()
+
+	// the data is no longer in the active persisters, but it is still available from the archive of epoch 0
+	_, err = ps.Get(testKey)
+	assert.NotNil(t, err)
+
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	res, err = ps.SearchFirst(testKey)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	assert.Nil(t, ps.HasInEpoch(testKey, 0))
+	assert.NotNil(t, ps.HasInEpoch(testKey, 1))
+	_, err = ps.GetFromEpoch([]byte("missing key"), 0)
+	assert.NotNil(t, err)
+
+	err = ps.Close()
+	assert.Nil(t, err)
+
+	// a new storer, created after a restart, should load the existing archives
+	args.StartingEpoch = 2
+	args.PersisterFactory = createPersisterFactoryKeepingPersistersByPath()
+	restartedStorer, _ := pruning.NewPruningStorer(args)
+	defer func() {
+		_ = restartedStorer.Close()
+	}()
+
+	res, err = restartedStorer.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+}
+
+func TestPruningStorer_ChangeEpochArchivingFailureShouldKeepTheOldData(t *testing.T) {
+	t.Parallel()
+
+	archivePath, _ := ioutil.TempDir("", "pruning_archive")
+	defer func() {
+		_ = os.RemoveAll(archivePath)
+	}()
+
+	args := getDefaultArgs()
+	persistersFactory := createPersisterFactoryKeepingPersistersByPath()
+	createPersister := persistersFactory.CreateCalled
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister, err := createPersister(path)
+			// hide the RangeKeys method, so the persister can not be archived
+			return struct{ storage.Persister }{persister}, err
+		},
+	}
+	args.ArchiveEnabled = true
+	args.ArchivePath = archivePath
+	ps, _ := pruning.NewPruningStorer(args)
+
+	testKey := []byte("key")
+	testVal := []byte("value")
+	_ = ps.Put(testKey, testVal)
+
+	_ = ps.ChangeEpochSimple(1)
+	err := ps.ChangeEpochSimple(2)
+	assert.Nil(t, err)
+	ps.WaitForArchiving()
+	ps.ClearCache()
+
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Equal(t, []uint32{2, 1}, ps.GetActivePersistersEpochs())
+}
+
+type blockingRangerPersister struct {
+	storage.Persister
+	rangeStarted chan struct{}
+	release      chan struct{}
+}
+
+func (brp *blockingRangerPersister) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	close(brp.rangeStarted)
+	<-brp.release
+
+	return brp.Persister.(storage.SortedKeysRanger).RangeKeys(handler)
+}
+
+func TestPruningStorer_ChangeEpochShouldNotBlockTheStorerWhileArchiving(t *testing.T) {
+	t.Parallel()
+
+	archivePath, _ := ioutil.TempDir("", "pruning_archive")
+	defer func() {
+		_ = os.RemoveAll(archivePath)
+	}()
+
+	rangeStarted := make(chan struct{})
+	release := make(chan struct{})
+	args := getDefaultArgs()
+	persistersFactory := createPersisterFactoryKeepingPersistersByPath()
+	createPersister := persistersFactory.CreateCalled
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister, err := createPersister(path)
+			if !strings.Contains(path, "Epoch_0") {
+				return persister, err
+			}
+
+			return &blockingRangerPersister{
+				Persister:    persister,
+				rangeStarted: rangeStarted,
+				release:      release,
+			}, err
+		},
+	}
+	args.ArchiveEnabled = true
+	args.ArchivePath = archivePath
+	ps, _ := pruning.NewPruningStorer(args)
+
+	testKey := []byte("key")
+	testVal := []byte("value")
+	_ = ps.Put(testKey, testVal)
+
+	_ = ps.ChangeEpochSimple(1)
+	err := ps.ChangeEpochSimple(2)
+	assert.Nil(t, err)
+
+	select {
+	case <-rangeStarted:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the archiving did not start")
+	}
+
+	// the archiving is in progress, the storer can still be used and the epoch being archived can still be read
+	err = ps.Put([]byte("new key"), []byte("new value"))
+	assert.Nil(t, err)
+	ps.ClearCache()
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	close(release)
+	ps.WaitForArchiving()
+
+	res, err = ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Nil(t, ps.Close())
+}
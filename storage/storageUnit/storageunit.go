@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
@@ -48,6 +49,7 @@ const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
 	MemoryDB    DBType = "MemoryDB"
+	BadgerDB    DBType = "BadgerDB"
 )
 
 const (
@@ -85,6 +87,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       string
 }
 
 // BloomConfig holds the configurable elements of a bloom filter
@@ -231,6 +234,27 @@ func (u *Unit) DestroyUnit() error {
 	return u.persister.Destroy()
 }
 
+// PersisterStatistics returns the statistics of the persister, if it is able to report them
+func (u *Unit) PersisterStatistics() storage.PersisterStatistics {
+	provider, ok := u.persister.(storage.PersisterStatisticsProvider)
+	if !ok {
+		return storage.PersisterStatistics{}
+	}
+
+	return provider.PersisterStatistics()
+}
+
+// RangeKeys calls the handler for each pair stored in the persistence medium, in ascending key order, until the
+// handler returns false. The unit lock is not held while iterating, so the handler must not change the unit
+func (u *Unit) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	ranger, ok := u.persister.(storage.SortedKeysRanger)
+	if !ok {
+		return storage.ErrRangeKeysNotSupported
+	}
+
+	return ranger.RangeKeys(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (u *Unit) IsInterfaceNil() bool {
 	return u == nil
@@ -315,6 +339,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
@@ -380,6 +405,7 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       string
 }
 
 // NewDB creates a new database from database config
@@ -395,6 +421,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, badgerdb.CompressionType(argDB.Compression))
 		default:
 			return nil, storage.ErrNotSupportedDBType
 		}
@@ -442,6 +442,21 @@ func TestDestroyUnitWithNilBloomFilterNoError(t *testing.T) {
 	assert.Nil(t, err, "no error expected, but got %s", err)
 }
 
+func TestRangeKeysShouldIterateThePersistedPairs(t *testing.T) {
+	s := initStorageUnitWithNilBloomFilter(t, 10)
+	_ = s.Put([]byte("key1"), []byte("value1"))
+	_ = s.Put([]byte("key2"), []byte("value2"))
+
+	pairs := make(map[string]string)
+	err := s.RangeKeys(func(key []byte, value []byte) bool {
+		pairs[string(key)] = string(value)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, pairs)
+}
+
 func TestCreateCacheFromConfWrongType(t *testing.T) {
 
 	cacher, err := storageUnit.NewCache("NotLRU", 100, 1, 0)
@@ -502,6 +517,29 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfBadgerDBOk(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.BadgerDB,
+		Path:              dir,
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		Compression:       "Snappy",
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.NotNil(t, persister, "valid persister expected but got nil")
+
+	err = persister.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+
+	statistics := persister.(storage.PersisterStatisticsProvider).PersisterStatistics()
+	assert.True(t, statistics.DiskSize > 0)
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
 func TestCreateBloomFilterFromConfWrongSize(t *testing.T) {
 	bfConfig := storageUnit.BloomConfig{
 		Size:     2,
@@ -27,6 +27,9 @@ type ArgsNewAccountsDBSyncersContainerFactory struct {
 	TrieStorageManager   data.StorageManager
 	WaitTime             time.Duration
 	MaxTrieLevelInMemory uint
+	TrieSyncerVersion    uint32
+	MaxInFlightTrieNodes int
+	AppStatusHandler     core.AppStatusHandler
 }
 
 type accountDBSyncersContainerFactory struct {
@@ -39,6 +42,9 @@ type accountDBSyncersContainerFactory struct {
 	waitTime             time.Duration
 	trieStorageManager   data.StorageManager
 	maxTrieLevelinMemory uint
+	trieSyncerVersion    uint32
+	maxInFlightNodes     int
+	appStatusHandler     core.AppStatusHandler
 }
 
 const minWaitTime = time.Second
@@ -66,6 +72,9 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 	if args.WaitTime < minWaitTime {
 		return nil, fmt.Errorf("%w, minWaitTime is %d", update.ErrInvalidWaitTime, minWaitTime)
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
 
 	t := &accountDBSyncersContainerFactory{
 		shardCoordinator:     args.ShardCoordinator,
@@ -76,6 +85,9 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		trieStorageManager:   args.TrieStorageManager,
 		waitTime:             args.WaitTime,
 		maxTrieLevelinMemory: args.MaxTrieLevelInMemory,
+		trieSyncerVersion:    args.TrieSyncerVersion,
+		maxInFlightNodes:     args.MaxInFlightTrieNodes,
+		appStatusHandler:     args.AppStatusHandler,
 	}
 
 	return t, nil
@@ -115,6 +127,9 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncerVersion:    a.trieSyncerVersion,
+			MaxInFlightTrieNodes: a.maxInFlightNodes,
+			AppStatusHandler:     a.appStatusHandler,
 		},
 		ShardId: shardId,
 	}
@@ -137,6 +152,9 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncerVersion:    a.trieSyncerVersion,
+			MaxInFlightTrieNodes: a.maxInFlightNodes,
+			AppStatusHandler:     a.appStatusHandler,
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
@@ -49,6 +49,8 @@ type ArgsExporter struct {
 	ExportTriesStorageConfig config.StorageConfig
 	ExportStateStorageConfig config.StorageConfig
 	MaxTrieLevelInMemory     uint
+	TrieSyncConfig           config.TrieSyncConfig
+	StatusHandler            core.AppStatusHandler
 	WhiteListHandler         process.WhiteListHandler
 	WhiteListerVerifiedTxs   process.WhiteListHandler
 	InterceptorsContainer    process.InterceptorsContainer
@@ -64,6 +66,8 @@ type ArgsExporter struct {
 	ValidityAttester         process.ValidityAttester
 	InputAntifloodHandler    process.P2PAntifloodHandler
 	OutputAntifloodHandler   process.P2PAntifloodHandler
+	ChainID                  []byte
+	MinTransactionVersion    uint32
 }
 
 type exportHandlerFactory struct {
@@ -82,6 +86,8 @@ type exportHandlerFactory struct {
 	exportTriesStorageConfig config.StorageConfig
 	exportStateStorageConfig config.StorageConfig
 	maxTrieLevelInMemory     uint
+	trieSyncConfig           config.TrieSyncConfig
+	statusHandler            core.AppStatusHandler
 	whiteListHandler         process.WhiteListHandler
 	whiteListerVerifiedTxs   process.WhiteListHandler
 	interceptorsContainer    process.InterceptorsContainer
@@ -101,6 +107,8 @@ type exportHandlerFactory struct {
 	resolverContainer        dataRetriever.ResolversContainer
 	inputAntifloodHandler    process.P2PAntifloodHandler
 	outputAntifloodHandler   process.P2PAntifloodHandler
+	chainID                  []byte
+	minTransactionVersion    uint32
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -186,6 +194,9 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 	if check.IfNil(args.OutputAntifloodHandler) {
 		return nil, update.ErrNilAntiFloodHandler
 	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
 
 	e := &exportHandlerFactory{
 		txSignMarshalizer:        args.TxSignMarshalizer,
@@ -220,6 +231,10 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		inputAntifloodHandler:    args.InputAntifloodHandler,
 		outputAntifloodHandler:   args.OutputAntifloodHandler,
 		maxTrieLevelInMemory:     args.MaxTrieLevelInMemory,
+		trieSyncConfig:           args.TrieSyncConfig,
+		statusHandler:            args.StatusHandler,
+		chainID:                  args.ChainID,
+		minTransactionVersion:    args.MinTransactionVersion,
 	}
 
 	return e, nil
@@ -299,6 +314,9 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		TrieStorageManager:   dataTriesContainerFactory.TrieStorageManager(),
 		WaitTime:             time.Minute,
 		MaxTrieLevelInMemory: e.maxTrieLevelInMemory,
+		TrieSyncerVersion:    e.trieSyncConfig.TrieSyncerVersion,
+		MaxInFlightTrieNodes: e.trieSyncConfig.MaxInFlightNodes,
+		AppStatusHandler:     e.statusHandler,
 	}
 	accountsDBSyncerFactory, err := NewAccountsDBSContainerFactory(argsAccountsSyncers)
 	if err != nil {
@@ -429,6 +447,8 @@ func (e *exportHandlerFactory) createInterceptors() error {
 		InterceptorsContainer:   e.interceptorsContainer,
 		AntifloodHandler:        e.inputAntifloodHandler,
 		NonceConverter:          e.uint64Converter,
+		ChainID:                 e.chainID,
+		MinTransactionVersion:   e.minTransactionVersion,
 	}
 	fullSyncInterceptors, err := NewFullSyncInterceptorsContainerFactory(argsInterceptors)
 	if err != nil {
@@ -79,6 +79,8 @@ type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	InterceptorsContainer   process.InterceptorsContainer
 	AntifloodHandler        process.P2PAntifloodHandler
 	NonceConverter          typeConverters.Uint64ByteSliceConverter
+	ChainID                 []byte
+	MinTransactionVersion   uint32
 }
 
 // NewFullSyncInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -165,6 +167,8 @@ func NewFullSyncInterceptorsContainerFactory(
 		EpochStartTrigger:       args.EpochStartTrigger,
 		NonceConverter:          args.NonceConverter,
 		WhiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		ChainID:                 args.ChainID,
+		MinTransactionVersion:   args.MinTransactionVersion,
 	}
 
 	icf := &fullSyncInterceptorsContainerFactory{
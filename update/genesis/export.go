@@ -1,6 +1,7 @@
 package genesis
 
 import (
+	"context"
 	"encoding/json"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -174,11 +175,6 @@ func (se *stateExport) exportMeta() error {
 func (se *stateExport) exportTrie(key string, trie data.Trie) error {
 	fileName := TrieFileName + atSep + key
 
-	leaves, err := trie.GetAllLeaves()
-	if err != nil {
-		return err
-	}
-
 	accType, shId, err := GetTrieTypeAndShId(fileName)
 	if err != nil {
 		return err
@@ -199,58 +195,46 @@ func (se *stateExport) exportTrie(key string, trie data.Trie) error {
 		return err
 	}
 
-	if accType == DataTrie {
-		return se.exportDataTries(leaves, accType, shId, fileName)
-	}
-
-	return se.exportAccountLeafs(leaves, accType, shId, fileName)
-}
-
-func (se *stateExport) exportDataTries(leafs map[string][]byte, accType Type, shId uint32, fileName string) error {
-	for address, buff := range leafs {
-		keyToExport := CreateAccountKey(accType, shId, address)
-		err := se.writer.Write(fileName, keyToExport, buff)
-		if err != nil {
-			return err
+	err = trie.IterateLeaves(context.Background(), data.LeavesFilter{}, func(leafKey []byte, value []byte) error {
+		if accType == DataTrie {
+			return se.exportDataTrieLeaf(leafKey, value, accType, shId, fileName)
 		}
+
+		return se.exportAccountLeaf(leafKey, value, accType, shId, fileName)
+	})
+	if err != nil {
+		return err
 	}
 
 	se.writer.CloseFile(fileName)
 	return nil
 }
 
-func (se *stateExport) exportAccountLeafs(leafs map[string][]byte, accType Type, shId uint32, fileName string) error {
-	for address, buff := range leafs {
-		keyToExport := CreateAccountKey(accType, shId, address)
-		account, err := NewEmptyAccount(accType, []byte(address))
-		if err != nil {
-			log.Warn("error creating new account account", "address", address, "error", err)
-			continue
-		}
-		err = se.marshalizer.Unmarshal(account, buff)
-		if err != nil {
-			err = se.writer.Write(fileName, keyToExport, buff)
-			if err != nil {
-				return err
-			}
-			log.Trace("error unmarshaling account this is maybe a code", "address", address, "error", err)
-			continue
-		}
+func (se *stateExport) exportDataTrieLeaf(key []byte, buff []byte, accType Type, shId uint32, fileName string) error {
+	keyToExport := CreateAccountKey(accType, shId, string(key))
+	return se.writer.Write(fileName, keyToExport, buff)
+}
 
-		jsonData, err := json.Marshal(account)
-		if err != nil {
-			log.Warn("error marshaling account", "address", address, "error", err)
-			continue
-		}
+func (se *stateExport) exportAccountLeaf(address []byte, buff []byte, accType Type, shId uint32, fileName string) error {
+	keyToExport := CreateAccountKey(accType, shId, string(address))
+	account, err := NewEmptyAccount(accType, address)
+	if err != nil {
+		log.Warn("error creating new account account", "address", address, "error", err)
+		return nil
+	}
+	err = se.marshalizer.Unmarshal(account, buff)
+	if err != nil {
+		log.Trace("error unmarshaling account this is maybe a code", "address", address, "error", err)
+		return se.writer.Write(fileName, keyToExport, buff)
+	}
 
-		err = se.writer.Write(fileName, keyToExport, jsonData)
-		if err != nil {
-			return err
-		}
+	jsonData, err := json.Marshal(account)
+	if err != nil {
+		log.Warn("error marshaling account", "address", address, "error", err)
+		return nil
 	}
 
-	se.writer.CloseFile(fileName)
-	return nil
+	return se.writer.Write(fileName, keyToExport, jsonData)
 }
 
 func (se *stateExport) exportMBs(key string, mb *block.MiniBlock) error {
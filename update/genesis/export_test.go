@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	"context"
+	"errors"
 	"math/big"
 	"os"
 	"testing"
@@ -104,3 +106,88 @@ func TestExportAll(t *testing.T) {
 		require.Fail(t, "file wasn't created"+TransactionsFileName)
 	}
 }
+
+func TestStateExport_ExportTrieShouldStreamAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	leaves := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	}
+	trie := &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		IterateLeavesCalled: func(_ context.Context, _ data.LeavesFilter, handler data.LeafHandler) error {
+			for key, value := range leaves {
+				err := handler([]byte(key), value)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	trieIdentifier := CreateTrieIdentifier(0, DataTrie)
+	written := make(map[string][]byte)
+	closedFileName := ""
+	writer := &mock.MultiFileWriterStub{
+		WriteCalled: func(fileName string, key string, value []byte) error {
+			written[key] = value
+			return nil
+		},
+		CloseFileCalled: func(fileName string) {
+			closedFileName = fileName
+		},
+	}
+	args := ArgsNewStateExporter{
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		StateSyncer:      &mock.SyncStateStub{},
+		Writer:           writer,
+		Hasher:           &mock.HasherMock{},
+	}
+	stateExporter, _ := NewStateExporter(args)
+
+	err := stateExporter.exportTrie(trieIdentifier, trie)
+	require.Nil(t, err)
+
+	require.Equal(t, 3, len(written))
+	require.Equal(t, []byte("rootHash"), written[CreateRootHashKey(trieIdentifier)])
+	for key, value := range leaves {
+		require.Equal(t, value, written[CreateAccountKey(DataTrie, 0, key)])
+	}
+	require.Equal(t, TrieFileName+atSep+trieIdentifier, closedFileName)
+}
+
+func TestStateExport_ExportTrieIterateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	trie := &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+		IterateLeavesCalled: func(_ context.Context, _ data.LeavesFilter, _ data.LeafHandler) error {
+			return expectedErr
+		},
+	}
+	fileClosed := false
+	args := ArgsNewStateExporter{
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Marshalizer:      &mock.MarshalizerMock{},
+		StateSyncer:      &mock.SyncStateStub{},
+		Writer: &mock.MultiFileWriterStub{
+			CloseFileCalled: func(_ string) {
+				fileClosed = true
+			},
+		},
+		Hasher: &mock.HasherMock{},
+	}
+	stateExporter, _ := NewStateExporter(args)
+
+	err := stateExporter.exportTrie(CreateTrieIdentifier(0, UserAccount), trie)
+	require.Equal(t, expectedErr, err)
+	require.False(t, fileClosed)
+}
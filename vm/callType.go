@@ -0,0 +1,8 @@
+package vm
+
+import vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+
+// BuiltInFunctionCallType is the call type of the system smart contract calls created, on the metachain, by the
+// built-in functions which were executed in the sender shard. No transaction or smart contract result carries it, so
+// a system smart contract can rely on it to know that the built-in function was executed before the call
+const BuiltInFunctionCallType = vmcommon.AsynchronousCallBack + 1
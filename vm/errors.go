@@ -124,3 +124,12 @@ var ErrNilValidatorAccountsDB = errors.New("nil validator accounts DB")
 
 // ErrNilPublicKey signals that nil public key has been provided
 var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrTokenNotRegistered signals that the provided token was not registered
+var ErrTokenNotRegistered = errors.New("token was not registered")
+
+// ErrInvalidPropertyValue signals that an invalid value was provided for a token property
+var ErrInvalidPropertyValue = errors.New("invalid property value, expected true or false")
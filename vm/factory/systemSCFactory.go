@@ -20,6 +20,7 @@ type systemSCFactory struct {
 	marshalizer         marshal.Marshalizer
 	hasher              hashing.Hasher
 	systemSCConfig      *config.SystemSmartContractsConfig
+	shardCoordinator    vm.ShardCoordinator
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -32,6 +33,7 @@ type ArgsNewSystemSCFactory struct {
 	Marshalizer         marshal.Marshalizer
 	Hasher              hashing.Hasher
 	SystemSCConfig      *config.SystemSmartContractsConfig
+	ShardCoordinator    vm.ShardCoordinator
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -57,6 +59,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if args.SystemSCConfig == nil {
 		return nil, vm.ErrNilSystemSCConfig
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, vm.ErrNilShardCoordinator
+	}
 
 	scf := &systemSCFactory{
 		systemEI:            args.SystemEI,
@@ -66,6 +71,7 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		marshalizer:         args.Marshalizer,
 		hasher:              args.Hasher,
 		systemSCConfig:      args.SystemSCConfig,
+		shardCoordinator:    args.ShardCoordinator,
 	}
 
 	err := scf.createGasConfig(args.GasMap)
@@ -160,12 +166,13 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 	}
 
 	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
-		Eei:           scf.systemEI,
-		GasCost:       scf.gasCost,
-		ESDTSCAddress: ESDTSCAddress,
-		Marshalizer:   scf.marshalizer,
-		Hasher:        scf.hasher,
-		ESDTSCConfig:  scf.systemSCConfig.ESDTSystemSCConfig,
+		Eei:              scf.systemEI,
+		GasCost:          scf.gasCost,
+		ESDTSCAddress:    ESDTSCAddress,
+		Marshalizer:      scf.marshalizer,
+		Hasher:           scf.hasher,
+		ESDTSCConfig:     scf.systemSCConfig.ESDTSystemSCConfig,
+		ShardCoordinator: scf.shardCoordinator,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	if err != nil {
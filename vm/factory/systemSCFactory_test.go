@@ -23,6 +23,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		Hasher:              &mock.HasherMock{},
+		ShardCoordinator:    &mock.ShardCoordinatorStub{},
 		SystemSCConfig: &config.SystemSmartContractsConfig{
 			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
 				BaseIssuingCost: "100000000",
@@ -54,6 +55,17 @@ func TestNewSystemSCFactory_NilEconomicsData(t *testing.T) {
 	assert.Equal(t, vm.ErrNilEconomicsData, err)
 }
 
+func TestNewSystemSCFactory_NilShardCoordinator(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.ShardCoordinator = nil
+	scFactory, err := NewSystemSCFactory(arguments)
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilShardCoordinator, err)
+}
+
 func TestNewSystemSCFactory_Ok(t *testing.T) {
 	t.Parallel()
 
@@ -93,3 +93,9 @@ type NodesConfigProvider interface {
 	MinNumberOfNodes() uint32
 	IsInterfaceNil() bool
 }
+
+// ShardCoordinator defines the shard information needed by the system smart contracts
+type ShardCoordinator interface {
+	NumberOfShards() uint32
+	IsInterfaceNil() bool
+}
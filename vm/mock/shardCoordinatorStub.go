@@ -0,0 +1,19 @@
+package mock
+
+// ShardCoordinatorStub -
+type ShardCoordinatorStub struct {
+	NumberOfShardsCalled func() uint32
+}
+
+// NumberOfShards -
+func (s *ShardCoordinatorStub) NumberOfShards() uint32 {
+	if s.NumberOfShardsCalled != nil {
+		return s.NumberOfShardsCalled()
+	}
+	return 1
+}
+
+// IsInterfaceNil -
+func (s *ShardCoordinatorStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -31,6 +31,8 @@ func FillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveUserName"] = value
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
+	gasMap["ESDTBurn"] = value
+	gasMap["ESDTMultiTransfer"] = value
 
 	return gasMap
 }
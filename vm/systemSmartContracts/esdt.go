@@ -4,6 +4,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -23,27 +24,31 @@ const mintable = "mintable"
 const canPause = "canPause"
 const canFreeze = "canFreeze"
 const canWipe = "canWipe"
+const trueValue = "true"
+const falseValue = "false"
 
 const conversionBase = 10
 
 type esdt struct {
-	eei             vm.SystemEI
-	gasCost         vm.GasCost
-	baseIssuingCost *big.Int
-	ownerAddress    []byte
-	eSDTSCAddress   []byte
-	marshalizer     marshal.Marshalizer
-	hasher          hashing.Hasher
+	eei              vm.SystemEI
+	gasCost          vm.GasCost
+	baseIssuingCost  *big.Int
+	ownerAddress     []byte
+	eSDTSCAddress    []byte
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	shardCoordinator vm.ShardCoordinator
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
 type ArgsNewESDTSmartContract struct {
-	Eei           vm.SystemEI
-	GasCost       vm.GasCost
-	ESDTSCConfig  config.ESDTSystemSCConfig
-	ESDTSCAddress []byte
-	Marshalizer   marshal.Marshalizer
-	Hasher        hashing.Hasher
+	Eei              vm.SystemEI
+	GasCost          vm.GasCost
+	ESDTSCConfig     config.ESDTSystemSCConfig
+	ESDTSCAddress    []byte
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator vm.ShardCoordinator
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -57,6 +62,9 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	if check.IfNil(args.Hasher) {
 		return nil, vm.ErrNilHasher
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, vm.ErrNilShardCoordinator
+	}
 
 	baseIssuingCost, ok := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, conversionBase)
 	if !ok || baseIssuingCost.Cmp(big.NewInt(0)) < 0 {
@@ -64,13 +72,14 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	}
 
 	return &esdt{
-		eei:             args.Eei,
-		gasCost:         args.GasCost,
-		baseIssuingCost: baseIssuingCost,
-		ownerAddress:    []byte(args.ESDTSCConfig.OwnerAddress),
-		eSDTSCAddress:   args.ESDTSCAddress,
-		hasher:          args.Hasher,
-		marshalizer:     args.Marshalizer,
+		eei:              args.Eei,
+		gasCost:          args.GasCost,
+		baseIssuingCost:  baseIssuingCost,
+		ownerAddress:     []byte(args.ESDTSCConfig.OwnerAddress),
+		eSDTSCAddress:    args.ESDTSCAddress,
+		hasher:           args.Hasher,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
 	}, nil
 }
 
@@ -87,18 +96,20 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.issue(args)
 	case "issueProtected":
 		return e.issueProtected(args)
-	case "burn":
+	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
 		return e.mint(args)
 	case "freeze":
-		return e.freeze(args)
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTFreeze)
+	case "unFreeze":
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTUnFreeze)
 	case "wipe":
 		return e.wipe(args)
 	case "pause":
-		return e.pause(args)
+		return e.togglePause(args, core.BuiltInFunctionESDTPause)
 	case "unPause":
-		return e.unpause(args)
+		return e.togglePause(args, core.BuiltInFunctionESDTUnPause)
 	case "claim":
 		return e.claim(args)
 	case "configChange":
@@ -107,7 +118,8 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.esdtControlChanges(args)
 	}
 
-	return vmcommon.Ok
+	e.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.UserError
 }
 
 func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
@@ -125,7 +137,11 @@ func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 }
 
 func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	if !bytes.Equal(args.CallerAddr, e.ownerAddress) {
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
 		return vmcommon.UserError
 	}
 	if len(args.Arguments) < 3 {
@@ -134,10 +150,10 @@ func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 	if len(args.Arguments[0]) < len(args.CallerAddr) {
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		return vmcommon.OutOfFunds
 	}
-	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		return vmcommon.OutOfGas
 	}
@@ -151,16 +167,21 @@ func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 }
 
 func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		return vmcommon.UserError
+	}
 	if len(args.Arguments) < 2 {
 		return vmcommon.FunctionWrongSignature
 	}
-	if len(args.Arguments[0]) < minLengthForTokenName || len(args.Arguments[0]) > maxLengthForTokenName {
+	tokenNameLength := uint32(len(args.Arguments[0]))
+	if tokenNameLength < esdtConfig.MinTokenNameLength || tokenNameLength > esdtConfig.MaxTokenNameLength {
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		return vmcommon.OutOfFunds
 	}
-	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		return vmcommon.OutOfGas
 	}
@@ -220,60 +241,423 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 
 	e.eei.SetStorage(tokenName, marshalledData)
 
-	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(initialSupply.Bytes())
-	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	return e.sendESDTTransfer(owner, tokenName, initialSupply)
+}
+
+func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("number of arguments must be equal with 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	// the burnt tokens are removed from the balance by the ESDTBurn built-in function in the sender shard. Only the
+	// calls created on the metachain by the same built-in function are accepted, as any other call would give back,
+	// for a token which is not burnable, a value which was never removed
+	if args.CallType != vm.BuiltInFunctionCallType {
+		e.eei.AddReturnMessage("burn can be called only through the ESDTBurn built-in function")
+		return vmcommon.UserError
+	}
+	// a smart contract result sent by a smart contract runs the built-in function only on the metachain, so no value
+	// was removed from the balance of the smart contract
+	if core.IsSmartContractAddress(args.CallerAddr) {
+		e.eei.AddReturnMessage("burn can be called only by user accounts")
+		return vmcommon.UserError
+	}
+	burntValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if burntValue.Cmp(zero) <= 0 {
+		return vmcommon.UserError
+	}
+	token, err := e.getExistingToken(args.Arguments[0])
 	if err != nil {
-		return err
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return vmcommon.OutOfGas
 	}
 
-	return nil
-}
+	if !token.Burnable {
+		err = e.sendESDTTransfer(args.CallerAddr, args.Arguments[0], burntValue)
+		if err != nil {
+			return vmcommon.UserError
+		}
+
+		e.eei.AddReturnMessage("token is not burnable, the value was sent back")
+		return vmcommon.Ok
+	}
+
+	token.BurntValue.Add(token.BurntValue, burntValue)
+	err = e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
 
-func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
 	return vmcommon.Ok
 }
 
 func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) < 2 || len(args.Arguments) > 3 {
+		e.eei.AddReturnMessage("accepted arguments number 2/3")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if mintValue.Cmp(zero) <= 0 {
+		return vmcommon.UserError
+	}
+	token, returnCode := e.getTokenForOwnerOperation(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.Mintable {
+		e.eei.AddReturnMessage("token is not mintable")
+		return vmcommon.UserError
+	}
+
+	destination := token.IssuerAddress
+	if len(args.Arguments) == 3 {
+		if len(args.Arguments[2]) != len(args.CallerAddr) {
+			e.eei.AddReturnMessage("destination address of invalid length")
+			return vmcommon.UserError
+		}
+		destination = args.Arguments[2]
+	}
+
+	token.MintedValue.Add(token.MintedValue, mintValue)
+	err := e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	err = e.sendESDTTransfer(destination, token.TokenName, mintValue)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) freeze(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
-	return vmcommon.Ok
+func (e *esdt) toggleFreeze(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	token, returnCode := e.getTokenForOwnerOperation(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanFreeze {
+		e.eei.AddReturnMessage("cannot freeze")
+		return vmcommon.UserError
+	}
+
+	return e.sendBuiltInFunctionCall(args.Arguments[1], builtInFunc, token.TokenName)
 }
 
 func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
-	return vmcommon.Ok
-}
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	token, returnCode := e.getTokenForOwnerOperation(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanWipe {
+		e.eei.AddReturnMessage("cannot wipe")
+		return vmcommon.UserError
+	}
 
-func (e *esdt) pause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
-	return vmcommon.Ok
+	return e.sendBuiltInFunctionCall(args.Arguments[1], core.BuiltInFunctionESDTWipe, token.TokenName)
 }
 
-func (e *esdt) unpause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+func (e *esdt) togglePause(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 1")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	token, returnCode := e.getTokenForOwnerOperation(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanPause {
+		e.eei.AddReturnMessage("cannot pause/un-pause")
+		return vmcommon.UserError
+	}
+
+	pause := builtInFunc == core.BuiltInFunctionESDTPause
+	if token.Paused == pause {
+		e.eei.AddReturnMessage("cannot change the pause state to the current one")
+		return vmcommon.UserError
+	}
+
+	token.Paused = pause
+	err := e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	// the pause state is kept in each shard by the ESDT global settings account of that shard
+	for i := uint32(0); i < e.shardCoordinator.NumberOfShards(); i++ {
+		returnCode = e.sendBuiltInFunctionCall(core.ESDTGlobalSettingsAddress(i), builtInFunc, token.TokenName)
+		if returnCode != vmcommon.Ok {
+			return returnCode
+		}
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) configChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
+		e.eei.AddReturnMessage("configChange can be called by whitelisted address only")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 4 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 4")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return vmcommon.OutOfGas
+	}
+
+	newOwner := args.Arguments[0]
+	if len(newOwner) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("new owner address of invalid length")
+		return vmcommon.UserError
+	}
+	minTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[2])
+	maxTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[3])
+	if !minTokenNameLength.IsUint64() || !maxTokenNameLength.IsUint64() ||
+		minTokenNameLength.Uint64() > maxTokenNameLength.Uint64() || maxTokenNameLength.Uint64() > math.MaxUint32 {
+		e.eei.AddReturnMessage("invalid token name length limits")
+		return vmcommon.UserError
+	}
+
+	esdtConfig.OwnerAddress = newOwner
+	esdtConfig.BaseIssuingCost = big.NewInt(0).SetBytes(args.Arguments[1])
+	esdtConfig.MinTokenNameLength = uint32(minTokenNameLength.Uint64())
+	esdtConfig.MaxTokenNameLength = uint32(maxTokenNameLength.Uint64())
+	err = e.saveESDTConfig(esdtConfig)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) claim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
+		e.eei.AddReturnMessage("claim can be called by whitelisted address only")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return vmcommon.OutOfGas
+	}
+
+	scBalance := e.eei.GetBalance(args.RecipientAddr)
+	if scBalance == nil {
+		return vmcommon.UserError
+	}
+	err = e.eei.Transfer(args.CallerAddr, args.RecipientAddr, scBalance, nil, 0)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) esdtControlChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) < 3 || len(args.Arguments)%2 == 0 {
+		e.eei.AddReturnMessage("the token name has to be followed by pairs of property and value")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		return vmcommon.UserError
+	}
+	token, returnCode := e.getTokenForOwnerOperation(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for i := 1; i < len(args.Arguments); i += 2 {
+		value, err := parseBoolValue(args.Arguments[i+1])
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		switch string(args.Arguments[i]) {
+		case burnable:
+			token.Burnable = value
+		case mintable:
+			token.Mintable = value
+		case canPause:
+			token.CanPause = value
+		case canFreeze:
+			token.CanFreeze = value
+		case canWipe:
+			token.CanWipe = value
+		default:
+			e.eei.AddReturnMessage("unknown property " + string(args.Arguments[i]))
+			return vmcommon.UserError
+		}
+	}
+
+	err := e.saveToken(token)
+	if err != nil {
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func parseBoolValue(value []byte) (bool, error) {
+	switch string(value) {
+	case trueValue:
+		return true, nil
+	case falseValue:
+		return false, nil
+	}
+
+	return false, vm.ErrInvalidPropertyValue
+}
+
+// getTokenForOwnerOperation checks that the caller is the owner of the token from the first argument, consumes the
+// gas for the operation and returns the token data
+func (e *esdt) getTokenForOwnerOperation(args *vmcommon.ContractCallInput) (*ESDTData, vmcommon.ReturnCode) {
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if !bytes.Equal(token.IssuerAddress, args.CallerAddr) {
+		e.eei.AddReturnMessage("can be called by owner only")
+		return nil, vmcommon.UserError
+	}
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return nil, vmcommon.OutOfGas
+	}
+
+	return token, vmcommon.Ok
+}
+
+func (e *esdt) sendBuiltInFunctionCall(destination []byte, builtInFunc string, tokenName []byte) vmcommon.ReturnCode {
+	callData := builtInFunc + "@" + hex.EncodeToString(tokenName)
+	err := e.eei.Transfer(destination, e.eSDTSCAddress, big.NewInt(0), []byte(callData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
+func (e *esdt) sendESDTTransfer(destination []byte, tokenName []byte, value *big.Int) error {
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(value.Bytes())
+	return e.eei.Transfer(destination, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+}
+
+func (e *esdt) getExistingToken(tokenName []byte) (*ESDTData, error) {
+	marshalledData := e.eei.GetStorage(tokenName)
+	if len(marshalledData) == 0 {
+		return nil, vm.ErrTokenNotRegistered
+	}
+
+	token := &ESDTData{}
+	err := e.marshalizer.Unmarshal(token, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+	if token.MintedValue == nil {
+		token.MintedValue = big.NewInt(0)
+	}
+	if token.BurntValue == nil {
+		token.BurntValue = big.NewInt(0)
+	}
+
+	return token, nil
+}
+
+func (e *esdt) saveToken(token *ESDTData) error {
+	marshalledData, err := e.marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage(token.TokenName, marshalledData)
+	return nil
+}
+
+// getESDTConfig returns the config saved by the init function or the one received at construction if the init was
+// not yet called
+func (e *esdt) getESDTConfig() (*ESDTConfig, error) {
+	esdtConfig := &ESDTConfig{
+		OwnerAddress:       e.ownerAddress,
+		BaseIssuingCost:    e.baseIssuingCost,
+		MinTokenNameLength: minLengthForTokenName,
+		MaxTokenNameLength: maxLengthForTokenName,
+	}
+	marshalledData := e.eei.GetStorage([]byte(configKeyPrefix))
+	if len(marshalledData) == 0 {
+		return esdtConfig, nil
+	}
+
+	err := e.marshalizer.Unmarshal(esdtConfig, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+	if esdtConfig.BaseIssuingCost == nil {
+		esdtConfig.BaseIssuingCost = big.NewInt(0)
+	}
+
+	return esdtConfig, nil
+}
+
+func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
+	marshalledData, err := e.marshalizer.Marshal(esdtConfig)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage([]byte(configKeyPrefix), marshalledData)
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (e *esdt) IsInterfaceNil() bool {
 	return e == nil
@@ -1,14 +1,17 @@
 package systemSmartContracts
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
@@ -18,9 +21,10 @@ func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
 		ESDTSCConfig: config.ESDTSystemSCConfig{
 			BaseIssuingCost: "1000",
 		},
-		ESDTSCAddress: []byte("address"),
-		Marshalizer:   &mock.MarshalizerMock{},
-		Hasher:        &mock.HasherMock{},
+		ESDTSCAddress:    []byte("address"),
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		ShardCoordinator: &mock.ShardCoordinatorStub{},
 	}
 }
 
@@ -34,6 +38,17 @@ func TestNewESDTSmartContract(t *testing.T) {
 	assert.NotNil(t, e)
 }
 
+func TestNewESDTSmartContract_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ShardCoordinator = nil
+	e, err := NewESDTSmartContract(args)
+
+	assert.Nil(t, e)
+	assert.Equal(t, vm.ErrNilShardCoordinator, err)
+}
+
 func TestEsdt_ExecuteIssue(t *testing.T) {
 	t.Parallel()
 
@@ -133,3 +148,336 @@ func TestEsdt_ExecuteIssueProtected(t *testing.T) {
 
 	assert.Equal(t, vmcommon.Ok, output)
 }
+
+type esdtTransferRecord struct {
+	destination []byte
+	value       *big.Int
+	data        string
+}
+
+func createESDTWithIssuedToken(
+	t *testing.T,
+	args ArgsNewESDTSmartContract,
+	properties ...string,
+) (*esdt, *[]esdtTransferRecord, []byte, []byte) {
+	storage := make(map[string][]byte)
+	transfers := make([]esdtTransferRecord, 0)
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+		TransferCalled: func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+			transfers = append(transfers, esdtTransferRecord{destination: destination, value: value, data: string(input)})
+			return nil
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	owner := []byte("owner")
+	tokenName := []byte("0123456789ab")
+	arguments := [][]byte{tokenName, big.NewInt(100).Bytes()}
+	for _, property := range properties {
+		arguments = append(arguments, []byte(property))
+	}
+	vmInput := createESDTCallInput(owner, "issue", arguments...)
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	transfers = transfers[:0]
+
+	return e, &transfers, owner, tokenName
+}
+
+func createESDTCallInput(caller []byte, function string, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  arguments,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: []byte("address"),
+		Function:      function,
+	}
+}
+
+func getStoredToken(t *testing.T, e *esdt, tokenName []byte) *ESDTData {
+	token, err := e.getExistingToken(tokenName)
+	require.Nil(t, err)
+
+	return token
+}
+
+func TestEsdt_ExecuteUnknownFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewESDTSmartContract(createMockArgumentsForESDT())
+
+	output := e.Execute(createESDTCallInput([]byte("caller"), "unknown"))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteMint(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, owner, tokenName := createESDTWithIssuedToken(t, args, mintable)
+
+	output := e.Execute(createESDTCallInput([]byte("other"), "mint", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", []byte("not issued"), big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(0).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	destination := []byte("dest1")
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes(), destination))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(110), getStoredToken(t, e, tokenName).MintedValue)
+	require.Equal(t, 1, len(*transfers))
+	assert.Equal(t, destination, (*transfers)[0].destination)
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+	assert.Equal(t, expectedData, (*transfers)[0].data)
+}
+
+func TestEsdt_ExecuteMintNotMintableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, owner, tokenName := createESDTWithIssuedToken(t, args)
+
+	output := e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, 0, len(*transfers))
+}
+
+func TestEsdt_ExecuteFreezeAndUnFreeze(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, owner, tokenName := createESDTWithIssuedToken(t, args, canFreeze)
+	account := []byte("account")
+
+	output := e.Execute(createESDTCallInput([]byte("other"), "freeze", tokenName, account))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "freeze", tokenName, account))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(owner, "unFreeze", tokenName, account))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	require.Equal(t, 2, len(*transfers))
+	assert.Equal(t, account, (*transfers)[0].destination)
+	assert.Equal(t, core.BuiltInFunctionESDTFreeze+"@"+hex.EncodeToString(tokenName), (*transfers)[0].data)
+	assert.Equal(t, account, (*transfers)[1].destination)
+	assert.Equal(t, core.BuiltInFunctionESDTUnFreeze+"@"+hex.EncodeToString(tokenName), (*transfers)[1].data)
+}
+
+func TestEsdt_ExecuteFreezeCannotFreezeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, _, owner, tokenName := createESDTWithIssuedToken(t, args)
+
+	output := e.Execute(createESDTCallInput(owner, "freeze", tokenName, []byte("account")))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteWipe(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, _, owner, tokenName := createESDTWithIssuedToken(t, args)
+	output := e.Execute(createESDTCallInput(owner, "wipe", tokenName, []byte("account")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	e, transfers, owner, tokenName := createESDTWithIssuedToken(t, args, canWipe)
+	output = e.Execute(createESDTCallInput(owner, "wipe", tokenName, []byte("account")))
+	assert.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(*transfers))
+	assert.Equal(t, core.BuiltInFunctionESDTWipe+"@"+hex.EncodeToString(tokenName), (*transfers)[0].data)
+}
+
+func TestEsdt_ExecutePauseAndUnPause(t *testing.T) {
+	t.Parallel()
+
+	numShards := uint32(3)
+	args := createMockArgumentsForESDT()
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return numShards
+		},
+	}
+	e, transfers, owner, tokenName := createESDTWithIssuedToken(t, args, canPause)
+
+	output := e.Execute(createESDTCallInput(owner, "unPause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "pause", tokenName))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.True(t, getStoredToken(t, e, tokenName).Paused)
+	require.Equal(t, int(numShards), len(*transfers))
+	for i := uint32(0); i < numShards; i++ {
+		assert.Equal(t, core.ESDTGlobalSettingsAddress(i), (*transfers)[i].destination)
+		assert.Equal(t, core.BuiltInFunctionESDTPause+"@"+hex.EncodeToString(tokenName), (*transfers)[i].data)
+	}
+
+	output = e.Execute(createESDTCallInput(owner, "pause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "unPause", tokenName))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.False(t, getStoredToken(t, e, tokenName).Paused)
+	require.Equal(t, int(2*numShards), len(*transfers))
+	assert.Equal(t, core.BuiltInFunctionESDTUnPause+"@"+hex.EncodeToString(tokenName), (*transfers)[numShards].data)
+}
+
+func createESDTBurnCallInput(caller []byte, tokenName []byte, value *big.Int) *vmcommon.ContractCallInput {
+	input := createESDTCallInput(caller, core.BuiltInFunctionESDTBurn, tokenName, value.Bytes())
+	input.CallType = vm.BuiltInFunctionCallType
+
+	return input
+}
+
+func TestEsdt_ExecuteBurn(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, _, tokenName := createESDTWithIssuedToken(t, args, burnable)
+	holder := []byte("holder")
+
+	output := e.Execute(createESDTBurnCallInput(holder, tokenName, big.NewInt(30)))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(30), getStoredToken(t, e, tokenName).BurntValue)
+	assert.Equal(t, 0, len(*transfers))
+
+	scAddress := make([]byte, 32)
+	output = e.Execute(createESDTBurnCallInput(scAddress, tokenName, big.NewInt(30)))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, big.NewInt(30), getStoredToken(t, e, tokenName).BurntValue)
+}
+
+func TestEsdt_ExecuteBurnNotThroughTheBuiltInFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, _, tokenName := createESDTWithIssuedToken(t, args)
+	holder := []byte("holder")
+
+	output := e.Execute(createESDTCallInput(holder, core.BuiltInFunctionESDTBurn, tokenName, big.NewInt(30).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, big.NewInt(0), getStoredToken(t, e, tokenName).BurntValue)
+	assert.Equal(t, 0, len(*transfers))
+}
+
+func TestEsdt_ExecuteBurnNotBurnableShouldSendBack(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, transfers, _, tokenName := createESDTWithIssuedToken(t, args)
+	holder := []byte("holder")
+
+	output := e.Execute(createESDTBurnCallInput(holder, tokenName, big.NewInt(30)))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(0), getStoredToken(t, e, tokenName).BurntValue)
+	require.Equal(t, 1, len(*transfers))
+	assert.Equal(t, holder, (*transfers)[0].destination)
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(big.NewInt(30).Bytes())
+	assert.Equal(t, expectedData, (*transfers)[0].data)
+}
+
+func TestEsdt_ExecuteEsdtControlChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	e, _, owner, tokenName := createESDTWithIssuedToken(t, args, burnable)
+
+	output := e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable)))
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable), []byte("yes")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte("unknown"), []byte(trueValue)))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("other"), "esdtControlChanges", tokenName, []byte(mintable), []byte(trueValue)))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName,
+		[]byte(mintable), []byte(trueValue),
+		[]byte(burnable), []byte(falseValue),
+		[]byte(canWipe), []byte(trueValue),
+	))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getStoredToken(t, e, tokenName)
+	assert.True(t, token.Mintable)
+	assert.False(t, token.Burnable)
+	assert.True(t, token.CanWipe)
+	assert.False(t, token.CanPause)
+}
+
+func TestEsdt_ExecuteConfigChange(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = "scOwner"
+	e, _, _, _ := createESDTWithIssuedToken(t, args)
+
+	newOwner := []byte("owner02")
+	arguments := [][]byte{newOwner, big.NewInt(500).Bytes(), big.NewInt(5).Bytes(), big.NewInt(6).Bytes()}
+	output := e.Execute(createESDTCallInput([]byte("other"), "configChange", arguments...))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("scOwner"), "configChange", newOwner, big.NewInt(500).Bytes(), big.NewInt(7).Bytes(), big.NewInt(6).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("scOwner"), "configChange", arguments...))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	esdtConfig, err := e.getESDTConfig()
+	require.Nil(t, err)
+	assert.Equal(t, newOwner, esdtConfig.OwnerAddress)
+	assert.Equal(t, big.NewInt(500), esdtConfig.BaseIssuingCost)
+	assert.Equal(t, uint32(5), esdtConfig.MinTokenNameLength)
+	assert.Equal(t, uint32(6), esdtConfig.MaxTokenNameLength)
+
+	vmInput := createESDTCallInput([]byte("issuer"), "issue", []byte("12345"), big.NewInt(100).Bytes())
+	vmInput.CallValue = big.NewInt(500)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteClaim(t *testing.T) {
+	t.Parallel()
+
+	scBalance := big.NewInt(1000)
+	claimedValue := big.NewInt(0)
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = "scOwner"
+	args.Eei = &mock.SystemEIStub{
+		GetBalanceCalled: func(addr []byte) *big.Int {
+			return scBalance
+		},
+		TransferCalled: func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+			assert.Equal(t, []byte("scOwner"), destination)
+			claimedValue.Add(claimedValue, value)
+			return nil
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	output := e.Execute(createESDTCallInput([]byte("other"), "claim"))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("scOwner"), "claim"))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, scBalance, claimedValue)
+}